@@ -0,0 +1,231 @@
+package sep
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Admission is the result of checking a DERControlBase against what a DER
+// can do.
+type Admission struct {
+	// Responded and Responded2 are the modes of the control the DER can
+	// honour, as the modesResponded and modesResponded2 of a
+	// DERControlResponse.
+	Responded  *DERControlType
+	Responded2 *DERControlType2
+	// Problems are the modes it cannot honour, and why.
+	Problems []AdmissionProblem
+}
+
+// OK reports whether the DER can honour every mode of the control.
+func (a *Admission) OK() bool { return len(a.Problems) == 0 }
+
+// AdmissionProblem is a mode of a control a DER cannot honour.
+type AdmissionProblem struct {
+	// Mode is the name of the mode, such as "opModTargetW".
+	Mode string
+	Msg  string
+}
+
+// String describes p.
+func (p AdmissionProblem) String() string { return p.Mode + ": " + p.Msg }
+
+// AdmitDERControl checks whether a DER with the given capability and
+// settings can honour each mode b sets: the mode must be in modesSupported
+// or modesSupported2, if capability is given, and enabled by settings, if
+// they are given, and its setpoint within the ratings of the DER. A curve
+// must be of the mode's curveType, pass DERCurve.Check, and not ask for
+// more vars, watts or a lower power factor than the DER is rated for;
+// curves are only checked if curves is not nil, and one it does not
+// resolve cannot be honoured.
+func AdmitDERControl(b *DERControlBase, capability *DERCapability, settings *DERSettings, curves CurveResolver) *Admission {
+	a := &Admission{Responded: NewDERControlModes(), Responded2: NewDERControlModes2()}
+	if capability == nil {
+		capability = &DERCapability{ModesSupported: NewDERControlModes(), ModesSupported2: NewDERControlModes2()}
+		capability.ModesSupported.SetBits(1<<len(derControlModeNames) - 1)
+		capability.ModesSupported2.SetBits(1<<len(derControlMode2Names) - 1)
+	}
+	for _, mode := range b.Modes() {
+		if msg := admitMode(b, mode, capability, settings, curves); msg != "" {
+			a.Problems = append(a.Problems, AdmissionProblem{mode, msg})
+			continue
+		}
+		if i := slices.Index(derControlModeNames, mode); i >= 0 {
+			a.Responded.Set(DERControlMode(i))
+		} else if i := slices.Index(derControlMode2Names, mode); i >= 0 {
+			a.Responded2.Set(DERControlMode2(i))
+		}
+	}
+	return a
+}
+
+// admitMode returns why the DER cannot honour mode of b, or "" if it can.
+func admitMode(b *DERControlBase, mode string, capability *DERCapability, settings *DERSettings, curves CurveResolver) string {
+	if i := slices.Index(derControlModeNames, mode); i >= 0 && !capability.ModesSupported.Has(DERControlMode(i)) {
+		return "not in modesSupported"
+	}
+	if i := slices.Index(derControlMode2Names, mode); i >= 0 && !capability.ModesSupported2.Has(DERControlMode2(i)) {
+		return "not in modesSupported2"
+	}
+	if settings != nil && !settings.ModeEnabled(mode) {
+		return "not enabled in DERSettings"
+	}
+	maxW, maxChargeW := floatOr(capability.RtgMaxW), floatOr(capability.RtgMaxChargeRateW)
+	if math.IsNaN(maxChargeW) {
+		maxChargeW = maxW
+	}
+	maxVar, maxVarNeg := floatOr(capability.RtgMaxVar), math.Abs(floatOr(capability.RtgMaxVarNeg))
+	if math.IsNaN(maxVarNeg) {
+		maxVarNeg = maxVar
+	}
+	switch mode {
+	case "opModFixedPFAbsorbW":
+		return admitPF(b.OpModFixedPFAbsorbW.PowerFactorWithExcitation, capability)
+	case "opModFixedPFInjectW":
+		return admitPF(b.OpModFixedPFInjectW.PowerFactorWithExcitation, capability)
+	case "opModFixedW":
+		if v := b.OpModFixedW.SignedPerCent; v != nil {
+			return inPercent(float64(v.Value()), -10000)
+		}
+	case "opModFixedVar":
+		if v := b.OpModFixedVar.FixedVar; v != nil && v.Value != nil {
+			return inPercent(float64(v.Value.Value()), -10000)
+		}
+	case "opModMaxLimW", "opModMaxLimPctVAAbsorb", "opModMaxLimPctVAInject", "opModMaxLimPctWAbsorb":
+		if v := b.mode(mode).(*PerCentControlType).PerCent; v != nil {
+			return inPercent(float64(v.Value()), 0)
+		}
+	case "opModMaxLimPctVarAbsorb", "opModMaxLimPctVarInject":
+		if v := b.mode(mode).(*UnsignedFixedVarControlType).UnsignedFixedVar; v != nil && v.Value != nil {
+			return inPercent(float64(v.Value.Value()), 0)
+		}
+	case "opModTargetW":
+		w := b.OpModTargetW.ActivePower.Float64()
+		if w < 0 {
+			return withinRating(-w, maxChargeW, "W", "rtgMaxChargeRateW")
+		}
+		return withinRating(w, maxW, "W", "rtgMaxW")
+	case "opModMaxLimWInject":
+		return withinRating(b.OpModMaxLimWInject.UnsignedActivePower.Float64(), maxW, "W", "rtgMaxW")
+	case "opModMaxLimWAbsorb":
+		return withinRating(b.OpModMaxLimWAbsorb.UnsignedActivePower.Float64(), maxChargeW, "W", "rtgMaxChargeRateW")
+	case "opModTargetVar":
+		v := b.OpModTargetVar.ReactivePower.Float64()
+		if v < 0 {
+			return withinRating(-v, maxVarNeg, "var", "rtgMaxVarNeg")
+		}
+		return withinRating(v, maxVar, "var", "rtgMaxVar")
+	case "opModMaxLimVarInject":
+		return withinRating(b.OpModMaxLimVarInject.UnsignedReactivePower.Float64(), maxVar, "var", "rtgMaxVar")
+	case "opModMaxLimVarAbsorb":
+		return withinRating(b.OpModMaxLimVarAbsorb.UnsignedReactivePower.Float64(), maxVarNeg, "var", "rtgMaxVarNeg")
+	case "opModTargetV":
+		v := b.OpModTargetV.VoltageRMS.Float64()
+		if lo := floatOr(capability.RtgMinV); v < lo {
+			return fmt.Sprintf("%g V is below rtgMinV %g V", v, lo)
+		}
+		return withinRating(v, floatOr(capability.RtgMaxV), "V", "rtgMaxV")
+	}
+	if l, ok := b.mode(mode).(*DERCurveLink); ok && curves != nil {
+		return admitCurve(mode, curves(l), capability, settings, maxW, maxChargeW, maxVar, maxVarNeg)
+	}
+	return ""
+}
+
+// admitCurve returns why a DER cannot execute curve for mode, or "".
+func admitCurve(mode string, curve *DERCurve, capability *DERCapability, settings *DERSettings, maxW, maxChargeW, maxVar, maxVarNeg float64) string {
+	if curve == nil {
+		return "curve not available"
+	}
+	m, _ := curve.Mode()
+	if m.String() != mode {
+		return fmt.Sprintf("curve has curveType %s", m)
+	}
+	if err := curve.Check(); err != nil {
+		return fmt.Sprintf("invalid curve: %v", err.(ValidationErrors)[0].Msg)
+	}
+	e := CurveEvaluator{Settings: settings}
+	for _, p := range curve.Points() {
+		switch m {
+		case CurveWattPF:
+			if msg := admitPFValue(p.Y, *p.Excitation, capability); msg != "" {
+				return msg
+			}
+			continue
+		case CurveVoltVar, CurveWattVar:
+			v, err := e.reference(curve.UnitRef(), p.Y)
+			if err != nil {
+				continue
+			}
+			if v < 0 {
+				if msg := withinRating(-v, maxVarNeg, "var", "rtgMaxVarNeg"); msg != "" {
+					return msg
+				}
+			} else if msg := withinRating(v, maxVar, "var", "rtgMaxVar"); msg != "" {
+				return msg
+			}
+		case CurveVoltWatt, CurveFreqWatt:
+			ref := curve.UnitRef()
+			if ref == UnitRefNA {
+				ref = UnitRefSetMaxW
+			}
+			v, err := e.reference(ref, p.Y)
+			if err != nil {
+				continue
+			}
+			if v < 0 {
+				if msg := withinRating(-v, maxChargeW, "W", "rtgMaxChargeRateW"); msg != "" {
+					return msg
+				}
+			} else if msg := withinRating(v, maxW, "W", "rtgMaxW"); msg != "" {
+				return msg
+			}
+		}
+	}
+	return ""
+}
+
+// admitPF returns why a DER cannot hold the power factor pf, or "".
+func admitPF(pf *PowerFactorWithExcitation, capability *DERCapability) string {
+	if pf == nil {
+		return ""
+	}
+	return admitPFValue(pf.Float64(), pf.Excitation, capability)
+}
+
+// admitPFValue returns why a DER cannot hold the displacement power factor
+// pf, absorbing vars if absorbing, or "".
+func admitPFValue(pf float64, absorbing bool, capability *DERCapability) string {
+	if pf <= 0 || pf > 1 {
+		return fmt.Sprintf("power factor %g out of range (0, 1]", pf)
+	}
+	rtg, name := capability.RtgMinPFOverExcited, "rtgMinPFOverExcited"
+	if absorbing {
+		rtg, name = capability.RtgMinPFUnderExcited, "rtgMinPFUnderExcited"
+	}
+	if rtg != nil {
+		if lo := rtg.Float64(); pf < lo {
+			return fmt.Sprintf("power factor %g is below %s %g", pf, name, lo)
+		}
+	}
+	return ""
+}
+
+// inPercent returns why v, in hundredths of a percent, is not from lo to
+// 100%, or "".
+func inPercent(v, lo float64) string {
+	if v < lo || v > 10000 {
+		return fmt.Sprintf("%g%% out of range %g%% to 100%%", v/100, lo/100)
+	}
+	return ""
+}
+
+// withinRating returns why v is more than the rating hi named name, or
+// "". A rating the DER does not give, NaN, does not limit v.
+func withinRating(v, hi float64, unit, name string) string {
+	if v > hi {
+		return fmt.Sprintf("%g %s exceeds %s %g %s", v, unit, name, hi, unit)
+	}
+	return ""
+}
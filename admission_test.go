@@ -0,0 +1,188 @@
+package sep
+
+import (
+	"slices"
+	"testing"
+)
+
+// testCapability returns the DERCapability of a DER supporting every mode,
+// rated 5000 W, 3000 W charging, 2000 var, 1500 var absorbing and power
+// factors down to 0.85 over- and 0.95 under-excited.
+func testCapability() *DERCapability {
+	c := NewDERCapability()
+	c.ModesSupported, c.ModesSupported2 = NewDERControlModes(), NewDERControlModes2()
+	c.ModesSupported.SetBits(1<<len(derControlModeNames) - 1)
+	c.ModesSupported2.SetBits(1<<len(derControlMode2Names) - 1)
+	c.RtgMaxW, _ = ActivePowerFromFloat(5000)
+	c.RtgMaxChargeRateW, _ = ActivePowerFromFloat(3000)
+	c.RtgMaxVar, _ = ReactivePowerFromFloat(2000)
+	c.RtgMaxVarNeg, _ = ReactivePowerFromFloat(-1500)
+	c.RtgMinPFOverExcited, _ = PowerFactorFromFloat(0.85)
+	c.RtgMinPFUnderExcited, _ = PowerFactorFromFloat(0.95)
+	return c
+}
+
+func targetWControl(w float64) *ActivePowerControlType {
+	p, _ := ActivePowerFromFloat(w)
+	return &ActivePowerControlType{ActivePower: p}
+}
+
+func targetVarControl(v float64) *ReactivePowerControlType {
+	p, _ := ReactivePowerFromFloat(v)
+	return &ReactivePowerControlType{ReactivePower: p}
+}
+
+func pfControl(pf float64, absorbing bool) *PowerFactorWithExcitationControlType {
+	p, _ := PowerFactorWithExcitationFromFloat(pf, absorbing)
+	return &PowerFactorWithExcitationControlType{PowerFactorWithExcitation: p}
+}
+
+func TestAdmitDERControl(t *testing.T) {
+	noVoltVar := testCapability()
+	noVoltVar.ModesSupported.Clear(OpModVoltVar)
+	noTargetV := testCapability()
+	noTargetV.ModesSupported2.Clear(OpModTargetV)
+	v, _ := VoltageRMSFromFloat(240)
+
+	settings := NewDERSettings()
+	settings.ModesEnabled = NewDERControlModes(OpModTargetW)
+	settings.ModesEnabled2 = NewDERControlModes2(OpModTargetV)
+	vvSettings := NewDERSettings()
+	vvSettings.SetMaxVar, _ = ReactivePowerFromFloat(5000)
+	vvSettings.SetMaxW, _ = ActivePowerFromFloat(6000)
+
+	curves := validCurves()
+	wrongType := testCurve(CurveVoltWatt, UnitRefSetMaxW, 0, 0, [2]int{106, 100}, [2]int{110, 0})
+	invalid := testCurve(CurveVoltVar, UnitRefSetMaxVar, 0, 0, [2]int{98, 0}, [2]int{92, 44})
+	resolve := func(l *DERCurveLink) *DERCurve {
+		switch l.HrefAttr {
+		case "/dc/vv":
+			return curves[CurveVoltVar]
+		case "/dc/pf":
+			return curves[CurveWattPF]
+		case "/dc/vw":
+			return curves[CurveVoltWatt]
+		case "/dc/wrong":
+			return wrongType
+		case "/dc/invalid":
+			return invalid
+		}
+		return nil
+	}
+
+	for _, tt := range []struct {
+		name       string
+		b          *DERControlBase
+		capability *DERCapability
+		settings   *DERSettings
+		want       []string
+	}{
+		{name: "within ratings", b: &DERControlBase{
+			OpModTargetW:   targetWControl(5000),
+			OpModTargetVar: targetVarControl(-1500),
+			OpModMaxLimW:   maxLimW(10000),
+		}},
+		{name: "not in modesSupported", capability: noVoltVar,
+			b:    &DERControlBase{OpModVoltVar: curveLink("/dc/vv")},
+			want: []string{"opModVoltVar: not in modesSupported"}},
+		{name: "not in modesSupported2", capability: noTargetV,
+			b:    &DERControlBase{OpModTargetV: &VoltageRMSControlType{VoltageRMS: v}},
+			want: []string{"opModTargetV: not in modesSupported2"}},
+		{name: "not enabled", settings: settings,
+			b: &DERControlBase{
+				OpModTargetW:   targetWControl(1000),
+				OpModTargetV:   &VoltageRMSControlType{VoltageRMS: v},
+				OpModTargetVar: targetVarControl(1000),
+			},
+			want: []string{"opModTargetVar: not enabled in DERSettings"}},
+		{name: "above rtgMaxW",
+			b:    &DERControlBase{OpModTargetW: targetWControl(6000)},
+			want: []string{"opModTargetW: 6000 W exceeds rtgMaxW 5000 W"}},
+		{name: "above rtgMaxChargeRateW",
+			b:    &DERControlBase{OpModTargetW: targetWControl(-4000)},
+			want: []string{"opModTargetW: 4000 W exceeds rtgMaxChargeRateW 3000 W"}},
+		{name: "above rtgMaxVar",
+			b:    &DERControlBase{OpModTargetVar: targetVarControl(2500)},
+			want: []string{"opModTargetVar: 2500 var exceeds rtgMaxVar 2000 var"}},
+		{name: "above rtgMaxVarNeg",
+			b:    &DERControlBase{OpModTargetVar: targetVarControl(-2000)},
+			want: []string{"opModTargetVar: 2000 var exceeds rtgMaxVarNeg 1500 var"}},
+		{name: "opModMaxLimW above 100%",
+			b:    &DERControlBase{OpModMaxLimW: maxLimW(10001)},
+			want: []string{"opModMaxLimW: 100.01% out of range 0% to 100%"}},
+		{name: "opModMaxLimWInject above rtgMaxW",
+			b: &DERControlBase{OpModMaxLimWInject: &UnsignedActivePowerControlType{
+				UnsignedActivePower: &UnsignedActivePower{Multiplier: NewPowerOfTenMultiplierType(3), Value: 6},
+			}},
+			want: []string{"opModMaxLimWInject: 6000 W exceeds rtgMaxW 5000 W"}},
+		{name: "below rtgMinPFOverExcited",
+			b:    &DERControlBase{OpModFixedPFInjectW: pfControl(0.8, false)},
+			want: []string{"opModFixedPFInjectW: power factor 0.8 is below rtgMinPFOverExcited 0.85"}},
+		{name: "below rtgMinPFUnderExcited",
+			b:    &DERControlBase{OpModFixedPFAbsorbW: pfControl(0.9, true)},
+			want: []string{"opModFixedPFAbsorbW: power factor 0.9 is below rtgMinPFUnderExcited 0.95"}},
+		{name: "curve of another curveType",
+			b:    &DERControlBase{OpModVoltVar: curveLink("/dc/wrong")},
+			want: []string{"opModVoltVar: curve has curveType opModVoltWatt"}},
+		{name: "invalid curve",
+			b:    &DERControlBase{OpModVoltVar: curveLink("/dc/invalid")},
+			want: []string{"opModVoltVar: invalid curve: xvalue 92 does not increase from 98"}},
+		{name: "unresolved curve",
+			b:    &DERControlBase{OpModVoltVar: curveLink("/dc/none")},
+			want: []string{"opModVoltVar: curve not available"}},
+		{name: "curve above rtgMaxVar", settings: vvSettings,
+			b:    &DERControlBase{OpModVoltVar: curveLink("/dc/vv")},
+			want: []string{"opModVoltVar: 2200 var exceeds rtgMaxVar 2000 var"}},
+		{name: "curve above rtgMaxW", settings: vvSettings,
+			b:    &DERControlBase{OpModVoltWatt: curveLink("/dc/vw")},
+			want: []string{"opModVoltWatt: 6000 W exceeds rtgMaxW 5000 W"}},
+		{name: "curve below rtgMinPFUnderExcited",
+			b:    &DERControlBase{OpModWattPF: curveLink("/dc/pf")},
+			want: []string{"opModWattPF: power factor 0.9 is below rtgMinPFUnderExcited 0.95"}},
+	} {
+		c := tt.capability
+		if c == nil {
+			c = testCapability()
+		}
+		a := AdmitDERControl(tt.b, c, tt.settings, resolve)
+		var got []string
+		for _, p := range a.Problems {
+			got = append(got, p.String())
+		}
+		if !slices.Equal(got, tt.want) || a.OK() != (len(tt.want) == 0) {
+			t.Errorf("%s: problems %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestAdmitDERControlResponded(t *testing.T) {
+	connect := true
+	b := &DERControlBase{
+		OpModConnect: &connect,
+		OpModTargetW: targetWControl(4000),
+		OpModMaxLimWAbsorb: &UnsignedActivePowerControlType{
+			UnsignedActivePower: &UnsignedActivePower{Multiplier: NewPowerOfTenMultiplierType(3), Value: 2},
+		},
+		OpModMaxLimWInject: &UnsignedActivePowerControlType{
+			UnsignedActivePower: &UnsignedActivePower{Multiplier: NewPowerOfTenMultiplierType(3), Value: 6},
+		},
+		OpModTargetVar: targetVarControl(3000),
+	}
+	a := AdmitDERControl(b, testCapability(), nil, nil)
+	if got := *a.Responded.HexBinary32; got != "00400004" || a.Responded.String() != "opModConnect|opModTargetW" {
+		t.Errorf("modesResponded %s (%s)", got, a.Responded)
+	}
+	if got := *a.Responded2.HexBinary32; got != "00000080" || a.Responded2.String() != "opModMaxLimWAbsorb" {
+		t.Errorf("modesResponded2 %s (%s)", got, a.Responded2)
+	}
+	if len(a.Problems) != 2 || a.Problems[0].Mode != "opModMaxLimWInject" || a.Problems[1].Mode != "opModTargetVar" {
+		t.Errorf("problems %v", a.Problems)
+	}
+
+	// Without a capability every mode is supported; without curves, curve
+	// links are not checked.
+	a = AdmitDERControl(&DERControlBase{OpModTargetW: targetWControl(1e6), OpModVoltVar: curveLink("/dc/none")}, nil, nil, nil)
+	if !a.OK() || !a.Responded.Has(OpModTargetW) || !a.Responded.Has(OpModVoltVar) {
+		t.Errorf("without capability: %+v", a)
+	}
+}
@@ -0,0 +1,8881 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package sep
+
+// NewDeviceCapability returns a DeviceCapability with its chain of base types allocated.
+func NewDeviceCapability() *DeviceCapability {
+	return &DeviceCapability{FunctionSetAssignmentsBase: NewFunctionSetAssignmentsBase()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DeviceCapability) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.FunctionSetAssignmentsBase.GetHref()
+}
+
+// GetCustomerAccountListLink returns the CustomerAccountListLink of d, or nil if d or one of its bases is nil.
+func (d *DeviceCapability) GetCustomerAccountListLink() *CustomerAccountListLink {
+	if d == nil {
+		return nil
+	}
+	return d.FunctionSetAssignmentsBase.GetCustomerAccountListLink()
+}
+
+// GetDemandResponseProgramListLink returns the DemandResponseProgramListLink of d, or nil if d or one of its bases is nil.
+func (d *DeviceCapability) GetDemandResponseProgramListLink() *DemandResponseProgramListLink {
+	if d == nil {
+		return nil
+	}
+	return d.FunctionSetAssignmentsBase.GetDemandResponseProgramListLink()
+}
+
+// GetDERProgramListLink returns the DERProgramListLink of d, or nil if d or one of its bases is nil.
+func (d *DeviceCapability) GetDERProgramListLink() *DERProgramListLink {
+	if d == nil {
+		return nil
+	}
+	return d.FunctionSetAssignmentsBase.GetDERProgramListLink()
+}
+
+// GetFileListLink returns the FileListLink of d, or nil if d or one of its bases is nil.
+func (d *DeviceCapability) GetFileListLink() *FileListLink {
+	if d == nil {
+		return nil
+	}
+	return d.FunctionSetAssignmentsBase.GetFileListLink()
+}
+
+// GetMessagingProgramListLink returns the MessagingProgramListLink of d, or nil if d or one of its bases is nil.
+func (d *DeviceCapability) GetMessagingProgramListLink() *MessagingProgramListLink {
+	if d == nil {
+		return nil
+	}
+	return d.FunctionSetAssignmentsBase.GetMessagingProgramListLink()
+}
+
+// GetPrepaymentListLink returns the PrepaymentListLink of d, or nil if d or one of its bases is nil.
+func (d *DeviceCapability) GetPrepaymentListLink() *PrepaymentListLink {
+	if d == nil {
+		return nil
+	}
+	return d.FunctionSetAssignmentsBase.GetPrepaymentListLink()
+}
+
+// GetResponseSetListLink returns the ResponseSetListLink of d, or nil if d or one of its bases is nil.
+func (d *DeviceCapability) GetResponseSetListLink() *ResponseSetListLink {
+	if d == nil {
+		return nil
+	}
+	return d.FunctionSetAssignmentsBase.GetResponseSetListLink()
+}
+
+// GetTariffProfileListLink returns the TariffProfileListLink of d, or nil if d or one of its bases is nil.
+func (d *DeviceCapability) GetTariffProfileListLink() *TariffProfileListLink {
+	if d == nil {
+		return nil
+	}
+	return d.FunctionSetAssignmentsBase.GetTariffProfileListLink()
+}
+
+// GetTimeLink returns the TimeLink of d, or nil if d or one of its bases is nil.
+func (d *DeviceCapability) GetTimeLink() *TimeLink {
+	if d == nil {
+		return nil
+	}
+	return d.FunctionSetAssignmentsBase.GetTimeLink()
+}
+
+// GetUsagePointListLink returns the UsagePointListLink of d, or nil if d or one of its bases is nil.
+func (d *DeviceCapability) GetUsagePointListLink() *UsagePointListLink {
+	if d == nil {
+		return nil
+	}
+	return d.FunctionSetAssignmentsBase.GetUsagePointListLink()
+}
+
+// NewAbstractDevice returns an AbstractDevice with its chain of base types allocated.
+func NewAbstractDevice() *AbstractDevice {
+	return &AbstractDevice{SubscribableResource: NewSubscribableResource()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *AbstractDevice) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.SubscribableResource.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of a, or nil if a or one of its bases is nil.
+func (a *AbstractDevice) GetSubscribable() *UInt8 {
+	if a == nil {
+		return nil
+	}
+	return a.SubscribableResource.GetSubscribable()
+}
+
+// GetAggregatedDeviceListLink returns a.AggregatedDeviceListLink, or nil if a is nil.
+func (a *AbstractDevice) GetAggregatedDeviceListLink() *AggregatedDeviceListLink {
+	if a == nil {
+		return nil
+	}
+	return a.AggregatedDeviceListLink
+}
+
+// GetAggregationPriorityLink returns a.AggregationPriorityLink, or nil if a is nil.
+func (a *AbstractDevice) GetAggregationPriorityLink() *AggregationPriorityLink {
+	if a == nil {
+		return nil
+	}
+	return a.AggregationPriorityLink
+}
+
+// GetConfigurationLink returns a.ConfigurationLink, or nil if a is nil.
+func (a *AbstractDevice) GetConfigurationLink() *ConfigurationLink {
+	if a == nil {
+		return nil
+	}
+	return a.ConfigurationLink
+}
+
+// GetDERListLink returns a.DERListLink, or nil if a is nil.
+func (a *AbstractDevice) GetDERListLink() *DERListLink {
+	if a == nil {
+		return nil
+	}
+	return a.DERListLink
+}
+
+// GetDeviceCategory returns a.DeviceCategory, or nil if a is nil.
+func (a *AbstractDevice) GetDeviceCategory() *DeviceCategoryType {
+	if a == nil {
+		return nil
+	}
+	return a.DeviceCategory
+}
+
+// GetDeviceInformationLink returns a.DeviceInformationLink, or nil if a is nil.
+func (a *AbstractDevice) GetDeviceInformationLink() *DeviceInformationLink {
+	if a == nil {
+		return nil
+	}
+	return a.DeviceInformationLink
+}
+
+// GetDeviceStatusLink returns a.DeviceStatusLink, or nil if a is nil.
+func (a *AbstractDevice) GetDeviceStatusLink() *DeviceStatusLink {
+	if a == nil {
+		return nil
+	}
+	return a.DeviceStatusLink
+}
+
+// GetDistribution returns a.Distribution, or nil if a is nil.
+func (a *AbstractDevice) GetDistribution() *AggregationDistributionType {
+	if a == nil {
+		return nil
+	}
+	return a.Distribution
+}
+
+// GetFileStatusLink returns a.FileStatusLink, or nil if a is nil.
+func (a *AbstractDevice) GetFileStatusLink() *FileStatusLink {
+	if a == nil {
+		return nil
+	}
+	return a.FileStatusLink
+}
+
+// GetIPInterfaceListLink returns a.IPInterfaceListLink, or nil if a is nil.
+func (a *AbstractDevice) GetIPInterfaceListLink() *IPInterfaceListLink {
+	if a == nil {
+		return nil
+	}
+	return a.IPInterfaceListLink
+}
+
+// GetLFDI returns a.LFDI, or nil if a is nil.
+func (a *AbstractDevice) GetLFDI() *string {
+	if a == nil {
+		return nil
+	}
+	return a.LFDI
+}
+
+// GetLoadShedAvailabilityListLink returns a.LoadShedAvailabilityListLink, or nil if a is nil.
+func (a *AbstractDevice) GetLoadShedAvailabilityListLink() *LoadShedAvailabilityListLink {
+	if a == nil {
+		return nil
+	}
+	return a.LoadShedAvailabilityListLink
+}
+
+// GetLogEventListLink returns a.LogEventListLink, or nil if a is nil.
+func (a *AbstractDevice) GetLogEventListLink() *LogEventListLink {
+	if a == nil {
+		return nil
+	}
+	return a.LogEventListLink
+}
+
+// GetPhase returns a.Phase, or nil if a is nil.
+func (a *AbstractDevice) GetPhase() *PhaseCode {
+	if a == nil {
+		return nil
+	}
+	return a.Phase
+}
+
+// GetPowerStatusLink returns a.PowerStatusLink, or nil if a is nil.
+func (a *AbstractDevice) GetPowerStatusLink() *PowerStatusLink {
+	if a == nil {
+		return nil
+	}
+	return a.PowerStatusLink
+}
+
+// GetSFDI returns a.SFDI, or nil if a is nil.
+func (a *AbstractDevice) GetSFDI() *SFDIType {
+	if a == nil {
+		return nil
+	}
+	return a.SFDI
+}
+
+// NewDeviceStatus returns a DeviceStatus with its chain of base types allocated.
+func NewDeviceStatus() *DeviceStatus {
+	return &DeviceStatus{Resource: NewResource()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DeviceStatus) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.Resource.GetHref()
+}
+
+// NewEndDeviceList returns an EndDeviceList with its chain of base types allocated.
+func NewEndDeviceList() *EndDeviceList {
+	return &EndDeviceList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of e, or "" if e or one of its bases is nil.
+func (e *EndDeviceList) GetHref() string {
+	if e == nil {
+		return ""
+	}
+	return e.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of e, or nil if e or one of its bases is nil.
+func (e *EndDeviceList) GetSubscribable() *UInt8 {
+	if e == nil {
+		return nil
+	}
+	return e.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of e, or 0 if e or one of its bases is nil.
+func (e *EndDeviceList) GetAll() uint32 {
+	if e == nil {
+		return 0
+	}
+	return e.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of e, or 0 if e or one of its bases is nil.
+func (e *EndDeviceList) GetResults() uint32 {
+	if e == nil {
+		return 0
+	}
+	return e.SubscribableList.GetResults()
+}
+
+// NewEndDevice returns an EndDevice with its chain of base types allocated.
+func NewEndDevice() *EndDevice {
+	return &EndDevice{ExternalDevice: NewExternalDevice()}
+}
+
+// GetHref returns the Href of e, or "" if e or one of its bases is nil.
+func (e *EndDevice) GetHref() string {
+	if e == nil {
+		return ""
+	}
+	return e.ExternalDevice.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetSubscribable() *UInt8 {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetSubscribable()
+}
+
+// GetAggregatedDeviceListLink returns the AggregatedDeviceListLink of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetAggregatedDeviceListLink() *AggregatedDeviceListLink {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetAggregatedDeviceListLink()
+}
+
+// GetAggregationPriorityLink returns the AggregationPriorityLink of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetAggregationPriorityLink() *AggregationPriorityLink {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetAggregationPriorityLink()
+}
+
+// GetConfigurationLink returns the ConfigurationLink of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetConfigurationLink() *ConfigurationLink {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetConfigurationLink()
+}
+
+// GetDERListLink returns the DERListLink of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetDERListLink() *DERListLink {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetDERListLink()
+}
+
+// GetDeviceCategory returns the DeviceCategory of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetDeviceCategory() *DeviceCategoryType {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetDeviceCategory()
+}
+
+// GetDeviceInformationLink returns the DeviceInformationLink of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetDeviceInformationLink() *DeviceInformationLink {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetDeviceInformationLink()
+}
+
+// GetDeviceStatusLink returns the DeviceStatusLink of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetDeviceStatusLink() *DeviceStatusLink {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetDeviceStatusLink()
+}
+
+// GetDistribution returns the Distribution of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetDistribution() *AggregationDistributionType {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetDistribution()
+}
+
+// GetFileStatusLink returns the FileStatusLink of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetFileStatusLink() *FileStatusLink {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetFileStatusLink()
+}
+
+// GetIPInterfaceListLink returns the IPInterfaceListLink of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetIPInterfaceListLink() *IPInterfaceListLink {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetIPInterfaceListLink()
+}
+
+// GetLFDI returns the LFDI of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetLFDI() *string {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetLFDI()
+}
+
+// GetLoadShedAvailabilityListLink returns the LoadShedAvailabilityListLink of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetLoadShedAvailabilityListLink() *LoadShedAvailabilityListLink {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetLoadShedAvailabilityListLink()
+}
+
+// GetLogEventListLink returns the LogEventListLink of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetLogEventListLink() *LogEventListLink {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetLogEventListLink()
+}
+
+// GetPhase returns the Phase of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetPhase() *PhaseCode {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetPhase()
+}
+
+// GetPowerStatusLink returns the PowerStatusLink of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetPowerStatusLink() *PowerStatusLink {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetPowerStatusLink()
+}
+
+// GetSFDI returns the SFDI of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetSFDI() *SFDIType {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetSFDI()
+}
+
+// GetChangedTime returns the ChangedTime of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetChangedTime() *TimeType {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetChangedTime()
+}
+
+// GetEnabled returns the Enabled of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetEnabled() *bool {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetEnabled()
+}
+
+// GetFlowReservationRequestListLink returns the FlowReservationRequestListLink of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetFlowReservationRequestListLink() *FlowReservationRequestListLink {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetFlowReservationRequestListLink()
+}
+
+// GetFlowReservationResponseListLink returns the FlowReservationResponseListLink of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetFlowReservationResponseListLink() *FlowReservationResponseListLink {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetFlowReservationResponseListLink()
+}
+
+// GetFunctionSetAssignmentsListLink returns the FunctionSetAssignmentsListLink of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetFunctionSetAssignmentsListLink() *FunctionSetAssignmentsListLink {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetFunctionSetAssignmentsListLink()
+}
+
+// GetPostRate returns the PostRate of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetPostRate() *uint32 {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetPostRate()
+}
+
+// GetRegistrationLink returns the RegistrationLink of e, or nil if e or one of its bases is nil.
+func (e *EndDevice) GetRegistrationLink() *RegistrationLink {
+	if e == nil {
+		return nil
+	}
+	return e.ExternalDevice.GetRegistrationLink()
+}
+
+// NewExternalDevice returns an ExternalDevice with its chain of base types allocated.
+func NewExternalDevice() *ExternalDevice {
+	return &ExternalDevice{AbstractDevice: NewAbstractDevice()}
+}
+
+// GetHref returns the Href of e, or "" if e or one of its bases is nil.
+func (e *ExternalDevice) GetHref() string {
+	if e == nil {
+		return ""
+	}
+	return e.AbstractDevice.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of e, or nil if e or one of its bases is nil.
+func (e *ExternalDevice) GetSubscribable() *UInt8 {
+	if e == nil {
+		return nil
+	}
+	return e.AbstractDevice.GetSubscribable()
+}
+
+// GetAggregatedDeviceListLink returns the AggregatedDeviceListLink of e, or nil if e or one of its bases is nil.
+func (e *ExternalDevice) GetAggregatedDeviceListLink() *AggregatedDeviceListLink {
+	if e == nil {
+		return nil
+	}
+	return e.AbstractDevice.GetAggregatedDeviceListLink()
+}
+
+// GetAggregationPriorityLink returns the AggregationPriorityLink of e, or nil if e or one of its bases is nil.
+func (e *ExternalDevice) GetAggregationPriorityLink() *AggregationPriorityLink {
+	if e == nil {
+		return nil
+	}
+	return e.AbstractDevice.GetAggregationPriorityLink()
+}
+
+// GetConfigurationLink returns the ConfigurationLink of e, or nil if e or one of its bases is nil.
+func (e *ExternalDevice) GetConfigurationLink() *ConfigurationLink {
+	if e == nil {
+		return nil
+	}
+	return e.AbstractDevice.GetConfigurationLink()
+}
+
+// GetDERListLink returns the DERListLink of e, or nil if e or one of its bases is nil.
+func (e *ExternalDevice) GetDERListLink() *DERListLink {
+	if e == nil {
+		return nil
+	}
+	return e.AbstractDevice.GetDERListLink()
+}
+
+// GetDeviceCategory returns the DeviceCategory of e, or nil if e or one of its bases is nil.
+func (e *ExternalDevice) GetDeviceCategory() *DeviceCategoryType {
+	if e == nil {
+		return nil
+	}
+	return e.AbstractDevice.GetDeviceCategory()
+}
+
+// GetDeviceInformationLink returns the DeviceInformationLink of e, or nil if e or one of its bases is nil.
+func (e *ExternalDevice) GetDeviceInformationLink() *DeviceInformationLink {
+	if e == nil {
+		return nil
+	}
+	return e.AbstractDevice.GetDeviceInformationLink()
+}
+
+// GetDeviceStatusLink returns the DeviceStatusLink of e, or nil if e or one of its bases is nil.
+func (e *ExternalDevice) GetDeviceStatusLink() *DeviceStatusLink {
+	if e == nil {
+		return nil
+	}
+	return e.AbstractDevice.GetDeviceStatusLink()
+}
+
+// GetDistribution returns the Distribution of e, or nil if e or one of its bases is nil.
+func (e *ExternalDevice) GetDistribution() *AggregationDistributionType {
+	if e == nil {
+		return nil
+	}
+	return e.AbstractDevice.GetDistribution()
+}
+
+// GetFileStatusLink returns the FileStatusLink of e, or nil if e or one of its bases is nil.
+func (e *ExternalDevice) GetFileStatusLink() *FileStatusLink {
+	if e == nil {
+		return nil
+	}
+	return e.AbstractDevice.GetFileStatusLink()
+}
+
+// GetIPInterfaceListLink returns the IPInterfaceListLink of e, or nil if e or one of its bases is nil.
+func (e *ExternalDevice) GetIPInterfaceListLink() *IPInterfaceListLink {
+	if e == nil {
+		return nil
+	}
+	return e.AbstractDevice.GetIPInterfaceListLink()
+}
+
+// GetLFDI returns the LFDI of e, or nil if e or one of its bases is nil.
+func (e *ExternalDevice) GetLFDI() *string {
+	if e == nil {
+		return nil
+	}
+	return e.AbstractDevice.GetLFDI()
+}
+
+// GetLoadShedAvailabilityListLink returns the LoadShedAvailabilityListLink of e, or nil if e or one of its bases is nil.
+func (e *ExternalDevice) GetLoadShedAvailabilityListLink() *LoadShedAvailabilityListLink {
+	if e == nil {
+		return nil
+	}
+	return e.AbstractDevice.GetLoadShedAvailabilityListLink()
+}
+
+// GetLogEventListLink returns the LogEventListLink of e, or nil if e or one of its bases is nil.
+func (e *ExternalDevice) GetLogEventListLink() *LogEventListLink {
+	if e == nil {
+		return nil
+	}
+	return e.AbstractDevice.GetLogEventListLink()
+}
+
+// GetPhase returns the Phase of e, or nil if e or one of its bases is nil.
+func (e *ExternalDevice) GetPhase() *PhaseCode {
+	if e == nil {
+		return nil
+	}
+	return e.AbstractDevice.GetPhase()
+}
+
+// GetPowerStatusLink returns the PowerStatusLink of e, or nil if e or one of its bases is nil.
+func (e *ExternalDevice) GetPowerStatusLink() *PowerStatusLink {
+	if e == nil {
+		return nil
+	}
+	return e.AbstractDevice.GetPowerStatusLink()
+}
+
+// GetSFDI returns the SFDI of e, or nil if e or one of its bases is nil.
+func (e *ExternalDevice) GetSFDI() *SFDIType {
+	if e == nil {
+		return nil
+	}
+	return e.AbstractDevice.GetSFDI()
+}
+
+// GetChangedTime returns e.ChangedTime, or nil if e is nil.
+func (e *ExternalDevice) GetChangedTime() *TimeType {
+	if e == nil {
+		return nil
+	}
+	return e.ChangedTime
+}
+
+// GetEnabled returns e.Enabled, or nil if e is nil.
+func (e *ExternalDevice) GetEnabled() *bool {
+	if e == nil {
+		return nil
+	}
+	return e.Enabled
+}
+
+// GetFlowReservationRequestListLink returns e.FlowReservationRequestListLink, or nil if e is nil.
+func (e *ExternalDevice) GetFlowReservationRequestListLink() *FlowReservationRequestListLink {
+	if e == nil {
+		return nil
+	}
+	return e.FlowReservationRequestListLink
+}
+
+// GetFlowReservationResponseListLink returns e.FlowReservationResponseListLink, or nil if e is nil.
+func (e *ExternalDevice) GetFlowReservationResponseListLink() *FlowReservationResponseListLink {
+	if e == nil {
+		return nil
+	}
+	return e.FlowReservationResponseListLink
+}
+
+// GetFunctionSetAssignmentsListLink returns e.FunctionSetAssignmentsListLink, or nil if e is nil.
+func (e *ExternalDevice) GetFunctionSetAssignmentsListLink() *FunctionSetAssignmentsListLink {
+	if e == nil {
+		return nil
+	}
+	return e.FunctionSetAssignmentsListLink
+}
+
+// GetPostRate returns e.PostRate, or nil if e is nil.
+func (e *ExternalDevice) GetPostRate() *uint32 {
+	if e == nil {
+		return nil
+	}
+	return e.PostRate
+}
+
+// GetRegistrationLink returns e.RegistrationLink, or nil if e is nil.
+func (e *ExternalDevice) GetRegistrationLink() *RegistrationLink {
+	if e == nil {
+		return nil
+	}
+	return e.RegistrationLink
+}
+
+// NewRegistration returns a Registration with its chain of base types allocated.
+func NewRegistration() *Registration {
+	return &Registration{Resource: NewResource()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *Registration) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.Resource.GetHref()
+}
+
+// NewSelfDevice returns a SelfDevice with its chain of base types allocated.
+func NewSelfDevice() *SelfDevice {
+	return &SelfDevice{AbstractDevice: NewAbstractDevice()}
+}
+
+// GetHref returns the Href of s, or "" if s or one of its bases is nil.
+func (s *SelfDevice) GetHref() string {
+	if s == nil {
+		return ""
+	}
+	return s.AbstractDevice.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of s, or nil if s or one of its bases is nil.
+func (s *SelfDevice) GetSubscribable() *UInt8 {
+	if s == nil {
+		return nil
+	}
+	return s.AbstractDevice.GetSubscribable()
+}
+
+// GetAggregatedDeviceListLink returns the AggregatedDeviceListLink of s, or nil if s or one of its bases is nil.
+func (s *SelfDevice) GetAggregatedDeviceListLink() *AggregatedDeviceListLink {
+	if s == nil {
+		return nil
+	}
+	return s.AbstractDevice.GetAggregatedDeviceListLink()
+}
+
+// GetAggregationPriorityLink returns the AggregationPriorityLink of s, or nil if s or one of its bases is nil.
+func (s *SelfDevice) GetAggregationPriorityLink() *AggregationPriorityLink {
+	if s == nil {
+		return nil
+	}
+	return s.AbstractDevice.GetAggregationPriorityLink()
+}
+
+// GetConfigurationLink returns the ConfigurationLink of s, or nil if s or one of its bases is nil.
+func (s *SelfDevice) GetConfigurationLink() *ConfigurationLink {
+	if s == nil {
+		return nil
+	}
+	return s.AbstractDevice.GetConfigurationLink()
+}
+
+// GetDERListLink returns the DERListLink of s, or nil if s or one of its bases is nil.
+func (s *SelfDevice) GetDERListLink() *DERListLink {
+	if s == nil {
+		return nil
+	}
+	return s.AbstractDevice.GetDERListLink()
+}
+
+// GetDeviceCategory returns the DeviceCategory of s, or nil if s or one of its bases is nil.
+func (s *SelfDevice) GetDeviceCategory() *DeviceCategoryType {
+	if s == nil {
+		return nil
+	}
+	return s.AbstractDevice.GetDeviceCategory()
+}
+
+// GetDeviceInformationLink returns the DeviceInformationLink of s, or nil if s or one of its bases is nil.
+func (s *SelfDevice) GetDeviceInformationLink() *DeviceInformationLink {
+	if s == nil {
+		return nil
+	}
+	return s.AbstractDevice.GetDeviceInformationLink()
+}
+
+// GetDeviceStatusLink returns the DeviceStatusLink of s, or nil if s or one of its bases is nil.
+func (s *SelfDevice) GetDeviceStatusLink() *DeviceStatusLink {
+	if s == nil {
+		return nil
+	}
+	return s.AbstractDevice.GetDeviceStatusLink()
+}
+
+// GetDistribution returns the Distribution of s, or nil if s or one of its bases is nil.
+func (s *SelfDevice) GetDistribution() *AggregationDistributionType {
+	if s == nil {
+		return nil
+	}
+	return s.AbstractDevice.GetDistribution()
+}
+
+// GetFileStatusLink returns the FileStatusLink of s, or nil if s or one of its bases is nil.
+func (s *SelfDevice) GetFileStatusLink() *FileStatusLink {
+	if s == nil {
+		return nil
+	}
+	return s.AbstractDevice.GetFileStatusLink()
+}
+
+// GetIPInterfaceListLink returns the IPInterfaceListLink of s, or nil if s or one of its bases is nil.
+func (s *SelfDevice) GetIPInterfaceListLink() *IPInterfaceListLink {
+	if s == nil {
+		return nil
+	}
+	return s.AbstractDevice.GetIPInterfaceListLink()
+}
+
+// GetLFDI returns the LFDI of s, or nil if s or one of its bases is nil.
+func (s *SelfDevice) GetLFDI() *string {
+	if s == nil {
+		return nil
+	}
+	return s.AbstractDevice.GetLFDI()
+}
+
+// GetLoadShedAvailabilityListLink returns the LoadShedAvailabilityListLink of s, or nil if s or one of its bases is nil.
+func (s *SelfDevice) GetLoadShedAvailabilityListLink() *LoadShedAvailabilityListLink {
+	if s == nil {
+		return nil
+	}
+	return s.AbstractDevice.GetLoadShedAvailabilityListLink()
+}
+
+// GetLogEventListLink returns the LogEventListLink of s, or nil if s or one of its bases is nil.
+func (s *SelfDevice) GetLogEventListLink() *LogEventListLink {
+	if s == nil {
+		return nil
+	}
+	return s.AbstractDevice.GetLogEventListLink()
+}
+
+// GetPhase returns the Phase of s, or nil if s or one of its bases is nil.
+func (s *SelfDevice) GetPhase() *PhaseCode {
+	if s == nil {
+		return nil
+	}
+	return s.AbstractDevice.GetPhase()
+}
+
+// GetPowerStatusLink returns the PowerStatusLink of s, or nil if s or one of its bases is nil.
+func (s *SelfDevice) GetPowerStatusLink() *PowerStatusLink {
+	if s == nil {
+		return nil
+	}
+	return s.AbstractDevice.GetPowerStatusLink()
+}
+
+// GetSFDI returns the SFDI of s, or nil if s or one of its bases is nil.
+func (s *SelfDevice) GetSFDI() *SFDIType {
+	if s == nil {
+		return nil
+	}
+	return s.AbstractDevice.GetSFDI()
+}
+
+// NewTemperature returns a new Temperature.
+func NewTemperature() *Temperature {
+	return &Temperature{}
+}
+
+// NewFunctionSetAssignmentsBase returns a FunctionSetAssignmentsBase with its chain of base types allocated.
+func NewFunctionSetAssignmentsBase() *FunctionSetAssignmentsBase {
+	return &FunctionSetAssignmentsBase{Resource: NewResource()}
+}
+
+// GetHref returns the Href of f, or "" if f or one of its bases is nil.
+func (f *FunctionSetAssignmentsBase) GetHref() string {
+	if f == nil {
+		return ""
+	}
+	return f.Resource.GetHref()
+}
+
+// GetCustomerAccountListLink returns f.CustomerAccountListLink, or nil if f is nil.
+func (f *FunctionSetAssignmentsBase) GetCustomerAccountListLink() *CustomerAccountListLink {
+	if f == nil {
+		return nil
+	}
+	return f.CustomerAccountListLink
+}
+
+// GetDemandResponseProgramListLink returns f.DemandResponseProgramListLink, or nil if f is nil.
+func (f *FunctionSetAssignmentsBase) GetDemandResponseProgramListLink() *DemandResponseProgramListLink {
+	if f == nil {
+		return nil
+	}
+	return f.DemandResponseProgramListLink
+}
+
+// GetDERProgramListLink returns f.DERProgramListLink, or nil if f is nil.
+func (f *FunctionSetAssignmentsBase) GetDERProgramListLink() *DERProgramListLink {
+	if f == nil {
+		return nil
+	}
+	return f.DERProgramListLink
+}
+
+// GetFileListLink returns f.FileListLink, or nil if f is nil.
+func (f *FunctionSetAssignmentsBase) GetFileListLink() *FileListLink {
+	if f == nil {
+		return nil
+	}
+	return f.FileListLink
+}
+
+// GetMessagingProgramListLink returns f.MessagingProgramListLink, or nil if f is nil.
+func (f *FunctionSetAssignmentsBase) GetMessagingProgramListLink() *MessagingProgramListLink {
+	if f == nil {
+		return nil
+	}
+	return f.MessagingProgramListLink
+}
+
+// GetPrepaymentListLink returns f.PrepaymentListLink, or nil if f is nil.
+func (f *FunctionSetAssignmentsBase) GetPrepaymentListLink() *PrepaymentListLink {
+	if f == nil {
+		return nil
+	}
+	return f.PrepaymentListLink
+}
+
+// GetResponseSetListLink returns f.ResponseSetListLink, or nil if f is nil.
+func (f *FunctionSetAssignmentsBase) GetResponseSetListLink() *ResponseSetListLink {
+	if f == nil {
+		return nil
+	}
+	return f.ResponseSetListLink
+}
+
+// GetTariffProfileListLink returns f.TariffProfileListLink, or nil if f is nil.
+func (f *FunctionSetAssignmentsBase) GetTariffProfileListLink() *TariffProfileListLink {
+	if f == nil {
+		return nil
+	}
+	return f.TariffProfileListLink
+}
+
+// GetTimeLink returns f.TimeLink, or nil if f is nil.
+func (f *FunctionSetAssignmentsBase) GetTimeLink() *TimeLink {
+	if f == nil {
+		return nil
+	}
+	return f.TimeLink
+}
+
+// GetUsagePointListLink returns f.UsagePointListLink, or nil if f is nil.
+func (f *FunctionSetAssignmentsBase) GetUsagePointListLink() *UsagePointListLink {
+	if f == nil {
+		return nil
+	}
+	return f.UsagePointListLink
+}
+
+// NewFunctionSetAssignments returns a FunctionSetAssignments with its chain of base types allocated.
+func NewFunctionSetAssignments() *FunctionSetAssignments {
+	return &FunctionSetAssignments{FunctionSetAssignmentsBase: NewFunctionSetAssignmentsBase()}
+}
+
+// GetHref returns the Href of f, or "" if f or one of its bases is nil.
+func (f *FunctionSetAssignments) GetHref() string {
+	if f == nil {
+		return ""
+	}
+	return f.FunctionSetAssignmentsBase.GetHref()
+}
+
+// GetCustomerAccountListLink returns the CustomerAccountListLink of f, or nil if f or one of its bases is nil.
+func (f *FunctionSetAssignments) GetCustomerAccountListLink() *CustomerAccountListLink {
+	if f == nil {
+		return nil
+	}
+	return f.FunctionSetAssignmentsBase.GetCustomerAccountListLink()
+}
+
+// GetDemandResponseProgramListLink returns the DemandResponseProgramListLink of f, or nil if f or one of its bases is nil.
+func (f *FunctionSetAssignments) GetDemandResponseProgramListLink() *DemandResponseProgramListLink {
+	if f == nil {
+		return nil
+	}
+	return f.FunctionSetAssignmentsBase.GetDemandResponseProgramListLink()
+}
+
+// GetDERProgramListLink returns the DERProgramListLink of f, or nil if f or one of its bases is nil.
+func (f *FunctionSetAssignments) GetDERProgramListLink() *DERProgramListLink {
+	if f == nil {
+		return nil
+	}
+	return f.FunctionSetAssignmentsBase.GetDERProgramListLink()
+}
+
+// GetFileListLink returns the FileListLink of f, or nil if f or one of its bases is nil.
+func (f *FunctionSetAssignments) GetFileListLink() *FileListLink {
+	if f == nil {
+		return nil
+	}
+	return f.FunctionSetAssignmentsBase.GetFileListLink()
+}
+
+// GetMessagingProgramListLink returns the MessagingProgramListLink of f, or nil if f or one of its bases is nil.
+func (f *FunctionSetAssignments) GetMessagingProgramListLink() *MessagingProgramListLink {
+	if f == nil {
+		return nil
+	}
+	return f.FunctionSetAssignmentsBase.GetMessagingProgramListLink()
+}
+
+// GetPrepaymentListLink returns the PrepaymentListLink of f, or nil if f or one of its bases is nil.
+func (f *FunctionSetAssignments) GetPrepaymentListLink() *PrepaymentListLink {
+	if f == nil {
+		return nil
+	}
+	return f.FunctionSetAssignmentsBase.GetPrepaymentListLink()
+}
+
+// GetResponseSetListLink returns the ResponseSetListLink of f, or nil if f or one of its bases is nil.
+func (f *FunctionSetAssignments) GetResponseSetListLink() *ResponseSetListLink {
+	if f == nil {
+		return nil
+	}
+	return f.FunctionSetAssignmentsBase.GetResponseSetListLink()
+}
+
+// GetTariffProfileListLink returns the TariffProfileListLink of f, or nil if f or one of its bases is nil.
+func (f *FunctionSetAssignments) GetTariffProfileListLink() *TariffProfileListLink {
+	if f == nil {
+		return nil
+	}
+	return f.FunctionSetAssignmentsBase.GetTariffProfileListLink()
+}
+
+// GetTimeLink returns the TimeLink of f, or nil if f or one of its bases is nil.
+func (f *FunctionSetAssignments) GetTimeLink() *TimeLink {
+	if f == nil {
+		return nil
+	}
+	return f.FunctionSetAssignmentsBase.GetTimeLink()
+}
+
+// GetUsagePointListLink returns the UsagePointListLink of f, or nil if f or one of its bases is nil.
+func (f *FunctionSetAssignments) GetUsagePointListLink() *UsagePointListLink {
+	if f == nil {
+		return nil
+	}
+	return f.FunctionSetAssignmentsBase.GetUsagePointListLink()
+}
+
+// NewFunctionSetAssignmentsList returns a FunctionSetAssignmentsList with its chain of base types allocated.
+func NewFunctionSetAssignmentsList() *FunctionSetAssignmentsList {
+	return &FunctionSetAssignmentsList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of f, or "" if f or one of its bases is nil.
+func (f *FunctionSetAssignmentsList) GetHref() string {
+	if f == nil {
+		return ""
+	}
+	return f.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of f, or nil if f or one of its bases is nil.
+func (f *FunctionSetAssignmentsList) GetSubscribable() *UInt8 {
+	if f == nil {
+		return nil
+	}
+	return f.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of f, or 0 if f or one of its bases is nil.
+func (f *FunctionSetAssignmentsList) GetAll() uint32 {
+	if f == nil {
+		return 0
+	}
+	return f.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of f, or 0 if f or one of its bases is nil.
+func (f *FunctionSetAssignmentsList) GetResults() uint32 {
+	if f == nil {
+		return 0
+	}
+	return f.SubscribableList.GetResults()
+}
+
+// NewCondition returns a new Condition.
+func NewCondition() *Condition {
+	return &Condition{}
+}
+
+// NewSubscriptionBase returns a SubscriptionBase with its chain of base types allocated.
+func NewSubscriptionBase() *SubscriptionBase {
+	return &SubscriptionBase{Resource: NewResource()}
+}
+
+// GetHref returns the Href of s, or "" if s or one of its bases is nil.
+func (s *SubscriptionBase) GetHref() string {
+	if s == nil {
+		return ""
+	}
+	return s.Resource.GetHref()
+}
+
+// GetSubscribedResource returns s.SubscribedResource, or "" if s is nil.
+func (s *SubscriptionBase) GetSubscribedResource() string {
+	if s == nil {
+		return ""
+	}
+	return s.SubscribedResource
+}
+
+// NewSubscription returns a Subscription with its chain of base types allocated.
+func NewSubscription() *Subscription {
+	return &Subscription{SubscriptionBase: NewSubscriptionBase()}
+}
+
+// GetHref returns the Href of s, or "" if s or one of its bases is nil.
+func (s *Subscription) GetHref() string {
+	if s == nil {
+		return ""
+	}
+	return s.SubscriptionBase.GetHref()
+}
+
+// GetSubscribedResource returns the SubscribedResource of s, or "" if s or one of its bases is nil.
+func (s *Subscription) GetSubscribedResource() string {
+	if s == nil {
+		return ""
+	}
+	return s.SubscriptionBase.GetSubscribedResource()
+}
+
+// NewSubscriptionList returns a SubscriptionList with its chain of base types allocated.
+func NewSubscriptionList() *SubscriptionList {
+	return &SubscriptionList{List: NewList()}
+}
+
+// GetHref returns the Href of s, or "" if s or one of its bases is nil.
+func (s *SubscriptionList) GetHref() string {
+	if s == nil {
+		return ""
+	}
+	return s.List.GetHref()
+}
+
+// GetAll returns the All of s, or 0 if s or one of its bases is nil.
+func (s *SubscriptionList) GetAll() uint32 {
+	if s == nil {
+		return 0
+	}
+	return s.List.GetAll()
+}
+
+// GetResults returns the Results of s, or 0 if s or one of its bases is nil.
+func (s *SubscriptionList) GetResults() uint32 {
+	if s == nil {
+		return 0
+	}
+	return s.List.GetResults()
+}
+
+// NewNotification returns a Notification with its chain of base types allocated.
+func NewNotification() *Notification {
+	return &Notification{SubscriptionBase: NewSubscriptionBase()}
+}
+
+// GetHref returns the Href of n, or "" if n or one of its bases is nil.
+func (n *Notification) GetHref() string {
+	if n == nil {
+		return ""
+	}
+	return n.SubscriptionBase.GetHref()
+}
+
+// GetSubscribedResource returns the SubscribedResource of n, or "" if n or one of its bases is nil.
+func (n *Notification) GetSubscribedResource() string {
+	if n == nil {
+		return ""
+	}
+	return n.SubscriptionBase.GetSubscribedResource()
+}
+
+// NewNotificationList returns a NotificationList with its chain of base types allocated.
+func NewNotificationList() *NotificationList {
+	return &NotificationList{List: NewList()}
+}
+
+// GetHref returns the Href of n, or "" if n or one of its bases is nil.
+func (n *NotificationList) GetHref() string {
+	if n == nil {
+		return ""
+	}
+	return n.List.GetHref()
+}
+
+// GetAll returns the All of n, or 0 if n or one of its bases is nil.
+func (n *NotificationList) GetAll() uint32 {
+	if n == nil {
+		return 0
+	}
+	return n.List.GetAll()
+}
+
+// GetResults returns the Results of n, or 0 if n or one of its bases is nil.
+func (n *NotificationList) GetResults() uint32 {
+	if n == nil {
+		return 0
+	}
+	return n.List.GetResults()
+}
+
+// NewResponseSetList returns a ResponseSetList with its chain of base types allocated.
+func NewResponseSetList() *ResponseSetList {
+	return &ResponseSetList{List: NewList()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *ResponseSetList) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.List.GetHref()
+}
+
+// GetAll returns the All of r, or 0 if r or one of its bases is nil.
+func (r *ResponseSetList) GetAll() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.List.GetAll()
+}
+
+// GetResults returns the Results of r, or 0 if r or one of its bases is nil.
+func (r *ResponseSetList) GetResults() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.List.GetResults()
+}
+
+// NewResponseSet returns a ResponseSet with its chain of base types allocated.
+func NewResponseSet() *ResponseSet {
+	return &ResponseSet{IdentifiedObject: NewIdentifiedObject()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *ResponseSet) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.IdentifiedObject.GetHref()
+}
+
+// GetMRID returns the MRID of r, or nil if r or one of its bases is nil.
+func (r *ResponseSet) GetMRID() *MRIDType {
+	if r == nil {
+		return nil
+	}
+	return r.IdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of r, or nil if r or one of its bases is nil.
+func (r *ResponseSet) GetDescription() *string {
+	if r == nil {
+		return nil
+	}
+	return r.IdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of r, or nil if r or one of its bases is nil.
+func (r *ResponseSet) GetVersion() *VersionType {
+	if r == nil {
+		return nil
+	}
+	return r.IdentifiedObject.GetVersion()
+}
+
+// NewResponseList returns a ResponseList with its chain of base types allocated.
+func NewResponseList() *ResponseList {
+	return &ResponseList{List: NewList()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *ResponseList) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.List.GetHref()
+}
+
+// GetAll returns the All of r, or 0 if r or one of its bases is nil.
+func (r *ResponseList) GetAll() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.List.GetAll()
+}
+
+// GetResults returns the Results of r, or 0 if r or one of its bases is nil.
+func (r *ResponseList) GetResults() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.List.GetResults()
+}
+
+// NewResponse returns a Response with its chain of base types allocated.
+func NewResponse() *Response {
+	return &Response{Resource: NewResource()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *Response) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.Resource.GetHref()
+}
+
+// GetCreatedDateTime returns r.CreatedDateTime, or nil if r is nil.
+func (r *Response) GetCreatedDateTime() *TimeType {
+	if r == nil {
+		return nil
+	}
+	return r.CreatedDateTime
+}
+
+// GetEndDeviceLFDI returns r.EndDeviceLFDI, or "" if r is nil.
+func (r *Response) GetEndDeviceLFDI() string {
+	if r == nil {
+		return ""
+	}
+	return r.EndDeviceLFDI
+}
+
+// GetStatus returns r.Status, or nil if r is nil.
+func (r *Response) GetStatus() *ResponseStatus {
+	if r == nil {
+		return nil
+	}
+	return r.Status
+}
+
+// GetSubject returns r.Subject, or nil if r is nil.
+func (r *Response) GetSubject() *MRIDType {
+	if r == nil {
+		return nil
+	}
+	return r.Subject
+}
+
+// NewDefaultDERControlResponse returns a DefaultDERControlResponse with its chain of base types allocated.
+func NewDefaultDERControlResponse() *DefaultDERControlResponse {
+	return &DefaultDERControlResponse{Response: NewResponse()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DefaultDERControlResponse) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.Response.GetHref()
+}
+
+// GetCreatedDateTime returns the CreatedDateTime of d, or nil if d or one of its bases is nil.
+func (d *DefaultDERControlResponse) GetCreatedDateTime() *TimeType {
+	if d == nil {
+		return nil
+	}
+	return d.Response.GetCreatedDateTime()
+}
+
+// GetEndDeviceLFDI returns the EndDeviceLFDI of d, or "" if d or one of its bases is nil.
+func (d *DefaultDERControlResponse) GetEndDeviceLFDI() string {
+	if d == nil {
+		return ""
+	}
+	return d.Response.GetEndDeviceLFDI()
+}
+
+// GetStatus returns the Status of d, or nil if d or one of its bases is nil.
+func (d *DefaultDERControlResponse) GetStatus() *ResponseStatus {
+	if d == nil {
+		return nil
+	}
+	return d.Response.GetStatus()
+}
+
+// GetSubject returns the Subject of d, or nil if d or one of its bases is nil.
+func (d *DefaultDERControlResponse) GetSubject() *MRIDType {
+	if d == nil {
+		return nil
+	}
+	return d.Response.GetSubject()
+}
+
+// NewDERControlResponse returns a DERControlResponse with its chain of base types allocated.
+func NewDERControlResponse() *DERControlResponse {
+	return &DERControlResponse{Response: NewResponse()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERControlResponse) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.Response.GetHref()
+}
+
+// GetCreatedDateTime returns the CreatedDateTime of d, or nil if d or one of its bases is nil.
+func (d *DERControlResponse) GetCreatedDateTime() *TimeType {
+	if d == nil {
+		return nil
+	}
+	return d.Response.GetCreatedDateTime()
+}
+
+// GetEndDeviceLFDI returns the EndDeviceLFDI of d, or "" if d or one of its bases is nil.
+func (d *DERControlResponse) GetEndDeviceLFDI() string {
+	if d == nil {
+		return ""
+	}
+	return d.Response.GetEndDeviceLFDI()
+}
+
+// GetStatus returns the Status of d, or nil if d or one of its bases is nil.
+func (d *DERControlResponse) GetStatus() *ResponseStatus {
+	if d == nil {
+		return nil
+	}
+	return d.Response.GetStatus()
+}
+
+// GetSubject returns the Subject of d, or nil if d or one of its bases is nil.
+func (d *DERControlResponse) GetSubject() *MRIDType {
+	if d == nil {
+		return nil
+	}
+	return d.Response.GetSubject()
+}
+
+// NewDrResponse returns a DrResponse with its chain of base types allocated.
+func NewDrResponse() *DrResponse {
+	return &DrResponse{Response: NewResponse()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DrResponse) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.Response.GetHref()
+}
+
+// GetCreatedDateTime returns the CreatedDateTime of d, or nil if d or one of its bases is nil.
+func (d *DrResponse) GetCreatedDateTime() *TimeType {
+	if d == nil {
+		return nil
+	}
+	return d.Response.GetCreatedDateTime()
+}
+
+// GetEndDeviceLFDI returns the EndDeviceLFDI of d, or "" if d or one of its bases is nil.
+func (d *DrResponse) GetEndDeviceLFDI() string {
+	if d == nil {
+		return ""
+	}
+	return d.Response.GetEndDeviceLFDI()
+}
+
+// GetStatus returns the Status of d, or nil if d or one of its bases is nil.
+func (d *DrResponse) GetStatus() *ResponseStatus {
+	if d == nil {
+		return nil
+	}
+	return d.Response.GetStatus()
+}
+
+// GetSubject returns the Subject of d, or nil if d or one of its bases is nil.
+func (d *DrResponse) GetSubject() *MRIDType {
+	if d == nil {
+		return nil
+	}
+	return d.Response.GetSubject()
+}
+
+// NewAppliedTargetReduction returns a new AppliedTargetReduction.
+func NewAppliedTargetReduction() *AppliedTargetReduction {
+	return &AppliedTargetReduction{}
+}
+
+// NewFlowReservationResponseResponse returns a FlowReservationResponseResponse with its chain of base types allocated.
+func NewFlowReservationResponseResponse() *FlowReservationResponseResponse {
+	return &FlowReservationResponseResponse{Response: NewResponse()}
+}
+
+// GetHref returns the Href of f, or "" if f or one of its bases is nil.
+func (f *FlowReservationResponseResponse) GetHref() string {
+	if f == nil {
+		return ""
+	}
+	return f.Response.GetHref()
+}
+
+// GetCreatedDateTime returns the CreatedDateTime of f, or nil if f or one of its bases is nil.
+func (f *FlowReservationResponseResponse) GetCreatedDateTime() *TimeType {
+	if f == nil {
+		return nil
+	}
+	return f.Response.GetCreatedDateTime()
+}
+
+// GetEndDeviceLFDI returns the EndDeviceLFDI of f, or "" if f or one of its bases is nil.
+func (f *FlowReservationResponseResponse) GetEndDeviceLFDI() string {
+	if f == nil {
+		return ""
+	}
+	return f.Response.GetEndDeviceLFDI()
+}
+
+// GetStatus returns the Status of f, or nil if f or one of its bases is nil.
+func (f *FlowReservationResponseResponse) GetStatus() *ResponseStatus {
+	if f == nil {
+		return nil
+	}
+	return f.Response.GetStatus()
+}
+
+// GetSubject returns the Subject of f, or nil if f or one of its bases is nil.
+func (f *FlowReservationResponseResponse) GetSubject() *MRIDType {
+	if f == nil {
+		return nil
+	}
+	return f.Response.GetSubject()
+}
+
+// NewPriceResponse returns a PriceResponse with its chain of base types allocated.
+func NewPriceResponse() *PriceResponse {
+	return &PriceResponse{Response: NewResponse()}
+}
+
+// GetHref returns the Href of p, or "" if p or one of its bases is nil.
+func (p *PriceResponse) GetHref() string {
+	if p == nil {
+		return ""
+	}
+	return p.Response.GetHref()
+}
+
+// GetCreatedDateTime returns the CreatedDateTime of p, or nil if p or one of its bases is nil.
+func (p *PriceResponse) GetCreatedDateTime() *TimeType {
+	if p == nil {
+		return nil
+	}
+	return p.Response.GetCreatedDateTime()
+}
+
+// GetEndDeviceLFDI returns the EndDeviceLFDI of p, or "" if p or one of its bases is nil.
+func (p *PriceResponse) GetEndDeviceLFDI() string {
+	if p == nil {
+		return ""
+	}
+	return p.Response.GetEndDeviceLFDI()
+}
+
+// GetStatus returns the Status of p, or nil if p or one of its bases is nil.
+func (p *PriceResponse) GetStatus() *ResponseStatus {
+	if p == nil {
+		return nil
+	}
+	return p.Response.GetStatus()
+}
+
+// GetSubject returns the Subject of p, or nil if p or one of its bases is nil.
+func (p *PriceResponse) GetSubject() *MRIDType {
+	if p == nil {
+		return nil
+	}
+	return p.Response.GetSubject()
+}
+
+// NewTextResponse returns a TextResponse with its chain of base types allocated.
+func NewTextResponse() *TextResponse {
+	return &TextResponse{Response: NewResponse()}
+}
+
+// GetHref returns the Href of t, or "" if t or one of its bases is nil.
+func (t *TextResponse) GetHref() string {
+	if t == nil {
+		return ""
+	}
+	return t.Response.GetHref()
+}
+
+// GetCreatedDateTime returns the CreatedDateTime of t, or nil if t or one of its bases is nil.
+func (t *TextResponse) GetCreatedDateTime() *TimeType {
+	if t == nil {
+		return nil
+	}
+	return t.Response.GetCreatedDateTime()
+}
+
+// GetEndDeviceLFDI returns the EndDeviceLFDI of t, or "" if t or one of its bases is nil.
+func (t *TextResponse) GetEndDeviceLFDI() string {
+	if t == nil {
+		return ""
+	}
+	return t.Response.GetEndDeviceLFDI()
+}
+
+// GetStatus returns the Status of t, or nil if t or one of its bases is nil.
+func (t *TextResponse) GetStatus() *ResponseStatus {
+	if t == nil {
+		return nil
+	}
+	return t.Response.GetStatus()
+}
+
+// GetSubject returns the Subject of t, or nil if t or one of its bases is nil.
+func (t *TextResponse) GetSubject() *MRIDType {
+	if t == nil {
+		return nil
+	}
+	return t.Response.GetSubject()
+}
+
+// NewTime returns a Time with its chain of base types allocated.
+func NewTime() *Time {
+	return &Time{Resource: NewResource()}
+}
+
+// GetHref returns the Href of t, or "" if t or one of its bases is nil.
+func (t *Time) GetHref() string {
+	if t == nil {
+		return ""
+	}
+	return t.Resource.GetHref()
+}
+
+// NewDeviceInformation returns a DeviceInformation with its chain of base types allocated.
+func NewDeviceInformation() *DeviceInformation {
+	return &DeviceInformation{Resource: NewResource()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DeviceInformation) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.Resource.GetHref()
+}
+
+// NewDRLCCapabilities returns a new DRLCCapabilities.
+func NewDRLCCapabilities() *DRLCCapabilities {
+	return &DRLCCapabilities{}
+}
+
+// NewSupportedLocale returns a SupportedLocale with its chain of base types allocated.
+func NewSupportedLocale() *SupportedLocale {
+	return &SupportedLocale{Resource: NewResource()}
+}
+
+// GetHref returns the Href of s, or "" if s or one of its bases is nil.
+func (s *SupportedLocale) GetHref() string {
+	if s == nil {
+		return ""
+	}
+	return s.Resource.GetHref()
+}
+
+// NewSupportedLocaleList returns a SupportedLocaleList with its chain of base types allocated.
+func NewSupportedLocaleList() *SupportedLocaleList {
+	return &SupportedLocaleList{List: NewList()}
+}
+
+// GetHref returns the Href of s, or "" if s or one of its bases is nil.
+func (s *SupportedLocaleList) GetHref() string {
+	if s == nil {
+		return ""
+	}
+	return s.List.GetHref()
+}
+
+// GetAll returns the All of s, or 0 if s or one of its bases is nil.
+func (s *SupportedLocaleList) GetAll() uint32 {
+	if s == nil {
+		return 0
+	}
+	return s.List.GetAll()
+}
+
+// GetResults returns the Results of s, or 0 if s or one of its bases is nil.
+func (s *SupportedLocaleList) GetResults() uint32 {
+	if s == nil {
+		return 0
+	}
+	return s.List.GetResults()
+}
+
+// NewPowerStatus returns a PowerStatus with its chain of base types allocated.
+func NewPowerStatus() *PowerStatus {
+	return &PowerStatus{Resource: NewResource()}
+}
+
+// GetHref returns the Href of p, or "" if p or one of its bases is nil.
+func (p *PowerStatus) GetHref() string {
+	if p == nil {
+		return ""
+	}
+	return p.Resource.GetHref()
+}
+
+// NewPEVInfo returns a new PEVInfo.
+func NewPEVInfo() *PEVInfo {
+	return &PEVInfo{}
+}
+
+// NewIEEE802154 returns a new IEEE802154.
+func NewIEEE802154() *IEEE802154 {
+	return &IEEE802154{}
+}
+
+// NewIPAddr returns an IPAddr with its chain of base types allocated.
+func NewIPAddr() *IPAddr {
+	return &IPAddr{Resource: NewResource()}
+}
+
+// GetHref returns the Href of i, or "" if i or one of its bases is nil.
+func (i *IPAddr) GetHref() string {
+	if i == nil {
+		return ""
+	}
+	return i.Resource.GetHref()
+}
+
+// NewIPAddrList returns an IPAddrList with its chain of base types allocated.
+func NewIPAddrList() *IPAddrList {
+	return &IPAddrList{List: NewList()}
+}
+
+// GetHref returns the Href of i, or "" if i or one of its bases is nil.
+func (i *IPAddrList) GetHref() string {
+	if i == nil {
+		return ""
+	}
+	return i.List.GetHref()
+}
+
+// GetAll returns the All of i, or 0 if i or one of its bases is nil.
+func (i *IPAddrList) GetAll() uint32 {
+	if i == nil {
+		return 0
+	}
+	return i.List.GetAll()
+}
+
+// GetResults returns the Results of i, or 0 if i or one of its bases is nil.
+func (i *IPAddrList) GetResults() uint32 {
+	if i == nil {
+		return 0
+	}
+	return i.List.GetResults()
+}
+
+// NewIPInterface returns an IPInterface with its chain of base types allocated.
+func NewIPInterface() *IPInterface {
+	return &IPInterface{Resource: NewResource()}
+}
+
+// GetHref returns the Href of i, or "" if i or one of its bases is nil.
+func (i *IPInterface) GetHref() string {
+	if i == nil {
+		return ""
+	}
+	return i.Resource.GetHref()
+}
+
+// NewIPInterfaceList returns an IPInterfaceList with its chain of base types allocated.
+func NewIPInterfaceList() *IPInterfaceList {
+	return &IPInterfaceList{List: NewList()}
+}
+
+// GetHref returns the Href of i, or "" if i or one of its bases is nil.
+func (i *IPInterfaceList) GetHref() string {
+	if i == nil {
+		return ""
+	}
+	return i.List.GetHref()
+}
+
+// GetAll returns the All of i, or 0 if i or one of its bases is nil.
+func (i *IPInterfaceList) GetAll() uint32 {
+	if i == nil {
+		return 0
+	}
+	return i.List.GetAll()
+}
+
+// GetResults returns the Results of i, or 0 if i or one of its bases is nil.
+func (i *IPInterfaceList) GetResults() uint32 {
+	if i == nil {
+		return 0
+	}
+	return i.List.GetResults()
+}
+
+// NewLLInterface returns a LLInterface with its chain of base types allocated.
+func NewLLInterface() *LLInterface {
+	return &LLInterface{Resource: NewResource()}
+}
+
+// GetHref returns the Href of l, or "" if l or one of its bases is nil.
+func (l *LLInterface) GetHref() string {
+	if l == nil {
+		return ""
+	}
+	return l.Resource.GetHref()
+}
+
+// NewLLInterfaceList returns a LLInterfaceList with its chain of base types allocated.
+func NewLLInterfaceList() *LLInterfaceList {
+	return &LLInterfaceList{List: NewList()}
+}
+
+// GetHref returns the Href of l, or "" if l or one of its bases is nil.
+func (l *LLInterfaceList) GetHref() string {
+	if l == nil {
+		return ""
+	}
+	return l.List.GetHref()
+}
+
+// GetAll returns the All of l, or 0 if l or one of its bases is nil.
+func (l *LLInterfaceList) GetAll() uint32 {
+	if l == nil {
+		return 0
+	}
+	return l.List.GetAll()
+}
+
+// GetResults returns the Results of l, or 0 if l or one of its bases is nil.
+func (l *LLInterfaceList) GetResults() uint32 {
+	if l == nil {
+		return 0
+	}
+	return l.List.GetResults()
+}
+
+// NewLoWPAN returns a new LoWPAN.
+func NewLoWPAN() *LoWPAN {
+	return &LoWPAN{}
+}
+
+// NewNeighbor returns a Neighbor with its chain of base types allocated.
+func NewNeighbor() *Neighbor {
+	return &Neighbor{Resource: NewResource()}
+}
+
+// GetHref returns the Href of n, or "" if n or one of its bases is nil.
+func (n *Neighbor) GetHref() string {
+	if n == nil {
+		return ""
+	}
+	return n.Resource.GetHref()
+}
+
+// NewNeighborList returns a NeighborList with its chain of base types allocated.
+func NewNeighborList() *NeighborList {
+	return &NeighborList{List: NewList()}
+}
+
+// GetHref returns the Href of n, or "" if n or one of its bases is nil.
+func (n *NeighborList) GetHref() string {
+	if n == nil {
+		return ""
+	}
+	return n.List.GetHref()
+}
+
+// GetAll returns the All of n, or 0 if n or one of its bases is nil.
+func (n *NeighborList) GetAll() uint32 {
+	if n == nil {
+		return 0
+	}
+	return n.List.GetAll()
+}
+
+// GetResults returns the Results of n, or 0 if n or one of its bases is nil.
+func (n *NeighborList) GetResults() uint32 {
+	if n == nil {
+		return 0
+	}
+	return n.List.GetResults()
+}
+
+// NewRPLInstance returns a RPLInstance with its chain of base types allocated.
+func NewRPLInstance() *RPLInstance {
+	return &RPLInstance{Resource: NewResource()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *RPLInstance) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.Resource.GetHref()
+}
+
+// NewRPLInstanceList returns a RPLInstanceList with its chain of base types allocated.
+func NewRPLInstanceList() *RPLInstanceList {
+	return &RPLInstanceList{List: NewList()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *RPLInstanceList) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.List.GetHref()
+}
+
+// GetAll returns the All of r, or 0 if r or one of its bases is nil.
+func (r *RPLInstanceList) GetAll() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.List.GetAll()
+}
+
+// GetResults returns the Results of r, or 0 if r or one of its bases is nil.
+func (r *RPLInstanceList) GetResults() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.List.GetResults()
+}
+
+// NewRPLSourceRoutes returns a RPLSourceRoutes with its chain of base types allocated.
+func NewRPLSourceRoutes() *RPLSourceRoutes {
+	return &RPLSourceRoutes{Resource: NewResource()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *RPLSourceRoutes) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.Resource.GetHref()
+}
+
+// NewRPLSourceRoutesList returns a RPLSourceRoutesList with its chain of base types allocated.
+func NewRPLSourceRoutesList() *RPLSourceRoutesList {
+	return &RPLSourceRoutesList{List: NewList()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *RPLSourceRoutesList) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.List.GetHref()
+}
+
+// GetAll returns the All of r, or 0 if r or one of its bases is nil.
+func (r *RPLSourceRoutesList) GetAll() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.List.GetAll()
+}
+
+// GetResults returns the Results of r, or 0 if r or one of its bases is nil.
+func (r *RPLSourceRoutesList) GetResults() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.List.GetResults()
+}
+
+// NewLogEvent returns a LogEvent with its chain of base types allocated.
+func NewLogEvent() *LogEvent {
+	return &LogEvent{Resource: NewResource()}
+}
+
+// GetHref returns the Href of l, or "" if l or one of its bases is nil.
+func (l *LogEvent) GetHref() string {
+	if l == nil {
+		return ""
+	}
+	return l.Resource.GetHref()
+}
+
+// NewLogEventList returns a LogEventList with its chain of base types allocated.
+func NewLogEventList() *LogEventList {
+	return &LogEventList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of l, or "" if l or one of its bases is nil.
+func (l *LogEventList) GetHref() string {
+	if l == nil {
+		return ""
+	}
+	return l.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of l, or nil if l or one of its bases is nil.
+func (l *LogEventList) GetSubscribable() *UInt8 {
+	if l == nil {
+		return nil
+	}
+	return l.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of l, or 0 if l or one of its bases is nil.
+func (l *LogEventList) GetAll() uint32 {
+	if l == nil {
+		return 0
+	}
+	return l.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of l, or 0 if l or one of its bases is nil.
+func (l *LogEventList) GetResults() uint32 {
+	if l == nil {
+		return 0
+	}
+	return l.SubscribableList.GetResults()
+}
+
+// NewConfiguration returns a Configuration with its chain of base types allocated.
+func NewConfiguration() *Configuration {
+	return &Configuration{SubscribableResource: NewSubscribableResource()}
+}
+
+// GetHref returns the Href of c, or "" if c or one of its bases is nil.
+func (c *Configuration) GetHref() string {
+	if c == nil {
+		return ""
+	}
+	return c.SubscribableResource.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of c, or nil if c or one of its bases is nil.
+func (c *Configuration) GetSubscribable() *UInt8 {
+	if c == nil {
+		return nil
+	}
+	return c.SubscribableResource.GetSubscribable()
+}
+
+// NewPowerConfiguration returns a new PowerConfiguration.
+func NewPowerConfiguration() *PowerConfiguration {
+	return &PowerConfiguration{}
+}
+
+// NewPriceResponseCfg returns a PriceResponseCfg with its chain of base types allocated.
+func NewPriceResponseCfg() *PriceResponseCfg {
+	return &PriceResponseCfg{Resource: NewResource()}
+}
+
+// GetHref returns the Href of p, or "" if p or one of its bases is nil.
+func (p *PriceResponseCfg) GetHref() string {
+	if p == nil {
+		return ""
+	}
+	return p.Resource.GetHref()
+}
+
+// NewPriceResponseCfgList returns a PriceResponseCfgList with its chain of base types allocated.
+func NewPriceResponseCfgList() *PriceResponseCfgList {
+	return &PriceResponseCfgList{List: NewList()}
+}
+
+// GetHref returns the Href of p, or "" if p or one of its bases is nil.
+func (p *PriceResponseCfgList) GetHref() string {
+	if p == nil {
+		return ""
+	}
+	return p.List.GetHref()
+}
+
+// GetAll returns the All of p, or 0 if p or one of its bases is nil.
+func (p *PriceResponseCfgList) GetAll() uint32 {
+	if p == nil {
+		return 0
+	}
+	return p.List.GetAll()
+}
+
+// GetResults returns the Results of p, or 0 if p or one of its bases is nil.
+func (p *PriceResponseCfgList) GetResults() uint32 {
+	if p == nil {
+		return 0
+	}
+	return p.List.GetResults()
+}
+
+// NewTimeConfiguration returns a new TimeConfiguration.
+func NewTimeConfiguration() *TimeConfiguration {
+	return &TimeConfiguration{}
+}
+
+// NewFile returns a File with its chain of base types allocated.
+func NewFile() *File {
+	return &File{Resource: NewResource()}
+}
+
+// GetHref returns the Href of f, or "" if f or one of its bases is nil.
+func (f *File) GetHref() string {
+	if f == nil {
+		return ""
+	}
+	return f.Resource.GetHref()
+}
+
+// NewFileList returns a FileList with its chain of base types allocated.
+func NewFileList() *FileList {
+	return &FileList{List: NewList()}
+}
+
+// GetHref returns the Href of f, or "" if f or one of its bases is nil.
+func (f *FileList) GetHref() string {
+	if f == nil {
+		return ""
+	}
+	return f.List.GetHref()
+}
+
+// GetAll returns the All of f, or 0 if f or one of its bases is nil.
+func (f *FileList) GetAll() uint32 {
+	if f == nil {
+		return 0
+	}
+	return f.List.GetAll()
+}
+
+// GetResults returns the Results of f, or 0 if f or one of its bases is nil.
+func (f *FileList) GetResults() uint32 {
+	if f == nil {
+		return 0
+	}
+	return f.List.GetResults()
+}
+
+// NewFileStatus returns a FileStatus with its chain of base types allocated.
+func NewFileStatus() *FileStatus {
+	return &FileStatus{Resource: NewResource()}
+}
+
+// GetHref returns the Href of f, or "" if f or one of its bases is nil.
+func (f *FileStatus) GetHref() string {
+	if f == nil {
+		return ""
+	}
+	return f.Resource.GetHref()
+}
+
+// NewLoadShedAvailabilityList returns a LoadShedAvailabilityList with its chain of base types allocated.
+func NewLoadShedAvailabilityList() *LoadShedAvailabilityList {
+	return &LoadShedAvailabilityList{List: NewList()}
+}
+
+// GetHref returns the Href of l, or "" if l or one of its bases is nil.
+func (l *LoadShedAvailabilityList) GetHref() string {
+	if l == nil {
+		return ""
+	}
+	return l.List.GetHref()
+}
+
+// GetAll returns the All of l, or 0 if l or one of its bases is nil.
+func (l *LoadShedAvailabilityList) GetAll() uint32 {
+	if l == nil {
+		return 0
+	}
+	return l.List.GetAll()
+}
+
+// GetResults returns the Results of l, or 0 if l or one of its bases is nil.
+func (l *LoadShedAvailabilityList) GetResults() uint32 {
+	if l == nil {
+		return 0
+	}
+	return l.List.GetResults()
+}
+
+// NewApplianceLoadReduction returns a new ApplianceLoadReduction.
+func NewApplianceLoadReduction() *ApplianceLoadReduction {
+	return &ApplianceLoadReduction{}
+}
+
+// NewDemandResponseProgram returns a DemandResponseProgram with its chain of base types allocated.
+func NewDemandResponseProgram() *DemandResponseProgram {
+	return &DemandResponseProgram{IdentifiedObject: NewIdentifiedObject()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DemandResponseProgram) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.IdentifiedObject.GetHref()
+}
+
+// GetMRID returns the MRID of d, or nil if d or one of its bases is nil.
+func (d *DemandResponseProgram) GetMRID() *MRIDType {
+	if d == nil {
+		return nil
+	}
+	return d.IdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of d, or nil if d or one of its bases is nil.
+func (d *DemandResponseProgram) GetDescription() *string {
+	if d == nil {
+		return nil
+	}
+	return d.IdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of d, or nil if d or one of its bases is nil.
+func (d *DemandResponseProgram) GetVersion() *VersionType {
+	if d == nil {
+		return nil
+	}
+	return d.IdentifiedObject.GetVersion()
+}
+
+// NewDemandResponseProgramList returns a DemandResponseProgramList with its chain of base types allocated.
+func NewDemandResponseProgramList() *DemandResponseProgramList {
+	return &DemandResponseProgramList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DemandResponseProgramList) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of d, or nil if d or one of its bases is nil.
+func (d *DemandResponseProgramList) GetSubscribable() *UInt8 {
+	if d == nil {
+		return nil
+	}
+	return d.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of d, or 0 if d or one of its bases is nil.
+func (d *DemandResponseProgramList) GetAll() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of d, or 0 if d or one of its bases is nil.
+func (d *DemandResponseProgramList) GetResults() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.SubscribableList.GetResults()
+}
+
+// NewDutyCycle returns a new DutyCycle.
+func NewDutyCycle() *DutyCycle {
+	return &DutyCycle{}
+}
+
+// NewEndDeviceControl returns an EndDeviceControl with its chain of base types allocated.
+func NewEndDeviceControl() *EndDeviceControl {
+	return &EndDeviceControl{RandomizableEvent: NewRandomizableEvent()}
+}
+
+// GetHref returns the Href of e, or "" if e or one of its bases is nil.
+func (e *EndDeviceControl) GetHref() string {
+	if e == nil {
+		return ""
+	}
+	return e.RandomizableEvent.GetHref()
+}
+
+// GetReplyTo returns the ReplyTo of e, or "" if e or one of its bases is nil.
+func (e *EndDeviceControl) GetReplyTo() string {
+	if e == nil {
+		return ""
+	}
+	return e.RandomizableEvent.GetReplyTo()
+}
+
+// GetResponseRequired returns the ResponseRequired of e, or "" if e or one of its bases is nil.
+func (e *EndDeviceControl) GetResponseRequired() string {
+	if e == nil {
+		return ""
+	}
+	return e.RandomizableEvent.GetResponseRequired()
+}
+
+// GetSubscribable returns the Subscribable of e, or nil if e or one of its bases is nil.
+func (e *EndDeviceControl) GetSubscribable() *UInt8 {
+	if e == nil {
+		return nil
+	}
+	return e.RandomizableEvent.GetSubscribable()
+}
+
+// GetMRID returns the MRID of e, or nil if e or one of its bases is nil.
+func (e *EndDeviceControl) GetMRID() *MRIDType {
+	if e == nil {
+		return nil
+	}
+	return e.RandomizableEvent.GetMRID()
+}
+
+// GetDescription returns the Description of e, or nil if e or one of its bases is nil.
+func (e *EndDeviceControl) GetDescription() *string {
+	if e == nil {
+		return nil
+	}
+	return e.RandomizableEvent.GetDescription()
+}
+
+// GetVersion returns the Version of e, or nil if e or one of its bases is nil.
+func (e *EndDeviceControl) GetVersion() *VersionType {
+	if e == nil {
+		return nil
+	}
+	return e.RandomizableEvent.GetVersion()
+}
+
+// GetCreationTime returns the CreationTime of e, or nil if e or one of its bases is nil.
+func (e *EndDeviceControl) GetCreationTime() *TimeType {
+	if e == nil {
+		return nil
+	}
+	return e.RandomizableEvent.GetCreationTime()
+}
+
+// GetEventStatus returns the EventStatus of e, or nil if e or one of its bases is nil.
+func (e *EndDeviceControl) GetEventStatus() *EventStatus {
+	if e == nil {
+		return nil
+	}
+	return e.RandomizableEvent.GetEventStatus()
+}
+
+// GetInterval returns the Interval of e, or nil if e or one of its bases is nil.
+func (e *EndDeviceControl) GetInterval() *DateTimeInterval {
+	if e == nil {
+		return nil
+	}
+	return e.RandomizableEvent.GetInterval()
+}
+
+// GetRandomizeDuration returns the RandomizeDuration of e, or nil if e or one of its bases is nil.
+func (e *EndDeviceControl) GetRandomizeDuration() *OneHourRangeType {
+	if e == nil {
+		return nil
+	}
+	return e.RandomizableEvent.GetRandomizeDuration()
+}
+
+// GetRandomizeStart returns the RandomizeStart of e, or nil if e or one of its bases is nil.
+func (e *EndDeviceControl) GetRandomizeStart() *OneHourRangeType {
+	if e == nil {
+		return nil
+	}
+	return e.RandomizableEvent.GetRandomizeStart()
+}
+
+// NewEndDeviceControlList returns an EndDeviceControlList with its chain of base types allocated.
+func NewEndDeviceControlList() *EndDeviceControlList {
+	return &EndDeviceControlList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of e, or "" if e or one of its bases is nil.
+func (e *EndDeviceControlList) GetHref() string {
+	if e == nil {
+		return ""
+	}
+	return e.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of e, or nil if e or one of its bases is nil.
+func (e *EndDeviceControlList) GetSubscribable() *UInt8 {
+	if e == nil {
+		return nil
+	}
+	return e.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of e, or 0 if e or one of its bases is nil.
+func (e *EndDeviceControlList) GetAll() uint32 {
+	if e == nil {
+		return 0
+	}
+	return e.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of e, or 0 if e or one of its bases is nil.
+func (e *EndDeviceControlList) GetResults() uint32 {
+	if e == nil {
+		return 0
+	}
+	return e.SubscribableList.GetResults()
+}
+
+// NewLoadShedAvailability returns a LoadShedAvailability with its chain of base types allocated.
+func NewLoadShedAvailability() *LoadShedAvailability {
+	return &LoadShedAvailability{Resource: NewResource()}
+}
+
+// GetHref returns the Href of l, or "" if l or one of its bases is nil.
+func (l *LoadShedAvailability) GetHref() string {
+	if l == nil {
+		return ""
+	}
+	return l.Resource.GetHref()
+}
+
+// NewOffset returns a new Offset.
+func NewOffset() *Offset {
+	return &Offset{}
+}
+
+// NewSetPoint returns a new SetPoint.
+func NewSetPoint() *SetPoint {
+	return &SetPoint{}
+}
+
+// NewTargetReduction returns a new TargetReduction.
+func NewTargetReduction() *TargetReduction {
+	return &TargetReduction{}
+}
+
+// NewMeterReading returns a MeterReading with its chain of base types allocated.
+func NewMeterReading() *MeterReading {
+	return &MeterReading{MeterReadingBase: NewMeterReadingBase()}
+}
+
+// GetHref returns the Href of m, or "" if m or one of its bases is nil.
+func (m *MeterReading) GetHref() string {
+	if m == nil {
+		return ""
+	}
+	return m.MeterReadingBase.GetHref()
+}
+
+// GetMRID returns the MRID of m, or nil if m or one of its bases is nil.
+func (m *MeterReading) GetMRID() *MRIDType {
+	if m == nil {
+		return nil
+	}
+	return m.MeterReadingBase.GetMRID()
+}
+
+// GetDescription returns the Description of m, or nil if m or one of its bases is nil.
+func (m *MeterReading) GetDescription() *string {
+	if m == nil {
+		return nil
+	}
+	return m.MeterReadingBase.GetDescription()
+}
+
+// GetVersion returns the Version of m, or nil if m or one of its bases is nil.
+func (m *MeterReading) GetVersion() *VersionType {
+	if m == nil {
+		return nil
+	}
+	return m.MeterReadingBase.GetVersion()
+}
+
+// NewMeterReadingList returns a MeterReadingList with its chain of base types allocated.
+func NewMeterReadingList() *MeterReadingList {
+	return &MeterReadingList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of m, or "" if m or one of its bases is nil.
+func (m *MeterReadingList) GetHref() string {
+	if m == nil {
+		return ""
+	}
+	return m.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of m, or nil if m or one of its bases is nil.
+func (m *MeterReadingList) GetSubscribable() *UInt8 {
+	if m == nil {
+		return nil
+	}
+	return m.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of m, or 0 if m or one of its bases is nil.
+func (m *MeterReadingList) GetAll() uint32 {
+	if m == nil {
+		return 0
+	}
+	return m.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of m, or 0 if m or one of its bases is nil.
+func (m *MeterReadingList) GetResults() uint32 {
+	if m == nil {
+		return 0
+	}
+	return m.SubscribableList.GetResults()
+}
+
+// NewReading returns a Reading with its chain of base types allocated.
+func NewReading() *Reading {
+	return &Reading{ReadingBase: NewReadingBase()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *Reading) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.ReadingBase.GetHref()
+}
+
+// GetConsumptionBlock returns the ConsumptionBlock of r, or nil if r or one of its bases is nil.
+func (r *Reading) GetConsumptionBlock() *ConsumptionBlockType {
+	if r == nil {
+		return nil
+	}
+	return r.ReadingBase.GetConsumptionBlock()
+}
+
+// GetQualityFlags returns the QualityFlags of r, or nil if r or one of its bases is nil.
+func (r *Reading) GetQualityFlags() *string {
+	if r == nil {
+		return nil
+	}
+	return r.ReadingBase.GetQualityFlags()
+}
+
+// GetTimePeriod returns the TimePeriod of r, or nil if r or one of its bases is nil.
+func (r *Reading) GetTimePeriod() *DateTimeInterval {
+	if r == nil {
+		return nil
+	}
+	return r.ReadingBase.GetTimePeriod()
+}
+
+// GetTouTier returns the TouTier of r, or nil if r or one of its bases is nil.
+func (r *Reading) GetTouTier() *TOUType {
+	if r == nil {
+		return nil
+	}
+	return r.ReadingBase.GetTouTier()
+}
+
+// GetValue returns the Value of r, or nil if r or one of its bases is nil.
+func (r *Reading) GetValue() *int64 {
+	if r == nil {
+		return nil
+	}
+	return r.ReadingBase.GetValue()
+}
+
+// NewReadingList returns a ReadingList with its chain of base types allocated.
+func NewReadingList() *ReadingList {
+	return &ReadingList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *ReadingList) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of r, or nil if r or one of its bases is nil.
+func (r *ReadingList) GetSubscribable() *UInt8 {
+	if r == nil {
+		return nil
+	}
+	return r.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of r, or 0 if r or one of its bases is nil.
+func (r *ReadingList) GetAll() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of r, or 0 if r or one of its bases is nil.
+func (r *ReadingList) GetResults() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.SubscribableList.GetResults()
+}
+
+// NewReadingSet returns a ReadingSet with its chain of base types allocated.
+func NewReadingSet() *ReadingSet {
+	return &ReadingSet{ReadingSetBase: NewReadingSetBase()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *ReadingSet) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.ReadingSetBase.GetHref()
+}
+
+// GetMRID returns the MRID of r, or nil if r or one of its bases is nil.
+func (r *ReadingSet) GetMRID() *MRIDType {
+	if r == nil {
+		return nil
+	}
+	return r.ReadingSetBase.GetMRID()
+}
+
+// GetDescription returns the Description of r, or nil if r or one of its bases is nil.
+func (r *ReadingSet) GetDescription() *string {
+	if r == nil {
+		return nil
+	}
+	return r.ReadingSetBase.GetDescription()
+}
+
+// GetVersion returns the Version of r, or nil if r or one of its bases is nil.
+func (r *ReadingSet) GetVersion() *VersionType {
+	if r == nil {
+		return nil
+	}
+	return r.ReadingSetBase.GetVersion()
+}
+
+// GetTimePeriod returns the TimePeriod of r, or nil if r or one of its bases is nil.
+func (r *ReadingSet) GetTimePeriod() *DateTimeInterval {
+	if r == nil {
+		return nil
+	}
+	return r.ReadingSetBase.GetTimePeriod()
+}
+
+// NewReadingSetList returns a ReadingSetList with its chain of base types allocated.
+func NewReadingSetList() *ReadingSetList {
+	return &ReadingSetList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *ReadingSetList) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of r, or nil if r or one of its bases is nil.
+func (r *ReadingSetList) GetSubscribable() *UInt8 {
+	if r == nil {
+		return nil
+	}
+	return r.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of r, or 0 if r or one of its bases is nil.
+func (r *ReadingSetList) GetAll() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of r, or 0 if r or one of its bases is nil.
+func (r *ReadingSetList) GetResults() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.SubscribableList.GetResults()
+}
+
+// NewReadingType returns a ReadingType with its chain of base types allocated.
+func NewReadingType() *ReadingType {
+	return &ReadingType{Resource: NewResource()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *ReadingType) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.Resource.GetHref()
+}
+
+// NewUsagePoint returns an UsagePoint with its chain of base types allocated.
+func NewUsagePoint() *UsagePoint {
+	return &UsagePoint{UsagePointBase: NewUsagePointBase()}
+}
+
+// GetHref returns the Href of u, or "" if u or one of its bases is nil.
+func (u *UsagePoint) GetHref() string {
+	if u == nil {
+		return ""
+	}
+	return u.UsagePointBase.GetHref()
+}
+
+// GetMRID returns the MRID of u, or nil if u or one of its bases is nil.
+func (u *UsagePoint) GetMRID() *MRIDType {
+	if u == nil {
+		return nil
+	}
+	return u.UsagePointBase.GetMRID()
+}
+
+// GetDescription returns the Description of u, or nil if u or one of its bases is nil.
+func (u *UsagePoint) GetDescription() *string {
+	if u == nil {
+		return nil
+	}
+	return u.UsagePointBase.GetDescription()
+}
+
+// GetVersion returns the Version of u, or nil if u or one of its bases is nil.
+func (u *UsagePoint) GetVersion() *VersionType {
+	if u == nil {
+		return nil
+	}
+	return u.UsagePointBase.GetVersion()
+}
+
+// GetRoleFlags returns the RoleFlags of u, or nil if u or one of its bases is nil.
+func (u *UsagePoint) GetRoleFlags() *RoleFlagsType {
+	if u == nil {
+		return nil
+	}
+	return u.UsagePointBase.GetRoleFlags()
+}
+
+// GetServiceCategoryKind returns the ServiceCategoryKind of u, or nil if u or one of its bases is nil.
+func (u *UsagePoint) GetServiceCategoryKind() *ServiceKind {
+	if u == nil {
+		return nil
+	}
+	return u.UsagePointBase.GetServiceCategoryKind()
+}
+
+// GetStatus returns the Status of u, or 0 if u or one of its bases is nil.
+func (u *UsagePoint) GetStatus() uint8 {
+	if u == nil {
+		return 0
+	}
+	return u.UsagePointBase.GetStatus()
+}
+
+// NewUsagePointList returns an UsagePointList with its chain of base types allocated.
+func NewUsagePointList() *UsagePointList {
+	return &UsagePointList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of u, or "" if u or one of its bases is nil.
+func (u *UsagePointList) GetHref() string {
+	if u == nil {
+		return ""
+	}
+	return u.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of u, or nil if u or one of its bases is nil.
+func (u *UsagePointList) GetSubscribable() *UInt8 {
+	if u == nil {
+		return nil
+	}
+	return u.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of u, or 0 if u or one of its bases is nil.
+func (u *UsagePointList) GetAll() uint32 {
+	if u == nil {
+		return 0
+	}
+	return u.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of u, or 0 if u or one of its bases is nil.
+func (u *UsagePointList) GetResults() uint32 {
+	if u == nil {
+		return 0
+	}
+	return u.SubscribableList.GetResults()
+}
+
+// NewConsumptionTariffInterval returns a ConsumptionTariffInterval with its chain of base types allocated.
+func NewConsumptionTariffInterval() *ConsumptionTariffInterval {
+	return &ConsumptionTariffInterval{Resource: NewResource()}
+}
+
+// GetHref returns the Href of c, or "" if c or one of its bases is nil.
+func (c *ConsumptionTariffInterval) GetHref() string {
+	if c == nil {
+		return ""
+	}
+	return c.Resource.GetHref()
+}
+
+// NewConsumptionTariffIntervalList returns a ConsumptionTariffIntervalList with its chain of base types allocated.
+func NewConsumptionTariffIntervalList() *ConsumptionTariffIntervalList {
+	return &ConsumptionTariffIntervalList{List: NewList()}
+}
+
+// GetHref returns the Href of c, or "" if c or one of its bases is nil.
+func (c *ConsumptionTariffIntervalList) GetHref() string {
+	if c == nil {
+		return ""
+	}
+	return c.List.GetHref()
+}
+
+// GetAll returns the All of c, or 0 if c or one of its bases is nil.
+func (c *ConsumptionTariffIntervalList) GetAll() uint32 {
+	if c == nil {
+		return 0
+	}
+	return c.List.GetAll()
+}
+
+// GetResults returns the Results of c, or 0 if c or one of its bases is nil.
+func (c *ConsumptionTariffIntervalList) GetResults() uint32 {
+	if c == nil {
+		return 0
+	}
+	return c.List.GetResults()
+}
+
+// NewEnvironmentalCost returns a new EnvironmentalCost.
+func NewEnvironmentalCost() *EnvironmentalCost {
+	return &EnvironmentalCost{}
+}
+
+// NewRateComponent returns a RateComponent with its chain of base types allocated.
+func NewRateComponent() *RateComponent {
+	return &RateComponent{IdentifiedObject: NewIdentifiedObject()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *RateComponent) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.IdentifiedObject.GetHref()
+}
+
+// GetMRID returns the MRID of r, or nil if r or one of its bases is nil.
+func (r *RateComponent) GetMRID() *MRIDType {
+	if r == nil {
+		return nil
+	}
+	return r.IdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of r, or nil if r or one of its bases is nil.
+func (r *RateComponent) GetDescription() *string {
+	if r == nil {
+		return nil
+	}
+	return r.IdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of r, or nil if r or one of its bases is nil.
+func (r *RateComponent) GetVersion() *VersionType {
+	if r == nil {
+		return nil
+	}
+	return r.IdentifiedObject.GetVersion()
+}
+
+// NewRateComponentList returns a RateComponentList with its chain of base types allocated.
+func NewRateComponentList() *RateComponentList {
+	return &RateComponentList{List: NewList()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *RateComponentList) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.List.GetHref()
+}
+
+// GetAll returns the All of r, or 0 if r or one of its bases is nil.
+func (r *RateComponentList) GetAll() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.List.GetAll()
+}
+
+// GetResults returns the Results of r, or 0 if r or one of its bases is nil.
+func (r *RateComponentList) GetResults() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.List.GetResults()
+}
+
+// NewTariffProfile returns a TariffProfile with its chain of base types allocated.
+func NewTariffProfile() *TariffProfile {
+	return &TariffProfile{IdentifiedObject: NewIdentifiedObject()}
+}
+
+// GetHref returns the Href of t, or "" if t or one of its bases is nil.
+func (t *TariffProfile) GetHref() string {
+	if t == nil {
+		return ""
+	}
+	return t.IdentifiedObject.GetHref()
+}
+
+// GetMRID returns the MRID of t, or nil if t or one of its bases is nil.
+func (t *TariffProfile) GetMRID() *MRIDType {
+	if t == nil {
+		return nil
+	}
+	return t.IdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of t, or nil if t or one of its bases is nil.
+func (t *TariffProfile) GetDescription() *string {
+	if t == nil {
+		return nil
+	}
+	return t.IdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of t, or nil if t or one of its bases is nil.
+func (t *TariffProfile) GetVersion() *VersionType {
+	if t == nil {
+		return nil
+	}
+	return t.IdentifiedObject.GetVersion()
+}
+
+// NewTariffProfileList returns a TariffProfileList with its chain of base types allocated.
+func NewTariffProfileList() *TariffProfileList {
+	return &TariffProfileList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of t, or "" if t or one of its bases is nil.
+func (t *TariffProfileList) GetHref() string {
+	if t == nil {
+		return ""
+	}
+	return t.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of t, or nil if t or one of its bases is nil.
+func (t *TariffProfileList) GetSubscribable() *UInt8 {
+	if t == nil {
+		return nil
+	}
+	return t.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of t, or 0 if t or one of its bases is nil.
+func (t *TariffProfileList) GetAll() uint32 {
+	if t == nil {
+		return 0
+	}
+	return t.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of t, or 0 if t or one of its bases is nil.
+func (t *TariffProfileList) GetResults() uint32 {
+	if t == nil {
+		return 0
+	}
+	return t.SubscribableList.GetResults()
+}
+
+// NewTimeTariffInterval returns a TimeTariffInterval with its chain of base types allocated.
+func NewTimeTariffInterval() *TimeTariffInterval {
+	return &TimeTariffInterval{RandomizableEvent: NewRandomizableEvent()}
+}
+
+// GetHref returns the Href of t, or "" if t or one of its bases is nil.
+func (t *TimeTariffInterval) GetHref() string {
+	if t == nil {
+		return ""
+	}
+	return t.RandomizableEvent.GetHref()
+}
+
+// GetReplyTo returns the ReplyTo of t, or "" if t or one of its bases is nil.
+func (t *TimeTariffInterval) GetReplyTo() string {
+	if t == nil {
+		return ""
+	}
+	return t.RandomizableEvent.GetReplyTo()
+}
+
+// GetResponseRequired returns the ResponseRequired of t, or "" if t or one of its bases is nil.
+func (t *TimeTariffInterval) GetResponseRequired() string {
+	if t == nil {
+		return ""
+	}
+	return t.RandomizableEvent.GetResponseRequired()
+}
+
+// GetSubscribable returns the Subscribable of t, or nil if t or one of its bases is nil.
+func (t *TimeTariffInterval) GetSubscribable() *UInt8 {
+	if t == nil {
+		return nil
+	}
+	return t.RandomizableEvent.GetSubscribable()
+}
+
+// GetMRID returns the MRID of t, or nil if t or one of its bases is nil.
+func (t *TimeTariffInterval) GetMRID() *MRIDType {
+	if t == nil {
+		return nil
+	}
+	return t.RandomizableEvent.GetMRID()
+}
+
+// GetDescription returns the Description of t, or nil if t or one of its bases is nil.
+func (t *TimeTariffInterval) GetDescription() *string {
+	if t == nil {
+		return nil
+	}
+	return t.RandomizableEvent.GetDescription()
+}
+
+// GetVersion returns the Version of t, or nil if t or one of its bases is nil.
+func (t *TimeTariffInterval) GetVersion() *VersionType {
+	if t == nil {
+		return nil
+	}
+	return t.RandomizableEvent.GetVersion()
+}
+
+// GetCreationTime returns the CreationTime of t, or nil if t or one of its bases is nil.
+func (t *TimeTariffInterval) GetCreationTime() *TimeType {
+	if t == nil {
+		return nil
+	}
+	return t.RandomizableEvent.GetCreationTime()
+}
+
+// GetEventStatus returns the EventStatus of t, or nil if t or one of its bases is nil.
+func (t *TimeTariffInterval) GetEventStatus() *EventStatus {
+	if t == nil {
+		return nil
+	}
+	return t.RandomizableEvent.GetEventStatus()
+}
+
+// GetInterval returns the Interval of t, or nil if t or one of its bases is nil.
+func (t *TimeTariffInterval) GetInterval() *DateTimeInterval {
+	if t == nil {
+		return nil
+	}
+	return t.RandomizableEvent.GetInterval()
+}
+
+// GetRandomizeDuration returns the RandomizeDuration of t, or nil if t or one of its bases is nil.
+func (t *TimeTariffInterval) GetRandomizeDuration() *OneHourRangeType {
+	if t == nil {
+		return nil
+	}
+	return t.RandomizableEvent.GetRandomizeDuration()
+}
+
+// GetRandomizeStart returns the RandomizeStart of t, or nil if t or one of its bases is nil.
+func (t *TimeTariffInterval) GetRandomizeStart() *OneHourRangeType {
+	if t == nil {
+		return nil
+	}
+	return t.RandomizableEvent.GetRandomizeStart()
+}
+
+// NewTimeTariffIntervalList returns a TimeTariffIntervalList with its chain of base types allocated.
+func NewTimeTariffIntervalList() *TimeTariffIntervalList {
+	return &TimeTariffIntervalList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of t, or "" if t or one of its bases is nil.
+func (t *TimeTariffIntervalList) GetHref() string {
+	if t == nil {
+		return ""
+	}
+	return t.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of t, or nil if t or one of its bases is nil.
+func (t *TimeTariffIntervalList) GetSubscribable() *UInt8 {
+	if t == nil {
+		return nil
+	}
+	return t.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of t, or 0 if t or one of its bases is nil.
+func (t *TimeTariffIntervalList) GetAll() uint32 {
+	if t == nil {
+		return 0
+	}
+	return t.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of t, or 0 if t or one of its bases is nil.
+func (t *TimeTariffIntervalList) GetResults() uint32 {
+	if t == nil {
+		return 0
+	}
+	return t.SubscribableList.GetResults()
+}
+
+// NewMessagingProgram returns a MessagingProgram with its chain of base types allocated.
+func NewMessagingProgram() *MessagingProgram {
+	return &MessagingProgram{SubscribableIdentifiedObject: NewSubscribableIdentifiedObject()}
+}
+
+// GetHref returns the Href of m, or "" if m or one of its bases is nil.
+func (m *MessagingProgram) GetHref() string {
+	if m == nil {
+		return ""
+	}
+	return m.SubscribableIdentifiedObject.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of m, or nil if m or one of its bases is nil.
+func (m *MessagingProgram) GetSubscribable() *UInt8 {
+	if m == nil {
+		return nil
+	}
+	return m.SubscribableIdentifiedObject.GetSubscribable()
+}
+
+// GetMRID returns the MRID of m, or nil if m or one of its bases is nil.
+func (m *MessagingProgram) GetMRID() *MRIDType {
+	if m == nil {
+		return nil
+	}
+	return m.SubscribableIdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of m, or nil if m or one of its bases is nil.
+func (m *MessagingProgram) GetDescription() *string {
+	if m == nil {
+		return nil
+	}
+	return m.SubscribableIdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of m, or nil if m or one of its bases is nil.
+func (m *MessagingProgram) GetVersion() *VersionType {
+	if m == nil {
+		return nil
+	}
+	return m.SubscribableIdentifiedObject.GetVersion()
+}
+
+// NewMessagingProgramList returns a MessagingProgramList with its chain of base types allocated.
+func NewMessagingProgramList() *MessagingProgramList {
+	return &MessagingProgramList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of m, or "" if m or one of its bases is nil.
+func (m *MessagingProgramList) GetHref() string {
+	if m == nil {
+		return ""
+	}
+	return m.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of m, or nil if m or one of its bases is nil.
+func (m *MessagingProgramList) GetSubscribable() *UInt8 {
+	if m == nil {
+		return nil
+	}
+	return m.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of m, or 0 if m or one of its bases is nil.
+func (m *MessagingProgramList) GetAll() uint32 {
+	if m == nil {
+		return 0
+	}
+	return m.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of m, or 0 if m or one of its bases is nil.
+func (m *MessagingProgramList) GetResults() uint32 {
+	if m == nil {
+		return 0
+	}
+	return m.SubscribableList.GetResults()
+}
+
+// NewTextMessage returns a TextMessage with its chain of base types allocated.
+func NewTextMessage() *TextMessage {
+	return &TextMessage{Event: NewEvent()}
+}
+
+// GetHref returns the Href of t, or "" if t or one of its bases is nil.
+func (t *TextMessage) GetHref() string {
+	if t == nil {
+		return ""
+	}
+	return t.Event.GetHref()
+}
+
+// GetReplyTo returns the ReplyTo of t, or "" if t or one of its bases is nil.
+func (t *TextMessage) GetReplyTo() string {
+	if t == nil {
+		return ""
+	}
+	return t.Event.GetReplyTo()
+}
+
+// GetResponseRequired returns the ResponseRequired of t, or "" if t or one of its bases is nil.
+func (t *TextMessage) GetResponseRequired() string {
+	if t == nil {
+		return ""
+	}
+	return t.Event.GetResponseRequired()
+}
+
+// GetSubscribable returns the Subscribable of t, or nil if t or one of its bases is nil.
+func (t *TextMessage) GetSubscribable() *UInt8 {
+	if t == nil {
+		return nil
+	}
+	return t.Event.GetSubscribable()
+}
+
+// GetMRID returns the MRID of t, or nil if t or one of its bases is nil.
+func (t *TextMessage) GetMRID() *MRIDType {
+	if t == nil {
+		return nil
+	}
+	return t.Event.GetMRID()
+}
+
+// GetDescription returns the Description of t, or nil if t or one of its bases is nil.
+func (t *TextMessage) GetDescription() *string {
+	if t == nil {
+		return nil
+	}
+	return t.Event.GetDescription()
+}
+
+// GetVersion returns the Version of t, or nil if t or one of its bases is nil.
+func (t *TextMessage) GetVersion() *VersionType {
+	if t == nil {
+		return nil
+	}
+	return t.Event.GetVersion()
+}
+
+// GetCreationTime returns the CreationTime of t, or nil if t or one of its bases is nil.
+func (t *TextMessage) GetCreationTime() *TimeType {
+	if t == nil {
+		return nil
+	}
+	return t.Event.GetCreationTime()
+}
+
+// GetEventStatus returns the EventStatus of t, or nil if t or one of its bases is nil.
+func (t *TextMessage) GetEventStatus() *EventStatus {
+	if t == nil {
+		return nil
+	}
+	return t.Event.GetEventStatus()
+}
+
+// GetInterval returns the Interval of t, or nil if t or one of its bases is nil.
+func (t *TextMessage) GetInterval() *DateTimeInterval {
+	if t == nil {
+		return nil
+	}
+	return t.Event.GetInterval()
+}
+
+// NewTextMessageList returns a TextMessageList with its chain of base types allocated.
+func NewTextMessageList() *TextMessageList {
+	return &TextMessageList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of t, or "" if t or one of its bases is nil.
+func (t *TextMessageList) GetHref() string {
+	if t == nil {
+		return ""
+	}
+	return t.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of t, or nil if t or one of its bases is nil.
+func (t *TextMessageList) GetSubscribable() *UInt8 {
+	if t == nil {
+		return nil
+	}
+	return t.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of t, or 0 if t or one of its bases is nil.
+func (t *TextMessageList) GetAll() uint32 {
+	if t == nil {
+		return 0
+	}
+	return t.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of t, or 0 if t or one of its bases is nil.
+func (t *TextMessageList) GetResults() uint32 {
+	if t == nil {
+		return 0
+	}
+	return t.SubscribableList.GetResults()
+}
+
+// NewBillingPeriod returns a BillingPeriod with its chain of base types allocated.
+func NewBillingPeriod() *BillingPeriod {
+	return &BillingPeriod{Resource: NewResource()}
+}
+
+// GetHref returns the Href of b, or "" if b or one of its bases is nil.
+func (b *BillingPeriod) GetHref() string {
+	if b == nil {
+		return ""
+	}
+	return b.Resource.GetHref()
+}
+
+// NewBillingPeriodList returns a BillingPeriodList with its chain of base types allocated.
+func NewBillingPeriodList() *BillingPeriodList {
+	return &BillingPeriodList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of b, or "" if b or one of its bases is nil.
+func (b *BillingPeriodList) GetHref() string {
+	if b == nil {
+		return ""
+	}
+	return b.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of b, or nil if b or one of its bases is nil.
+func (b *BillingPeriodList) GetSubscribable() *UInt8 {
+	if b == nil {
+		return nil
+	}
+	return b.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of b, or 0 if b or one of its bases is nil.
+func (b *BillingPeriodList) GetAll() uint32 {
+	if b == nil {
+		return 0
+	}
+	return b.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of b, or 0 if b or one of its bases is nil.
+func (b *BillingPeriodList) GetResults() uint32 {
+	if b == nil {
+		return 0
+	}
+	return b.SubscribableList.GetResults()
+}
+
+// NewBillingMeterReadingBase returns a BillingMeterReadingBase with its chain of base types allocated.
+func NewBillingMeterReadingBase() *BillingMeterReadingBase {
+	return &BillingMeterReadingBase{MeterReadingBase: NewMeterReadingBase()}
+}
+
+// GetHref returns the Href of b, or "" if b or one of its bases is nil.
+func (b *BillingMeterReadingBase) GetHref() string {
+	if b == nil {
+		return ""
+	}
+	return b.MeterReadingBase.GetHref()
+}
+
+// GetMRID returns the MRID of b, or nil if b or one of its bases is nil.
+func (b *BillingMeterReadingBase) GetMRID() *MRIDType {
+	if b == nil {
+		return nil
+	}
+	return b.MeterReadingBase.GetMRID()
+}
+
+// GetDescription returns the Description of b, or nil if b or one of its bases is nil.
+func (b *BillingMeterReadingBase) GetDescription() *string {
+	if b == nil {
+		return nil
+	}
+	return b.MeterReadingBase.GetDescription()
+}
+
+// GetVersion returns the Version of b, or nil if b or one of its bases is nil.
+func (b *BillingMeterReadingBase) GetVersion() *VersionType {
+	if b == nil {
+		return nil
+	}
+	return b.MeterReadingBase.GetVersion()
+}
+
+// GetBillingReadingSetListLink returns b.BillingReadingSetListLink, or nil if b is nil.
+func (b *BillingMeterReadingBase) GetBillingReadingSetListLink() *BillingReadingSetListLink {
+	if b == nil {
+		return nil
+	}
+	return b.BillingReadingSetListLink
+}
+
+// GetReadingTypeLink returns b.ReadingTypeLink, or nil if b is nil.
+func (b *BillingMeterReadingBase) GetReadingTypeLink() *ReadingTypeLink {
+	if b == nil {
+		return nil
+	}
+	return b.ReadingTypeLink
+}
+
+// NewBillingReading returns a BillingReading with its chain of base types allocated.
+func NewBillingReading() *BillingReading {
+	return &BillingReading{ReadingBase: NewReadingBase()}
+}
+
+// GetHref returns the Href of b, or "" if b or one of its bases is nil.
+func (b *BillingReading) GetHref() string {
+	if b == nil {
+		return ""
+	}
+	return b.ReadingBase.GetHref()
+}
+
+// GetConsumptionBlock returns the ConsumptionBlock of b, or nil if b or one of its bases is nil.
+func (b *BillingReading) GetConsumptionBlock() *ConsumptionBlockType {
+	if b == nil {
+		return nil
+	}
+	return b.ReadingBase.GetConsumptionBlock()
+}
+
+// GetQualityFlags returns the QualityFlags of b, or nil if b or one of its bases is nil.
+func (b *BillingReading) GetQualityFlags() *string {
+	if b == nil {
+		return nil
+	}
+	return b.ReadingBase.GetQualityFlags()
+}
+
+// GetTimePeriod returns the TimePeriod of b, or nil if b or one of its bases is nil.
+func (b *BillingReading) GetTimePeriod() *DateTimeInterval {
+	if b == nil {
+		return nil
+	}
+	return b.ReadingBase.GetTimePeriod()
+}
+
+// GetTouTier returns the TouTier of b, or nil if b or one of its bases is nil.
+func (b *BillingReading) GetTouTier() *TOUType {
+	if b == nil {
+		return nil
+	}
+	return b.ReadingBase.GetTouTier()
+}
+
+// GetValue returns the Value of b, or nil if b or one of its bases is nil.
+func (b *BillingReading) GetValue() *int64 {
+	if b == nil {
+		return nil
+	}
+	return b.ReadingBase.GetValue()
+}
+
+// NewBillingReadingList returns a BillingReadingList with its chain of base types allocated.
+func NewBillingReadingList() *BillingReadingList {
+	return &BillingReadingList{List: NewList()}
+}
+
+// GetHref returns the Href of b, or "" if b or one of its bases is nil.
+func (b *BillingReadingList) GetHref() string {
+	if b == nil {
+		return ""
+	}
+	return b.List.GetHref()
+}
+
+// GetAll returns the All of b, or 0 if b or one of its bases is nil.
+func (b *BillingReadingList) GetAll() uint32 {
+	if b == nil {
+		return 0
+	}
+	return b.List.GetAll()
+}
+
+// GetResults returns the Results of b, or 0 if b or one of its bases is nil.
+func (b *BillingReadingList) GetResults() uint32 {
+	if b == nil {
+		return 0
+	}
+	return b.List.GetResults()
+}
+
+// NewBillingReadingSet returns a BillingReadingSet with its chain of base types allocated.
+func NewBillingReadingSet() *BillingReadingSet {
+	return &BillingReadingSet{ReadingSetBase: NewReadingSetBase()}
+}
+
+// GetHref returns the Href of b, or "" if b or one of its bases is nil.
+func (b *BillingReadingSet) GetHref() string {
+	if b == nil {
+		return ""
+	}
+	return b.ReadingSetBase.GetHref()
+}
+
+// GetMRID returns the MRID of b, or nil if b or one of its bases is nil.
+func (b *BillingReadingSet) GetMRID() *MRIDType {
+	if b == nil {
+		return nil
+	}
+	return b.ReadingSetBase.GetMRID()
+}
+
+// GetDescription returns the Description of b, or nil if b or one of its bases is nil.
+func (b *BillingReadingSet) GetDescription() *string {
+	if b == nil {
+		return nil
+	}
+	return b.ReadingSetBase.GetDescription()
+}
+
+// GetVersion returns the Version of b, or nil if b or one of its bases is nil.
+func (b *BillingReadingSet) GetVersion() *VersionType {
+	if b == nil {
+		return nil
+	}
+	return b.ReadingSetBase.GetVersion()
+}
+
+// GetTimePeriod returns the TimePeriod of b, or nil if b or one of its bases is nil.
+func (b *BillingReadingSet) GetTimePeriod() *DateTimeInterval {
+	if b == nil {
+		return nil
+	}
+	return b.ReadingSetBase.GetTimePeriod()
+}
+
+// NewBillingReadingSetList returns a BillingReadingSetList with its chain of base types allocated.
+func NewBillingReadingSetList() *BillingReadingSetList {
+	return &BillingReadingSetList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of b, or "" if b or one of its bases is nil.
+func (b *BillingReadingSetList) GetHref() string {
+	if b == nil {
+		return ""
+	}
+	return b.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of b, or nil if b or one of its bases is nil.
+func (b *BillingReadingSetList) GetSubscribable() *UInt8 {
+	if b == nil {
+		return nil
+	}
+	return b.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of b, or 0 if b or one of its bases is nil.
+func (b *BillingReadingSetList) GetAll() uint32 {
+	if b == nil {
+		return 0
+	}
+	return b.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of b, or 0 if b or one of its bases is nil.
+func (b *BillingReadingSetList) GetResults() uint32 {
+	if b == nil {
+		return 0
+	}
+	return b.SubscribableList.GetResults()
+}
+
+// NewCharge returns a new Charge.
+func NewCharge() *Charge {
+	return &Charge{}
+}
+
+// NewCustomerAccount returns a CustomerAccount with its chain of base types allocated.
+func NewCustomerAccount() *CustomerAccount {
+	return &CustomerAccount{IdentifiedObject: NewIdentifiedObject()}
+}
+
+// GetHref returns the Href of c, or "" if c or one of its bases is nil.
+func (c *CustomerAccount) GetHref() string {
+	if c == nil {
+		return ""
+	}
+	return c.IdentifiedObject.GetHref()
+}
+
+// GetMRID returns the MRID of c, or nil if c or one of its bases is nil.
+func (c *CustomerAccount) GetMRID() *MRIDType {
+	if c == nil {
+		return nil
+	}
+	return c.IdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of c, or nil if c or one of its bases is nil.
+func (c *CustomerAccount) GetDescription() *string {
+	if c == nil {
+		return nil
+	}
+	return c.IdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of c, or nil if c or one of its bases is nil.
+func (c *CustomerAccount) GetVersion() *VersionType {
+	if c == nil {
+		return nil
+	}
+	return c.IdentifiedObject.GetVersion()
+}
+
+// NewCustomerAccountList returns a CustomerAccountList with its chain of base types allocated.
+func NewCustomerAccountList() *CustomerAccountList {
+	return &CustomerAccountList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of c, or "" if c or one of its bases is nil.
+func (c *CustomerAccountList) GetHref() string {
+	if c == nil {
+		return ""
+	}
+	return c.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of c, or nil if c or one of its bases is nil.
+func (c *CustomerAccountList) GetSubscribable() *UInt8 {
+	if c == nil {
+		return nil
+	}
+	return c.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of c, or 0 if c or one of its bases is nil.
+func (c *CustomerAccountList) GetAll() uint32 {
+	if c == nil {
+		return 0
+	}
+	return c.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of c, or 0 if c or one of its bases is nil.
+func (c *CustomerAccountList) GetResults() uint32 {
+	if c == nil {
+		return 0
+	}
+	return c.SubscribableList.GetResults()
+}
+
+// NewCustomerAgreement returns a CustomerAgreement with its chain of base types allocated.
+func NewCustomerAgreement() *CustomerAgreement {
+	return &CustomerAgreement{IdentifiedObject: NewIdentifiedObject()}
+}
+
+// GetHref returns the Href of c, or "" if c or one of its bases is nil.
+func (c *CustomerAgreement) GetHref() string {
+	if c == nil {
+		return ""
+	}
+	return c.IdentifiedObject.GetHref()
+}
+
+// GetMRID returns the MRID of c, or nil if c or one of its bases is nil.
+func (c *CustomerAgreement) GetMRID() *MRIDType {
+	if c == nil {
+		return nil
+	}
+	return c.IdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of c, or nil if c or one of its bases is nil.
+func (c *CustomerAgreement) GetDescription() *string {
+	if c == nil {
+		return nil
+	}
+	return c.IdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of c, or nil if c or one of its bases is nil.
+func (c *CustomerAgreement) GetVersion() *VersionType {
+	if c == nil {
+		return nil
+	}
+	return c.IdentifiedObject.GetVersion()
+}
+
+// NewCustomerAgreementList returns a CustomerAgreementList with its chain of base types allocated.
+func NewCustomerAgreementList() *CustomerAgreementList {
+	return &CustomerAgreementList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of c, or "" if c or one of its bases is nil.
+func (c *CustomerAgreementList) GetHref() string {
+	if c == nil {
+		return ""
+	}
+	return c.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of c, or nil if c or one of its bases is nil.
+func (c *CustomerAgreementList) GetSubscribable() *UInt8 {
+	if c == nil {
+		return nil
+	}
+	return c.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of c, or 0 if c or one of its bases is nil.
+func (c *CustomerAgreementList) GetAll() uint32 {
+	if c == nil {
+		return 0
+	}
+	return c.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of c, or 0 if c or one of its bases is nil.
+func (c *CustomerAgreementList) GetResults() uint32 {
+	if c == nil {
+		return 0
+	}
+	return c.SubscribableList.GetResults()
+}
+
+// NewHistoricalReading returns a HistoricalReading with its chain of base types allocated.
+func NewHistoricalReading() *HistoricalReading {
+	return &HistoricalReading{BillingMeterReadingBase: NewBillingMeterReadingBase()}
+}
+
+// GetHref returns the Href of h, or "" if h or one of its bases is nil.
+func (h *HistoricalReading) GetHref() string {
+	if h == nil {
+		return ""
+	}
+	return h.BillingMeterReadingBase.GetHref()
+}
+
+// GetMRID returns the MRID of h, or nil if h or one of its bases is nil.
+func (h *HistoricalReading) GetMRID() *MRIDType {
+	if h == nil {
+		return nil
+	}
+	return h.BillingMeterReadingBase.GetMRID()
+}
+
+// GetDescription returns the Description of h, or nil if h or one of its bases is nil.
+func (h *HistoricalReading) GetDescription() *string {
+	if h == nil {
+		return nil
+	}
+	return h.BillingMeterReadingBase.GetDescription()
+}
+
+// GetVersion returns the Version of h, or nil if h or one of its bases is nil.
+func (h *HistoricalReading) GetVersion() *VersionType {
+	if h == nil {
+		return nil
+	}
+	return h.BillingMeterReadingBase.GetVersion()
+}
+
+// GetBillingReadingSetListLink returns the BillingReadingSetListLink of h, or nil if h or one of its bases is nil.
+func (h *HistoricalReading) GetBillingReadingSetListLink() *BillingReadingSetListLink {
+	if h == nil {
+		return nil
+	}
+	return h.BillingMeterReadingBase.GetBillingReadingSetListLink()
+}
+
+// GetReadingTypeLink returns the ReadingTypeLink of h, or nil if h or one of its bases is nil.
+func (h *HistoricalReading) GetReadingTypeLink() *ReadingTypeLink {
+	if h == nil {
+		return nil
+	}
+	return h.BillingMeterReadingBase.GetReadingTypeLink()
+}
+
+// NewHistoricalReadingList returns a HistoricalReadingList with its chain of base types allocated.
+func NewHistoricalReadingList() *HistoricalReadingList {
+	return &HistoricalReadingList{List: NewList()}
+}
+
+// GetHref returns the Href of h, or "" if h or one of its bases is nil.
+func (h *HistoricalReadingList) GetHref() string {
+	if h == nil {
+		return ""
+	}
+	return h.List.GetHref()
+}
+
+// GetAll returns the All of h, or 0 if h or one of its bases is nil.
+func (h *HistoricalReadingList) GetAll() uint32 {
+	if h == nil {
+		return 0
+	}
+	return h.List.GetAll()
+}
+
+// GetResults returns the Results of h, or 0 if h or one of its bases is nil.
+func (h *HistoricalReadingList) GetResults() uint32 {
+	if h == nil {
+		return 0
+	}
+	return h.List.GetResults()
+}
+
+// NewProjectionReading returns a ProjectionReading with its chain of base types allocated.
+func NewProjectionReading() *ProjectionReading {
+	return &ProjectionReading{BillingMeterReadingBase: NewBillingMeterReadingBase()}
+}
+
+// GetHref returns the Href of p, or "" if p or one of its bases is nil.
+func (p *ProjectionReading) GetHref() string {
+	if p == nil {
+		return ""
+	}
+	return p.BillingMeterReadingBase.GetHref()
+}
+
+// GetMRID returns the MRID of p, or nil if p or one of its bases is nil.
+func (p *ProjectionReading) GetMRID() *MRIDType {
+	if p == nil {
+		return nil
+	}
+	return p.BillingMeterReadingBase.GetMRID()
+}
+
+// GetDescription returns the Description of p, or nil if p or one of its bases is nil.
+func (p *ProjectionReading) GetDescription() *string {
+	if p == nil {
+		return nil
+	}
+	return p.BillingMeterReadingBase.GetDescription()
+}
+
+// GetVersion returns the Version of p, or nil if p or one of its bases is nil.
+func (p *ProjectionReading) GetVersion() *VersionType {
+	if p == nil {
+		return nil
+	}
+	return p.BillingMeterReadingBase.GetVersion()
+}
+
+// GetBillingReadingSetListLink returns the BillingReadingSetListLink of p, or nil if p or one of its bases is nil.
+func (p *ProjectionReading) GetBillingReadingSetListLink() *BillingReadingSetListLink {
+	if p == nil {
+		return nil
+	}
+	return p.BillingMeterReadingBase.GetBillingReadingSetListLink()
+}
+
+// GetReadingTypeLink returns the ReadingTypeLink of p, or nil if p or one of its bases is nil.
+func (p *ProjectionReading) GetReadingTypeLink() *ReadingTypeLink {
+	if p == nil {
+		return nil
+	}
+	return p.BillingMeterReadingBase.GetReadingTypeLink()
+}
+
+// NewProjectionReadingList returns a ProjectionReadingList with its chain of base types allocated.
+func NewProjectionReadingList() *ProjectionReadingList {
+	return &ProjectionReadingList{List: NewList()}
+}
+
+// GetHref returns the Href of p, or "" if p or one of its bases is nil.
+func (p *ProjectionReadingList) GetHref() string {
+	if p == nil {
+		return ""
+	}
+	return p.List.GetHref()
+}
+
+// GetAll returns the All of p, or 0 if p or one of its bases is nil.
+func (p *ProjectionReadingList) GetAll() uint32 {
+	if p == nil {
+		return 0
+	}
+	return p.List.GetAll()
+}
+
+// GetResults returns the Results of p, or 0 if p or one of its bases is nil.
+func (p *ProjectionReadingList) GetResults() uint32 {
+	if p == nil {
+		return 0
+	}
+	return p.List.GetResults()
+}
+
+// NewTargetReading returns a TargetReading with its chain of base types allocated.
+func NewTargetReading() *TargetReading {
+	return &TargetReading{BillingMeterReadingBase: NewBillingMeterReadingBase()}
+}
+
+// GetHref returns the Href of t, or "" if t or one of its bases is nil.
+func (t *TargetReading) GetHref() string {
+	if t == nil {
+		return ""
+	}
+	return t.BillingMeterReadingBase.GetHref()
+}
+
+// GetMRID returns the MRID of t, or nil if t or one of its bases is nil.
+func (t *TargetReading) GetMRID() *MRIDType {
+	if t == nil {
+		return nil
+	}
+	return t.BillingMeterReadingBase.GetMRID()
+}
+
+// GetDescription returns the Description of t, or nil if t or one of its bases is nil.
+func (t *TargetReading) GetDescription() *string {
+	if t == nil {
+		return nil
+	}
+	return t.BillingMeterReadingBase.GetDescription()
+}
+
+// GetVersion returns the Version of t, or nil if t or one of its bases is nil.
+func (t *TargetReading) GetVersion() *VersionType {
+	if t == nil {
+		return nil
+	}
+	return t.BillingMeterReadingBase.GetVersion()
+}
+
+// GetBillingReadingSetListLink returns the BillingReadingSetListLink of t, or nil if t or one of its bases is nil.
+func (t *TargetReading) GetBillingReadingSetListLink() *BillingReadingSetListLink {
+	if t == nil {
+		return nil
+	}
+	return t.BillingMeterReadingBase.GetBillingReadingSetListLink()
+}
+
+// GetReadingTypeLink returns the ReadingTypeLink of t, or nil if t or one of its bases is nil.
+func (t *TargetReading) GetReadingTypeLink() *ReadingTypeLink {
+	if t == nil {
+		return nil
+	}
+	return t.BillingMeterReadingBase.GetReadingTypeLink()
+}
+
+// NewTargetReadingList returns a TargetReadingList with its chain of base types allocated.
+func NewTargetReadingList() *TargetReadingList {
+	return &TargetReadingList{List: NewList()}
+}
+
+// GetHref returns the Href of t, or "" if t or one of its bases is nil.
+func (t *TargetReadingList) GetHref() string {
+	if t == nil {
+		return ""
+	}
+	return t.List.GetHref()
+}
+
+// GetAll returns the All of t, or 0 if t or one of its bases is nil.
+func (t *TargetReadingList) GetAll() uint32 {
+	if t == nil {
+		return 0
+	}
+	return t.List.GetAll()
+}
+
+// GetResults returns the Results of t, or 0 if t or one of its bases is nil.
+func (t *TargetReadingList) GetResults() uint32 {
+	if t == nil {
+		return 0
+	}
+	return t.List.GetResults()
+}
+
+// NewServiceSupplier returns a ServiceSupplier with its chain of base types allocated.
+func NewServiceSupplier() *ServiceSupplier {
+	return &ServiceSupplier{IdentifiedObject: NewIdentifiedObject()}
+}
+
+// GetHref returns the Href of s, or "" if s or one of its bases is nil.
+func (s *ServiceSupplier) GetHref() string {
+	if s == nil {
+		return ""
+	}
+	return s.IdentifiedObject.GetHref()
+}
+
+// GetMRID returns the MRID of s, or nil if s or one of its bases is nil.
+func (s *ServiceSupplier) GetMRID() *MRIDType {
+	if s == nil {
+		return nil
+	}
+	return s.IdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of s, or nil if s or one of its bases is nil.
+func (s *ServiceSupplier) GetDescription() *string {
+	if s == nil {
+		return nil
+	}
+	return s.IdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of s, or nil if s or one of its bases is nil.
+func (s *ServiceSupplier) GetVersion() *VersionType {
+	if s == nil {
+		return nil
+	}
+	return s.IdentifiedObject.GetVersion()
+}
+
+// NewAccountBalance returns an AccountBalance with its chain of base types allocated.
+func NewAccountBalance() *AccountBalance {
+	return &AccountBalance{Resource: NewResource()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *AccountBalance) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.Resource.GetHref()
+}
+
+// NewAccountingUnit returns a new AccountingUnit.
+func NewAccountingUnit() *AccountingUnit {
+	return &AccountingUnit{}
+}
+
+// NewCreditRegister returns a CreditRegister with its chain of base types allocated.
+func NewCreditRegister() *CreditRegister {
+	return &CreditRegister{IdentifiedObject: NewIdentifiedObject()}
+}
+
+// GetHref returns the Href of c, or "" if c or one of its bases is nil.
+func (c *CreditRegister) GetHref() string {
+	if c == nil {
+		return ""
+	}
+	return c.IdentifiedObject.GetHref()
+}
+
+// GetMRID returns the MRID of c, or nil if c or one of its bases is nil.
+func (c *CreditRegister) GetMRID() *MRIDType {
+	if c == nil {
+		return nil
+	}
+	return c.IdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of c, or nil if c or one of its bases is nil.
+func (c *CreditRegister) GetDescription() *string {
+	if c == nil {
+		return nil
+	}
+	return c.IdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of c, or nil if c or one of its bases is nil.
+func (c *CreditRegister) GetVersion() *VersionType {
+	if c == nil {
+		return nil
+	}
+	return c.IdentifiedObject.GetVersion()
+}
+
+// NewCreditRegisterList returns a CreditRegisterList with its chain of base types allocated.
+func NewCreditRegisterList() *CreditRegisterList {
+	return &CreditRegisterList{List: NewList()}
+}
+
+// GetHref returns the Href of c, or "" if c or one of its bases is nil.
+func (c *CreditRegisterList) GetHref() string {
+	if c == nil {
+		return ""
+	}
+	return c.List.GetHref()
+}
+
+// GetAll returns the All of c, or 0 if c or one of its bases is nil.
+func (c *CreditRegisterList) GetAll() uint32 {
+	if c == nil {
+		return 0
+	}
+	return c.List.GetAll()
+}
+
+// GetResults returns the Results of c, or 0 if c or one of its bases is nil.
+func (c *CreditRegisterList) GetResults() uint32 {
+	if c == nil {
+		return 0
+	}
+	return c.List.GetResults()
+}
+
+// NewPrepayment returns a Prepayment with its chain of base types allocated.
+func NewPrepayment() *Prepayment {
+	return &Prepayment{IdentifiedObject: NewIdentifiedObject()}
+}
+
+// GetHref returns the Href of p, or "" if p or one of its bases is nil.
+func (p *Prepayment) GetHref() string {
+	if p == nil {
+		return ""
+	}
+	return p.IdentifiedObject.GetHref()
+}
+
+// GetMRID returns the MRID of p, or nil if p or one of its bases is nil.
+func (p *Prepayment) GetMRID() *MRIDType {
+	if p == nil {
+		return nil
+	}
+	return p.IdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of p, or nil if p or one of its bases is nil.
+func (p *Prepayment) GetDescription() *string {
+	if p == nil {
+		return nil
+	}
+	return p.IdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of p, or nil if p or one of its bases is nil.
+func (p *Prepayment) GetVersion() *VersionType {
+	if p == nil {
+		return nil
+	}
+	return p.IdentifiedObject.GetVersion()
+}
+
+// NewPrepaymentList returns a PrepaymentList with its chain of base types allocated.
+func NewPrepaymentList() *PrepaymentList {
+	return &PrepaymentList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of p, or "" if p or one of its bases is nil.
+func (p *PrepaymentList) GetHref() string {
+	if p == nil {
+		return ""
+	}
+	return p.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of p, or nil if p or one of its bases is nil.
+func (p *PrepaymentList) GetSubscribable() *UInt8 {
+	if p == nil {
+		return nil
+	}
+	return p.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of p, or 0 if p or one of its bases is nil.
+func (p *PrepaymentList) GetAll() uint32 {
+	if p == nil {
+		return 0
+	}
+	return p.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of p, or 0 if p or one of its bases is nil.
+func (p *PrepaymentList) GetResults() uint32 {
+	if p == nil {
+		return 0
+	}
+	return p.SubscribableList.GetResults()
+}
+
+// NewPrepayOperationStatus returns a PrepayOperationStatus with its chain of base types allocated.
+func NewPrepayOperationStatus() *PrepayOperationStatus {
+	return &PrepayOperationStatus{Resource: NewResource()}
+}
+
+// GetHref returns the Href of p, or "" if p or one of its bases is nil.
+func (p *PrepayOperationStatus) GetHref() string {
+	if p == nil {
+		return ""
+	}
+	return p.Resource.GetHref()
+}
+
+// NewServiceChange returns a new ServiceChange.
+func NewServiceChange() *ServiceChange {
+	return &ServiceChange{}
+}
+
+// NewSupplyInterruptionOverride returns a SupplyInterruptionOverride with its chain of base types allocated.
+func NewSupplyInterruptionOverride() *SupplyInterruptionOverride {
+	return &SupplyInterruptionOverride{Resource: NewResource()}
+}
+
+// GetHref returns the Href of s, or "" if s or one of its bases is nil.
+func (s *SupplyInterruptionOverride) GetHref() string {
+	if s == nil {
+		return ""
+	}
+	return s.Resource.GetHref()
+}
+
+// NewSupplyInterruptionOverrideList returns a SupplyInterruptionOverrideList with its chain of base types allocated.
+func NewSupplyInterruptionOverrideList() *SupplyInterruptionOverrideList {
+	return &SupplyInterruptionOverrideList{List: NewList()}
+}
+
+// GetHref returns the Href of s, or "" if s or one of its bases is nil.
+func (s *SupplyInterruptionOverrideList) GetHref() string {
+	if s == nil {
+		return ""
+	}
+	return s.List.GetHref()
+}
+
+// GetAll returns the All of s, or 0 if s or one of its bases is nil.
+func (s *SupplyInterruptionOverrideList) GetAll() uint32 {
+	if s == nil {
+		return 0
+	}
+	return s.List.GetAll()
+}
+
+// GetResults returns the Results of s, or 0 if s or one of its bases is nil.
+func (s *SupplyInterruptionOverrideList) GetResults() uint32 {
+	if s == nil {
+		return 0
+	}
+	return s.List.GetResults()
+}
+
+// NewCreditTypeChange returns a new CreditTypeChange.
+func NewCreditTypeChange() *CreditTypeChange {
+	return &CreditTypeChange{}
+}
+
+// NewRequestStatus returns a new RequestStatus.
+func NewRequestStatus() *RequestStatus {
+	return &RequestStatus{}
+}
+
+// NewFlowReservationRequest returns a FlowReservationRequest with its chain of base types allocated.
+func NewFlowReservationRequest() *FlowReservationRequest {
+	return &FlowReservationRequest{IdentifiedObject: NewIdentifiedObject()}
+}
+
+// GetHref returns the Href of f, or "" if f or one of its bases is nil.
+func (f *FlowReservationRequest) GetHref() string {
+	if f == nil {
+		return ""
+	}
+	return f.IdentifiedObject.GetHref()
+}
+
+// GetMRID returns the MRID of f, or nil if f or one of its bases is nil.
+func (f *FlowReservationRequest) GetMRID() *MRIDType {
+	if f == nil {
+		return nil
+	}
+	return f.IdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of f, or nil if f or one of its bases is nil.
+func (f *FlowReservationRequest) GetDescription() *string {
+	if f == nil {
+		return nil
+	}
+	return f.IdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of f, or nil if f or one of its bases is nil.
+func (f *FlowReservationRequest) GetVersion() *VersionType {
+	if f == nil {
+		return nil
+	}
+	return f.IdentifiedObject.GetVersion()
+}
+
+// NewFlowReservationRequestList returns a FlowReservationRequestList with its chain of base types allocated.
+func NewFlowReservationRequestList() *FlowReservationRequestList {
+	return &FlowReservationRequestList{List: NewList()}
+}
+
+// GetHref returns the Href of f, or "" if f or one of its bases is nil.
+func (f *FlowReservationRequestList) GetHref() string {
+	if f == nil {
+		return ""
+	}
+	return f.List.GetHref()
+}
+
+// GetAll returns the All of f, or 0 if f or one of its bases is nil.
+func (f *FlowReservationRequestList) GetAll() uint32 {
+	if f == nil {
+		return 0
+	}
+	return f.List.GetAll()
+}
+
+// GetResults returns the Results of f, or 0 if f or one of its bases is nil.
+func (f *FlowReservationRequestList) GetResults() uint32 {
+	if f == nil {
+		return 0
+	}
+	return f.List.GetResults()
+}
+
+// NewFlowReservationResponse returns a FlowReservationResponse with its chain of base types allocated.
+func NewFlowReservationResponse() *FlowReservationResponse {
+	return &FlowReservationResponse{Event: NewEvent()}
+}
+
+// GetHref returns the Href of f, or "" if f or one of its bases is nil.
+func (f *FlowReservationResponse) GetHref() string {
+	if f == nil {
+		return ""
+	}
+	return f.Event.GetHref()
+}
+
+// GetReplyTo returns the ReplyTo of f, or "" if f or one of its bases is nil.
+func (f *FlowReservationResponse) GetReplyTo() string {
+	if f == nil {
+		return ""
+	}
+	return f.Event.GetReplyTo()
+}
+
+// GetResponseRequired returns the ResponseRequired of f, or "" if f or one of its bases is nil.
+func (f *FlowReservationResponse) GetResponseRequired() string {
+	if f == nil {
+		return ""
+	}
+	return f.Event.GetResponseRequired()
+}
+
+// GetSubscribable returns the Subscribable of f, or nil if f or one of its bases is nil.
+func (f *FlowReservationResponse) GetSubscribable() *UInt8 {
+	if f == nil {
+		return nil
+	}
+	return f.Event.GetSubscribable()
+}
+
+// GetMRID returns the MRID of f, or nil if f or one of its bases is nil.
+func (f *FlowReservationResponse) GetMRID() *MRIDType {
+	if f == nil {
+		return nil
+	}
+	return f.Event.GetMRID()
+}
+
+// GetDescription returns the Description of f, or nil if f or one of its bases is nil.
+func (f *FlowReservationResponse) GetDescription() *string {
+	if f == nil {
+		return nil
+	}
+	return f.Event.GetDescription()
+}
+
+// GetVersion returns the Version of f, or nil if f or one of its bases is nil.
+func (f *FlowReservationResponse) GetVersion() *VersionType {
+	if f == nil {
+		return nil
+	}
+	return f.Event.GetVersion()
+}
+
+// GetCreationTime returns the CreationTime of f, or nil if f or one of its bases is nil.
+func (f *FlowReservationResponse) GetCreationTime() *TimeType {
+	if f == nil {
+		return nil
+	}
+	return f.Event.GetCreationTime()
+}
+
+// GetEventStatus returns the EventStatus of f, or nil if f or one of its bases is nil.
+func (f *FlowReservationResponse) GetEventStatus() *EventStatus {
+	if f == nil {
+		return nil
+	}
+	return f.Event.GetEventStatus()
+}
+
+// GetInterval returns the Interval of f, or nil if f or one of its bases is nil.
+func (f *FlowReservationResponse) GetInterval() *DateTimeInterval {
+	if f == nil {
+		return nil
+	}
+	return f.Event.GetInterval()
+}
+
+// NewFlowReservationResponseList returns a FlowReservationResponseList with its chain of base types allocated.
+func NewFlowReservationResponseList() *FlowReservationResponseList {
+	return &FlowReservationResponseList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of f, or "" if f or one of its bases is nil.
+func (f *FlowReservationResponseList) GetHref() string {
+	if f == nil {
+		return ""
+	}
+	return f.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of f, or nil if f or one of its bases is nil.
+func (f *FlowReservationResponseList) GetSubscribable() *UInt8 {
+	if f == nil {
+		return nil
+	}
+	return f.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of f, or 0 if f or one of its bases is nil.
+func (f *FlowReservationResponseList) GetAll() uint32 {
+	if f == nil {
+		return 0
+	}
+	return f.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of f, or 0 if f or one of its bases is nil.
+func (f *FlowReservationResponseList) GetResults() uint32 {
+	if f == nil {
+		return 0
+	}
+	return f.SubscribableList.GetResults()
+}
+
+// NewDERList returns a DERList with its chain of base types allocated.
+func NewDERList() *DERList {
+	return &DERList{List: NewList()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERList) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.List.GetHref()
+}
+
+// GetAll returns the All of d, or 0 if d or one of its bases is nil.
+func (d *DERList) GetAll() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.List.GetAll()
+}
+
+// GetResults returns the Results of d, or 0 if d or one of its bases is nil.
+func (d *DERList) GetResults() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.List.GetResults()
+}
+
+// NewDER returns a DER with its chain of base types allocated.
+func NewDER() *DER {
+	return &DER{SubscribableResource: NewSubscribableResource()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DER) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.SubscribableResource.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of d, or nil if d or one of its bases is nil.
+func (d *DER) GetSubscribable() *UInt8 {
+	if d == nil {
+		return nil
+	}
+	return d.SubscribableResource.GetSubscribable()
+}
+
+// NewCurrentDERControls returns a CurrentDERControls with its chain of base types allocated.
+func NewCurrentDERControls() *CurrentDERControls {
+	return &CurrentDERControls{SubscribableResource: NewSubscribableResource()}
+}
+
+// GetHref returns the Href of c, or "" if c or one of its bases is nil.
+func (c *CurrentDERControls) GetHref() string {
+	if c == nil {
+		return ""
+	}
+	return c.SubscribableResource.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of c, or nil if c or one of its bases is nil.
+func (c *CurrentDERControls) GetSubscribable() *UInt8 {
+	if c == nil {
+		return nil
+	}
+	return c.SubscribableResource.GetSubscribable()
+}
+
+// NewDERComponentList returns a DERComponentList with its chain of base types allocated.
+func NewDERComponentList() *DERComponentList {
+	return &DERComponentList{List: NewList()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERComponentList) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.List.GetHref()
+}
+
+// GetAll returns the All of d, or 0 if d or one of its bases is nil.
+func (d *DERComponentList) GetAll() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.List.GetAll()
+}
+
+// GetResults returns the Results of d, or 0 if d or one of its bases is nil.
+func (d *DERComponentList) GetResults() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.List.GetResults()
+}
+
+// NewDERComponentBase returns a DERComponentBase with its chain of base types allocated.
+func NewDERComponentBase() *DERComponentBase {
+	return &DERComponentBase{SubscribableResource: NewSubscribableResource()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERComponentBase) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.SubscribableResource.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of d, or nil if d or one of its bases is nil.
+func (d *DERComponentBase) GetSubscribable() *UInt8 {
+	if d == nil {
+		return nil
+	}
+	return d.SubscribableResource.GetSubscribable()
+}
+
+// GetAssociatedUsagePointLink returns d.AssociatedUsagePointLink, or nil if d is nil.
+func (d *DERComponentBase) GetAssociatedUsagePointLink() *AssociatedUsagePointLink {
+	if d == nil {
+		return nil
+	}
+	return d.AssociatedUsagePointLink
+}
+
+// GetDERAvailabilityLink returns d.DERAvailabilityLink, or nil if d is nil.
+func (d *DERComponentBase) GetDERAvailabilityLink() *DERAvailabilityLink {
+	if d == nil {
+		return nil
+	}
+	return d.DERAvailabilityLink
+}
+
+// GetDERCapabilityLink returns d.DERCapabilityLink, or nil if d is nil.
+func (d *DERComponentBase) GetDERCapabilityLink() *DERCapabilityLink {
+	if d == nil {
+		return nil
+	}
+	return d.DERCapabilityLink
+}
+
+// GetDERSettingsLink returns d.DERSettingsLink, or nil if d is nil.
+func (d *DERComponentBase) GetDERSettingsLink() *DERSettingsLink {
+	if d == nil {
+		return nil
+	}
+	return d.DERSettingsLink
+}
+
+// GetDERStatusLink returns d.DERStatusLink, or nil if d is nil.
+func (d *DERComponentBase) GetDERStatusLink() *DERStatusLink {
+	if d == nil {
+		return nil
+	}
+	return d.DERStatusLink
+}
+
+// NewDERComponent returns a DERComponent with its chain of base types allocated.
+func NewDERComponent() *DERComponent {
+	return &DERComponent{DERComponentBase: NewDERComponentBase()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERComponent) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.DERComponentBase.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of d, or nil if d or one of its bases is nil.
+func (d *DERComponent) GetSubscribable() *UInt8 {
+	if d == nil {
+		return nil
+	}
+	return d.DERComponentBase.GetSubscribable()
+}
+
+// GetAssociatedUsagePointLink returns the AssociatedUsagePointLink of d, or nil if d or one of its bases is nil.
+func (d *DERComponent) GetAssociatedUsagePointLink() *AssociatedUsagePointLink {
+	if d == nil {
+		return nil
+	}
+	return d.DERComponentBase.GetAssociatedUsagePointLink()
+}
+
+// GetDERAvailabilityLink returns the DERAvailabilityLink of d, or nil if d or one of its bases is nil.
+func (d *DERComponent) GetDERAvailabilityLink() *DERAvailabilityLink {
+	if d == nil {
+		return nil
+	}
+	return d.DERComponentBase.GetDERAvailabilityLink()
+}
+
+// GetDERCapabilityLink returns the DERCapabilityLink of d, or nil if d or one of its bases is nil.
+func (d *DERComponent) GetDERCapabilityLink() *DERCapabilityLink {
+	if d == nil {
+		return nil
+	}
+	return d.DERComponentBase.GetDERCapabilityLink()
+}
+
+// GetDERSettingsLink returns the DERSettingsLink of d, or nil if d or one of its bases is nil.
+func (d *DERComponent) GetDERSettingsLink() *DERSettingsLink {
+	if d == nil {
+		return nil
+	}
+	return d.DERComponentBase.GetDERSettingsLink()
+}
+
+// GetDERStatusLink returns the DERStatusLink of d, or nil if d or one of its bases is nil.
+func (d *DERComponent) GetDERStatusLink() *DERStatusLink {
+	if d == nil {
+		return nil
+	}
+	return d.DERComponentBase.GetDERStatusLink()
+}
+
+// NewDERAvailability returns a DERAvailability with its chain of base types allocated.
+func NewDERAvailability() *DERAvailability {
+	return &DERAvailability{SubscribableResource: NewSubscribableResource()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERAvailability) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.SubscribableResource.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of d, or nil if d or one of its bases is nil.
+func (d *DERAvailability) GetSubscribable() *UInt8 {
+	if d == nil {
+		return nil
+	}
+	return d.SubscribableResource.GetSubscribable()
+}
+
+// NewDERCapability returns a DERCapability with its chain of base types allocated.
+func NewDERCapability() *DERCapability {
+	return &DERCapability{Resource: NewResource()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERCapability) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.Resource.GetHref()
+}
+
+// NewDERSettings returns a DERSettings with its chain of base types allocated.
+func NewDERSettings() *DERSettings {
+	return &DERSettings{SubscribableResource: NewSubscribableResource()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERSettings) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.SubscribableResource.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of d, or nil if d or one of its bases is nil.
+func (d *DERSettings) GetSubscribable() *UInt8 {
+	if d == nil {
+		return nil
+	}
+	return d.SubscribableResource.GetSubscribable()
+}
+
+// NewDERStatus returns a DERStatus with its chain of base types allocated.
+func NewDERStatus() *DERStatus {
+	return &DERStatus{SubscribableResource: NewSubscribableResource()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERStatus) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.SubscribableResource.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of d, or nil if d or one of its bases is nil.
+func (d *DERStatus) GetSubscribable() *UInt8 {
+	if d == nil {
+		return nil
+	}
+	return d.SubscribableResource.GetSubscribable()
+}
+
+// NewDERProgramList returns a DERProgramList with its chain of base types allocated.
+func NewDERProgramList() *DERProgramList {
+	return &DERProgramList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERProgramList) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of d, or nil if d or one of its bases is nil.
+func (d *DERProgramList) GetSubscribable() *UInt8 {
+	if d == nil {
+		return nil
+	}
+	return d.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of d, or 0 if d or one of its bases is nil.
+func (d *DERProgramList) GetAll() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of d, or 0 if d or one of its bases is nil.
+func (d *DERProgramList) GetResults() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.SubscribableList.GetResults()
+}
+
+// NewDERProgram returns a DERProgram with its chain of base types allocated.
+func NewDERProgram() *DERProgram {
+	return &DERProgram{SubscribableIdentifiedObject: NewSubscribableIdentifiedObject()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERProgram) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.SubscribableIdentifiedObject.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of d, or nil if d or one of its bases is nil.
+func (d *DERProgram) GetSubscribable() *UInt8 {
+	if d == nil {
+		return nil
+	}
+	return d.SubscribableIdentifiedObject.GetSubscribable()
+}
+
+// GetMRID returns the MRID of d, or nil if d or one of its bases is nil.
+func (d *DERProgram) GetMRID() *MRIDType {
+	if d == nil {
+		return nil
+	}
+	return d.SubscribableIdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of d, or nil if d or one of its bases is nil.
+func (d *DERProgram) GetDescription() *string {
+	if d == nil {
+		return nil
+	}
+	return d.SubscribableIdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of d, or nil if d or one of its bases is nil.
+func (d *DERProgram) GetVersion() *VersionType {
+	if d == nil {
+		return nil
+	}
+	return d.SubscribableIdentifiedObject.GetVersion()
+}
+
+// NewDERControlBase returns a new DERControlBase.
+func NewDERControlBase() *DERControlBase {
+	return &DERControlBase{}
+}
+
+// NewDefaultDERControl returns a DefaultDERControl with its chain of base types allocated.
+func NewDefaultDERControl() *DefaultDERControl {
+	return &DefaultDERControl{RespondableSubscribableIdentifiedObject: NewRespondableSubscribableIdentifiedObject()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DefaultDERControl) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.RespondableSubscribableIdentifiedObject.GetHref()
+}
+
+// GetReplyTo returns the ReplyTo of d, or "" if d or one of its bases is nil.
+func (d *DefaultDERControl) GetReplyTo() string {
+	if d == nil {
+		return ""
+	}
+	return d.RespondableSubscribableIdentifiedObject.GetReplyTo()
+}
+
+// GetResponseRequired returns the ResponseRequired of d, or "" if d or one of its bases is nil.
+func (d *DefaultDERControl) GetResponseRequired() string {
+	if d == nil {
+		return ""
+	}
+	return d.RespondableSubscribableIdentifiedObject.GetResponseRequired()
+}
+
+// GetSubscribable returns the Subscribable of d, or nil if d or one of its bases is nil.
+func (d *DefaultDERControl) GetSubscribable() *UInt8 {
+	if d == nil {
+		return nil
+	}
+	return d.RespondableSubscribableIdentifiedObject.GetSubscribable()
+}
+
+// GetMRID returns the MRID of d, or nil if d or one of its bases is nil.
+func (d *DefaultDERControl) GetMRID() *MRIDType {
+	if d == nil {
+		return nil
+	}
+	return d.RespondableSubscribableIdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of d, or nil if d or one of its bases is nil.
+func (d *DefaultDERControl) GetDescription() *string {
+	if d == nil {
+		return nil
+	}
+	return d.RespondableSubscribableIdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of d, or nil if d or one of its bases is nil.
+func (d *DefaultDERControl) GetVersion() *VersionType {
+	if d == nil {
+		return nil
+	}
+	return d.RespondableSubscribableIdentifiedObject.GetVersion()
+}
+
+// NewDERControlList returns a DERControlList with its chain of base types allocated.
+func NewDERControlList() *DERControlList {
+	return &DERControlList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERControlList) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of d, or nil if d or one of its bases is nil.
+func (d *DERControlList) GetSubscribable() *UInt8 {
+	if d == nil {
+		return nil
+	}
+	return d.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of d, or 0 if d or one of its bases is nil.
+func (d *DERControlList) GetAll() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of d, or 0 if d or one of its bases is nil.
+func (d *DERControlList) GetResults() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.SubscribableList.GetResults()
+}
+
+// NewDERControl returns a DERControl with its chain of base types allocated.
+func NewDERControl() *DERControl {
+	return &DERControl{RandomizableEvent: NewRandomizableEvent()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERControl) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.RandomizableEvent.GetHref()
+}
+
+// GetReplyTo returns the ReplyTo of d, or "" if d or one of its bases is nil.
+func (d *DERControl) GetReplyTo() string {
+	if d == nil {
+		return ""
+	}
+	return d.RandomizableEvent.GetReplyTo()
+}
+
+// GetResponseRequired returns the ResponseRequired of d, or "" if d or one of its bases is nil.
+func (d *DERControl) GetResponseRequired() string {
+	if d == nil {
+		return ""
+	}
+	return d.RandomizableEvent.GetResponseRequired()
+}
+
+// GetSubscribable returns the Subscribable of d, or nil if d or one of its bases is nil.
+func (d *DERControl) GetSubscribable() *UInt8 {
+	if d == nil {
+		return nil
+	}
+	return d.RandomizableEvent.GetSubscribable()
+}
+
+// GetMRID returns the MRID of d, or nil if d or one of its bases is nil.
+func (d *DERControl) GetMRID() *MRIDType {
+	if d == nil {
+		return nil
+	}
+	return d.RandomizableEvent.GetMRID()
+}
+
+// GetDescription returns the Description of d, or nil if d or one of its bases is nil.
+func (d *DERControl) GetDescription() *string {
+	if d == nil {
+		return nil
+	}
+	return d.RandomizableEvent.GetDescription()
+}
+
+// GetVersion returns the Version of d, or nil if d or one of its bases is nil.
+func (d *DERControl) GetVersion() *VersionType {
+	if d == nil {
+		return nil
+	}
+	return d.RandomizableEvent.GetVersion()
+}
+
+// GetCreationTime returns the CreationTime of d, or nil if d or one of its bases is nil.
+func (d *DERControl) GetCreationTime() *TimeType {
+	if d == nil {
+		return nil
+	}
+	return d.RandomizableEvent.GetCreationTime()
+}
+
+// GetEventStatus returns the EventStatus of d, or nil if d or one of its bases is nil.
+func (d *DERControl) GetEventStatus() *EventStatus {
+	if d == nil {
+		return nil
+	}
+	return d.RandomizableEvent.GetEventStatus()
+}
+
+// GetInterval returns the Interval of d, or nil if d or one of its bases is nil.
+func (d *DERControl) GetInterval() *DateTimeInterval {
+	if d == nil {
+		return nil
+	}
+	return d.RandomizableEvent.GetInterval()
+}
+
+// GetRandomizeDuration returns the RandomizeDuration of d, or nil if d or one of its bases is nil.
+func (d *DERControl) GetRandomizeDuration() *OneHourRangeType {
+	if d == nil {
+		return nil
+	}
+	return d.RandomizableEvent.GetRandomizeDuration()
+}
+
+// GetRandomizeStart returns the RandomizeStart of d, or nil if d or one of its bases is nil.
+func (d *DERControl) GetRandomizeStart() *OneHourRangeType {
+	if d == nil {
+		return nil
+	}
+	return d.RandomizableEvent.GetRandomizeStart()
+}
+
+// NewDERCurveList returns a DERCurveList with its chain of base types allocated.
+func NewDERCurveList() *DERCurveList {
+	return &DERCurveList{List: NewList()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERCurveList) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.List.GetHref()
+}
+
+// GetAll returns the All of d, or 0 if d or one of its bases is nil.
+func (d *DERCurveList) GetAll() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.List.GetAll()
+}
+
+// GetResults returns the Results of d, or 0 if d or one of its bases is nil.
+func (d *DERCurveList) GetResults() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.List.GetResults()
+}
+
+// NewDERCurve returns a DERCurve with its chain of base types allocated.
+func NewDERCurve() *DERCurve {
+	return &DERCurve{IdentifiedObject: NewIdentifiedObject()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERCurve) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.IdentifiedObject.GetHref()
+}
+
+// GetMRID returns the MRID of d, or nil if d or one of its bases is nil.
+func (d *DERCurve) GetMRID() *MRIDType {
+	if d == nil {
+		return nil
+	}
+	return d.IdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of d, or nil if d or one of its bases is nil.
+func (d *DERCurve) GetDescription() *string {
+	if d == nil {
+		return nil
+	}
+	return d.IdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of d, or nil if d or one of its bases is nil.
+func (d *DERCurve) GetVersion() *VersionType {
+	if d == nil {
+		return nil
+	}
+	return d.IdentifiedObject.GetVersion()
+}
+
+// GetAutonomousVRefEnable returns d.AutonomousVRefEnable, or nil if d is nil.
+func (d *DERCurve) GetAutonomousVRefEnable() *bool {
+	if d == nil {
+		return nil
+	}
+	return d.AutonomousVRefEnable
+}
+
+// GetAutonomousVRefTimeConstant returns d.AutonomousVRefTimeConstant, or nil if d is nil.
+func (d *DERCurve) GetAutonomousVRefTimeConstant() *uint32 {
+	if d == nil {
+		return nil
+	}
+	return d.AutonomousVRefTimeConstant
+}
+
+// GetCreationTime returns d.CreationTime, or nil if d is nil.
+func (d *DERCurve) GetCreationTime() *TimeType {
+	if d == nil {
+		return nil
+	}
+	return d.CreationTime
+}
+
+// GetCurveData returns d.CurveData, or nil if d is nil.
+func (d *DERCurve) GetCurveData() []*CurveData {
+	if d == nil {
+		return nil
+	}
+	return d.CurveData
+}
+
+// GetCurveType returns d.CurveType, or nil if d is nil.
+func (d *DERCurve) GetCurveType() *DERCurveType {
+	if d == nil {
+		return nil
+	}
+	return d.CurveType
+}
+
+// GetOpenLoopTms returns d.OpenLoopTms, or nil if d is nil.
+func (d *DERCurve) GetOpenLoopTms() *uint16 {
+	if d == nil {
+		return nil
+	}
+	return d.OpenLoopTms
+}
+
+// GetRampDecTms returns d.RampDecTms, or nil if d is nil.
+func (d *DERCurve) GetRampDecTms() *uint16 {
+	if d == nil {
+		return nil
+	}
+	return d.RampDecTms
+}
+
+// GetRampIncTms returns d.RampIncTms, or nil if d is nil.
+func (d *DERCurve) GetRampIncTms() *uint16 {
+	if d == nil {
+		return nil
+	}
+	return d.RampIncTms
+}
+
+// GetRampPT1Tms returns d.RampPT1Tms, or nil if d is nil.
+func (d *DERCurve) GetRampPT1Tms() *uint16 {
+	if d == nil {
+		return nil
+	}
+	return d.RampPT1Tms
+}
+
+// GetVRef returns d.VRef, or nil if d is nil.
+func (d *DERCurve) GetVRef() *PerCent {
+	if d == nil {
+		return nil
+	}
+	return d.VRef
+}
+
+// GetXMultiplier returns d.XMultiplier, or nil if d is nil.
+func (d *DERCurve) GetXMultiplier() *PowerOfTenMultiplierType {
+	if d == nil {
+		return nil
+	}
+	return d.XMultiplier
+}
+
+// GetYMultiplier returns d.YMultiplier, or nil if d is nil.
+func (d *DERCurve) GetYMultiplier() *PowerOfTenMultiplierType {
+	if d == nil {
+		return nil
+	}
+	return d.YMultiplier
+}
+
+// GetYRefType returns d.YRefType, or nil if d is nil.
+func (d *DERCurve) GetYRefType() *DERUnitRefType {
+	if d == nil {
+		return nil
+	}
+	return d.YRefType
+}
+
+// NewDERCurveControlType returns a DERCurveControlType with its chain of base types allocated.
+func NewDERCurveControlType() *DERCurveControlType {
+	return &DERCurveControlType{DERCurve: NewDERCurve()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERCurveControlType) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.DERCurve.GetHref()
+}
+
+// GetMRID returns the MRID of d, or nil if d or one of its bases is nil.
+func (d *DERCurveControlType) GetMRID() *MRIDType {
+	if d == nil {
+		return nil
+	}
+	return d.DERCurve.GetMRID()
+}
+
+// GetDescription returns the Description of d, or nil if d or one of its bases is nil.
+func (d *DERCurveControlType) GetDescription() *string {
+	if d == nil {
+		return nil
+	}
+	return d.DERCurve.GetDescription()
+}
+
+// GetVersion returns the Version of d, or nil if d or one of its bases is nil.
+func (d *DERCurveControlType) GetVersion() *VersionType {
+	if d == nil {
+		return nil
+	}
+	return d.DERCurve.GetVersion()
+}
+
+// GetAutonomousVRefEnable returns the AutonomousVRefEnable of d, or nil if d or one of its bases is nil.
+func (d *DERCurveControlType) GetAutonomousVRefEnable() *bool {
+	if d == nil {
+		return nil
+	}
+	return d.DERCurve.GetAutonomousVRefEnable()
+}
+
+// GetAutonomousVRefTimeConstant returns the AutonomousVRefTimeConstant of d, or nil if d or one of its bases is nil.
+func (d *DERCurveControlType) GetAutonomousVRefTimeConstant() *uint32 {
+	if d == nil {
+		return nil
+	}
+	return d.DERCurve.GetAutonomousVRefTimeConstant()
+}
+
+// GetCreationTime returns the CreationTime of d, or nil if d or one of its bases is nil.
+func (d *DERCurveControlType) GetCreationTime() *TimeType {
+	if d == nil {
+		return nil
+	}
+	return d.DERCurve.GetCreationTime()
+}
+
+// GetCurveData returns the CurveData of d, or nil if d or one of its bases is nil.
+func (d *DERCurveControlType) GetCurveData() []*CurveData {
+	if d == nil {
+		return nil
+	}
+	return d.DERCurve.GetCurveData()
+}
+
+// GetCurveType returns the CurveType of d, or nil if d or one of its bases is nil.
+func (d *DERCurveControlType) GetCurveType() *DERCurveType {
+	if d == nil {
+		return nil
+	}
+	return d.DERCurve.GetCurveType()
+}
+
+// GetOpenLoopTms returns the OpenLoopTms of d, or nil if d or one of its bases is nil.
+func (d *DERCurveControlType) GetOpenLoopTms() *uint16 {
+	if d == nil {
+		return nil
+	}
+	return d.DERCurve.GetOpenLoopTms()
+}
+
+// GetRampDecTms returns the RampDecTms of d, or nil if d or one of its bases is nil.
+func (d *DERCurveControlType) GetRampDecTms() *uint16 {
+	if d == nil {
+		return nil
+	}
+	return d.DERCurve.GetRampDecTms()
+}
+
+// GetRampIncTms returns the RampIncTms of d, or nil if d or one of its bases is nil.
+func (d *DERCurveControlType) GetRampIncTms() *uint16 {
+	if d == nil {
+		return nil
+	}
+	return d.DERCurve.GetRampIncTms()
+}
+
+// GetRampPT1Tms returns the RampPT1Tms of d, or nil if d or one of its bases is nil.
+func (d *DERCurveControlType) GetRampPT1Tms() *uint16 {
+	if d == nil {
+		return nil
+	}
+	return d.DERCurve.GetRampPT1Tms()
+}
+
+// GetVRef returns the VRef of d, or nil if d or one of its bases is nil.
+func (d *DERCurveControlType) GetVRef() *PerCent {
+	if d == nil {
+		return nil
+	}
+	return d.DERCurve.GetVRef()
+}
+
+// GetXMultiplier returns the XMultiplier of d, or nil if d or one of its bases is nil.
+func (d *DERCurveControlType) GetXMultiplier() *PowerOfTenMultiplierType {
+	if d == nil {
+		return nil
+	}
+	return d.DERCurve.GetXMultiplier()
+}
+
+// GetYMultiplier returns the YMultiplier of d, or nil if d or one of its bases is nil.
+func (d *DERCurveControlType) GetYMultiplier() *PowerOfTenMultiplierType {
+	if d == nil {
+		return nil
+	}
+	return d.DERCurve.GetYMultiplier()
+}
+
+// GetYRefType returns the YRefType of d, or nil if d or one of its bases is nil.
+func (d *DERCurveControlType) GetYRefType() *DERUnitRefType {
+	if d == nil {
+		return nil
+	}
+	return d.DERCurve.GetYRefType()
+}
+
+// NewCurveData returns a new CurveData.
+func NewCurveData() *CurveData {
+	return &CurveData{}
+}
+
+// NewActivePower returns a new ActivePower.
+func NewActivePower() *ActivePower {
+	return &ActivePower{}
+}
+
+// GetMultiplier returns a.Multiplier, or nil if a is nil.
+func (a *ActivePower) GetMultiplier() *PowerOfTenMultiplierType {
+	if a == nil {
+		return nil
+	}
+	return a.Multiplier
+}
+
+// GetValue returns a.Value, or 0 if a is nil.
+func (a *ActivePower) GetValue() int16 {
+	if a == nil {
+		return 0
+	}
+	return a.Value
+}
+
+// NewActivePowerControlType returns an ActivePowerControlType with its chain of base types allocated.
+func NewActivePowerControlType() *ActivePowerControlType {
+	return &ActivePowerControlType{ActivePower: NewActivePower()}
+}
+
+// GetMultiplier returns the Multiplier of a, or nil if a or one of its bases is nil.
+func (a *ActivePowerControlType) GetMultiplier() *PowerOfTenMultiplierType {
+	if a == nil {
+		return nil
+	}
+	return a.ActivePower.GetMultiplier()
+}
+
+// GetValue returns the Value of a, or 0 if a or one of its bases is nil.
+func (a *ActivePowerControlType) GetValue() int16 {
+	if a == nil {
+		return 0
+	}
+	return a.ActivePower.GetValue()
+}
+
+// NewActivePowerDeltaControlType returns an ActivePowerDeltaControlType with its chain of base types allocated.
+func NewActivePowerDeltaControlType() *ActivePowerDeltaControlType {
+	return &ActivePowerDeltaControlType{ActivePower: NewActivePower()}
+}
+
+// GetMultiplier returns the Multiplier of a, or nil if a or one of its bases is nil.
+func (a *ActivePowerDeltaControlType) GetMultiplier() *PowerOfTenMultiplierType {
+	if a == nil {
+		return nil
+	}
+	return a.ActivePower.GetMultiplier()
+}
+
+// GetValue returns the Value of a, or 0 if a or one of its bases is nil.
+func (a *ActivePowerDeltaControlType) GetValue() int16 {
+	if a == nil {
+		return 0
+	}
+	return a.ActivePower.GetValue()
+}
+
+// NewUnsignedActivePower returns a new UnsignedActivePower.
+func NewUnsignedActivePower() *UnsignedActivePower {
+	return &UnsignedActivePower{}
+}
+
+// GetMultiplier returns u.Multiplier, or nil if u is nil.
+func (u *UnsignedActivePower) GetMultiplier() *PowerOfTenMultiplierType {
+	if u == nil {
+		return nil
+	}
+	return u.Multiplier
+}
+
+// GetValue returns u.Value, or 0 if u is nil.
+func (u *UnsignedActivePower) GetValue() uint16 {
+	if u == nil {
+		return 0
+	}
+	return u.Value
+}
+
+// NewUnsignedActivePowerControlType returns an UnsignedActivePowerControlType with its chain of base types allocated.
+func NewUnsignedActivePowerControlType() *UnsignedActivePowerControlType {
+	return &UnsignedActivePowerControlType{UnsignedActivePower: NewUnsignedActivePower()}
+}
+
+// GetMultiplier returns the Multiplier of u, or nil if u or one of its bases is nil.
+func (u *UnsignedActivePowerControlType) GetMultiplier() *PowerOfTenMultiplierType {
+	if u == nil {
+		return nil
+	}
+	return u.UnsignedActivePower.GetMultiplier()
+}
+
+// GetValue returns the Value of u, or 0 if u or one of its bases is nil.
+func (u *UnsignedActivePowerControlType) GetValue() uint16 {
+	if u == nil {
+		return 0
+	}
+	return u.UnsignedActivePower.GetValue()
+}
+
+// NewAmpereHour returns a new AmpereHour.
+func NewAmpereHour() *AmpereHour {
+	return &AmpereHour{}
+}
+
+// NewApparentPower returns a new ApparentPower.
+func NewApparentPower() *ApparentPower {
+	return &ApparentPower{}
+}
+
+// NewCurrentRMS returns a new CurrentRMS.
+func NewCurrentRMS() *CurrentRMS {
+	return &CurrentRMS{}
+}
+
+// NewFixedPointType returns a new FixedPointType.
+func NewFixedPointType() *FixedPointType {
+	return &FixedPointType{}
+}
+
+// NewUnsignedFixedPointType returns a new UnsignedFixedPointType.
+func NewUnsignedFixedPointType() *UnsignedFixedPointType {
+	return &UnsignedFixedPointType{}
+}
+
+// NewFixedVar returns a new FixedVar.
+func NewFixedVar() *FixedVar {
+	return &FixedVar{}
+}
+
+// GetRefType returns f.RefType, or nil if f is nil.
+func (f *FixedVar) GetRefType() *DERUnitRefType {
+	if f == nil {
+		return nil
+	}
+	return f.RefType
+}
+
+// GetValue returns f.Value, or nil if f is nil.
+func (f *FixedVar) GetValue() *SignedPerCent {
+	if f == nil {
+		return nil
+	}
+	return f.Value
+}
+
+// NewFixedVarControlType returns a FixedVarControlType with its chain of base types allocated.
+func NewFixedVarControlType() *FixedVarControlType {
+	return &FixedVarControlType{FixedVar: NewFixedVar()}
+}
+
+// GetRefType returns the RefType of f, or nil if f or one of its bases is nil.
+func (f *FixedVarControlType) GetRefType() *DERUnitRefType {
+	if f == nil {
+		return nil
+	}
+	return f.FixedVar.GetRefType()
+}
+
+// GetValue returns the Value of f, or nil if f or one of its bases is nil.
+func (f *FixedVarControlType) GetValue() *SignedPerCent {
+	if f == nil {
+		return nil
+	}
+	return f.FixedVar.GetValue()
+}
+
+// NewUnsignedFixedVar returns a new UnsignedFixedVar.
+func NewUnsignedFixedVar() *UnsignedFixedVar {
+	return &UnsignedFixedVar{}
+}
+
+// GetRefType returns u.RefType, or nil if u is nil.
+func (u *UnsignedFixedVar) GetRefType() *DERUnitRefType {
+	if u == nil {
+		return nil
+	}
+	return u.RefType
+}
+
+// GetValue returns u.Value, or nil if u is nil.
+func (u *UnsignedFixedVar) GetValue() *PerCent {
+	if u == nil {
+		return nil
+	}
+	return u.Value
+}
+
+// NewUnsignedFixedVarControlType returns an UnsignedFixedVarControlType with its chain of base types allocated.
+func NewUnsignedFixedVarControlType() *UnsignedFixedVarControlType {
+	return &UnsignedFixedVarControlType{UnsignedFixedVar: NewUnsignedFixedVar()}
+}
+
+// GetRefType returns the RefType of u, or nil if u or one of its bases is nil.
+func (u *UnsignedFixedVarControlType) GetRefType() *DERUnitRefType {
+	if u == nil {
+		return nil
+	}
+	return u.UnsignedFixedVar.GetRefType()
+}
+
+// GetValue returns the Value of u, or nil if u or one of its bases is nil.
+func (u *UnsignedFixedVarControlType) GetValue() *PerCent {
+	if u == nil {
+		return nil
+	}
+	return u.UnsignedFixedVar.GetValue()
+}
+
+// NewFreqDroopType returns a new FreqDroopType.
+func NewFreqDroopType() *FreqDroopType {
+	return &FreqDroopType{}
+}
+
+// NewPerCentControlType returns a new PerCentControlType.
+func NewPerCentControlType() *PerCentControlType {
+	return &PerCentControlType{}
+}
+
+// NewPowerFactor returns a new PowerFactor.
+func NewPowerFactor() *PowerFactor {
+	return &PowerFactor{}
+}
+
+// NewPowerFactorWithExcitation returns a new PowerFactorWithExcitation.
+func NewPowerFactorWithExcitation() *PowerFactorWithExcitation {
+	return &PowerFactorWithExcitation{}
+}
+
+// GetDisplacement returns p.Displacement, or 0 if p is nil.
+func (p *PowerFactorWithExcitation) GetDisplacement() uint16 {
+	if p == nil {
+		return 0
+	}
+	return p.Displacement
+}
+
+// GetExcitation returns p.Excitation, or false if p is nil.
+func (p *PowerFactorWithExcitation) GetExcitation() bool {
+	if p == nil {
+		return false
+	}
+	return p.Excitation
+}
+
+// GetMultiplier returns p.Multiplier, or nil if p is nil.
+func (p *PowerFactorWithExcitation) GetMultiplier() *PowerOfTenMultiplierType {
+	if p == nil {
+		return nil
+	}
+	return p.Multiplier
+}
+
+// NewPowerFactorWithExcitationControlType returns a PowerFactorWithExcitationControlType with its chain of base types allocated.
+func NewPowerFactorWithExcitationControlType() *PowerFactorWithExcitationControlType {
+	return &PowerFactorWithExcitationControlType{PowerFactorWithExcitation: NewPowerFactorWithExcitation()}
+}
+
+// GetDisplacement returns the Displacement of p, or 0 if p or one of its bases is nil.
+func (p *PowerFactorWithExcitationControlType) GetDisplacement() uint16 {
+	if p == nil {
+		return 0
+	}
+	return p.PowerFactorWithExcitation.GetDisplacement()
+}
+
+// GetExcitation returns the Excitation of p, or false if p or one of its bases is nil.
+func (p *PowerFactorWithExcitationControlType) GetExcitation() bool {
+	if p == nil {
+		return false
+	}
+	return p.PowerFactorWithExcitation.GetExcitation()
+}
+
+// GetMultiplier returns the Multiplier of p, or nil if p or one of its bases is nil.
+func (p *PowerFactorWithExcitationControlType) GetMultiplier() *PowerOfTenMultiplierType {
+	if p == nil {
+		return nil
+	}
+	return p.PowerFactorWithExcitation.GetMultiplier()
+}
+
+// NewReactivePower returns a new ReactivePower.
+func NewReactivePower() *ReactivePower {
+	return &ReactivePower{}
+}
+
+// GetMultiplier returns r.Multiplier, or nil if r is nil.
+func (r *ReactivePower) GetMultiplier() *PowerOfTenMultiplierType {
+	if r == nil {
+		return nil
+	}
+	return r.Multiplier
+}
+
+// GetValue returns r.Value, or 0 if r is nil.
+func (r *ReactivePower) GetValue() int16 {
+	if r == nil {
+		return 0
+	}
+	return r.Value
+}
+
+// NewReactivePowerControlType returns a ReactivePowerControlType with its chain of base types allocated.
+func NewReactivePowerControlType() *ReactivePowerControlType {
+	return &ReactivePowerControlType{ReactivePower: NewReactivePower()}
+}
+
+// GetMultiplier returns the Multiplier of r, or nil if r or one of its bases is nil.
+func (r *ReactivePowerControlType) GetMultiplier() *PowerOfTenMultiplierType {
+	if r == nil {
+		return nil
+	}
+	return r.ReactivePower.GetMultiplier()
+}
+
+// GetValue returns the Value of r, or 0 if r or one of its bases is nil.
+func (r *ReactivePowerControlType) GetValue() int16 {
+	if r == nil {
+		return 0
+	}
+	return r.ReactivePower.GetValue()
+}
+
+// NewReactivePowerDeltaControlType returns a ReactivePowerDeltaControlType with its chain of base types allocated.
+func NewReactivePowerDeltaControlType() *ReactivePowerDeltaControlType {
+	return &ReactivePowerDeltaControlType{ReactivePower: NewReactivePower()}
+}
+
+// GetMultiplier returns the Multiplier of r, or nil if r or one of its bases is nil.
+func (r *ReactivePowerDeltaControlType) GetMultiplier() *PowerOfTenMultiplierType {
+	if r == nil {
+		return nil
+	}
+	return r.ReactivePower.GetMultiplier()
+}
+
+// GetValue returns the Value of r, or 0 if r or one of its bases is nil.
+func (r *ReactivePowerDeltaControlType) GetValue() int16 {
+	if r == nil {
+		return 0
+	}
+	return r.ReactivePower.GetValue()
+}
+
+// NewUnsignedReactivePower returns a new UnsignedReactivePower.
+func NewUnsignedReactivePower() *UnsignedReactivePower {
+	return &UnsignedReactivePower{}
+}
+
+// GetMultiplier returns u.Multiplier, or nil if u is nil.
+func (u *UnsignedReactivePower) GetMultiplier() *PowerOfTenMultiplierType {
+	if u == nil {
+		return nil
+	}
+	return u.Multiplier
+}
+
+// GetValue returns u.Value, or 0 if u is nil.
+func (u *UnsignedReactivePower) GetValue() uint16 {
+	if u == nil {
+		return 0
+	}
+	return u.Value
+}
+
+// NewUnsignedReactivePowerControlType returns an UnsignedReactivePowerControlType with its chain of base types allocated.
+func NewUnsignedReactivePowerControlType() *UnsignedReactivePowerControlType {
+	return &UnsignedReactivePowerControlType{UnsignedReactivePower: NewUnsignedReactivePower()}
+}
+
+// GetMultiplier returns the Multiplier of u, or nil if u or one of its bases is nil.
+func (u *UnsignedReactivePowerControlType) GetMultiplier() *PowerOfTenMultiplierType {
+	if u == nil {
+		return nil
+	}
+	return u.UnsignedReactivePower.GetMultiplier()
+}
+
+// GetValue returns the Value of u, or 0 if u or one of its bases is nil.
+func (u *UnsignedReactivePowerControlType) GetValue() uint16 {
+	if u == nil {
+		return 0
+	}
+	return u.UnsignedReactivePower.GetValue()
+}
+
+// NewReactiveSusceptance returns a new ReactiveSusceptance.
+func NewReactiveSusceptance() *ReactiveSusceptance {
+	return &ReactiveSusceptance{}
+}
+
+// NewSignedPerCentControlType returns a new SignedPerCentControlType.
+func NewSignedPerCentControlType() *SignedPerCentControlType {
+	return &SignedPerCentControlType{}
+}
+
+// NewVoltageRMS returns a new VoltageRMS.
+func NewVoltageRMS() *VoltageRMS {
+	return &VoltageRMS{}
+}
+
+// GetMultiplier returns v.Multiplier, or nil if v is nil.
+func (v *VoltageRMS) GetMultiplier() *PowerOfTenMultiplierType {
+	if v == nil {
+		return nil
+	}
+	return v.Multiplier
+}
+
+// GetValue returns v.Value, or 0 if v is nil.
+func (v *VoltageRMS) GetValue() uint16 {
+	if v == nil {
+		return 0
+	}
+	return v.Value
+}
+
+// NewVoltageRMSControlType returns a VoltageRMSControlType with its chain of base types allocated.
+func NewVoltageRMSControlType() *VoltageRMSControlType {
+	return &VoltageRMSControlType{VoltageRMS: NewVoltageRMS()}
+}
+
+// GetMultiplier returns the Multiplier of v, or nil if v or one of its bases is nil.
+func (v *VoltageRMSControlType) GetMultiplier() *PowerOfTenMultiplierType {
+	if v == nil {
+		return nil
+	}
+	return v.VoltageRMS.GetMultiplier()
+}
+
+// GetValue returns the Value of v, or 0 if v or one of its bases is nil.
+func (v *VoltageRMSControlType) GetValue() uint16 {
+	if v == nil {
+		return 0
+	}
+	return v.VoltageRMS.GetValue()
+}
+
+// NewWattHour returns a new WattHour.
+func NewWattHour() *WattHour {
+	return &WattHour{}
+}
+
+// NewConnectStatusType returns a new ConnectStatusType.
+func NewConnectStatusType() *ConnectStatusType {
+	return &ConnectStatusType{}
+}
+
+// NewConnectStatusType2 returns a new ConnectStatusType2.
+func NewConnectStatusType2() *ConnectStatusType2 {
+	return &ConnectStatusType2{}
+}
+
+// NewInverterStatusType returns a new InverterStatusType.
+func NewInverterStatusType() *InverterStatusType {
+	return &InverterStatusType{}
+}
+
+// NewLocalControlModeStatusType returns a new LocalControlModeStatusType.
+func NewLocalControlModeStatusType() *LocalControlModeStatusType {
+	return &LocalControlModeStatusType{}
+}
+
+// NewManufacturerStatusType returns a new ManufacturerStatusType.
+func NewManufacturerStatusType() *ManufacturerStatusType {
+	return &ManufacturerStatusType{}
+}
+
+// NewOperationalModeStatusType returns a new OperationalModeStatusType.
+func NewOperationalModeStatusType() *OperationalModeStatusType {
+	return &OperationalModeStatusType{}
+}
+
+// NewStateOfChargeStatusType returns a new StateOfChargeStatusType.
+func NewStateOfChargeStatusType() *StateOfChargeStatusType {
+	return &StateOfChargeStatusType{}
+}
+
+// NewStorageModeStatusType returns a new StorageModeStatusType.
+func NewStorageModeStatusType() *StorageModeStatusType {
+	return &StorageModeStatusType{}
+}
+
+// NewCurrentDERProgramLink returns a CurrentDERProgramLink with its chain of base types allocated.
+func NewCurrentDERProgramLink() *CurrentDERProgramLink {
+	return &CurrentDERProgramLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of c, or "" if c or one of its bases is nil.
+func (c *CurrentDERProgramLink) GetHref() string {
+	if c == nil {
+		return ""
+	}
+	return c.Link.GetHref()
+}
+
+// NewAggregationPriority returns an AggregationPriority with its chain of base types allocated.
+func NewAggregationPriority() *AggregationPriority {
+	return &AggregationPriority{IdentifiedObject: NewIdentifiedObject()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *AggregationPriority) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.IdentifiedObject.GetHref()
+}
+
+// GetMRID returns the MRID of a, or nil if a or one of its bases is nil.
+func (a *AggregationPriority) GetMRID() *MRIDType {
+	if a == nil {
+		return nil
+	}
+	return a.IdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of a, or nil if a or one of its bases is nil.
+func (a *AggregationPriority) GetDescription() *string {
+	if a == nil {
+		return nil
+	}
+	return a.IdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of a, or nil if a or one of its bases is nil.
+func (a *AggregationPriority) GetVersion() *VersionType {
+	if a == nil {
+		return nil
+	}
+	return a.IdentifiedObject.GetVersion()
+}
+
+// NewPriorityData returns a new PriorityData.
+func NewPriorityData() *PriorityData {
+	return &PriorityData{}
+}
+
+// NewAggregatedDeviceList returns an AggregatedDeviceList with its chain of base types allocated.
+func NewAggregatedDeviceList() *AggregatedDeviceList {
+	return &AggregatedDeviceList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *AggregatedDeviceList) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of a, or nil if a or one of its bases is nil.
+func (a *AggregatedDeviceList) GetSubscribable() *UInt8 {
+	if a == nil {
+		return nil
+	}
+	return a.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of a, or 0 if a or one of its bases is nil.
+func (a *AggregatedDeviceList) GetAll() uint32 {
+	if a == nil {
+		return 0
+	}
+	return a.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of a, or 0 if a or one of its bases is nil.
+func (a *AggregatedDeviceList) GetResults() uint32 {
+	if a == nil {
+		return 0
+	}
+	return a.SubscribableList.GetResults()
+}
+
+// NewAggregatedDevice returns an AggregatedDevice with its chain of base types allocated.
+func NewAggregatedDevice() *AggregatedDevice {
+	return &AggregatedDevice{Resource: NewResource()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *AggregatedDevice) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.Resource.GetHref()
+}
+
+// NewProxiedDevice returns a ProxiedDevice with its chain of base types allocated.
+func NewProxiedDevice() *ProxiedDevice {
+	return &ProxiedDevice{ExternalDevice: NewExternalDevice()}
+}
+
+// GetHref returns the Href of p, or "" if p or one of its bases is nil.
+func (p *ProxiedDevice) GetHref() string {
+	if p == nil {
+		return ""
+	}
+	return p.ExternalDevice.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetSubscribable() *UInt8 {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetSubscribable()
+}
+
+// GetAggregatedDeviceListLink returns the AggregatedDeviceListLink of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetAggregatedDeviceListLink() *AggregatedDeviceListLink {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetAggregatedDeviceListLink()
+}
+
+// GetAggregationPriorityLink returns the AggregationPriorityLink of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetAggregationPriorityLink() *AggregationPriorityLink {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetAggregationPriorityLink()
+}
+
+// GetConfigurationLink returns the ConfigurationLink of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetConfigurationLink() *ConfigurationLink {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetConfigurationLink()
+}
+
+// GetDERListLink returns the DERListLink of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetDERListLink() *DERListLink {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetDERListLink()
+}
+
+// GetDeviceCategory returns the DeviceCategory of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetDeviceCategory() *DeviceCategoryType {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetDeviceCategory()
+}
+
+// GetDeviceInformationLink returns the DeviceInformationLink of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetDeviceInformationLink() *DeviceInformationLink {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetDeviceInformationLink()
+}
+
+// GetDeviceStatusLink returns the DeviceStatusLink of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetDeviceStatusLink() *DeviceStatusLink {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetDeviceStatusLink()
+}
+
+// GetDistribution returns the Distribution of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetDistribution() *AggregationDistributionType {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetDistribution()
+}
+
+// GetFileStatusLink returns the FileStatusLink of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetFileStatusLink() *FileStatusLink {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetFileStatusLink()
+}
+
+// GetIPInterfaceListLink returns the IPInterfaceListLink of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetIPInterfaceListLink() *IPInterfaceListLink {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetIPInterfaceListLink()
+}
+
+// GetLFDI returns the LFDI of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetLFDI() *string {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetLFDI()
+}
+
+// GetLoadShedAvailabilityListLink returns the LoadShedAvailabilityListLink of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetLoadShedAvailabilityListLink() *LoadShedAvailabilityListLink {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetLoadShedAvailabilityListLink()
+}
+
+// GetLogEventListLink returns the LogEventListLink of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetLogEventListLink() *LogEventListLink {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetLogEventListLink()
+}
+
+// GetPhase returns the Phase of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetPhase() *PhaseCode {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetPhase()
+}
+
+// GetPowerStatusLink returns the PowerStatusLink of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetPowerStatusLink() *PowerStatusLink {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetPowerStatusLink()
+}
+
+// GetSFDI returns the SFDI of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetSFDI() *SFDIType {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetSFDI()
+}
+
+// GetChangedTime returns the ChangedTime of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetChangedTime() *TimeType {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetChangedTime()
+}
+
+// GetEnabled returns the Enabled of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetEnabled() *bool {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetEnabled()
+}
+
+// GetFlowReservationRequestListLink returns the FlowReservationRequestListLink of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetFlowReservationRequestListLink() *FlowReservationRequestListLink {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetFlowReservationRequestListLink()
+}
+
+// GetFlowReservationResponseListLink returns the FlowReservationResponseListLink of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetFlowReservationResponseListLink() *FlowReservationResponseListLink {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetFlowReservationResponseListLink()
+}
+
+// GetFunctionSetAssignmentsListLink returns the FunctionSetAssignmentsListLink of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetFunctionSetAssignmentsListLink() *FunctionSetAssignmentsListLink {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetFunctionSetAssignmentsListLink()
+}
+
+// GetPostRate returns the PostRate of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetPostRate() *uint32 {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetPostRate()
+}
+
+// GetRegistrationLink returns the RegistrationLink of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDevice) GetRegistrationLink() *RegistrationLink {
+	if p == nil {
+		return nil
+	}
+	return p.ExternalDevice.GetRegistrationLink()
+}
+
+// NewProxiedDeviceList returns a ProxiedDeviceList with its chain of base types allocated.
+func NewProxiedDeviceList() *ProxiedDeviceList {
+	return &ProxiedDeviceList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of p, or "" if p or one of its bases is nil.
+func (p *ProxiedDeviceList) GetHref() string {
+	if p == nil {
+		return ""
+	}
+	return p.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of p, or nil if p or one of its bases is nil.
+func (p *ProxiedDeviceList) GetSubscribable() *UInt8 {
+	if p == nil {
+		return nil
+	}
+	return p.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of p, or 0 if p or one of its bases is nil.
+func (p *ProxiedDeviceList) GetAll() uint32 {
+	if p == nil {
+		return 0
+	}
+	return p.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of p, or 0 if p or one of its bases is nil.
+func (p *ProxiedDeviceList) GetResults() uint32 {
+	if p == nil {
+		return 0
+	}
+	return p.SubscribableList.GetResults()
+}
+
+// NewAccountBalanceLink returns an AccountBalanceLink with its chain of base types allocated.
+func NewAccountBalanceLink() *AccountBalanceLink {
+	return &AccountBalanceLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *AccountBalanceLink) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.Link.GetHref()
+}
+
+// NewAggregatedDeviceListLink returns an AggregatedDeviceListLink with its chain of base types allocated.
+func NewAggregatedDeviceListLink() *AggregatedDeviceListLink {
+	return &AggregatedDeviceListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *AggregatedDeviceListLink) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.ListLink.GetHref()
+}
+
+// GetAll returns the All of a, or 0 if a or one of its bases is nil.
+func (a *AggregatedDeviceListLink) GetAll() uint32 {
+	if a == nil {
+		return 0
+	}
+	return a.ListLink.GetAll()
+}
+
+// NewAggregationPriorityLink returns an AggregationPriorityLink with its chain of base types allocated.
+func NewAggregationPriorityLink() *AggregationPriorityLink {
+	return &AggregationPriorityLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *AggregationPriorityLink) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.Link.GetHref()
+}
+
+// NewAssociatedDERProgramListLink returns an AssociatedDERProgramListLink with its chain of base types allocated.
+func NewAssociatedDERProgramListLink() *AssociatedDERProgramListLink {
+	return &AssociatedDERProgramListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *AssociatedDERProgramListLink) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.ListLink.GetHref()
+}
+
+// GetAll returns the All of a, or 0 if a or one of its bases is nil.
+func (a *AssociatedDERProgramListLink) GetAll() uint32 {
+	if a == nil {
+		return 0
+	}
+	return a.ListLink.GetAll()
+}
+
+// NewAssociatedUsagePointLink returns an AssociatedUsagePointLink with its chain of base types allocated.
+func NewAssociatedUsagePointLink() *AssociatedUsagePointLink {
+	return &AssociatedUsagePointLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *AssociatedUsagePointLink) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.Link.GetHref()
+}
+
+// NewBillingPeriodListLink returns a BillingPeriodListLink with its chain of base types allocated.
+func NewBillingPeriodListLink() *BillingPeriodListLink {
+	return &BillingPeriodListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of b, or "" if b or one of its bases is nil.
+func (b *BillingPeriodListLink) GetHref() string {
+	if b == nil {
+		return ""
+	}
+	return b.ListLink.GetHref()
+}
+
+// GetAll returns the All of b, or 0 if b or one of its bases is nil.
+func (b *BillingPeriodListLink) GetAll() uint32 {
+	if b == nil {
+		return 0
+	}
+	return b.ListLink.GetAll()
+}
+
+// NewBillingReadingListLink returns a BillingReadingListLink with its chain of base types allocated.
+func NewBillingReadingListLink() *BillingReadingListLink {
+	return &BillingReadingListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of b, or "" if b or one of its bases is nil.
+func (b *BillingReadingListLink) GetHref() string {
+	if b == nil {
+		return ""
+	}
+	return b.ListLink.GetHref()
+}
+
+// GetAll returns the All of b, or 0 if b or one of its bases is nil.
+func (b *BillingReadingListLink) GetAll() uint32 {
+	if b == nil {
+		return 0
+	}
+	return b.ListLink.GetAll()
+}
+
+// NewBillingReadingSetListLink returns a BillingReadingSetListLink with its chain of base types allocated.
+func NewBillingReadingSetListLink() *BillingReadingSetListLink {
+	return &BillingReadingSetListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of b, or "" if b or one of its bases is nil.
+func (b *BillingReadingSetListLink) GetHref() string {
+	if b == nil {
+		return ""
+	}
+	return b.ListLink.GetHref()
+}
+
+// GetAll returns the All of b, or 0 if b or one of its bases is nil.
+func (b *BillingReadingSetListLink) GetAll() uint32 {
+	if b == nil {
+		return 0
+	}
+	return b.ListLink.GetAll()
+}
+
+// NewConfigurationLink returns a ConfigurationLink with its chain of base types allocated.
+func NewConfigurationLink() *ConfigurationLink {
+	return &ConfigurationLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of c, or "" if c or one of its bases is nil.
+func (c *ConfigurationLink) GetHref() string {
+	if c == nil {
+		return ""
+	}
+	return c.Link.GetHref()
+}
+
+// NewConsumptionTariffIntervalListLink returns a ConsumptionTariffIntervalListLink with its chain of base types allocated.
+func NewConsumptionTariffIntervalListLink() *ConsumptionTariffIntervalListLink {
+	return &ConsumptionTariffIntervalListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of c, or "" if c or one of its bases is nil.
+func (c *ConsumptionTariffIntervalListLink) GetHref() string {
+	if c == nil {
+		return ""
+	}
+	return c.ListLink.GetHref()
+}
+
+// GetAll returns the All of c, or 0 if c or one of its bases is nil.
+func (c *ConsumptionTariffIntervalListLink) GetAll() uint32 {
+	if c == nil {
+		return 0
+	}
+	return c.ListLink.GetAll()
+}
+
+// NewCreditRegisterListLink returns a CreditRegisterListLink with its chain of base types allocated.
+func NewCreditRegisterListLink() *CreditRegisterListLink {
+	return &CreditRegisterListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of c, or "" if c or one of its bases is nil.
+func (c *CreditRegisterListLink) GetHref() string {
+	if c == nil {
+		return ""
+	}
+	return c.ListLink.GetHref()
+}
+
+// GetAll returns the All of c, or 0 if c or one of its bases is nil.
+func (c *CreditRegisterListLink) GetAll() uint32 {
+	if c == nil {
+		return 0
+	}
+	return c.ListLink.GetAll()
+}
+
+// NewCurrentDERControlsLink returns a CurrentDERControlsLink with its chain of base types allocated.
+func NewCurrentDERControlsLink() *CurrentDERControlsLink {
+	return &CurrentDERControlsLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of c, or "" if c or one of its bases is nil.
+func (c *CurrentDERControlsLink) GetHref() string {
+	if c == nil {
+		return ""
+	}
+	return c.Link.GetHref()
+}
+
+// NewCustomerAccountLink returns a CustomerAccountLink with its chain of base types allocated.
+func NewCustomerAccountLink() *CustomerAccountLink {
+	return &CustomerAccountLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of c, or "" if c or one of its bases is nil.
+func (c *CustomerAccountLink) GetHref() string {
+	if c == nil {
+		return ""
+	}
+	return c.Link.GetHref()
+}
+
+// NewCustomerAccountListLink returns a CustomerAccountListLink with its chain of base types allocated.
+func NewCustomerAccountListLink() *CustomerAccountListLink {
+	return &CustomerAccountListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of c, or "" if c or one of its bases is nil.
+func (c *CustomerAccountListLink) GetHref() string {
+	if c == nil {
+		return ""
+	}
+	return c.ListLink.GetHref()
+}
+
+// GetAll returns the All of c, or 0 if c or one of its bases is nil.
+func (c *CustomerAccountListLink) GetAll() uint32 {
+	if c == nil {
+		return 0
+	}
+	return c.ListLink.GetAll()
+}
+
+// NewCustomerAgreementListLink returns a CustomerAgreementListLink with its chain of base types allocated.
+func NewCustomerAgreementListLink() *CustomerAgreementListLink {
+	return &CustomerAgreementListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of c, or "" if c or one of its bases is nil.
+func (c *CustomerAgreementListLink) GetHref() string {
+	if c == nil {
+		return ""
+	}
+	return c.ListLink.GetHref()
+}
+
+// GetAll returns the All of c, or 0 if c or one of its bases is nil.
+func (c *CustomerAgreementListLink) GetAll() uint32 {
+	if c == nil {
+		return 0
+	}
+	return c.ListLink.GetAll()
+}
+
+// NewDefaultDERControlLink returns a DefaultDERControlLink with its chain of base types allocated.
+func NewDefaultDERControlLink() *DefaultDERControlLink {
+	return &DefaultDERControlLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DefaultDERControlLink) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.Link.GetHref()
+}
+
+// NewDemandResponseProgramLink returns a DemandResponseProgramLink with its chain of base types allocated.
+func NewDemandResponseProgramLink() *DemandResponseProgramLink {
+	return &DemandResponseProgramLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DemandResponseProgramLink) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.Link.GetHref()
+}
+
+// NewDemandResponseProgramListLink returns a DemandResponseProgramListLink with its chain of base types allocated.
+func NewDemandResponseProgramListLink() *DemandResponseProgramListLink {
+	return &DemandResponseProgramListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DemandResponseProgramListLink) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.ListLink.GetHref()
+}
+
+// GetAll returns the All of d, or 0 if d or one of its bases is nil.
+func (d *DemandResponseProgramListLink) GetAll() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.ListLink.GetAll()
+}
+
+// NewDERAvailabilityLink returns a DERAvailabilityLink with its chain of base types allocated.
+func NewDERAvailabilityLink() *DERAvailabilityLink {
+	return &DERAvailabilityLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERAvailabilityLink) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.Link.GetHref()
+}
+
+// NewDERCapabilityLink returns a DERCapabilityLink with its chain of base types allocated.
+func NewDERCapabilityLink() *DERCapabilityLink {
+	return &DERCapabilityLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERCapabilityLink) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.Link.GetHref()
+}
+
+// NewDERComponentListLink returns a DERComponentListLink with its chain of base types allocated.
+func NewDERComponentListLink() *DERComponentListLink {
+	return &DERComponentListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERComponentListLink) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.ListLink.GetHref()
+}
+
+// GetAll returns the All of d, or 0 if d or one of its bases is nil.
+func (d *DERComponentListLink) GetAll() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.ListLink.GetAll()
+}
+
+// NewDERControlListLink returns a DERControlListLink with its chain of base types allocated.
+func NewDERControlListLink() *DERControlListLink {
+	return &DERControlListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERControlListLink) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.ListLink.GetHref()
+}
+
+// GetAll returns the All of d, or 0 if d or one of its bases is nil.
+func (d *DERControlListLink) GetAll() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.ListLink.GetAll()
+}
+
+// NewDERCurveLink returns a DERCurveLink with its chain of base types allocated.
+func NewDERCurveLink() *DERCurveLink {
+	return &DERCurveLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERCurveLink) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.Link.GetHref()
+}
+
+// NewDERCurveListLink returns a DERCurveListLink with its chain of base types allocated.
+func NewDERCurveListLink() *DERCurveListLink {
+	return &DERCurveListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERCurveListLink) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.ListLink.GetHref()
+}
+
+// GetAll returns the All of d, or 0 if d or one of its bases is nil.
+func (d *DERCurveListLink) GetAll() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.ListLink.GetAll()
+}
+
+// NewDERLink returns a DERLink with its chain of base types allocated.
+func NewDERLink() *DERLink {
+	return &DERLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERLink) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.Link.GetHref()
+}
+
+// NewDERListLink returns a DERListLink with its chain of base types allocated.
+func NewDERListLink() *DERListLink {
+	return &DERListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERListLink) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.ListLink.GetHref()
+}
+
+// GetAll returns the All of d, or 0 if d or one of its bases is nil.
+func (d *DERListLink) GetAll() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.ListLink.GetAll()
+}
+
+// NewDERProgramLink returns a DERProgramLink with its chain of base types allocated.
+func NewDERProgramLink() *DERProgramLink {
+	return &DERProgramLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERProgramLink) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.Link.GetHref()
+}
+
+// NewDERProgramListLink returns a DERProgramListLink with its chain of base types allocated.
+func NewDERProgramListLink() *DERProgramListLink {
+	return &DERProgramListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERProgramListLink) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.ListLink.GetHref()
+}
+
+// GetAll returns the All of d, or 0 if d or one of its bases is nil.
+func (d *DERProgramListLink) GetAll() uint32 {
+	if d == nil {
+		return 0
+	}
+	return d.ListLink.GetAll()
+}
+
+// NewDERSettingsLink returns a DERSettingsLink with its chain of base types allocated.
+func NewDERSettingsLink() *DERSettingsLink {
+	return &DERSettingsLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERSettingsLink) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.Link.GetHref()
+}
+
+// NewDERStatusLink returns a DERStatusLink with its chain of base types allocated.
+func NewDERStatusLink() *DERStatusLink {
+	return &DERStatusLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DERStatusLink) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.Link.GetHref()
+}
+
+// NewDeviceCapabilityLink returns a DeviceCapabilityLink with its chain of base types allocated.
+func NewDeviceCapabilityLink() *DeviceCapabilityLink {
+	return &DeviceCapabilityLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DeviceCapabilityLink) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.Link.GetHref()
+}
+
+// NewDeviceInformationLink returns a DeviceInformationLink with its chain of base types allocated.
+func NewDeviceInformationLink() *DeviceInformationLink {
+	return &DeviceInformationLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DeviceInformationLink) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.Link.GetHref()
+}
+
+// NewDeviceStatusLink returns a DeviceStatusLink with its chain of base types allocated.
+func NewDeviceStatusLink() *DeviceStatusLink {
+	return &DeviceStatusLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of d, or "" if d or one of its bases is nil.
+func (d *DeviceStatusLink) GetHref() string {
+	if d == nil {
+		return ""
+	}
+	return d.Link.GetHref()
+}
+
+// NewEndDeviceControlListLink returns an EndDeviceControlListLink with its chain of base types allocated.
+func NewEndDeviceControlListLink() *EndDeviceControlListLink {
+	return &EndDeviceControlListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of e, or "" if e or one of its bases is nil.
+func (e *EndDeviceControlListLink) GetHref() string {
+	if e == nil {
+		return ""
+	}
+	return e.ListLink.GetHref()
+}
+
+// GetAll returns the All of e, or 0 if e or one of its bases is nil.
+func (e *EndDeviceControlListLink) GetAll() uint32 {
+	if e == nil {
+		return 0
+	}
+	return e.ListLink.GetAll()
+}
+
+// NewEndDeviceLink returns an EndDeviceLink with its chain of base types allocated.
+func NewEndDeviceLink() *EndDeviceLink {
+	return &EndDeviceLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of e, or "" if e or one of its bases is nil.
+func (e *EndDeviceLink) GetHref() string {
+	if e == nil {
+		return ""
+	}
+	return e.Link.GetHref()
+}
+
+// NewEndDeviceListLink returns an EndDeviceListLink with its chain of base types allocated.
+func NewEndDeviceListLink() *EndDeviceListLink {
+	return &EndDeviceListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of e, or "" if e or one of its bases is nil.
+func (e *EndDeviceListLink) GetHref() string {
+	if e == nil {
+		return ""
+	}
+	return e.ListLink.GetHref()
+}
+
+// GetAll returns the All of e, or 0 if e or one of its bases is nil.
+func (e *EndDeviceListLink) GetAll() uint32 {
+	if e == nil {
+		return 0
+	}
+	return e.ListLink.GetAll()
+}
+
+// NewFileLink returns a FileLink with its chain of base types allocated.
+func NewFileLink() *FileLink {
+	return &FileLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of f, or "" if f or one of its bases is nil.
+func (f *FileLink) GetHref() string {
+	if f == nil {
+		return ""
+	}
+	return f.Link.GetHref()
+}
+
+// NewFileListLink returns a FileListLink with its chain of base types allocated.
+func NewFileListLink() *FileListLink {
+	return &FileListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of f, or "" if f or one of its bases is nil.
+func (f *FileListLink) GetHref() string {
+	if f == nil {
+		return ""
+	}
+	return f.ListLink.GetHref()
+}
+
+// GetAll returns the All of f, or 0 if f or one of its bases is nil.
+func (f *FileListLink) GetAll() uint32 {
+	if f == nil {
+		return 0
+	}
+	return f.ListLink.GetAll()
+}
+
+// NewFileStatusLink returns a FileStatusLink with its chain of base types allocated.
+func NewFileStatusLink() *FileStatusLink {
+	return &FileStatusLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of f, or "" if f or one of its bases is nil.
+func (f *FileStatusLink) GetHref() string {
+	if f == nil {
+		return ""
+	}
+	return f.Link.GetHref()
+}
+
+// NewFlowReservationRequestListLink returns a FlowReservationRequestListLink with its chain of base types allocated.
+func NewFlowReservationRequestListLink() *FlowReservationRequestListLink {
+	return &FlowReservationRequestListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of f, or "" if f or one of its bases is nil.
+func (f *FlowReservationRequestListLink) GetHref() string {
+	if f == nil {
+		return ""
+	}
+	return f.ListLink.GetHref()
+}
+
+// GetAll returns the All of f, or 0 if f or one of its bases is nil.
+func (f *FlowReservationRequestListLink) GetAll() uint32 {
+	if f == nil {
+		return 0
+	}
+	return f.ListLink.GetAll()
+}
+
+// NewFlowReservationResponseListLink returns a FlowReservationResponseListLink with its chain of base types allocated.
+func NewFlowReservationResponseListLink() *FlowReservationResponseListLink {
+	return &FlowReservationResponseListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of f, or "" if f or one of its bases is nil.
+func (f *FlowReservationResponseListLink) GetHref() string {
+	if f == nil {
+		return ""
+	}
+	return f.ListLink.GetHref()
+}
+
+// GetAll returns the All of f, or 0 if f or one of its bases is nil.
+func (f *FlowReservationResponseListLink) GetAll() uint32 {
+	if f == nil {
+		return 0
+	}
+	return f.ListLink.GetAll()
+}
+
+// NewFunctionSetAssignmentsListLink returns a FunctionSetAssignmentsListLink with its chain of base types allocated.
+func NewFunctionSetAssignmentsListLink() *FunctionSetAssignmentsListLink {
+	return &FunctionSetAssignmentsListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of f, or "" if f or one of its bases is nil.
+func (f *FunctionSetAssignmentsListLink) GetHref() string {
+	if f == nil {
+		return ""
+	}
+	return f.ListLink.GetHref()
+}
+
+// GetAll returns the All of f, or 0 if f or one of its bases is nil.
+func (f *FunctionSetAssignmentsListLink) GetAll() uint32 {
+	if f == nil {
+		return 0
+	}
+	return f.ListLink.GetAll()
+}
+
+// NewHistoricalReadingListLink returns a HistoricalReadingListLink with its chain of base types allocated.
+func NewHistoricalReadingListLink() *HistoricalReadingListLink {
+	return &HistoricalReadingListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of h, or "" if h or one of its bases is nil.
+func (h *HistoricalReadingListLink) GetHref() string {
+	if h == nil {
+		return ""
+	}
+	return h.ListLink.GetHref()
+}
+
+// GetAll returns the All of h, or 0 if h or one of its bases is nil.
+func (h *HistoricalReadingListLink) GetAll() uint32 {
+	if h == nil {
+		return 0
+	}
+	return h.ListLink.GetAll()
+}
+
+// NewIPAddrListLink returns an IPAddrListLink with its chain of base types allocated.
+func NewIPAddrListLink() *IPAddrListLink {
+	return &IPAddrListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of i, or "" if i or one of its bases is nil.
+func (i *IPAddrListLink) GetHref() string {
+	if i == nil {
+		return ""
+	}
+	return i.ListLink.GetHref()
+}
+
+// GetAll returns the All of i, or 0 if i or one of its bases is nil.
+func (i *IPAddrListLink) GetAll() uint32 {
+	if i == nil {
+		return 0
+	}
+	return i.ListLink.GetAll()
+}
+
+// NewIPInterfaceListLink returns an IPInterfaceListLink with its chain of base types allocated.
+func NewIPInterfaceListLink() *IPInterfaceListLink {
+	return &IPInterfaceListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of i, or "" if i or one of its bases is nil.
+func (i *IPInterfaceListLink) GetHref() string {
+	if i == nil {
+		return ""
+	}
+	return i.ListLink.GetHref()
+}
+
+// GetAll returns the All of i, or 0 if i or one of its bases is nil.
+func (i *IPInterfaceListLink) GetAll() uint32 {
+	if i == nil {
+		return 0
+	}
+	return i.ListLink.GetAll()
+}
+
+// NewLLInterfaceListLink returns a LLInterfaceListLink with its chain of base types allocated.
+func NewLLInterfaceListLink() *LLInterfaceListLink {
+	return &LLInterfaceListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of l, or "" if l or one of its bases is nil.
+func (l *LLInterfaceListLink) GetHref() string {
+	if l == nil {
+		return ""
+	}
+	return l.ListLink.GetHref()
+}
+
+// GetAll returns the All of l, or 0 if l or one of its bases is nil.
+func (l *LLInterfaceListLink) GetAll() uint32 {
+	if l == nil {
+		return 0
+	}
+	return l.ListLink.GetAll()
+}
+
+// NewLoadShedAvailabilityListLink returns a LoadShedAvailabilityListLink with its chain of base types allocated.
+func NewLoadShedAvailabilityListLink() *LoadShedAvailabilityListLink {
+	return &LoadShedAvailabilityListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of l, or "" if l or one of its bases is nil.
+func (l *LoadShedAvailabilityListLink) GetHref() string {
+	if l == nil {
+		return ""
+	}
+	return l.ListLink.GetHref()
+}
+
+// GetAll returns the All of l, or 0 if l or one of its bases is nil.
+func (l *LoadShedAvailabilityListLink) GetAll() uint32 {
+	if l == nil {
+		return 0
+	}
+	return l.ListLink.GetAll()
+}
+
+// NewLogEventListLink returns a LogEventListLink with its chain of base types allocated.
+func NewLogEventListLink() *LogEventListLink {
+	return &LogEventListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of l, or "" if l or one of its bases is nil.
+func (l *LogEventListLink) GetHref() string {
+	if l == nil {
+		return ""
+	}
+	return l.ListLink.GetHref()
+}
+
+// GetAll returns the All of l, or 0 if l or one of its bases is nil.
+func (l *LogEventListLink) GetAll() uint32 {
+	if l == nil {
+		return 0
+	}
+	return l.ListLink.GetAll()
+}
+
+// NewMessagingProgramListLink returns a MessagingProgramListLink with its chain of base types allocated.
+func NewMessagingProgramListLink() *MessagingProgramListLink {
+	return &MessagingProgramListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of m, or "" if m or one of its bases is nil.
+func (m *MessagingProgramListLink) GetHref() string {
+	if m == nil {
+		return ""
+	}
+	return m.ListLink.GetHref()
+}
+
+// GetAll returns the All of m, or 0 if m or one of its bases is nil.
+func (m *MessagingProgramListLink) GetAll() uint32 {
+	if m == nil {
+		return 0
+	}
+	return m.ListLink.GetAll()
+}
+
+// NewMeterReadingLink returns a MeterReadingLink with its chain of base types allocated.
+func NewMeterReadingLink() *MeterReadingLink {
+	return &MeterReadingLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of m, or "" if m or one of its bases is nil.
+func (m *MeterReadingLink) GetHref() string {
+	if m == nil {
+		return ""
+	}
+	return m.Link.GetHref()
+}
+
+// NewMeterReadingListLink returns a MeterReadingListLink with its chain of base types allocated.
+func NewMeterReadingListLink() *MeterReadingListLink {
+	return &MeterReadingListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of m, or "" if m or one of its bases is nil.
+func (m *MeterReadingListLink) GetHref() string {
+	if m == nil {
+		return ""
+	}
+	return m.ListLink.GetHref()
+}
+
+// GetAll returns the All of m, or 0 if m or one of its bases is nil.
+func (m *MeterReadingListLink) GetAll() uint32 {
+	if m == nil {
+		return 0
+	}
+	return m.ListLink.GetAll()
+}
+
+// NewMirrorUsagePointListLink returns a MirrorUsagePointListLink with its chain of base types allocated.
+func NewMirrorUsagePointListLink() *MirrorUsagePointListLink {
+	return &MirrorUsagePointListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of m, or "" if m or one of its bases is nil.
+func (m *MirrorUsagePointListLink) GetHref() string {
+	if m == nil {
+		return ""
+	}
+	return m.ListLink.GetHref()
+}
+
+// GetAll returns the All of m, or 0 if m or one of its bases is nil.
+func (m *MirrorUsagePointListLink) GetAll() uint32 {
+	if m == nil {
+		return 0
+	}
+	return m.ListLink.GetAll()
+}
+
+// NewNeighborListLink returns a NeighborListLink with its chain of base types allocated.
+func NewNeighborListLink() *NeighborListLink {
+	return &NeighborListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of n, or "" if n or one of its bases is nil.
+func (n *NeighborListLink) GetHref() string {
+	if n == nil {
+		return ""
+	}
+	return n.ListLink.GetHref()
+}
+
+// GetAll returns the All of n, or 0 if n or one of its bases is nil.
+func (n *NeighborListLink) GetAll() uint32 {
+	if n == nil {
+		return 0
+	}
+	return n.ListLink.GetAll()
+}
+
+// NewNotificationListLink returns a NotificationListLink with its chain of base types allocated.
+func NewNotificationListLink() *NotificationListLink {
+	return &NotificationListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of n, or "" if n or one of its bases is nil.
+func (n *NotificationListLink) GetHref() string {
+	if n == nil {
+		return ""
+	}
+	return n.ListLink.GetHref()
+}
+
+// GetAll returns the All of n, or 0 if n or one of its bases is nil.
+func (n *NotificationListLink) GetAll() uint32 {
+	if n == nil {
+		return 0
+	}
+	return n.ListLink.GetAll()
+}
+
+// NewPowerStatusLink returns a PowerStatusLink with its chain of base types allocated.
+func NewPowerStatusLink() *PowerStatusLink {
+	return &PowerStatusLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of p, or "" if p or one of its bases is nil.
+func (p *PowerStatusLink) GetHref() string {
+	if p == nil {
+		return ""
+	}
+	return p.Link.GetHref()
+}
+
+// NewPrepaymentLink returns a PrepaymentLink with its chain of base types allocated.
+func NewPrepaymentLink() *PrepaymentLink {
+	return &PrepaymentLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of p, or "" if p or one of its bases is nil.
+func (p *PrepaymentLink) GetHref() string {
+	if p == nil {
+		return ""
+	}
+	return p.Link.GetHref()
+}
+
+// NewPrepaymentListLink returns a PrepaymentListLink with its chain of base types allocated.
+func NewPrepaymentListLink() *PrepaymentListLink {
+	return &PrepaymentListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of p, or "" if p or one of its bases is nil.
+func (p *PrepaymentListLink) GetHref() string {
+	if p == nil {
+		return ""
+	}
+	return p.ListLink.GetHref()
+}
+
+// GetAll returns the All of p, or 0 if p or one of its bases is nil.
+func (p *PrepaymentListLink) GetAll() uint32 {
+	if p == nil {
+		return 0
+	}
+	return p.ListLink.GetAll()
+}
+
+// NewPrepayOperationStatusLink returns a PrepayOperationStatusLink with its chain of base types allocated.
+func NewPrepayOperationStatusLink() *PrepayOperationStatusLink {
+	return &PrepayOperationStatusLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of p, or "" if p or one of its bases is nil.
+func (p *PrepayOperationStatusLink) GetHref() string {
+	if p == nil {
+		return ""
+	}
+	return p.Link.GetHref()
+}
+
+// NewPriceResponseCfgListLink returns a PriceResponseCfgListLink with its chain of base types allocated.
+func NewPriceResponseCfgListLink() *PriceResponseCfgListLink {
+	return &PriceResponseCfgListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of p, or "" if p or one of its bases is nil.
+func (p *PriceResponseCfgListLink) GetHref() string {
+	if p == nil {
+		return ""
+	}
+	return p.ListLink.GetHref()
+}
+
+// GetAll returns the All of p, or 0 if p or one of its bases is nil.
+func (p *PriceResponseCfgListLink) GetAll() uint32 {
+	if p == nil {
+		return 0
+	}
+	return p.ListLink.GetAll()
+}
+
+// NewProjectionReadingListLink returns a ProjectionReadingListLink with its chain of base types allocated.
+func NewProjectionReadingListLink() *ProjectionReadingListLink {
+	return &ProjectionReadingListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of p, or "" if p or one of its bases is nil.
+func (p *ProjectionReadingListLink) GetHref() string {
+	if p == nil {
+		return ""
+	}
+	return p.ListLink.GetHref()
+}
+
+// GetAll returns the All of p, or 0 if p or one of its bases is nil.
+func (p *ProjectionReadingListLink) GetAll() uint32 {
+	if p == nil {
+		return 0
+	}
+	return p.ListLink.GetAll()
+}
+
+// NewProxiedDeviceListLink returns a ProxiedDeviceListLink with its chain of base types allocated.
+func NewProxiedDeviceListLink() *ProxiedDeviceListLink {
+	return &ProxiedDeviceListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of p, or "" if p or one of its bases is nil.
+func (p *ProxiedDeviceListLink) GetHref() string {
+	if p == nil {
+		return ""
+	}
+	return p.ListLink.GetHref()
+}
+
+// GetAll returns the All of p, or 0 if p or one of its bases is nil.
+func (p *ProxiedDeviceListLink) GetAll() uint32 {
+	if p == nil {
+		return 0
+	}
+	return p.ListLink.GetAll()
+}
+
+// NewRateComponentLink returns a RateComponentLink with its chain of base types allocated.
+func NewRateComponentLink() *RateComponentLink {
+	return &RateComponentLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *RateComponentLink) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.Link.GetHref()
+}
+
+// NewRateComponentListLink returns a RateComponentListLink with its chain of base types allocated.
+func NewRateComponentListLink() *RateComponentListLink {
+	return &RateComponentListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *RateComponentListLink) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.ListLink.GetHref()
+}
+
+// GetAll returns the All of r, or 0 if r or one of its bases is nil.
+func (r *RateComponentListLink) GetAll() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.ListLink.GetAll()
+}
+
+// NewReadingLink returns a ReadingLink with its chain of base types allocated.
+func NewReadingLink() *ReadingLink {
+	return &ReadingLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *ReadingLink) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.Link.GetHref()
+}
+
+// NewReadingListLink returns a ReadingListLink with its chain of base types allocated.
+func NewReadingListLink() *ReadingListLink {
+	return &ReadingListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *ReadingListLink) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.ListLink.GetHref()
+}
+
+// GetAll returns the All of r, or 0 if r or one of its bases is nil.
+func (r *ReadingListLink) GetAll() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.ListLink.GetAll()
+}
+
+// NewReadingSetListLink returns a ReadingSetListLink with its chain of base types allocated.
+func NewReadingSetListLink() *ReadingSetListLink {
+	return &ReadingSetListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *ReadingSetListLink) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.ListLink.GetHref()
+}
+
+// GetAll returns the All of r, or 0 if r or one of its bases is nil.
+func (r *ReadingSetListLink) GetAll() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.ListLink.GetAll()
+}
+
+// NewReadingTypeLink returns a ReadingTypeLink with its chain of base types allocated.
+func NewReadingTypeLink() *ReadingTypeLink {
+	return &ReadingTypeLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *ReadingTypeLink) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.Link.GetHref()
+}
+
+// NewRegistrationLink returns a RegistrationLink with its chain of base types allocated.
+func NewRegistrationLink() *RegistrationLink {
+	return &RegistrationLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *RegistrationLink) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.Link.GetHref()
+}
+
+// NewResponseListLink returns a ResponseListLink with its chain of base types allocated.
+func NewResponseListLink() *ResponseListLink {
+	return &ResponseListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *ResponseListLink) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.ListLink.GetHref()
+}
+
+// GetAll returns the All of r, or 0 if r or one of its bases is nil.
+func (r *ResponseListLink) GetAll() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.ListLink.GetAll()
+}
+
+// NewResponseSetListLink returns a ResponseSetListLink with its chain of base types allocated.
+func NewResponseSetListLink() *ResponseSetListLink {
+	return &ResponseSetListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *ResponseSetListLink) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.ListLink.GetHref()
+}
+
+// GetAll returns the All of r, or 0 if r or one of its bases is nil.
+func (r *ResponseSetListLink) GetAll() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.ListLink.GetAll()
+}
+
+// NewRPLInstanceListLink returns a RPLInstanceListLink with its chain of base types allocated.
+func NewRPLInstanceListLink() *RPLInstanceListLink {
+	return &RPLInstanceListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *RPLInstanceListLink) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.ListLink.GetHref()
+}
+
+// GetAll returns the All of r, or 0 if r or one of its bases is nil.
+func (r *RPLInstanceListLink) GetAll() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.ListLink.GetAll()
+}
+
+// NewRPLSourceRoutesListLink returns a RPLSourceRoutesListLink with its chain of base types allocated.
+func NewRPLSourceRoutesListLink() *RPLSourceRoutesListLink {
+	return &RPLSourceRoutesListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *RPLSourceRoutesListLink) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.ListLink.GetHref()
+}
+
+// GetAll returns the All of r, or 0 if r or one of its bases is nil.
+func (r *RPLSourceRoutesListLink) GetAll() uint32 {
+	if r == nil {
+		return 0
+	}
+	return r.ListLink.GetAll()
+}
+
+// NewSelfDeviceLink returns a SelfDeviceLink with its chain of base types allocated.
+func NewSelfDeviceLink() *SelfDeviceLink {
+	return &SelfDeviceLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of s, or "" if s or one of its bases is nil.
+func (s *SelfDeviceLink) GetHref() string {
+	if s == nil {
+		return ""
+	}
+	return s.Link.GetHref()
+}
+
+// NewServiceSupplierLink returns a ServiceSupplierLink with its chain of base types allocated.
+func NewServiceSupplierLink() *ServiceSupplierLink {
+	return &ServiceSupplierLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of s, or "" if s or one of its bases is nil.
+func (s *ServiceSupplierLink) GetHref() string {
+	if s == nil {
+		return ""
+	}
+	return s.Link.GetHref()
+}
+
+// NewSubscriptionListLink returns a SubscriptionListLink with its chain of base types allocated.
+func NewSubscriptionListLink() *SubscriptionListLink {
+	return &SubscriptionListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of s, or "" if s or one of its bases is nil.
+func (s *SubscriptionListLink) GetHref() string {
+	if s == nil {
+		return ""
+	}
+	return s.ListLink.GetHref()
+}
+
+// GetAll returns the All of s, or 0 if s or one of its bases is nil.
+func (s *SubscriptionListLink) GetAll() uint32 {
+	if s == nil {
+		return 0
+	}
+	return s.ListLink.GetAll()
+}
+
+// NewSupplyInterruptionOverrideListLink returns a SupplyInterruptionOverrideListLink with its chain of base types allocated.
+func NewSupplyInterruptionOverrideListLink() *SupplyInterruptionOverrideListLink {
+	return &SupplyInterruptionOverrideListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of s, or "" if s or one of its bases is nil.
+func (s *SupplyInterruptionOverrideListLink) GetHref() string {
+	if s == nil {
+		return ""
+	}
+	return s.ListLink.GetHref()
+}
+
+// GetAll returns the All of s, or 0 if s or one of its bases is nil.
+func (s *SupplyInterruptionOverrideListLink) GetAll() uint32 {
+	if s == nil {
+		return 0
+	}
+	return s.ListLink.GetAll()
+}
+
+// NewSupportedLocaleListLink returns a SupportedLocaleListLink with its chain of base types allocated.
+func NewSupportedLocaleListLink() *SupportedLocaleListLink {
+	return &SupportedLocaleListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of s, or "" if s or one of its bases is nil.
+func (s *SupportedLocaleListLink) GetHref() string {
+	if s == nil {
+		return ""
+	}
+	return s.ListLink.GetHref()
+}
+
+// GetAll returns the All of s, or 0 if s or one of its bases is nil.
+func (s *SupportedLocaleListLink) GetAll() uint32 {
+	if s == nil {
+		return 0
+	}
+	return s.ListLink.GetAll()
+}
+
+// NewTargetReadingListLink returns a TargetReadingListLink with its chain of base types allocated.
+func NewTargetReadingListLink() *TargetReadingListLink {
+	return &TargetReadingListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of t, or "" if t or one of its bases is nil.
+func (t *TargetReadingListLink) GetHref() string {
+	if t == nil {
+		return ""
+	}
+	return t.ListLink.GetHref()
+}
+
+// GetAll returns the All of t, or 0 if t or one of its bases is nil.
+func (t *TargetReadingListLink) GetAll() uint32 {
+	if t == nil {
+		return 0
+	}
+	return t.ListLink.GetAll()
+}
+
+// NewTariffProfileLink returns a TariffProfileLink with its chain of base types allocated.
+func NewTariffProfileLink() *TariffProfileLink {
+	return &TariffProfileLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of t, or "" if t or one of its bases is nil.
+func (t *TariffProfileLink) GetHref() string {
+	if t == nil {
+		return ""
+	}
+	return t.Link.GetHref()
+}
+
+// NewTariffProfileListLink returns a TariffProfileListLink with its chain of base types allocated.
+func NewTariffProfileListLink() *TariffProfileListLink {
+	return &TariffProfileListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of t, or "" if t or one of its bases is nil.
+func (t *TariffProfileListLink) GetHref() string {
+	if t == nil {
+		return ""
+	}
+	return t.ListLink.GetHref()
+}
+
+// GetAll returns the All of t, or 0 if t or one of its bases is nil.
+func (t *TariffProfileListLink) GetAll() uint32 {
+	if t == nil {
+		return 0
+	}
+	return t.ListLink.GetAll()
+}
+
+// NewTextMessageListLink returns a TextMessageListLink with its chain of base types allocated.
+func NewTextMessageListLink() *TextMessageListLink {
+	return &TextMessageListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of t, or "" if t or one of its bases is nil.
+func (t *TextMessageListLink) GetHref() string {
+	if t == nil {
+		return ""
+	}
+	return t.ListLink.GetHref()
+}
+
+// GetAll returns the All of t, or 0 if t or one of its bases is nil.
+func (t *TextMessageListLink) GetAll() uint32 {
+	if t == nil {
+		return 0
+	}
+	return t.ListLink.GetAll()
+}
+
+// NewTimeLink returns a TimeLink with its chain of base types allocated.
+func NewTimeLink() *TimeLink {
+	return &TimeLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of t, or "" if t or one of its bases is nil.
+func (t *TimeLink) GetHref() string {
+	if t == nil {
+		return ""
+	}
+	return t.Link.GetHref()
+}
+
+// NewTimeTariffIntervalListLink returns a TimeTariffIntervalListLink with its chain of base types allocated.
+func NewTimeTariffIntervalListLink() *TimeTariffIntervalListLink {
+	return &TimeTariffIntervalListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of t, or "" if t or one of its bases is nil.
+func (t *TimeTariffIntervalListLink) GetHref() string {
+	if t == nil {
+		return ""
+	}
+	return t.ListLink.GetHref()
+}
+
+// GetAll returns the All of t, or 0 if t or one of its bases is nil.
+func (t *TimeTariffIntervalListLink) GetAll() uint32 {
+	if t == nil {
+		return 0
+	}
+	return t.ListLink.GetAll()
+}
+
+// NewUsagePointLink returns an UsagePointLink with its chain of base types allocated.
+func NewUsagePointLink() *UsagePointLink {
+	return &UsagePointLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of u, or "" if u or one of its bases is nil.
+func (u *UsagePointLink) GetHref() string {
+	if u == nil {
+		return ""
+	}
+	return u.Link.GetHref()
+}
+
+// NewUsagePointListLink returns an UsagePointListLink with its chain of base types allocated.
+func NewUsagePointListLink() *UsagePointListLink {
+	return &UsagePointListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of u, or "" if u or one of its bases is nil.
+func (u *UsagePointListLink) GetHref() string {
+	if u == nil {
+		return ""
+	}
+	return u.ListLink.GetHref()
+}
+
+// GetAll returns the All of u, or 0 if u or one of its bases is nil.
+func (u *UsagePointListLink) GetAll() uint32 {
+	if u == nil {
+		return 0
+	}
+	return u.ListLink.GetAll()
+}
+
+// NewActiveBillingPeriodListLink returns an ActiveBillingPeriodListLink with its chain of base types allocated.
+func NewActiveBillingPeriodListLink() *ActiveBillingPeriodListLink {
+	return &ActiveBillingPeriodListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *ActiveBillingPeriodListLink) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.ListLink.GetHref()
+}
+
+// GetAll returns the All of a, or 0 if a or one of its bases is nil.
+func (a *ActiveBillingPeriodListLink) GetAll() uint32 {
+	if a == nil {
+		return 0
+	}
+	return a.ListLink.GetAll()
+}
+
+// NewActiveCreditRegisterListLink returns an ActiveCreditRegisterListLink with its chain of base types allocated.
+func NewActiveCreditRegisterListLink() *ActiveCreditRegisterListLink {
+	return &ActiveCreditRegisterListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *ActiveCreditRegisterListLink) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.ListLink.GetHref()
+}
+
+// GetAll returns the All of a, or 0 if a or one of its bases is nil.
+func (a *ActiveCreditRegisterListLink) GetAll() uint32 {
+	if a == nil {
+		return 0
+	}
+	return a.ListLink.GetAll()
+}
+
+// NewActiveDERControlListLink returns an ActiveDERControlListLink with its chain of base types allocated.
+func NewActiveDERControlListLink() *ActiveDERControlListLink {
+	return &ActiveDERControlListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *ActiveDERControlListLink) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.ListLink.GetHref()
+}
+
+// GetAll returns the All of a, or 0 if a or one of its bases is nil.
+func (a *ActiveDERControlListLink) GetAll() uint32 {
+	if a == nil {
+		return 0
+	}
+	return a.ListLink.GetAll()
+}
+
+// NewActiveEndDeviceControlListLink returns an ActiveEndDeviceControlListLink with its chain of base types allocated.
+func NewActiveEndDeviceControlListLink() *ActiveEndDeviceControlListLink {
+	return &ActiveEndDeviceControlListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *ActiveEndDeviceControlListLink) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.ListLink.GetHref()
+}
+
+// GetAll returns the All of a, or 0 if a or one of its bases is nil.
+func (a *ActiveEndDeviceControlListLink) GetAll() uint32 {
+	if a == nil {
+		return 0
+	}
+	return a.ListLink.GetAll()
+}
+
+// NewActiveFlowReservationListLink returns an ActiveFlowReservationListLink with its chain of base types allocated.
+func NewActiveFlowReservationListLink() *ActiveFlowReservationListLink {
+	return &ActiveFlowReservationListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *ActiveFlowReservationListLink) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.ListLink.GetHref()
+}
+
+// GetAll returns the All of a, or 0 if a or one of its bases is nil.
+func (a *ActiveFlowReservationListLink) GetAll() uint32 {
+	if a == nil {
+		return 0
+	}
+	return a.ListLink.GetAll()
+}
+
+// NewActiveProjectionReadingListLink returns an ActiveProjectionReadingListLink with its chain of base types allocated.
+func NewActiveProjectionReadingListLink() *ActiveProjectionReadingListLink {
+	return &ActiveProjectionReadingListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *ActiveProjectionReadingListLink) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.ListLink.GetHref()
+}
+
+// GetAll returns the All of a, or 0 if a or one of its bases is nil.
+func (a *ActiveProjectionReadingListLink) GetAll() uint32 {
+	if a == nil {
+		return 0
+	}
+	return a.ListLink.GetAll()
+}
+
+// NewActiveSupplyInterruptionOverrideListLink returns an ActiveSupplyInterruptionOverrideListLink with its chain of base types allocated.
+func NewActiveSupplyInterruptionOverrideListLink() *ActiveSupplyInterruptionOverrideListLink {
+	return &ActiveSupplyInterruptionOverrideListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *ActiveSupplyInterruptionOverrideListLink) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.ListLink.GetHref()
+}
+
+// GetAll returns the All of a, or 0 if a or one of its bases is nil.
+func (a *ActiveSupplyInterruptionOverrideListLink) GetAll() uint32 {
+	if a == nil {
+		return 0
+	}
+	return a.ListLink.GetAll()
+}
+
+// NewActiveTargetReadingListLink returns an ActiveTargetReadingListLink with its chain of base types allocated.
+func NewActiveTargetReadingListLink() *ActiveTargetReadingListLink {
+	return &ActiveTargetReadingListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *ActiveTargetReadingListLink) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.ListLink.GetHref()
+}
+
+// GetAll returns the All of a, or 0 if a or one of its bases is nil.
+func (a *ActiveTargetReadingListLink) GetAll() uint32 {
+	if a == nil {
+		return 0
+	}
+	return a.ListLink.GetAll()
+}
+
+// NewActiveTextMessageListLink returns an ActiveTextMessageListLink with its chain of base types allocated.
+func NewActiveTextMessageListLink() *ActiveTextMessageListLink {
+	return &ActiveTextMessageListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *ActiveTextMessageListLink) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.ListLink.GetHref()
+}
+
+// GetAll returns the All of a, or 0 if a or one of its bases is nil.
+func (a *ActiveTextMessageListLink) GetAll() uint32 {
+	if a == nil {
+		return 0
+	}
+	return a.ListLink.GetAll()
+}
+
+// NewActiveTimeTariffIntervalListLink returns an ActiveTimeTariffIntervalListLink with its chain of base types allocated.
+func NewActiveTimeTariffIntervalListLink() *ActiveTimeTariffIntervalListLink {
+	return &ActiveTimeTariffIntervalListLink{ListLink: NewListLink()}
+}
+
+// GetHref returns the Href of a, or "" if a or one of its bases is nil.
+func (a *ActiveTimeTariffIntervalListLink) GetHref() string {
+	if a == nil {
+		return ""
+	}
+	return a.ListLink.GetHref()
+}
+
+// GetAll returns the All of a, or 0 if a or one of its bases is nil.
+func (a *ActiveTimeTariffIntervalListLink) GetAll() uint32 {
+	if a == nil {
+		return 0
+	}
+	return a.ListLink.GetAll()
+}
+
+// NewIdentifiedObject returns an IdentifiedObject with its chain of base types allocated.
+func NewIdentifiedObject() *IdentifiedObject {
+	return &IdentifiedObject{Resource: NewResource()}
+}
+
+// GetHref returns the Href of i, or "" if i or one of its bases is nil.
+func (i *IdentifiedObject) GetHref() string {
+	if i == nil {
+		return ""
+	}
+	return i.Resource.GetHref()
+}
+
+// GetMRID returns i.MRID, or nil if i is nil.
+func (i *IdentifiedObject) GetMRID() *MRIDType {
+	if i == nil {
+		return nil
+	}
+	return i.MRID
+}
+
+// GetDescription returns i.Description, or nil if i is nil.
+func (i *IdentifiedObject) GetDescription() *string {
+	if i == nil {
+		return nil
+	}
+	return i.Description
+}
+
+// GetVersion returns i.Version, or nil if i is nil.
+func (i *IdentifiedObject) GetVersion() *VersionType {
+	if i == nil {
+		return nil
+	}
+	return i.Version
+}
+
+// NewLink returns a new Link.
+func NewLink() *Link {
+	return &Link{}
+}
+
+// GetHref returns l.HrefAttr, or "" if l is nil.
+func (l *Link) GetHref() string {
+	if l == nil {
+		return ""
+	}
+	return l.HrefAttr
+}
+
+// NewList returns a List with its chain of base types allocated.
+func NewList() *List {
+	return &List{Resource: NewResource()}
+}
+
+// GetHref returns the Href of l, or "" if l or one of its bases is nil.
+func (l *List) GetHref() string {
+	if l == nil {
+		return ""
+	}
+	return l.Resource.GetHref()
+}
+
+// GetAll returns l.AllAttr, or 0 if l is nil.
+func (l *List) GetAll() uint32 {
+	if l == nil {
+		return 0
+	}
+	return l.AllAttr
+}
+
+// GetResults returns l.ResultsAttr, or 0 if l is nil.
+func (l *List) GetResults() uint32 {
+	if l == nil {
+		return 0
+	}
+	return l.ResultsAttr
+}
+
+// NewListLink returns a ListLink with its chain of base types allocated.
+func NewListLink() *ListLink {
+	return &ListLink{Link: NewLink()}
+}
+
+// GetHref returns the Href of l, or "" if l or one of its bases is nil.
+func (l *ListLink) GetHref() string {
+	if l == nil {
+		return ""
+	}
+	return l.Link.GetHref()
+}
+
+// GetAll returns l.AllAttr, or 0 if l is nil.
+func (l *ListLink) GetAll() uint32 {
+	if l == nil {
+		return 0
+	}
+	return l.AllAttr
+}
+
+// NewResource returns a new Resource.
+func NewResource() *Resource {
+	return &Resource{}
+}
+
+// GetHref returns r.HrefAttr, or "" if r is nil.
+func (r *Resource) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.HrefAttr
+}
+
+// NewRespondableIdentifiedObject returns a RespondableIdentifiedObject with its chain of base types allocated.
+func NewRespondableIdentifiedObject() *RespondableIdentifiedObject {
+	return &RespondableIdentifiedObject{RespondableResource: NewRespondableResource()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *RespondableIdentifiedObject) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.RespondableResource.GetHref()
+}
+
+// GetReplyTo returns the ReplyTo of r, or "" if r or one of its bases is nil.
+func (r *RespondableIdentifiedObject) GetReplyTo() string {
+	if r == nil {
+		return ""
+	}
+	return r.RespondableResource.GetReplyTo()
+}
+
+// GetResponseRequired returns the ResponseRequired of r, or "" if r or one of its bases is nil.
+func (r *RespondableIdentifiedObject) GetResponseRequired() string {
+	if r == nil {
+		return ""
+	}
+	return r.RespondableResource.GetResponseRequired()
+}
+
+// NewRespondableResource returns a RespondableResource with its chain of base types allocated.
+func NewRespondableResource() *RespondableResource {
+	return &RespondableResource{Resource: NewResource()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *RespondableResource) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.Resource.GetHref()
+}
+
+// GetReplyTo returns r.ReplyToAttr, or "" if r is nil.
+func (r *RespondableResource) GetReplyTo() string {
+	if r == nil {
+		return ""
+	}
+	return r.ReplyToAttr
+}
+
+// GetResponseRequired returns r.ResponseRequiredAttr, or "" if r is nil.
+func (r *RespondableResource) GetResponseRequired() string {
+	if r == nil {
+		return ""
+	}
+	return r.ResponseRequiredAttr
+}
+
+// NewRespondableSubscribableIdentifiedObject returns a RespondableSubscribableIdentifiedObject with its chain of base types allocated.
+func NewRespondableSubscribableIdentifiedObject() *RespondableSubscribableIdentifiedObject {
+	return &RespondableSubscribableIdentifiedObject{RespondableResource: NewRespondableResource()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *RespondableSubscribableIdentifiedObject) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.RespondableResource.GetHref()
+}
+
+// GetReplyTo returns the ReplyTo of r, or "" if r or one of its bases is nil.
+func (r *RespondableSubscribableIdentifiedObject) GetReplyTo() string {
+	if r == nil {
+		return ""
+	}
+	return r.RespondableResource.GetReplyTo()
+}
+
+// GetResponseRequired returns the ResponseRequired of r, or "" if r or one of its bases is nil.
+func (r *RespondableSubscribableIdentifiedObject) GetResponseRequired() string {
+	if r == nil {
+		return ""
+	}
+	return r.RespondableResource.GetResponseRequired()
+}
+
+// GetSubscribable returns r.SubscribableAttr, or nil if r is nil.
+func (r *RespondableSubscribableIdentifiedObject) GetSubscribable() *UInt8 {
+	if r == nil {
+		return nil
+	}
+	return r.SubscribableAttr
+}
+
+// GetMRID returns r.MRID, or nil if r is nil.
+func (r *RespondableSubscribableIdentifiedObject) GetMRID() *MRIDType {
+	if r == nil {
+		return nil
+	}
+	return r.MRID
+}
+
+// GetDescription returns r.Description, or nil if r is nil.
+func (r *RespondableSubscribableIdentifiedObject) GetDescription() *string {
+	if r == nil {
+		return nil
+	}
+	return r.Description
+}
+
+// GetVersion returns r.Version, or nil if r is nil.
+func (r *RespondableSubscribableIdentifiedObject) GetVersion() *VersionType {
+	if r == nil {
+		return nil
+	}
+	return r.Version
+}
+
+// NewSubscribableIdentifiedObject returns a SubscribableIdentifiedObject with its chain of base types allocated.
+func NewSubscribableIdentifiedObject() *SubscribableIdentifiedObject {
+	return &SubscribableIdentifiedObject{SubscribableResource: NewSubscribableResource()}
+}
+
+// GetHref returns the Href of s, or "" if s or one of its bases is nil.
+func (s *SubscribableIdentifiedObject) GetHref() string {
+	if s == nil {
+		return ""
+	}
+	return s.SubscribableResource.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of s, or nil if s or one of its bases is nil.
+func (s *SubscribableIdentifiedObject) GetSubscribable() *UInt8 {
+	if s == nil {
+		return nil
+	}
+	return s.SubscribableResource.GetSubscribable()
+}
+
+// GetMRID returns s.MRID, or nil if s is nil.
+func (s *SubscribableIdentifiedObject) GetMRID() *MRIDType {
+	if s == nil {
+		return nil
+	}
+	return s.MRID
+}
+
+// GetDescription returns s.Description, or nil if s is nil.
+func (s *SubscribableIdentifiedObject) GetDescription() *string {
+	if s == nil {
+		return nil
+	}
+	return s.Description
+}
+
+// GetVersion returns s.Version, or nil if s is nil.
+func (s *SubscribableIdentifiedObject) GetVersion() *VersionType {
+	if s == nil {
+		return nil
+	}
+	return s.Version
+}
+
+// NewSubscribableList returns a SubscribableList with its chain of base types allocated.
+func NewSubscribableList() *SubscribableList {
+	return &SubscribableList{SubscribableResource: NewSubscribableResource()}
+}
+
+// GetHref returns the Href of s, or "" if s or one of its bases is nil.
+func (s *SubscribableList) GetHref() string {
+	if s == nil {
+		return ""
+	}
+	return s.SubscribableResource.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of s, or nil if s or one of its bases is nil.
+func (s *SubscribableList) GetSubscribable() *UInt8 {
+	if s == nil {
+		return nil
+	}
+	return s.SubscribableResource.GetSubscribable()
+}
+
+// GetAll returns s.AllAttr, or 0 if s is nil.
+func (s *SubscribableList) GetAll() uint32 {
+	if s == nil {
+		return 0
+	}
+	return s.AllAttr
+}
+
+// GetResults returns s.ResultsAttr, or 0 if s is nil.
+func (s *SubscribableList) GetResults() uint32 {
+	if s == nil {
+		return 0
+	}
+	return s.ResultsAttr
+}
+
+// NewSubscribableResource returns a SubscribableResource with its chain of base types allocated.
+func NewSubscribableResource() *SubscribableResource {
+	return &SubscribableResource{Resource: NewResource()}
+}
+
+// GetHref returns the Href of s, or "" if s or one of its bases is nil.
+func (s *SubscribableResource) GetHref() string {
+	if s == nil {
+		return ""
+	}
+	return s.Resource.GetHref()
+}
+
+// GetSubscribable returns s.SubscribableAttr, or nil if s is nil.
+func (s *SubscribableResource) GetSubscribable() *UInt8 {
+	if s == nil {
+		return nil
+	}
+	return s.SubscribableAttr
+}
+
+// NewError returns a new Error.
+func NewError() *Error {
+	return &Error{}
+}
+
+// NewEvent returns an Event with its chain of base types allocated.
+func NewEvent() *Event {
+	return &Event{RespondableSubscribableIdentifiedObject: NewRespondableSubscribableIdentifiedObject()}
+}
+
+// GetHref returns the Href of e, or "" if e or one of its bases is nil.
+func (e *Event) GetHref() string {
+	if e == nil {
+		return ""
+	}
+	return e.RespondableSubscribableIdentifiedObject.GetHref()
+}
+
+// GetReplyTo returns the ReplyTo of e, or "" if e or one of its bases is nil.
+func (e *Event) GetReplyTo() string {
+	if e == nil {
+		return ""
+	}
+	return e.RespondableSubscribableIdentifiedObject.GetReplyTo()
+}
+
+// GetResponseRequired returns the ResponseRequired of e, or "" if e or one of its bases is nil.
+func (e *Event) GetResponseRequired() string {
+	if e == nil {
+		return ""
+	}
+	return e.RespondableSubscribableIdentifiedObject.GetResponseRequired()
+}
+
+// GetSubscribable returns the Subscribable of e, or nil if e or one of its bases is nil.
+func (e *Event) GetSubscribable() *UInt8 {
+	if e == nil {
+		return nil
+	}
+	return e.RespondableSubscribableIdentifiedObject.GetSubscribable()
+}
+
+// GetMRID returns the MRID of e, or nil if e or one of its bases is nil.
+func (e *Event) GetMRID() *MRIDType {
+	if e == nil {
+		return nil
+	}
+	return e.RespondableSubscribableIdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of e, or nil if e or one of its bases is nil.
+func (e *Event) GetDescription() *string {
+	if e == nil {
+		return nil
+	}
+	return e.RespondableSubscribableIdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of e, or nil if e or one of its bases is nil.
+func (e *Event) GetVersion() *VersionType {
+	if e == nil {
+		return nil
+	}
+	return e.RespondableSubscribableIdentifiedObject.GetVersion()
+}
+
+// GetCreationTime returns e.CreationTime, or nil if e is nil.
+func (e *Event) GetCreationTime() *TimeType {
+	if e == nil {
+		return nil
+	}
+	return e.CreationTime
+}
+
+// GetEventStatus returns e.EventStatus, or nil if e is nil.
+func (e *Event) GetEventStatus() *EventStatus {
+	if e == nil {
+		return nil
+	}
+	return e.EventStatus
+}
+
+// GetInterval returns e.Interval, or nil if e is nil.
+func (e *Event) GetInterval() *DateTimeInterval {
+	if e == nil {
+		return nil
+	}
+	return e.Interval
+}
+
+// NewEventStatus returns a new EventStatus.
+func NewEventStatus() *EventStatus {
+	return &EventStatus{}
+}
+
+// NewRandomizableEvent returns a RandomizableEvent with its chain of base types allocated.
+func NewRandomizableEvent() *RandomizableEvent {
+	return &RandomizableEvent{Event: NewEvent()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *RandomizableEvent) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.Event.GetHref()
+}
+
+// GetReplyTo returns the ReplyTo of r, or "" if r or one of its bases is nil.
+func (r *RandomizableEvent) GetReplyTo() string {
+	if r == nil {
+		return ""
+	}
+	return r.Event.GetReplyTo()
+}
+
+// GetResponseRequired returns the ResponseRequired of r, or "" if r or one of its bases is nil.
+func (r *RandomizableEvent) GetResponseRequired() string {
+	if r == nil {
+		return ""
+	}
+	return r.Event.GetResponseRequired()
+}
+
+// GetSubscribable returns the Subscribable of r, or nil if r or one of its bases is nil.
+func (r *RandomizableEvent) GetSubscribable() *UInt8 {
+	if r == nil {
+		return nil
+	}
+	return r.Event.GetSubscribable()
+}
+
+// GetMRID returns the MRID of r, or nil if r or one of its bases is nil.
+func (r *RandomizableEvent) GetMRID() *MRIDType {
+	if r == nil {
+		return nil
+	}
+	return r.Event.GetMRID()
+}
+
+// GetDescription returns the Description of r, or nil if r or one of its bases is nil.
+func (r *RandomizableEvent) GetDescription() *string {
+	if r == nil {
+		return nil
+	}
+	return r.Event.GetDescription()
+}
+
+// GetVersion returns the Version of r, or nil if r or one of its bases is nil.
+func (r *RandomizableEvent) GetVersion() *VersionType {
+	if r == nil {
+		return nil
+	}
+	return r.Event.GetVersion()
+}
+
+// GetCreationTime returns the CreationTime of r, or nil if r or one of its bases is nil.
+func (r *RandomizableEvent) GetCreationTime() *TimeType {
+	if r == nil {
+		return nil
+	}
+	return r.Event.GetCreationTime()
+}
+
+// GetEventStatus returns the EventStatus of r, or nil if r or one of its bases is nil.
+func (r *RandomizableEvent) GetEventStatus() *EventStatus {
+	if r == nil {
+		return nil
+	}
+	return r.Event.GetEventStatus()
+}
+
+// GetInterval returns the Interval of r, or nil if r or one of its bases is nil.
+func (r *RandomizableEvent) GetInterval() *DateTimeInterval {
+	if r == nil {
+		return nil
+	}
+	return r.Event.GetInterval()
+}
+
+// GetRandomizeDuration returns r.RandomizeDuration, or nil if r is nil.
+func (r *RandomizableEvent) GetRandomizeDuration() *OneHourRangeType {
+	if r == nil {
+		return nil
+	}
+	return r.RandomizeDuration
+}
+
+// GetRandomizeStart returns r.RandomizeStart, or nil if r is nil.
+func (r *RandomizableEvent) GetRandomizeStart() *OneHourRangeType {
+	if r == nil {
+		return nil
+	}
+	return r.RandomizeStart
+}
+
+// NewDateTimeInterval returns a new DateTimeInterval.
+func NewDateTimeInterval() *DateTimeInterval {
+	return &DateTimeInterval{}
+}
+
+// NewGeographicLocationType returns a new GeographicLocationType.
+func NewGeographicLocationType() *GeographicLocationType {
+	return &GeographicLocationType{}
+}
+
+// NewGPSLocationType returns a new GPSLocationType.
+func NewGPSLocationType() *GPSLocationType {
+	return &GPSLocationType{}
+}
+
+// NewRealEnergy returns a new RealEnergy.
+func NewRealEnergy() *RealEnergy {
+	return &RealEnergy{}
+}
+
+// NewSignedRealEnergy returns a new SignedRealEnergy.
+func NewSignedRealEnergy() *SignedRealEnergy {
+	return &SignedRealEnergy{}
+}
+
+// NewUnitValueType returns a new UnitValueType.
+func NewUnitValueType() *UnitValueType {
+	return &UnitValueType{}
+}
+
+// NewMirrorMeterReading returns a MirrorMeterReading with its chain of base types allocated.
+func NewMirrorMeterReading() *MirrorMeterReading {
+	return &MirrorMeterReading{MeterReadingBase: NewMeterReadingBase()}
+}
+
+// GetHref returns the Href of m, or "" if m or one of its bases is nil.
+func (m *MirrorMeterReading) GetHref() string {
+	if m == nil {
+		return ""
+	}
+	return m.MeterReadingBase.GetHref()
+}
+
+// GetMRID returns the MRID of m, or nil if m or one of its bases is nil.
+func (m *MirrorMeterReading) GetMRID() *MRIDType {
+	if m == nil {
+		return nil
+	}
+	return m.MeterReadingBase.GetMRID()
+}
+
+// GetDescription returns the Description of m, or nil if m or one of its bases is nil.
+func (m *MirrorMeterReading) GetDescription() *string {
+	if m == nil {
+		return nil
+	}
+	return m.MeterReadingBase.GetDescription()
+}
+
+// GetVersion returns the Version of m, or nil if m or one of its bases is nil.
+func (m *MirrorMeterReading) GetVersion() *VersionType {
+	if m == nil {
+		return nil
+	}
+	return m.MeterReadingBase.GetVersion()
+}
+
+// NewMirrorMeterReadingList returns a MirrorMeterReadingList with its chain of base types allocated.
+func NewMirrorMeterReadingList() *MirrorMeterReadingList {
+	return &MirrorMeterReadingList{List: NewList()}
+}
+
+// GetHref returns the Href of m, or "" if m or one of its bases is nil.
+func (m *MirrorMeterReadingList) GetHref() string {
+	if m == nil {
+		return ""
+	}
+	return m.List.GetHref()
+}
+
+// GetAll returns the All of m, or 0 if m or one of its bases is nil.
+func (m *MirrorMeterReadingList) GetAll() uint32 {
+	if m == nil {
+		return 0
+	}
+	return m.List.GetAll()
+}
+
+// GetResults returns the Results of m, or 0 if m or one of its bases is nil.
+func (m *MirrorMeterReadingList) GetResults() uint32 {
+	if m == nil {
+		return 0
+	}
+	return m.List.GetResults()
+}
+
+// NewMeterReadingBase returns a MeterReadingBase with its chain of base types allocated.
+func NewMeterReadingBase() *MeterReadingBase {
+	return &MeterReadingBase{IdentifiedObject: NewIdentifiedObject()}
+}
+
+// GetHref returns the Href of m, or "" if m or one of its bases is nil.
+func (m *MeterReadingBase) GetHref() string {
+	if m == nil {
+		return ""
+	}
+	return m.IdentifiedObject.GetHref()
+}
+
+// GetMRID returns the MRID of m, or nil if m or one of its bases is nil.
+func (m *MeterReadingBase) GetMRID() *MRIDType {
+	if m == nil {
+		return nil
+	}
+	return m.IdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of m, or nil if m or one of its bases is nil.
+func (m *MeterReadingBase) GetDescription() *string {
+	if m == nil {
+		return nil
+	}
+	return m.IdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of m, or nil if m or one of its bases is nil.
+func (m *MeterReadingBase) GetVersion() *VersionType {
+	if m == nil {
+		return nil
+	}
+	return m.IdentifiedObject.GetVersion()
+}
+
+// NewMirrorReadingSet returns a MirrorReadingSet with its chain of base types allocated.
+func NewMirrorReadingSet() *MirrorReadingSet {
+	return &MirrorReadingSet{ReadingSetBase: NewReadingSetBase()}
+}
+
+// GetHref returns the Href of m, or "" if m or one of its bases is nil.
+func (m *MirrorReadingSet) GetHref() string {
+	if m == nil {
+		return ""
+	}
+	return m.ReadingSetBase.GetHref()
+}
+
+// GetMRID returns the MRID of m, or nil if m or one of its bases is nil.
+func (m *MirrorReadingSet) GetMRID() *MRIDType {
+	if m == nil {
+		return nil
+	}
+	return m.ReadingSetBase.GetMRID()
+}
+
+// GetDescription returns the Description of m, or nil if m or one of its bases is nil.
+func (m *MirrorReadingSet) GetDescription() *string {
+	if m == nil {
+		return nil
+	}
+	return m.ReadingSetBase.GetDescription()
+}
+
+// GetVersion returns the Version of m, or nil if m or one of its bases is nil.
+func (m *MirrorReadingSet) GetVersion() *VersionType {
+	if m == nil {
+		return nil
+	}
+	return m.ReadingSetBase.GetVersion()
+}
+
+// GetTimePeriod returns the TimePeriod of m, or nil if m or one of its bases is nil.
+func (m *MirrorReadingSet) GetTimePeriod() *DateTimeInterval {
+	if m == nil {
+		return nil
+	}
+	return m.ReadingSetBase.GetTimePeriod()
+}
+
+// NewMirrorUsagePoint returns a MirrorUsagePoint with its chain of base types allocated.
+func NewMirrorUsagePoint() *MirrorUsagePoint {
+	return &MirrorUsagePoint{UsagePointBase: NewUsagePointBase()}
+}
+
+// GetHref returns the Href of m, or "" if m or one of its bases is nil.
+func (m *MirrorUsagePoint) GetHref() string {
+	if m == nil {
+		return ""
+	}
+	return m.UsagePointBase.GetHref()
+}
+
+// GetMRID returns the MRID of m, or nil if m or one of its bases is nil.
+func (m *MirrorUsagePoint) GetMRID() *MRIDType {
+	if m == nil {
+		return nil
+	}
+	return m.UsagePointBase.GetMRID()
+}
+
+// GetDescription returns the Description of m, or nil if m or one of its bases is nil.
+func (m *MirrorUsagePoint) GetDescription() *string {
+	if m == nil {
+		return nil
+	}
+	return m.UsagePointBase.GetDescription()
+}
+
+// GetVersion returns the Version of m, or nil if m or one of its bases is nil.
+func (m *MirrorUsagePoint) GetVersion() *VersionType {
+	if m == nil {
+		return nil
+	}
+	return m.UsagePointBase.GetVersion()
+}
+
+// GetRoleFlags returns the RoleFlags of m, or nil if m or one of its bases is nil.
+func (m *MirrorUsagePoint) GetRoleFlags() *RoleFlagsType {
+	if m == nil {
+		return nil
+	}
+	return m.UsagePointBase.GetRoleFlags()
+}
+
+// GetServiceCategoryKind returns the ServiceCategoryKind of m, or nil if m or one of its bases is nil.
+func (m *MirrorUsagePoint) GetServiceCategoryKind() *ServiceKind {
+	if m == nil {
+		return nil
+	}
+	return m.UsagePointBase.GetServiceCategoryKind()
+}
+
+// GetStatus returns the Status of m, or 0 if m or one of its bases is nil.
+func (m *MirrorUsagePoint) GetStatus() uint8 {
+	if m == nil {
+		return 0
+	}
+	return m.UsagePointBase.GetStatus()
+}
+
+// NewMirrorUsagePointList returns a MirrorUsagePointList with its chain of base types allocated.
+func NewMirrorUsagePointList() *MirrorUsagePointList {
+	return &MirrorUsagePointList{SubscribableList: NewSubscribableList()}
+}
+
+// GetHref returns the Href of m, or "" if m or one of its bases is nil.
+func (m *MirrorUsagePointList) GetHref() string {
+	if m == nil {
+		return ""
+	}
+	return m.SubscribableList.GetHref()
+}
+
+// GetSubscribable returns the Subscribable of m, or nil if m or one of its bases is nil.
+func (m *MirrorUsagePointList) GetSubscribable() *UInt8 {
+	if m == nil {
+		return nil
+	}
+	return m.SubscribableList.GetSubscribable()
+}
+
+// GetAll returns the All of m, or 0 if m or one of its bases is nil.
+func (m *MirrorUsagePointList) GetAll() uint32 {
+	if m == nil {
+		return 0
+	}
+	return m.SubscribableList.GetAll()
+}
+
+// GetResults returns the Results of m, or 0 if m or one of its bases is nil.
+func (m *MirrorUsagePointList) GetResults() uint32 {
+	if m == nil {
+		return 0
+	}
+	return m.SubscribableList.GetResults()
+}
+
+// NewReadingBase returns a ReadingBase with its chain of base types allocated.
+func NewReadingBase() *ReadingBase {
+	return &ReadingBase{Resource: NewResource()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *ReadingBase) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.Resource.GetHref()
+}
+
+// GetConsumptionBlock returns r.ConsumptionBlock, or nil if r is nil.
+func (r *ReadingBase) GetConsumptionBlock() *ConsumptionBlockType {
+	if r == nil {
+		return nil
+	}
+	return r.ConsumptionBlock
+}
+
+// GetQualityFlags returns r.QualityFlags, or nil if r is nil.
+func (r *ReadingBase) GetQualityFlags() *string {
+	if r == nil {
+		return nil
+	}
+	return r.QualityFlags
+}
+
+// GetTimePeriod returns r.TimePeriod, or nil if r is nil.
+func (r *ReadingBase) GetTimePeriod() *DateTimeInterval {
+	if r == nil {
+		return nil
+	}
+	return r.TimePeriod
+}
+
+// GetTouTier returns r.TouTier, or nil if r is nil.
+func (r *ReadingBase) GetTouTier() *TOUType {
+	if r == nil {
+		return nil
+	}
+	return r.TouTier
+}
+
+// GetValue returns r.Value, or nil if r is nil.
+func (r *ReadingBase) GetValue() *int64 {
+	if r == nil {
+		return nil
+	}
+	return r.Value
+}
+
+// NewReadingSetBase returns a ReadingSetBase with its chain of base types allocated.
+func NewReadingSetBase() *ReadingSetBase {
+	return &ReadingSetBase{IdentifiedObject: NewIdentifiedObject()}
+}
+
+// GetHref returns the Href of r, or "" if r or one of its bases is nil.
+func (r *ReadingSetBase) GetHref() string {
+	if r == nil {
+		return ""
+	}
+	return r.IdentifiedObject.GetHref()
+}
+
+// GetMRID returns the MRID of r, or nil if r or one of its bases is nil.
+func (r *ReadingSetBase) GetMRID() *MRIDType {
+	if r == nil {
+		return nil
+	}
+	return r.IdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of r, or nil if r or one of its bases is nil.
+func (r *ReadingSetBase) GetDescription() *string {
+	if r == nil {
+		return nil
+	}
+	return r.IdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of r, or nil if r or one of its bases is nil.
+func (r *ReadingSetBase) GetVersion() *VersionType {
+	if r == nil {
+		return nil
+	}
+	return r.IdentifiedObject.GetVersion()
+}
+
+// GetTimePeriod returns r.TimePeriod, or nil if r is nil.
+func (r *ReadingSetBase) GetTimePeriod() *DateTimeInterval {
+	if r == nil {
+		return nil
+	}
+	return r.TimePeriod
+}
+
+// NewUsagePointBase returns an UsagePointBase with its chain of base types allocated.
+func NewUsagePointBase() *UsagePointBase {
+	return &UsagePointBase{IdentifiedObject: NewIdentifiedObject()}
+}
+
+// GetHref returns the Href of u, or "" if u or one of its bases is nil.
+func (u *UsagePointBase) GetHref() string {
+	if u == nil {
+		return ""
+	}
+	return u.IdentifiedObject.GetHref()
+}
+
+// GetMRID returns the MRID of u, or nil if u or one of its bases is nil.
+func (u *UsagePointBase) GetMRID() *MRIDType {
+	if u == nil {
+		return nil
+	}
+	return u.IdentifiedObject.GetMRID()
+}
+
+// GetDescription returns the Description of u, or nil if u or one of its bases is nil.
+func (u *UsagePointBase) GetDescription() *string {
+	if u == nil {
+		return nil
+	}
+	return u.IdentifiedObject.GetDescription()
+}
+
+// GetVersion returns the Version of u, or nil if u or one of its bases is nil.
+func (u *UsagePointBase) GetVersion() *VersionType {
+	if u == nil {
+		return nil
+	}
+	return u.IdentifiedObject.GetVersion()
+}
+
+// GetRoleFlags returns u.RoleFlags, or nil if u is nil.
+func (u *UsagePointBase) GetRoleFlags() *RoleFlagsType {
+	if u == nil {
+		return nil
+	}
+	return u.RoleFlags
+}
+
+// GetServiceCategoryKind returns u.ServiceCategoryKind, or nil if u is nil.
+func (u *UsagePointBase) GetServiceCategoryKind() *ServiceKind {
+	if u == nil {
+		return nil
+	}
+	return u.ServiceCategoryKind
+}
+
+// GetStatus returns u.Status, or 0 if u is nil.
+func (u *UsagePointBase) GetStatus() uint8 {
+	if u == nil {
+		return 0
+	}
+	return u.Status
+}
+
+// NewRevision23Type returns a new Revision23Type.
+func NewRevision23Type() *Revision23Type {
+	return &Revision23Type{}
+}
@@ -0,0 +1,112 @@
+package sep
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBasesNil(t *testing.T) {
+	// A nil control and controls whose chain of bases stops at each level.
+	controls := map[string]*DERControl{
+		"nil":               nil,
+		"no bases":          {},
+		"RandomizableEvent": {RandomizableEvent: &RandomizableEvent{}},
+		"Event":             {RandomizableEvent: &RandomizableEvent{Event: &Event{}}},
+		"RespondableSubscribableIdentifiedObject": {RandomizableEvent: &RandomizableEvent{Event: &Event{
+			RespondableSubscribableIdentifiedObject: &RespondableSubscribableIdentifiedObject{},
+		}}},
+		"RespondableResource": {RandomizableEvent: &RandomizableEvent{Event: &Event{
+			RespondableSubscribableIdentifiedObject: &RespondableSubscribableIdentifiedObject{
+				RespondableResource: &RespondableResource{},
+			},
+		}}},
+	}
+	for name, c := range controls {
+		if c.GetHref() != "" || c.GetReplyTo() != "" || c.GetResponseRequired() != "" {
+			t.Errorf("%s: attributes are set", name)
+		}
+		if c.GetSubscribable() != nil || c.GetMRID() != nil || c.GetDescription() != nil || c.GetVersion() != nil {
+			t.Errorf("%s: identity is set", name)
+		}
+		if c.GetCreationTime() != nil || c.GetEventStatus() != nil || c.GetInterval() != nil {
+			t.Errorf("%s: event is set", name)
+		}
+		if c.GetRandomizeDuration() != nil || c.GetRandomizeStart() != nil {
+			t.Errorf("%s: randomization is set", name)
+		}
+	}
+
+	for name, l := range map[string]*DERControlList{
+		"nil":              nil,
+		"no bases":         {},
+		"SubscribableList": {SubscribableList: &SubscribableList{}},
+	} {
+		if l.GetHref() != "" || l.GetSubscribable() != nil || l.GetAll() != 0 || l.GetResults() != 0 {
+			t.Errorf("%s list: fields are set", name)
+		}
+	}
+}
+
+func TestBasesSet(t *testing.T) {
+	c := NewDERControl()
+	c.HrefAttr = "/derp/0/derc/1"
+	c.ReplyToAttr = "/rsps/0/rsp"
+	c.MRID = NewMRIDType("00000000000000000000000000000001")
+	c.Interval = &DateTimeInterval{Start: NewTimeType(1700000000), Duration: 60}
+	c.RandomizeStart = NewOneHourRangeType(30)
+	if c.GetHref() != "/derp/0/derc/1" || c.GetReplyTo() != "/rsps/0/rsp" {
+		t.Errorf("attributes = %q, %q", c.GetHref(), c.GetReplyTo())
+	}
+	if c.GetMRID() != c.MRID || c.GetInterval() != c.Interval || c.GetRandomizeStart() != c.RandomizeStart {
+		t.Error("accessors do not return the promoted fields")
+	}
+	// The accessors of a base see the same fields.
+	if c.RandomizableEvent.GetHref() != c.HrefAttr || c.Event.GetInterval() != c.Interval || c.Resource.GetHref() != c.HrefAttr {
+		t.Error("accessors of the bases differ")
+	}
+}
+
+func TestUnmarshalPopulatesBases(t *testing.T) {
+	const doc = `<DERControlList xmlns="urn:ieee:std:2030.5:ns" all="2" results="2">` +
+		`<DERControl><DERControlBase/></DERControl>` +
+		`<DERControl href="/derc/2"><mRID>00000000000000000000000000000002</mRID></DERControl>` +
+		`</DERControlList>`
+
+	var l, lossless DERControlList
+	if err := Unmarshal([]byte(doc), &l); err != nil {
+		t.Fatal(err)
+	}
+	if err := UnmarshalLossless([]byte(doc), &lossless); err != nil {
+		t.Fatal(err)
+	}
+	v, err := Decode(MediaTypeXML, strings.NewReader(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, l := range []*DERControlList{&l, &lossless, v.(*DERControlList)} {
+		if l.SubscribableList == nil || l.SubscribableResource == nil || l.Resource == nil {
+			t.Fatal("list bases are nil")
+		}
+		if len(l.DERControl) != 2 {
+			t.Fatalf("%d controls, want 2", len(l.DERControl))
+		}
+		for i, c := range l.DERControl {
+			if c.RandomizableEvent == nil || c.Event == nil || c.RespondableSubscribableIdentifiedObject == nil ||
+				c.RespondableResource == nil || c.Resource == nil {
+				t.Fatalf("control %d: bases are nil", i)
+			}
+		}
+		// Promoted fields are accessible without checks.
+		first, second := l.DERControl[0], l.DERControl[1]
+		if first.HrefAttr != "" || first.MRID != nil || first.Interval != nil {
+			t.Error("first control has fields")
+		}
+		if second.HrefAttr != "/derc/2" || second.MRID.Value() != "00000000000000000000000000000002" {
+			t.Errorf("second control = %q, %v", second.HrefAttr, second.MRID)
+		}
+		// Wrapped simple types and optional elements are not allocated.
+		if first.DERControlBase == nil || first.DERControlBase.OpModConnect != nil || first.DeviceCategory != nil {
+			t.Error("optional elements were allocated")
+		}
+	}
+}
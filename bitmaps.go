@@ -0,0 +1,630 @@
+package sep
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// The hexBinary bitmap types have named bit positions and methods to test,
+// set, clear and range over their bits. Bit 0 is the least significant bit
+// of the hex string. Reading a nil or unparsable bitmap yields no bits, and
+// setting bits rewrites the hex string at the full width of its type. The
+// methods that set bits need a bitmap to write to: they panic on a nil
+// receiver, so an absent bitmap is first made with its constructor, such as
+// NewDERControlModes.
+
+// parseBits returns the bits of the hex string h, which holds at most size
+// bits.
+func parseBits(h string, size int) (uint64, error) {
+	h = strings.TrimSpace(h)
+	if h == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(h, 16, size)
+}
+
+func hexBits[H ~string](h *H, size int) uint64 {
+	if h == nil {
+		return 0
+	}
+	v, _ := parseBits(string(*h), size)
+	return v
+}
+
+func formatBits(v uint64, size int) string {
+	return fmt.Sprintf("%0*X", size/4, v)
+}
+
+func setHexBits[H ~string](h **H, v uint64, size int) {
+	x := H(formatBits(v, size))
+	*h = &x
+}
+
+// bitsOf yields the positions of the bits set in v, from bit 0 up.
+func bitsOf[F ~uint8](v uint64) iter.Seq[F] {
+	return func(yield func(F) bool) {
+		for v != 0 {
+			b := bits.TrailingZeros64(v)
+			if !yield(F(b)) {
+				return
+			}
+			v &^= 1 << b
+		}
+	}
+}
+
+func bitName[F ~uint8](b F, names []string) string {
+	if int(b) < len(names) {
+		return names[b]
+	}
+	return "bit" + strconv.Itoa(int(b))
+}
+
+// joinBits returns the names of the bits set in v separated by "|".
+func joinBits[F interface {
+	~uint8
+	String() string
+}](v uint64) string {
+	var names []string
+	for b := range bitsOf[F](v) {
+		names = append(names, b.String())
+	}
+	return strings.Join(names, "|")
+}
+
+// DERControlMode is a bit position of DERControlType.
+type DERControlMode uint8
+
+// Bit positions of DERControlType.
+const (
+	ChargeMode DERControlMode = iota
+	DischargeMode
+	OpModConnect
+	OpModEnergize
+	OpModFixedPFAbsorbW
+	OpModFixedPFInjectW
+	OpModFixedVar
+	OpModFixedW
+	OpModFreqDroop
+	OpModFreqWatt
+	OpModHFRTMayTrip
+	OpModHFRTMustTrip
+	OpModHVRTMayTrip
+	OpModHVRTMomentaryCessation
+	OpModHVRTMustTrip
+	OpModLFRTMayTrip
+	OpModLFRTMustTrip
+	OpModLVRTMayTrip
+	OpModLVRTMomentaryCessation
+	OpModLVRTMustTrip
+	OpModMaxLimW
+	OpModTargetVar
+	OpModTargetW
+	OpModVoltVar
+	OpModVoltWatt
+	OpModWattPF
+	OpModWattVar
+	OpModDeltaVar
+	OpModDeltaW
+	OpModFixedV
+	OpModGridConnectPermit
+	OpModIslandPermit
+)
+
+var derControlModeNames = []string{
+	"chargeMode", "dischargeMode", "opModConnect", "opModEnergize",
+	"opModFixedPFAbsorbW", "opModFixedPFInjectW", "opModFixedVar", "opModFixedW",
+	"opModFreqDroop", "opModFreqWatt", "opModHFRTMayTrip", "opModHFRTMustTrip",
+	"opModHVRTMayTrip", "opModHVRTMomentaryCessation", "opModHVRTMustTrip",
+	"opModLFRTMayTrip", "opModLFRTMustTrip", "opModLVRTMayTrip",
+	"opModLVRTMomentaryCessation", "opModLVRTMustTrip", "opModMaxLimW",
+	"opModTargetVar", "opModTargetW", "opModVoltVar", "opModVoltWatt",
+	"opModWattPF", "opModWattVar", "opModDeltaVar", "opModDeltaW", "opModFixedV",
+	"opModGridConnectPermit", "opModIslandPermit",
+}
+
+// String returns the schema name of the mode m, such as "opModVoltVar".
+func (m DERControlMode) String() string { return bitName(m, derControlModeNames) }
+
+// NewDERControlModes returns a DERControlType with the bits of modes set.
+func NewDERControlModes(modes ...DERControlMode) *DERControlType {
+	d := new(DERControlType)
+	var v uint32
+	for _, m := range modes {
+		v |= 1 << m
+	}
+	d.SetBits(v)
+	return d
+}
+
+// Bits returns the bitmap held by d.
+func (d *DERControlType) Bits() uint32 {
+	if d == nil {
+		return 0
+	}
+	return uint32(hexBits(d.HexBinary32, 32))
+}
+
+// SetBits sets the bitmap held by d to v. It panics if d is nil.
+func (d *DERControlType) SetBits(v uint32) { setHexBits(&d.HexBinary32, uint64(v), 32) }
+
+// Has reports whether the bit of mode m is set in d.
+func (d *DERControlType) Has(m DERControlMode) bool { return m < 32 && d.Bits()&(1<<m) != 0 }
+
+// Set sets the bit of mode m in d. It panics if d is nil.
+func (d *DERControlType) Set(m DERControlMode) { d.SetBits(d.Bits() | 1<<m) }
+
+// Clear clears the bit of mode m in d. It panics if d is nil.
+func (d *DERControlType) Clear(m DERControlMode) { d.SetBits(d.Bits() &^ (1 << m)) }
+
+// All yields the modes whose bits are set in d, in bit order.
+func (d *DERControlType) All() iter.Seq[DERControlMode] {
+	return bitsOf[DERControlMode](uint64(d.Bits()))
+}
+
+// String returns the names of the modes set in d, separated by "|".
+func (d *DERControlType) String() string { return joinBits[DERControlMode](uint64(d.Bits())) }
+
+// DERControlMode2 is a bit position of DERControlType2.
+type DERControlMode2 uint8
+
+// Bit positions of DERControlType2.
+const (
+	OpModMaxLimPctVAAbsorb DERControlMode2 = iota
+	OpModMaxLimPctVAInject
+	OpModMaxLimPctVarAbsorb
+	OpModMaxLimPctVarInject
+	OpModMaxLimPctWAbsorb
+	OpModMaxLimVarAbsorb
+	OpModMaxLimVarInject
+	OpModMaxLimWAbsorb
+	OpModMaxLimWInject
+	OpModTargetV
+)
+
+var derControlMode2Names = []string{
+	"opModMaxLimPctVAAbsorb", "opModMaxLimPctVAInject", "opModMaxLimPctVarAbsorb",
+	"opModMaxLimPctVarInject", "opModMaxLimPctWAbsorb", "opModMaxLimVarAbsorb",
+	"opModMaxLimVarInject", "opModMaxLimWAbsorb", "opModMaxLimWInject", "opModTargetV",
+}
+
+// String returns the schema name of the mode m, such as "opModTargetV".
+func (m DERControlMode2) String() string { return bitName(m, derControlMode2Names) }
+
+// NewDERControlModes2 returns a DERControlType2 with the bits of modes set.
+func NewDERControlModes2(modes ...DERControlMode2) *DERControlType2 {
+	d := new(DERControlType2)
+	var v uint32
+	for _, m := range modes {
+		v |= 1 << m
+	}
+	d.SetBits(v)
+	return d
+}
+
+// Bits returns the bitmap held by d.
+func (d *DERControlType2) Bits() uint32 {
+	if d == nil {
+		return 0
+	}
+	return uint32(hexBits(d.HexBinary32, 32))
+}
+
+// SetBits sets the bitmap held by d to v. It panics if d is nil.
+func (d *DERControlType2) SetBits(v uint32) { setHexBits(&d.HexBinary32, uint64(v), 32) }
+
+// Has reports whether the bit of mode m is set in d.
+func (d *DERControlType2) Has(m DERControlMode2) bool { return m < 32 && d.Bits()&(1<<m) != 0 }
+
+// Set sets the bit of mode m in d. It panics if d is nil.
+func (d *DERControlType2) Set(m DERControlMode2) { d.SetBits(d.Bits() | 1<<m) }
+
+// Clear clears the bit of mode m in d. It panics if d is nil.
+func (d *DERControlType2) Clear(m DERControlMode2) { d.SetBits(d.Bits() &^ (1 << m)) }
+
+// All yields the modes whose bits are set in d, in bit order.
+func (d *DERControlType2) All() iter.Seq[DERControlMode2] {
+	return bitsOf[DERControlMode2](uint64(d.Bits()))
+}
+
+// String returns the names of the modes set in d, separated by "|".
+func (d *DERControlType2) String() string { return joinBits[DERControlMode2](uint64(d.Bits())) }
+
+// DeviceCategory is a bit position of DeviceCategoryType.
+type DeviceCategory uint8
+
+// Bit positions of DeviceCategoryType.
+const (
+	CategoryThermostat DeviceCategory = iota
+	CategoryStripHeaters
+	CategoryBaseboardHeaters
+	CategoryWaterHeater
+	CategoryPoolPump
+	CategorySauna
+	CategoryHotTub
+	CategorySmartAppliance
+	CategoryIrrigationPump
+	CategoryManagedCILoads
+	CategorySimpleMiscLoads
+	CategoryExteriorLighting
+	CategoryInteriorLighting
+	CategoryLoadControlSwitch
+	CategoryEnergyManagementSystem
+	CategorySmartEnergyModule
+	CategoryElectricVehicle
+	CategoryEVSE
+	CategoryVirtualOrMixedDER
+	CategoryReciprocatingEngine
+	CategoryFuelCell
+	CategoryPhotovoltaicSystem
+	CategoryCombinedHeatAndPower
+	CategoryCombinedPVAndStorage
+	CategoryOtherGenerationSystem
+	CategoryOtherStorageSystem
+	CategoryMicrogridController
+)
+
+var deviceCategoryNames = []string{
+	"Thermostat", "StripHeaters", "BaseboardHeaters", "WaterHeater", "PoolPump",
+	"Sauna", "HotTub", "SmartAppliance", "IrrigationPump", "ManagedCILoads",
+	"SimpleMiscLoads", "ExteriorLighting", "InteriorLighting", "LoadControlSwitch",
+	"EnergyManagementSystem", "SmartEnergyModule", "ElectricVehicle", "EVSE",
+	"VirtualOrMixedDER", "ReciprocatingEngine", "FuelCell", "PhotovoltaicSystem",
+	"CombinedHeatAndPower", "CombinedPVAndStorage", "OtherGenerationSystem",
+	"OtherStorageSystem", "MicrogridController",
+}
+
+// String returns the name of the category c, such as "WaterHeater".
+func (c DeviceCategory) String() string { return bitName(c, deviceCategoryNames) }
+
+// NewDeviceCategories returns a DeviceCategoryType with the bits of categories set.
+func NewDeviceCategories(categories ...DeviceCategory) *DeviceCategoryType {
+	d := new(DeviceCategoryType)
+	var v uint32
+	for _, c := range categories {
+		v |= 1 << c
+	}
+	d.SetBits(v)
+	return d
+}
+
+// Bits returns the bitmap held by d.
+func (d *DeviceCategoryType) Bits() uint32 {
+	if d == nil {
+		return 0
+	}
+	return uint32(hexBits(d.HexBinary32, 32))
+}
+
+// SetBits sets the bitmap held by d to v. It panics if d is nil.
+func (d *DeviceCategoryType) SetBits(v uint32) { setHexBits(&d.HexBinary32, uint64(v), 32) }
+
+// Has reports whether the bit of category c is set in d.
+func (d *DeviceCategoryType) Has(c DeviceCategory) bool { return c < 32 && d.Bits()&(1<<c) != 0 }
+
+// Set sets the bit of category c in d. It panics if d is nil.
+func (d *DeviceCategoryType) Set(c DeviceCategory) { d.SetBits(d.Bits() | 1<<c) }
+
+// Clear clears the bit of category c in d. It panics if d is nil.
+func (d *DeviceCategoryType) Clear(c DeviceCategory) { d.SetBits(d.Bits() &^ (1 << c)) }
+
+// All yields the categories whose bits are set in d, in bit order.
+func (d *DeviceCategoryType) All() iter.Seq[DeviceCategory] {
+	return bitsOf[DeviceCategory](uint64(d.Bits()))
+}
+
+// String returns the names of the categories set in d, separated by "|".
+func (d *DeviceCategoryType) String() string { return joinBits[DeviceCategory](uint64(d.Bits())) }
+
+// RoleFlag is a bit position of RoleFlagsType.
+type RoleFlag uint8
+
+// Bit positions of RoleFlagsType.
+const (
+	RoleIsMirror RoleFlag = iota
+	RoleIsPremisesAggregationPoint
+	RoleIsPEV
+	RoleIsDER
+	RoleIsRevenueQuality
+	RoleIsDC
+	RoleIsSubmeter
+)
+
+var roleFlagNames = []string{
+	"isMirror", "isPremisesAggregationPoint", "isPEV", "isDER",
+	"isRevenueQuality", "isDC", "isSubmeter",
+}
+
+// String returns the schema name of the flag f, such as "isMirror".
+func (f RoleFlag) String() string { return bitName(f, roleFlagNames) }
+
+// NewRoleFlags returns a RoleFlagsType with the bits of flags set.
+func NewRoleFlags(flags ...RoleFlag) *RoleFlagsType {
+	r := new(RoleFlagsType)
+	var v uint16
+	for _, f := range flags {
+		v |= 1 << f
+	}
+	r.SetBits(v)
+	return r
+}
+
+// Bits returns the bitmap held by r.
+func (r *RoleFlagsType) Bits() uint16 {
+	if r == nil {
+		return 0
+	}
+	return uint16(hexBits(r.HexBinary16, 16))
+}
+
+// SetBits sets the bitmap held by r to v. It panics if r is nil.
+func (r *RoleFlagsType) SetBits(v uint16) { setHexBits(&r.HexBinary16, uint64(v), 16) }
+
+// Has reports whether flag f is set in r.
+func (r *RoleFlagsType) Has(f RoleFlag) bool { return f < 16 && r.Bits()&(1<<f) != 0 }
+
+// Set sets flag f in r. It panics if r is nil.
+func (r *RoleFlagsType) Set(f RoleFlag) { r.SetBits(r.Bits() | 1<<f) }
+
+// Clear clears flag f in r. It panics if r is nil.
+func (r *RoleFlagsType) Clear(f RoleFlag) { r.SetBits(r.Bits() &^ (1 << f)) }
+
+// All yields the flags set in r, in bit order.
+func (r *RoleFlagsType) All() iter.Seq[RoleFlag] {
+	return bitsOf[RoleFlag](uint64(r.Bits()))
+}
+
+// String returns the names of the flags set in r, separated by "|".
+func (r *RoleFlagsType) String() string { return joinBits[RoleFlag](uint64(r.Bits())) }
+
+// Bits returns the rule held by d as a number; see DstRuleType for its
+// fields.
+func (d *DstRuleType) Bits() uint32 {
+	if d == nil {
+		return 0
+	}
+	return uint32(hexBits(d.HexBinary32, 32))
+}
+
+// SetBits sets the rule held by d to v. It panics if d is nil.
+func (d *DstRuleType) SetBits(v uint32) { setHexBits(&d.HexBinary32, uint64(v), 32) }
+
+// FunctionSet is a bit position of the functionsImplemented bitmap of
+// DeviceInformation.
+type FunctionSet uint8
+
+// Bit positions of FunctionSets.
+const (
+	FunctionSetDeviceCapability FunctionSet = iota
+	FunctionSetSelfDevice
+	FunctionSetEndDevice
+	FunctionSetFunctionSetAssignments
+	FunctionSetSubscription
+	FunctionSetResponse
+	FunctionSetTime
+	FunctionSetDeviceInformation
+	FunctionSetPowerStatus
+	FunctionSetNetworkStatus
+	FunctionSetLogEvent
+	FunctionSetConfiguration
+	FunctionSetSoftwareDownload
+	FunctionSetDRLC
+	FunctionSetMetering
+	FunctionSetPricing
+	FunctionSetMessaging
+	FunctionSetBilling
+	FunctionSetPrepayment
+	FunctionSetFlowReservation
+	FunctionSetDERControl
+	FunctionSetDERInfo
+	FunctionSetMeteringMirror
+	FunctionSetAggregatedDevice
+	FunctionSetProxiedDevice
+)
+
+var functionSetNames = []string{
+	"DeviceCapability", "SelfDevice", "EndDevice", "FunctionSetAssignments",
+	"Subscription", "Response", "Time", "DeviceInformation", "PowerStatus",
+	"NetworkStatus", "LogEvent", "Configuration", "SoftwareDownload", "DRLC",
+	"Metering", "Pricing", "Messaging", "Billing", "Prepayment",
+	"FlowReservation", "DERControl", "DERInfo", "MeteringMirror",
+	"AggregatedDevice", "ProxiedDevice",
+}
+
+// String returns the name of the function set f, such as "DERControl".
+func (f FunctionSet) String() string { return bitName(f, functionSetNames) }
+
+// FunctionSets is the functionsImplemented bitmap of DeviceInformation.
+type FunctionSets uint64
+
+// ParseFunctionSets parses the hex string form of a FunctionSets.
+func ParseFunctionSets(h string) (FunctionSets, error) {
+	v, err := parseBits(h, 64)
+	return FunctionSets(v), err
+}
+
+// Hex returns the hex string form of s.
+func (s FunctionSets) Hex() string { return formatBits(uint64(s), 64) }
+
+// Has reports whether the bit of function set f is set in s.
+func (s FunctionSets) Has(f FunctionSet) bool { return f < 64 && s&(1<<f) != 0 }
+
+// Set sets the bit of function set f in s.
+func (s *FunctionSets) Set(f FunctionSet) { *s |= 1 << f }
+
+// Clear clears the bit of function set f in s.
+func (s *FunctionSets) Clear(f FunctionSet) { *s &^= 1 << f }
+
+// All yields the function sets whose bits are set in s, in bit order.
+func (s FunctionSets) All() iter.Seq[FunctionSet] { return bitsOf[FunctionSet](uint64(s)) }
+
+// String returns the names of the function sets in s, separated by "|".
+func (s FunctionSets) String() string { return joinBits[FunctionSet](uint64(s)) }
+
+// FunctionSets returns the functionsImplemented bitmap of d, or no function
+// sets if d is nil or the bitmap is absent or malformed.
+func (d *DeviceInformation) FunctionSets() FunctionSets {
+	if d == nil {
+		return 0
+	}
+	s, _ := ParseFunctionSets(stringValue(d.FunctionsImplemented))
+	return s
+}
+
+// SetFunctionSets sets the functionsImplemented bitmap of d to s.
+func (d *DeviceInformation) SetFunctionSets(s FunctionSets) {
+	d.FunctionsImplemented = NewString(s.Hex())
+}
+
+// DRLCOption is a bit position of the optionsImplemented bitmap of
+// DRLCCapabilities.
+type DRLCOption uint8
+
+// Bit positions of DRLCOptions.
+const (
+	DRLCTargetKWh DRLCOption = iota
+	DRLCTargetKW
+	DRLCTargetWatts
+	DRLCTargetCubicMeters
+	DRLCTargetCubicFeet
+	DRLCTargetUSGallons
+	DRLCTargetImperialGallons
+	DRLCTargetBTUs
+	DRLCTargetLiters
+	DRLCTargetKPAGauge
+	DRLCTargetKPAAbsolute
+	DRLCTargetMegaJoule
+	DRLCTargetUnitless
+)
+
+// Bit positions of DRLCOptions after the reserved bits 13 to 15.
+const (
+	DRLCTemperatureSetPoint DRLCOption = iota + 16
+	DRLCTemperatureOffset
+	DRLCDutyCycle
+	DRLCLoadAdjustmentPercentage
+	DRLCApplianceLoadReduction
+)
+
+var drlcOptionNames = []string{
+	"TargetKWh", "TargetKW", "TargetWatts", "TargetCubicMeters",
+	"TargetCubicFeet", "TargetUSGallons", "TargetImperialGallons",
+	"TargetBTUs", "TargetLiters", "TargetKPAGauge", "TargetKPAAbsolute",
+	"TargetMegaJoule", "TargetUnitless", "bit13", "bit14", "bit15",
+	"TemperatureSetPoint", "TemperatureOffset", "DutyCycle",
+	"LoadAdjustmentPercentage", "ApplianceLoadReduction",
+}
+
+// String returns the name of the option o, such as "DutyCycle".
+func (o DRLCOption) String() string { return bitName(o, drlcOptionNames) }
+
+// DRLCOptions is the optionsImplemented bitmap of DRLCCapabilities.
+type DRLCOptions uint32
+
+// ParseDRLCOptions parses the hex string form of a DRLCOptions.
+func ParseDRLCOptions(h string) (DRLCOptions, error) {
+	v, err := parseBits(h, 32)
+	return DRLCOptions(v), err
+}
+
+// Hex returns the hex string form of s.
+func (s DRLCOptions) Hex() string { return formatBits(uint64(s), 32) }
+
+// Has reports whether the bit of option o is set in s.
+func (s DRLCOptions) Has(o DRLCOption) bool { return o < 32 && s&(1<<o) != 0 }
+
+// Set sets the bit of option o in s.
+func (s *DRLCOptions) Set(o DRLCOption) { *s |= 1 << o }
+
+// Clear clears the bit of option o in s.
+func (s *DRLCOptions) Clear(o DRLCOption) { *s &^= 1 << o }
+
+// All yields the options whose bits are set in s, in bit order.
+func (s DRLCOptions) All() iter.Seq[DRLCOption] { return bitsOf[DRLCOption](uint64(s)) }
+
+// String returns the names of the options in s, separated by "|".
+func (s DRLCOptions) String() string { return joinBits[DRLCOption](uint64(s)) }
+
+// Options returns the optionsImplemented bitmap of d, or no options if d is
+// nil or the bitmap is malformed.
+func (d *DRLCCapabilities) Options() DRLCOptions {
+	if d == nil {
+		return 0
+	}
+	s, _ := ParseDRLCOptions(d.OptionsImplemented)
+	return s
+}
+
+// SetOptions sets the optionsImplemented bitmap of d to s.
+func (d *DRLCCapabilities) SetOptions(s DRLCOptions) { d.OptionsImplemented = s.Hex() }
+
+// ResponseRequirement is a bit position of the responseRequired bitmap of
+// a RespondableResource.
+type ResponseRequirement uint8
+
+// Bit positions of ResponseRequirements.
+const (
+	// ResponseReceipt asks the device to indicate that it received the
+	// resource.
+	ResponseReceipt ResponseRequirement = iota
+	// ResponseSpecific asks the device to indicate its specific response,
+	// such as that an event started or completed.
+	ResponseSpecific
+	// ResponseUser asks for the response of the user, such as opting out
+	// of an event or acknowledging a message.
+	ResponseUser
+)
+
+var responseRequirementNames = []string{"Receipt", "Specific", "User"}
+
+// String returns the name of the requirement r, such as "Receipt".
+func (r ResponseRequirement) String() string { return bitName(r, responseRequirementNames) }
+
+// ResponseRequirements is the responseRequired bitmap of a
+// RespondableResource.
+type ResponseRequirements uint8
+
+// ParseResponseRequirements parses the hex string form of a
+// ResponseRequirements.
+func ParseResponseRequirements(h string) (ResponseRequirements, error) {
+	v, err := parseBits(h, 8)
+	return ResponseRequirements(v), err
+}
+
+// Hex returns the hex string form of s.
+func (s ResponseRequirements) Hex() string { return formatBits(uint64(s), 8) }
+
+// Has reports whether the bit of requirement r is set in s.
+func (s ResponseRequirements) Has(r ResponseRequirement) bool { return r < 8 && s&(1<<r) != 0 }
+
+// Set sets the bit of requirement r in s.
+func (s *ResponseRequirements) Set(r ResponseRequirement) { *s |= 1 << r }
+
+// Clear clears the bit of requirement r in s.
+func (s *ResponseRequirements) Clear(r ResponseRequirement) { *s &^= 1 << r }
+
+// All yields the requirements whose bits are set in s, in bit order.
+func (s ResponseRequirements) All() iter.Seq[ResponseRequirement] {
+	return bitsOf[ResponseRequirement](uint64(s))
+}
+
+// String returns the names of the requirements in s, separated by "|".
+func (s ResponseRequirements) String() string { return joinBits[ResponseRequirement](uint64(s)) }
+
+// ResponseRequirements returns the responseRequired bitmap of r, or no
+// requirements if r is nil or the bitmap is malformed.
+func (r *RespondableResource) ResponseRequirements() ResponseRequirements {
+	if r == nil {
+		return 0
+	}
+	s, _ := ParseResponseRequirements(r.ResponseRequiredAttr)
+	return s
+}
+
+// SetResponseRequirements sets the responseRequired bitmap of r to s.
+func (r *RespondableResource) SetResponseRequirements(s ResponseRequirements) {
+	r.ResponseRequiredAttr = s.Hex()
+}
@@ -0,0 +1,206 @@
+package sep
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDERControlModes(t *testing.T) {
+	d := NewDERControlModes(OpModVoltVar, ChargeMode)
+	if got := d.Value(); got != "00800001" {
+		t.Errorf("hex = %q, want 00800001", got)
+	}
+	if !d.Has(OpModVoltVar) || !d.Has(ChargeMode) || d.Has(OpModVoltWatt) {
+		t.Errorf("Has is wrong for %s", d)
+	}
+	d.Set(OpModIslandPermit)
+	d.Clear(ChargeMode)
+	d.Clear(OpModFixedW) // not set
+	if got := d.Value(); got != "80800000" {
+		t.Errorf("hex after Set and Clear = %q, want 80800000", got)
+	}
+	if got, want := slices.Collect(d.All()), []DERControlMode{OpModVoltVar, OpModIslandPermit}; !slices.Equal(got, want) {
+		t.Errorf("All = %v, want %v", got, want)
+	}
+	if got := d.String(); got != "opModVoltVar|opModIslandPermit" {
+		t.Errorf("String = %q", got)
+	}
+	if d.Has(32) {
+		t.Error("Has(32) reports a bit past the width")
+	}
+}
+
+func TestBitmapWidths(t *testing.T) {
+	tests := []struct {
+		got, want string
+	}{
+		{NewDERControlModes().Value(), "00000000"},
+		{NewDERControlModes2(OpModTargetV).Value(), "00000200"},
+		{NewDeviceCategories(CategoryWaterHeater, CategoryMicrogridController).Value(), "04000008"},
+		{NewRoleFlags(RoleIsMirror, RoleIsDER).Value(), "0009"},
+		{FunctionSets(1 << FunctionSetDERControl).Hex(), "0000000000100000"},
+		{DRLCOptions(1 << DRLCDutyCycle).Hex(), "00040000"},
+		{ResponseRequirements(1 << ResponseUser).Hex(), "04"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("hex = %q, want %q", tt.got, tt.want)
+		}
+	}
+}
+
+func TestBitmapNil(t *testing.T) {
+	var d *DERControlType
+	var d2 *DERControlType2
+	var c *DeviceCategoryType
+	var r *RoleFlagsType
+	if d.Bits() != 0 || d.Has(ChargeMode) || d.String() != "" {
+		t.Error("nil DERControlType has bits")
+	}
+	if d2.Bits() != 0 || d2.Has(OpModTargetV) || d2.String() != "" {
+		t.Error("nil DERControlType2 has bits")
+	}
+	if c.Bits() != 0 || c.Has(CategoryThermostat) || c.String() != "" {
+		t.Error("nil DeviceCategoryType has bits")
+	}
+	if r.Bits() != 0 || r.Has(RoleIsMirror) || r.String() != "" {
+		t.Error("nil RoleFlagsType has bits")
+	}
+	for range d.All() {
+		t.Error("nil DERControlType yields a mode")
+	}
+	// An absent hex string, as after decoding an empty struct, or an
+	// unparsable one has no bits either.
+	if (&DERControlType{}).Bits() != 0 || NewDERControlType("xyz").Bits() != 0 {
+		t.Error("absent or malformed DERControlType has bits")
+	}
+	// Setting bits in an absent hex string writes one.
+	e := new(RoleFlagsType)
+	e.Set(RoleIsSubmeter)
+	if got := e.Value(); got != "0040" {
+		t.Errorf("hex = %q, want 0040", got)
+	}
+}
+
+func TestBitmapSetNilPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Set on a nil DERControlType did not panic")
+		}
+	}()
+	var d *DERControlType
+	d.Set(ChargeMode)
+}
+
+func TestBitmapTypes(t *testing.T) {
+	d2 := NewDERControlModes2(OpModMaxLimWAbsorb)
+	d2.Set(OpModTargetV)
+	d2.Clear(OpModMaxLimWAbsorb)
+	if got := d2.String(); got != "opModTargetV" || !d2.Has(OpModTargetV) || d2.Has(OpModMaxLimWAbsorb) {
+		t.Errorf("DERControlType2 = %s", got)
+	}
+	c := NewDeviceCategories(CategoryEVSE)
+	c.Set(27) // unnamed
+	if got := c.String(); got != "EVSE|bit27" {
+		t.Errorf("DeviceCategoryType = %q, want EVSE|bit27", got)
+	}
+	r := NewRoleFlags(RoleIsPEV, RoleIsDC)
+	r.Clear(RoleIsPEV)
+	if got := slices.Collect(r.All()); !slices.Equal(got, []RoleFlag{RoleIsDC}) {
+		t.Errorf("RoleFlagsType.All = %v", got)
+	}
+	if r.Has(16) {
+		t.Error("Has(16) reports a bit past the width")
+	}
+}
+
+func TestParseFunctionSets(t *testing.T) {
+	tests := []struct {
+		in   string
+		want FunctionSets
+		err  bool
+	}{
+		{in: "", want: 0},
+		{in: "0000000000000007", want: 1<<FunctionSetDeviceCapability | 1<<FunctionSetSelfDevice | 1<<FunctionSetEndDevice},
+		{in: " 100000 ", want: 1 << FunctionSetDERControl},
+		{in: "ffffffffffffffff", want: ^FunctionSets(0)},
+		{in: "10000000000000000", err: true},
+		{in: "0x10", err: true},
+		{in: "DERControl", err: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseFunctionSets(tt.in)
+		if tt.err {
+			if err == nil {
+				t.Errorf("ParseFunctionSets(%q) = %v, want an error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseFunctionSets(%q) = %#x, %v, want %#x", tt.in, uint64(got), err, uint64(tt.want))
+		}
+	}
+	s, _ := ParseFunctionSets("0000000000300001")
+	s.Set(FunctionSetTime)
+	s.Clear(FunctionSetDeviceCapability)
+	if got := s.String(); got != "Time|DERControl|DERInfo" {
+		t.Errorf("String = %q", got)
+	}
+	if got := s.Hex(); got != "0000000000300040" {
+		t.Errorf("Hex = %q", got)
+	}
+	d := &DeviceInformation{}
+	d.SetFunctionSets(s)
+	if stringValue(d.FunctionsImplemented) != s.Hex() || d.FunctionSets() != s {
+		t.Errorf("DeviceInformation round trip = %q", stringValue(d.FunctionsImplemented))
+	}
+	if (*DeviceInformation)(nil).FunctionSets() != 0 {
+		t.Error("nil DeviceInformation has function sets")
+	}
+}
+
+func TestParseDRLCOptions(t *testing.T) {
+	tests := []struct {
+		in   string
+		want DRLCOptions
+		err  bool
+	}{
+		{in: "", want: 0},
+		{in: "00000003", want: 1<<DRLCTargetKWh | 1<<DRLCTargetKW},
+		{in: "10000", want: 1 << DRLCTemperatureSetPoint},
+		{in: "FFFFFFFF", want: ^DRLCOptions(0)},
+		{in: "100000000", err: true},
+		{in: "-1", err: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseDRLCOptions(tt.in)
+		if tt.err {
+			if err == nil {
+				t.Errorf("ParseDRLCOptions(%q) = %v, want an error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseDRLCOptions(%q) = %#x, %v, want %#x", tt.in, uint32(got), err, uint32(tt.want))
+		}
+	}
+	o := DRLCOptions(1<<DRLCDutyCycle | 1<<14)
+	if got := o.String(); got != "bit14|DutyCycle" {
+		t.Errorf("String = %q, want bit14|DutyCycle", got)
+	}
+	if !o.Has(DRLCDutyCycle) || o.Has(DRLCTargetKW) || o.Has(40) {
+		t.Error("Has is wrong")
+	}
+	if got := slices.Collect(o.All()); !slices.Equal(got, []DRLCOption{14, DRLCDutyCycle}) {
+		t.Errorf("All = %v", got)
+	}
+	c := &DRLCCapabilities{}
+	c.SetOptions(o)
+	if c.OptionsImplemented != "00044000" || c.Options() != o {
+		t.Errorf("DRLCCapabilities round trip = %q", c.OptionsImplemented)
+	}
+	c.OptionsImplemented = "zz"
+	if c.Options() != 0 {
+		t.Error("malformed optionsImplemented has options")
+	}
+}
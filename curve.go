@@ -0,0 +1,261 @@
+package sep
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// RideThrough reports whether m is one of the ride-through modes, whose
+// curves bound a region of duration against voltage or frequency.
+func (m CurveMode) RideThrough() bool { return m >= CurveHFRTMayTrip && m <= CurveLVRTMustTrip }
+
+// voltage reports whether m is a ride-through mode whose Y is voltage.
+func (m CurveMode) voltage() bool {
+	return (m >= CurveHVRTMayTrip && m <= CurveHVRTMustTrip) || (m >= CurveLVRTMayTrip && m <= CurveLVRTMustTrip)
+}
+
+// Mode returns the mode of the curveType of c, and false if it has none.
+func (c *DERCurve) Mode() (CurveMode, bool) {
+	if c == nil || c.CurveType == nil || c.CurveType.UInt8 == nil {
+		return 0, false
+	}
+	return CurveMode(c.CurveType.Value()), true
+}
+
+// UnitRef returns the yRefType of c, or UnitRefNA if it has none.
+func (c *DERCurve) UnitRef() UnitRef {
+	if c == nil || c.YRefType == nil || c.YRefType.UInt8 == nil {
+		return UnitRefNA
+	}
+	return UnitRef(c.YRefType.Value())
+}
+
+// CurvePoint is a point of a DERCurve with its multipliers applied.
+type CurvePoint struct {
+	X, Y float64
+	// Excitation is the excitation of a power factor Y: true if the DER
+	// absorbs reactive power, false if it injects it.
+	Excitation *bool
+}
+
+// Points returns the points of c with xMultiplier and yMultiplier applied
+// and, for a volt-var curve with a vRef, X scaled by vRef/10000, in the
+// order of the CurveData.
+func (c *DERCurve) Points() []CurvePoint {
+	if c == nil {
+		return nil
+	}
+	xm, ym := math.Pow10(multiplierOf(c.XMultiplier)), math.Pow10(multiplierOf(c.YMultiplier))
+	if m, _ := c.Mode(); m == CurveVoltVar && c.VRef != nil {
+		xm *= float64(c.VRef.Value()) / 10000
+	}
+	ps := make([]CurvePoint, 0, len(c.CurveData))
+	for _, d := range c.CurveData {
+		if d != nil {
+			ps = append(ps, CurvePoint{float64(d.Xvalue) * xm, float64(d.Yvalue) * ym, d.Excitation})
+		}
+	}
+	return ps
+}
+
+// Interpolate returns the piecewise-linear Y of c at x, in the units of its
+// points: x below the first point or above the last takes the Y of that
+// point. A power factor Y is interpolated through unity when its excitation
+// changes between points, and absorbing reports the excitation of the
+// result. It reports false if c has no points. Hysteresis is not modelled.
+func (c *DERCurve) Interpolate(x float64) (y float64, absorbing bool, ok bool) {
+	ps := c.Points()
+	if len(ps) == 0 {
+		return 0, false, false
+	}
+	slices.SortStableFunc(ps, func(a, b CurvePoint) int { return cmpFloat(a.X, b.X) })
+	// A power factor is interpolated as its signed distance from unity,
+	// positive when absorbing.
+	signed := func(p CurvePoint) float64 {
+		if p.Excitation == nil {
+			return p.Y
+		}
+		if *p.Excitation {
+			return 1 - p.Y
+		}
+		return p.Y - 1
+	}
+	var v float64
+	switch i, _ := slices.BinarySearchFunc(ps, x, func(p CurvePoint, x float64) int { return cmpFloat(p.X, x) }); {
+	case i == 0:
+		v = signed(ps[0])
+	case i == len(ps):
+		v = signed(ps[len(ps)-1])
+	default:
+		a, b := ps[i-1], ps[i]
+		v = signed(a) + (signed(b)-signed(a))*(x-a.X)/(b.X-a.X)
+		if a.X == b.X {
+			v = signed(b)
+		}
+	}
+	if ps[0].Excitation == nil {
+		return v, false, true
+	}
+	if v > 0 {
+		return 1 - v, true, true
+	}
+	return 1 + v, false, true
+}
+
+func cmpFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// ErrNoReference is returned, wrapped, when evaluating a curve needs a
+// setting or availability the DER does not have.
+var ErrNoReference = errors.New("sep: reference value not available")
+
+// CurveEvaluator evaluates DERCurves in engineering units for a DER with
+// the given settings and availability.
+type CurveEvaluator struct {
+	Settings *DERSettings
+	// Availability gives statVarAvail and statWAvail; it may be nil for
+	// curves that refer to neither.
+	Availability *DERAvailability
+}
+
+// Eval returns the Y of c at x, both in engineering units. x is a voltage
+// in volts for volt-var and volt-watt curves, a frequency in hertz for
+// freq-watt, active power in watts for watt-PF and watt-var, and a duration
+// in seconds for ride-through curves. y is in the unit its yRefType refers
+// to: watts, vars or volt-amperes, or volts for %setEffectiveV; a frequency
+// in hertz for frequency ride-through; and a displacement power factor for
+// watt-PF, absorbing giving its excitation.
+func (e CurveEvaluator) Eval(c *DERCurve, x float64) (y float64, absorbing bool, err error) {
+	m, ok := c.Mode()
+	if !ok || !m.Valid() {
+		return 0, false, fmt.Errorf("sep: DERCurve has no valid curveType")
+	}
+	s := e.settings()
+	switch m {
+	case CurveVoltVar, CurveVoltWatt:
+		if x, err = s.effectivePercent(x); err != nil {
+			return 0, false, err
+		}
+	case CurveWattPF, CurveWattVar:
+		ref, name := s.SetMaxW, "setMaxW"
+		if x < 0 && s.SetMaxChargeRateW != nil {
+			ref, name = s.SetMaxChargeRateW, "setMaxChargeRateW"
+		}
+		if ref == nil || ref.Float64() == 0 {
+			return 0, false, fmt.Errorf("%w: %s", ErrNoReference, name)
+		}
+		x = 100 * x / ref.Float64()
+	}
+	y, absorbing, ok = c.Interpolate(x)
+	if !ok {
+		return 0, false, fmt.Errorf("sep: DERCurve has no CurveData")
+	}
+	ref := c.UnitRef()
+	switch {
+	case m == CurveWattPF:
+		return y, absorbing, nil
+	case m == CurveFreqWatt && ref == UnitRefNA:
+		ref = UnitRefSetMaxW
+	case m.RideThrough() && !m.voltage():
+		return y, false, nil
+	case m.RideThrough():
+		ref = UnitRefSetEffectiveV
+	}
+	y, err = e.reference(ref, y)
+	return y, false, err
+}
+
+// settings returns the settings of e, or empty settings if it has none.
+func (e CurveEvaluator) settings() *DERSettings {
+	if e.Settings == nil {
+		return new(DERSettings)
+	}
+	return e.Settings
+}
+
+// reference returns the percentage pct of the quantity ref refers to.
+func (e CurveEvaluator) reference(ref UnitRef, pct float64) (float64, error) {
+	s, a := e.settings(), e.Availability
+	if a == nil {
+		a = new(DERAvailability)
+	}
+	var v float64
+	var name string
+	switch ref {
+	case UnitRefSetMaxW:
+		v, name = floatOr(s.SetMaxW), "setMaxW"
+		if pct < 0 && s.SetMaxChargeRateW != nil {
+			v, name = floatOr(s.SetMaxChargeRateW), "setMaxChargeRateW"
+		}
+	case UnitRefSetMaxVar:
+		v, name = floatOr(s.SetMaxVar), "setMaxVar"
+		if pct < 0 && s.SetMaxVarNeg != nil {
+			// setMaxVarNeg is the magnitude of the limit on absorbing.
+			v, name = math.Abs(floatOr(s.SetMaxVarNeg)), "setMaxVarNeg"
+		}
+	case UnitRefStatVarAvail:
+		v, name = floatOr(a.StatVarAvail), "statVarAvail"
+	case UnitRefSetEffectiveV:
+		vref, err := s.vRef()
+		if err != nil {
+			return 0, err
+		}
+		return pct/100*vref + s.SetVRefOfs.Float64(), nil
+	case UnitRefSetMaxChargeRateW:
+		v, name = floatOr(s.SetMaxChargeRateW), "setMaxChargeRateW"
+	case UnitRefSetMaxDischargeRateW:
+		v, name = floatOr(s.SetMaxDischargeRateW), "setMaxDischargeRateW"
+	case UnitRefStatWAvail:
+		v, name = floatOr(a.StatWAvail), "statWAvail"
+	case UnitRefSetMaxVA:
+		v, name = floatOr(s.SetMaxVA), "setMaxVA"
+	default:
+		return 0, fmt.Errorf("sep: DERCurve has yRefType %s where a reference is required", ref)
+	}
+	if math.IsNaN(v) {
+		return 0, fmt.Errorf("%w: %s", ErrNoReference, name)
+	}
+	return pct / 100 * v, nil
+}
+
+// floatOr returns the value of v, or NaN if v is nil.
+func floatOr[T interface {
+	comparable
+	Float64() float64
+}](v T) float64 {
+	var zero T
+	if v == zero {
+		return math.NaN()
+	}
+	return v.Float64()
+}
+
+// vRef returns setVRef, or setVNom if it is absent.
+func (s *DERSettings) vRef() (float64, error) {
+	if v := s.SetVRef.Float64(); v != 0 {
+		return v, nil
+	}
+	if v := s.SetVNom.Float64(); v != 0 {
+		return v, nil
+	}
+	return 0, fmt.Errorf("%w: setVRef", ErrNoReference)
+}
+
+// effectivePercent returns the voltage v as an effective percent voltage,
+// 100 * (v - setVRefOfs) / setVRef.
+func (s *DERSettings) effectivePercent(v float64) (float64, error) {
+	vref, err := s.vRef()
+	if err != nil {
+		return 0, err
+	}
+	return 100 * (v - s.SetVRefOfs.Float64()) / vref, nil
+}
@@ -0,0 +1,227 @@
+package sep
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// testCurve returns a DERCurve of mode m with yRefType ref and the given
+// multipliers and points, each an X and a Y.
+func testCurve(m CurveMode, ref UnitRef, xm, ym int8, points ...[2]int) *DERCurve {
+	c := NewDERCurve()
+	c.CurveType = NewDERCurveType(uint8(m))
+	c.YRefType = NewDERUnitRefType(uint8(ref))
+	c.XMultiplier = NewPowerOfTenMultiplierType(xm)
+	c.YMultiplier = NewPowerOfTenMultiplierType(ym)
+	for _, p := range points {
+		c.CurveData = append(c.CurveData, &CurveData{Xvalue: p[0], Yvalue: p[1]})
+	}
+	return c
+}
+
+// pfCurve returns a watt-PF curve whose points are an X in percent, a
+// displacement in hundredths and an excitation.
+func pfCurve(points ...struct {
+	x, pf     int
+	absorbing bool
+}) *DERCurve {
+	c := testCurve(CurveWattPF, UnitRefNA, 0, -2)
+	for _, p := range points {
+		c.CurveData = append(c.CurveData, &CurveData{Xvalue: p.x, Yvalue: p.pf, Excitation: &p.absorbing})
+	}
+	return c
+}
+
+func near(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+
+func TestDERCurvePoints(t *testing.T) {
+	c := testCurve(CurveVoltVar, UnitRefSetMaxVar, -1, -2, [2]int{920, 3000}, [2]int{1080, -3000})
+	ps := c.Points()
+	if len(ps) != 2 || !near(ps[0].X, 92) || !near(ps[0].Y, 30) || !near(ps[1].X, 108) || !near(ps[1].Y, -30) {
+		t.Errorf("Points() = %+v", ps)
+	}
+	// vRef moves a volt-var curve, and only a volt-var curve.
+	c.VRef = NewPerCent(10500)
+	if ps := c.Points(); !near(ps[0].X, 96.6) || !near(ps[1].X, 113.4) {
+		t.Errorf("Points() with vRef 105%% = %+v", ps)
+	}
+	c.CurveType = NewDERCurveType(uint8(CurveVoltWatt))
+	if ps := c.Points(); !near(ps[0].X, 92) {
+		t.Errorf("vRef applied to a volt-watt curve: %+v", ps)
+	}
+	if (*DERCurve)(nil).Points() != nil {
+		t.Error("a nil curve has points")
+	}
+}
+
+func TestDERCurveInterpolate(t *testing.T) {
+	vv := testCurve(CurveVoltVar, UnitRefSetMaxVar, 0, 0, [2]int{92, 30}, [2]int{98, 0}, [2]int{102, 0}, [2]int{108, -30})
+	unsorted := testCurve(CurveVoltWatt, UnitRefSetMaxW, 0, 0, [2]int{110, 0}, [2]int{106, 100})
+	step := testCurve(CurveLVRTMustTrip, UnitRefNA, 0, 0, [2]int{0, 50}, [2]int{10, 50}, [2]int{10, 70}, [2]int{20, 88})
+	for _, tt := range []struct {
+		name string
+		c    *DERCurve
+		x, y float64
+	}{
+		{"below the first point", vv, 80, 30},
+		{"on the first point", vv, 92, 30},
+		{"between points", vv, 95, 15},
+		{"on a flat segment", vv, 100, 0},
+		{"on a falling segment", vv, 106, -20},
+		{"above the last point", vv, 120, -30},
+		{"unsorted data", unsorted, 108, 50},
+		{"on a vertical step", step, 10, 50},
+		{"after a vertical step", step, 15, 79},
+	} {
+		y, absorbing, ok := tt.c.Interpolate(tt.x)
+		if !ok || absorbing || !near(y, tt.y) {
+			t.Errorf("%s: Interpolate(%g) = %g, %v, %v, want %g", tt.name, tt.x, y, absorbing, ok, tt.y)
+		}
+	}
+	if _, _, ok := testCurve(CurveVoltVar, UnitRefSetMaxVar, 0, 0).Interpolate(100); ok {
+		t.Error("a curve without points interpolates")
+	}
+}
+
+func TestDERCurveInterpolatePowerFactor(t *testing.T) {
+	type pt = struct {
+		x, pf     int
+		absorbing bool
+	}
+	// Injecting at 0.9 at no power, through unity, to absorbing at 0.9 at
+	// full power.
+	cross := pfCurve(pt{0, 90, false}, pt{100, 90, true})
+	// Absorbing throughout, from unity to 0.9.
+	absorb := pfCurve(pt{50, 100, true}, pt{100, 90, true})
+	for _, tt := range []struct {
+		name      string
+		c         *DERCurve
+		x, pf     float64
+		absorbing bool
+	}{
+		{"injecting end", cross, 0, 0.9, false},
+		{"injecting side", cross, 25, 0.95, false},
+		{"crossover", cross, 50, 1, false},
+		{"absorbing side", cross, 75, 0.95, true},
+		{"absorbing end", cross, 100, 0.9, true},
+		{"beyond the absorbing end", cross, 120, 0.9, true},
+		{"unity", absorb, 20, 1, false},
+		{"absorbing", absorb, 75, 0.95, true},
+	} {
+		pf, absorbing, ok := tt.c.Interpolate(tt.x)
+		if !ok || !near(pf, tt.pf) || absorbing != tt.absorbing {
+			t.Errorf("%s: Interpolate(%g) = %g, %v, want %g, %v", tt.name, tt.x, pf, absorbing, tt.pf, tt.absorbing)
+		}
+	}
+}
+
+func TestCurveEvaluatorReferences(t *testing.T) {
+	settings := NewDERSettings()
+	settings.SetMaxW, _ = ActivePowerFromFloat(5000)
+	settings.SetMaxChargeRateW, _ = ActivePowerFromFloat(4000)
+	settings.SetMaxDischargeRateW, _ = ActivePowerFromFloat(4500)
+	settings.SetMaxVar, _ = ReactivePowerFromFloat(2000)
+	settings.SetMaxVarNeg, _ = ReactivePowerFromFloat(-1600)
+	settings.SetMaxVA, _ = ApparentPowerFromFloat(5500)
+	settings.SetVRef, _ = VoltageRMSFromFloat(240)
+	settings.SetVRefOfs, _ = VoltageRMSFromFloat(2)
+	avail := NewDERAvailability()
+	avail.StatVarAvail, _ = ReactivePowerFromFloat(1200)
+	avail.StatWAvail, _ = ActivePowerFromFloat(3000)
+	e := CurveEvaluator{Settings: settings, Availability: avail}
+
+	for _, tt := range []struct {
+		ref  UnitRef
+		pct  int
+		want float64
+	}{
+		{UnitRefSetMaxW, 50, 2500},
+		{UnitRefSetMaxW, -50, -2000},
+		{UnitRefSetMaxVar, 50, 1000},
+		{UnitRefSetMaxVar, -50, -800},
+		{UnitRefStatVarAvail, 50, 600},
+		{UnitRefSetEffectiveV, 50, 122},
+		{UnitRefSetMaxChargeRateW, 50, 2000},
+		{UnitRefSetMaxDischargeRateW, 50, 2250},
+		{UnitRefStatWAvail, 50, 1500},
+		{UnitRefSetMaxVA, 50, 2750},
+	} {
+		// A flat volt-var curve evaluates to its Y anywhere.
+		c := testCurve(CurveVoltVar, tt.ref, 0, 0, [2]int{90, tt.pct}, [2]int{110, tt.pct})
+		y, _, err := e.Eval(c, 240)
+		if err != nil || !near(y, tt.want) {
+			t.Errorf("%s %d%%: Eval = %g, %v, want %g", tt.ref, tt.pct, y, err, tt.want)
+		}
+	}
+
+	flat := testCurve(CurveVoltVar, UnitRefNA, 0, 0, [2]int{90, 50}, [2]int{110, 50})
+	if _, _, err := e.Eval(flat, 240); err == nil {
+		t.Error("Eval of a volt-var curve without yRefType succeeded")
+	}
+	flat.YRefType = NewDERUnitRefType(uint8(UnitRefStatWAvail))
+	if _, _, err := (CurveEvaluator{Settings: settings}).Eval(flat, 240); !errors.Is(err, ErrNoReference) {
+		t.Errorf("Eval without availability error = %v, want ErrNoReference", err)
+	}
+	if _, _, err := (CurveEvaluator{}).Eval(flat, 240); !errors.Is(err, ErrNoReference) {
+		t.Errorf("Eval without setVRef error = %v, want ErrNoReference", err)
+	}
+	flat.CurveType = nil
+	if _, _, err := e.Eval(flat, 240); err == nil {
+		t.Error("Eval of a curve without curveType succeeded")
+	}
+}
+
+func TestCurveEvaluatorEval(t *testing.T) {
+	settings := NewDERSettings()
+	settings.SetMaxW, _ = ActivePowerFromFloat(5000)
+	settings.SetMaxChargeRateW, _ = ActivePowerFromFloat(4000)
+	settings.SetMaxVar, _ = ReactivePowerFromFloat(2000)
+	settings.SetVNom, _ = VoltageRMSFromFloat(120)
+	e := CurveEvaluator{Settings: settings}
+
+	type pt = struct {
+		x, pf     int
+		absorbing bool
+	}
+	for _, tt := range []struct {
+		name      string
+		c         *DERCurve
+		x, y      float64
+		absorbing bool
+	}{
+		// X in volts against setVNom, in the absence of setVRef.
+		{"volt-var", testCurve(CurveVoltVar, UnitRefSetMaxVar, 0, 0, [2]int{92, 44}, [2]int{98, 0}, [2]int{102, 0}, [2]int{108, -44}),
+			126, -22.0 / 100 * 2000, false},
+		{"volt-watt", testCurve(CurveVoltWatt, UnitRefSetMaxW, 0, 0, [2]int{106, 100}, [2]int{110, 0}),
+			128.4, 3750, false},
+		// A freq-watt curve without yRefType is in percent of setMaxW.
+		{"freq-watt", testCurve(CurveFreqWatt, UnitRefNA, -2, 0, [2]int{6000, 100}, [2]int{6100, 0}),
+			60.25, 3750, false},
+		// X in watts against setMaxW, or setMaxChargeRateW when negative.
+		{"watt-var", testCurve(CurveWattVar, UnitRefSetMaxVar, 0, 0, [2]int{-100, 20}, [2]int{0, 0}, [2]int{100, -20}),
+			2500, -200, false},
+		{"watt-var charging", testCurve(CurveWattVar, UnitRefSetMaxVar, 0, 0, [2]int{-100, 20}, [2]int{0, 0}, [2]int{100, -20}),
+			-2000, 200, false},
+		{"watt-PF", pfCurve(pt{0, 90, false}, pt{100, 90, true}), 3750, 0.95, true},
+		// Ride-through curves: X a duration; Y a frequency, or a percent of
+		// the effective voltage.
+		{"frequency ride-through", testCurve(CurveHFRTMustTrip, UnitRefNA, 0, -2, [2]int{0, 6200}, [2]int{10, 6100}),
+			5, 61.5, false},
+		{"voltage ride-through", testCurve(CurveLVRTMustTrip, UnitRefSetEffectiveV, 0, 0, [2]int{0, 50}, [2]int{10, 70}),
+			5, 72, false},
+	} {
+		y, absorbing, err := e.Eval(tt.c, tt.x)
+		if err != nil || !near(y, tt.y) || absorbing != tt.absorbing {
+			t.Errorf("%s: Eval(%g) = %g, %v, %v, want %g, %v", tt.name, tt.x, y, absorbing, err, tt.y, tt.absorbing)
+		}
+	}
+
+	noMaxW := CurveEvaluator{Settings: NewDERSettings()}
+	if _, _, err := noMaxW.Eval(pfCurve(pt{0, 90, false}, pt{100, 90, true}), 1000); !errors.Is(err, ErrNoReference) {
+		t.Errorf("watt-PF without setMaxW error = %v, want ErrNoReference", err)
+	}
+	if _, _, err := e.Eval(testCurve(CurveVoltVar, UnitRefSetMaxVar, 0, 0), 120); err == nil {
+		t.Error("Eval of a curve without points succeeded")
+	}
+}
@@ -0,0 +1,196 @@
+package sep
+
+import (
+	"fmt"
+	"slices"
+)
+
+// maxCurvePoints is the most CurveData a DERCurve may have.
+const maxCurvePoints = 10
+
+// curveRefs are the yRefTypes each curve mode may have.
+var curveRefs = map[CurveMode][]UnitRef{
+	CurveFreqWatt:               {UnitRefNA, UnitRefSetMaxW},
+	CurveHFRTMayTrip:            {UnitRefNA},
+	CurveHFRTMustTrip:           {UnitRefNA},
+	CurveHVRTMayTrip:            {UnitRefNA, UnitRefSetEffectiveV},
+	CurveHVRTMomentaryCessation: {UnitRefNA, UnitRefSetEffectiveV},
+	CurveHVRTMustTrip:           {UnitRefNA, UnitRefSetEffectiveV},
+	CurveLFRTMayTrip:            {UnitRefNA},
+	CurveLFRTMustTrip:           {UnitRefNA},
+	CurveLVRTMayTrip:            {UnitRefNA, UnitRefSetEffectiveV},
+	CurveLVRTMomentaryCessation: {UnitRefNA, UnitRefSetEffectiveV},
+	CurveLVRTMustTrip:           {UnitRefNA, UnitRefSetEffectiveV},
+	CurveVoltVar:                {UnitRefSetMaxW, UnitRefSetMaxVA, UnitRefSetMaxVar, UnitRefStatVarAvail},
+	CurveVoltWatt:               {UnitRefSetMaxW, UnitRefStatWAvail},
+	CurveWattPF:                 {UnitRefNA},
+	CurveWattVar:                {UnitRefSetMaxW, UnitRefSetMaxVA, UnitRefSetMaxVar, UnitRefStatVarAvail},
+}
+
+// Check checks c against the rules IEEE 2030.5 and IEEE 1547 set for
+// curves of its curveType, beyond those of the schema: it has from 2 to 10
+// points with strictly increasing X; its yRefType is one the curveType
+// allows; excitation is given for, and only for, the power factors of a
+// watt-PF curve, which lie in (0, 1]; percentages lie within -100 to 100;
+// vRef and autonomous vRef adjustment are only on volt-var curves; and the
+// Y of volt-var and volt-watt curves does not increase with X. It returns
+// ValidationErrors describing each violation.
+func (c *DERCurve) Check() error {
+	var errs ValidationErrors
+	c.check("/DERCurve", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (c *DERCurve) check(path string, errs *ValidationErrors) {
+	fail := func(path, format string, args ...any) {
+		*errs = append(*errs, &ValidationError{Path: path, Msg: fmt.Sprintf(format, args...)})
+	}
+	m, ok := c.Mode()
+	if !ok {
+		fail(path, "missing curveType")
+		return
+	}
+	if !m.Valid() {
+		fail(path+"/curveType", "reserved curveType %d", m)
+		return
+	}
+	switch n := len(c.CurveData); {
+	case n < 2:
+		fail(path, "%s curve has %d points, need at least 2", m, n)
+	case n > maxCurvePoints:
+		fail(path, "%s curve has %d points, at most %d allowed", m, n, maxCurvePoints)
+	}
+	if c.YRefType == nil {
+		fail(path, "%s curve is missing yRefType", m)
+	} else if ref := c.UnitRef(); !slices.Contains(curveRefs[m], ref) {
+		fail(path+"/yRefType", "%s curve cannot have yRefType %s; want one of %v", m, ref, curveRefs[m])
+	}
+	if m != CurveVoltVar {
+		if c.VRef != nil {
+			fail(path+"/vRef", "vRef is only allowed on opModVoltVar curves")
+		}
+		if c.AutonomousVRefEnable != nil || c.AutonomousVRefTimeConstant != nil {
+			fail(path, "autonomous vRef adjustment is only allowed on opModVoltVar curves")
+		}
+	} else if c.AutonomousVRefEnable != nil && *c.AutonomousVRefEnable && c.AutonomousVRefTimeConstant == nil {
+		fail(path, "autonomousVRefEnable is set without autonomousVRefTimeConstant")
+	}
+
+	ps := c.Points()
+	for i, p := range ps {
+		dpath := fmt.Sprintf("%s/CurveData[%d]", path, i+1)
+		if i > 0 && p.X <= ps[i-1].X {
+			fail(dpath+"/xvalue", "xvalue %g does not increase from %g", p.X, ps[i-1].X)
+		}
+		switch {
+		case m == CurveWattPF && p.Excitation == nil:
+			fail(dpath, "missing excitation for a power factor")
+		case m != CurveWattPF && p.Excitation != nil:
+			fail(dpath+"/excitation", "excitation is only allowed on opModWattPF curves")
+		}
+		if m == CurveWattPF && (p.Y <= 0 || p.Y > 1) {
+			fail(dpath+"/yvalue", "power factor %g out of range (0, 1]", p.Y)
+		}
+		if m == CurveWattPF || m == CurveWattVar {
+			if p.X < -100 || p.X > 100 {
+				fail(dpath+"/xvalue", "percentage %g out of range -100 to 100", p.X)
+			}
+		}
+		if m == CurveVoltVar || m == CurveVoltWatt || m == CurveWattVar || m == CurveFreqWatt {
+			if p.Y < -100 || p.Y > 100 {
+				fail(dpath+"/yvalue", "percentage %g out of range -100 to 100", p.Y)
+			}
+		}
+		if m.RideThrough() && p.X < 0 {
+			fail(dpath+"/xvalue", "negative duration %g", p.X)
+		}
+		if (m == CurveVoltVar || m == CurveVoltWatt) && i > 0 && p.Y > ps[i-1].Y {
+			fail(dpath+"/yvalue", "%s curve increases from %g to %g", m, ps[i-1].Y, p.Y)
+		}
+	}
+}
+
+// rideThroughPair is the may-trip and must-trip modes of a ride-through
+// function, and whether its must-trip region lies above its may-trip
+// region.
+type rideThroughPair struct {
+	may, must CurveMode
+	high      bool
+}
+
+var rideThroughPairs = []rideThroughPair{
+	{CurveHFRTMayTrip, CurveHFRTMustTrip, true},
+	{CurveHVRTMayTrip, CurveHVRTMustTrip, true},
+	{CurveLFRTMayTrip, CurveLFRTMustTrip, false},
+	{CurveLVRTMayTrip, CurveLVRTMustTrip, false},
+}
+
+// CheckRideThrough checks that the must-trip region of mustTrip lies
+// beyond the may-trip region of mayTrip, curves of the same ride-through
+// function: above it for high voltage or frequency, below it for low, at
+// every point of either curve.
+func CheckRideThrough(mayTrip, mustTrip *DERCurve) error {
+	var errs ValidationErrors
+	checkRideThrough(mayTrip, mustTrip, "/DERCurve", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func checkRideThrough(mayTrip, mustTrip *DERCurve, path string, errs *ValidationErrors) {
+	may, _ := mayTrip.Mode()
+	must, _ := mustTrip.Mode()
+	i := slices.IndexFunc(rideThroughPairs, func(p rideThroughPair) bool { return p.may == may && p.must == must })
+	if i < 0 {
+		*errs = append(*errs, &ValidationError{Path: path, Msg: fmt.Sprintf("%s and %s are not the may-trip and must-trip curves of one ride-through function", may, must)})
+		return
+	}
+	high := rideThroughPairs[i].high
+	var xs []float64
+	for _, p := range append(mayTrip.Points(), mustTrip.Points()...) {
+		xs = append(xs, p.X)
+	}
+	slices.Sort(xs)
+	for _, x := range slices.Compact(xs) {
+		a, _, ok := mayTrip.Interpolate(x)
+		b, _, ok2 := mustTrip.Interpolate(x)
+		if ok && ok2 && ((high && b < a) || (!high && b > a)) {
+			*errs = append(*errs, &ValidationError{Path: path, Msg: fmt.Sprintf("%s curve is at %g inside %s curve at %g after %g s", must, b, may, a, x)})
+			return
+		}
+	}
+}
+
+// Check checks each curve of l as DERCurve.Check does and, for each
+// ride-through function of which l has exactly one may-trip and one
+// must-trip curve, that the regions nest as CheckRideThrough requires.
+func (l *DERCurveList) Check() error {
+	if l == nil {
+		return nil
+	}
+	var errs ValidationErrors
+	byMode := make(map[CurveMode][]int)
+	for i, c := range l.DERCurve {
+		if c == nil {
+			continue
+		}
+		c.check(fmt.Sprintf("/DERCurveList/DERCurve[%d]", i+1), &errs)
+		if m, ok := c.Mode(); ok {
+			byMode[m] = append(byMode[m], i)
+		}
+	}
+	for _, p := range rideThroughPairs {
+		may, must := byMode[p.may], byMode[p.must]
+		if len(may) == 1 && len(must) == 1 {
+			checkRideThrough(l.DERCurve[may[0]], l.DERCurve[must[0]], fmt.Sprintf("/DERCurveList/DERCurve[%d]", must[0]+1), &errs)
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
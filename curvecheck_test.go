@@ -0,0 +1,146 @@
+package sep
+
+import (
+	"strings"
+	"testing"
+)
+
+// validCurves are curves of each type that pass Check.
+func validCurves() map[CurveMode]*DERCurve {
+	type pt = struct {
+		x, pf     int
+		absorbing bool
+	}
+	return map[CurveMode]*DERCurve{
+		CurveVoltVar:  testCurve(CurveVoltVar, UnitRefSetMaxVar, 0, 0, [2]int{92, 44}, [2]int{98, 0}, [2]int{102, 0}, [2]int{108, -44}),
+		CurveVoltWatt: testCurve(CurveVoltWatt, UnitRefSetMaxW, 0, 0, [2]int{106, 100}, [2]int{110, 0}),
+		CurveFreqWatt: testCurve(CurveFreqWatt, UnitRefNA, -2, 0, [2]int{6036, 100}, [2]int{6100, 0}),
+		CurveWattVar:  testCurve(CurveWattVar, UnitRefSetMaxVar, 0, 0, [2]int{-100, 20}, [2]int{0, 0}, [2]int{100, -20}),
+		CurveWattPF:   pfCurve(pt{0, 100, false}, pt{50, 100, false}, pt{100, 90, true}),
+		CurveHVRTMayTrip: testCurve(CurveHVRTMayTrip, UnitRefSetEffectiveV, -2, 0,
+			[2]int{0, 120}, [2]int{100, 120}, [2]int{101, 110}, [2]int{1300, 110}),
+		CurveHVRTMustTrip: testCurve(CurveHVRTMustTrip, UnitRefSetEffectiveV, -2, 0,
+			[2]int{0, 125}, [2]int{16, 125}, [2]int{17, 120}, [2]int{1300, 120}),
+		CurveLFRTMustTrip: testCurve(CurveLFRTMustTrip, UnitRefNA, 0, -1, [2]int{0, 565}, [2]int{300, 588}),
+	}
+}
+
+func TestDERCurveCheckValid(t *testing.T) {
+	for m, c := range validCurves() {
+		if err := c.Check(); err != nil {
+			t.Errorf("%s: %v", m, err)
+		}
+	}
+}
+
+func TestDERCurveCheck(t *testing.T) {
+	yes := true
+	for _, tt := range []struct {
+		name string
+		mode CurveMode
+		edit func(c *DERCurve)
+		path string
+		msg  string
+	}{
+		{"missing curveType", CurveVoltVar, func(c *DERCurve) { c.CurveType = nil },
+			"/DERCurve", "missing curveType"},
+		{"reserved curveType", CurveVoltVar, func(c *DERCurve) { c.CurveType = NewDERCurveType(40) },
+			"/DERCurve/curveType", "reserved curveType 40"},
+		{"too few points", CurveVoltWatt, func(c *DERCurve) { c.CurveData = c.CurveData[:1] },
+			"/DERCurve", "has 1 points, need at least 2"},
+		{"too many points", CurveFreqWatt, func(c *DERCurve) {
+			c.CurveData = nil
+			for i := range 11 {
+				c.CurveData = append(c.CurveData, &CurveData{Xvalue: 6000 + i, Yvalue: 100 - i})
+			}
+		}, "/DERCurve", "has 11 points, at most 10 allowed"},
+		{"missing yRefType", CurveVoltVar, func(c *DERCurve) { c.YRefType = nil },
+			"/DERCurve", "missing yRefType"},
+		{"yRefType of another curveType", CurveVoltWatt, func(c *DERCurve) { c.YRefType = NewDERUnitRefType(uint8(UnitRefSetMaxVar)) },
+			"/DERCurve/yRefType", "cannot have yRefType %setMaxVar"},
+		{"vRef off volt-var", CurveVoltWatt, func(c *DERCurve) { c.VRef = NewPerCent(10000) },
+			"/DERCurve/vRef", "only allowed on opModVoltVar"},
+		{"autonomous vRef off volt-var", CurveWattVar, func(c *DERCurve) { c.AutonomousVRefEnable = &yes },
+			"/DERCurve", "autonomous vRef adjustment is only allowed"},
+		{"autonomous vRef without time constant", CurveVoltVar, func(c *DERCurve) { c.AutonomousVRefEnable = &yes },
+			"/DERCurve", "without autonomousVRefTimeConstant"},
+		{"X not increasing", CurveVoltVar, func(c *DERCurve) { c.CurveData[2].Xvalue = 98 },
+			"/DERCurve/CurveData[3]/xvalue", "xvalue 98 does not increase from 98"},
+		{"power factor without excitation", CurveWattPF, func(c *DERCurve) { c.CurveData[1].Excitation = nil },
+			"/DERCurve/CurveData[2]", "missing excitation"},
+		{"excitation off watt-PF", CurveWattVar, func(c *DERCurve) { c.CurveData[0].Excitation = &yes },
+			"/DERCurve/CurveData[1]/excitation", "only allowed on opModWattPF"},
+		{"power factor of 0", CurveWattPF, func(c *DERCurve) { c.CurveData[2].Yvalue = 0 },
+			"/DERCurve/CurveData[3]/yvalue", "power factor 0 out of range"},
+		{"power factor above 1", CurveWattPF, func(c *DERCurve) { c.CurveData[0].Yvalue = 101 },
+			"/DERCurve/CurveData[1]/yvalue", "power factor 1.01 out of range"},
+		{"power percentage below -100", CurveWattVar, func(c *DERCurve) { c.CurveData[0].Xvalue = -120 },
+			"/DERCurve/CurveData[1]/xvalue", "percentage -120 out of range"},
+		{"Y percentage above 100", CurveVoltVar, func(c *DERCurve) { c.CurveData[0].Yvalue = 101 },
+			"/DERCurve/CurveData[1]/yvalue", "percentage 101 out of range"},
+		{"negative duration", CurveLFRTMustTrip, func(c *DERCurve) { c.CurveData[0].Xvalue = -1 },
+			"/DERCurve/CurveData[1]/xvalue", "negative duration -1"},
+		{"volt-var increasing", CurveVoltVar, func(c *DERCurve) { c.CurveData[3].Yvalue = 10 },
+			"/DERCurve/CurveData[4]/yvalue", "opModVoltVar curve increases from 0 to 10"},
+		{"volt-watt increasing", CurveVoltWatt, func(c *DERCurve) { c.CurveData[0].Yvalue = -10 },
+			"/DERCurve/CurveData[2]/yvalue", "opModVoltWatt curve increases from -10 to 0"},
+	} {
+		c := validCurves()[tt.mode]
+		tt.edit(c)
+		err := c.Check()
+		errs, ok := err.(ValidationErrors)
+		if !ok || len(errs) != 1 {
+			t.Errorf("%s: Check() = %v, want one error", tt.name, err)
+			continue
+		}
+		if errs[0].Path != tt.path || !strings.Contains(errs[0].Msg, tt.msg) {
+			t.Errorf("%s: Check() = %s: %s, want %s: %s", tt.name, errs[0].Path, errs[0].Msg, tt.path, tt.msg)
+		}
+	}
+}
+
+func TestCheckRideThrough(t *testing.T) {
+	curves := validCurves()
+	may, must := curves[CurveHVRTMayTrip], curves[CurveHVRTMustTrip]
+	if err := CheckRideThrough(may, must); err != nil {
+		t.Errorf("nested curves: %v", err)
+	}
+	// The must-trip curve dips below the may-trip curve after 0.17 s.
+	must.CurveData[2].Yvalue = 115
+	must.CurveData[3].Yvalue = 115
+	err := CheckRideThrough(may, must)
+	if err == nil || !strings.Contains(err.Error(), "opModHVRTMustTrip curve is at 115 inside opModHVRTMayTrip curve at 120 after 0.17 s") {
+		t.Errorf("crossing curves: %v", err)
+	}
+	if err := CheckRideThrough(must, may); err == nil || !strings.Contains(err.Error(), "are not the may-trip and must-trip curves") {
+		t.Errorf("swapped curves: %v", err)
+	}
+	if err := CheckRideThrough(may, curves[CurveLFRTMustTrip]); err == nil {
+		t.Error("curves of different functions pass")
+	}
+}
+
+func TestDERCurveListCheck(t *testing.T) {
+	curves := validCurves()
+	l := NewDERCurveList()
+	l.DERCurve = []*DERCurve{curves[CurveVoltVar], curves[CurveHVRTMayTrip], nil, curves[CurveHVRTMustTrip]}
+	if err := l.Check(); err != nil {
+		t.Errorf("valid list: %v", err)
+	}
+	if err := (*DERCurveList)(nil).Check(); err != nil {
+		t.Errorf("nil list: %v", err)
+	}
+
+	curves[CurveVoltVar].YRefType = nil
+	curves[CurveHVRTMustTrip].CurveData[3].Yvalue = 100
+	errs, _ := l.Check().(ValidationErrors)
+	if len(errs) != 2 {
+		t.Fatalf("Check() = %v, want two errors", errs)
+	}
+	if errs[0].Path != "/DERCurveList/DERCurve[1]" || !strings.Contains(errs[0].Msg, "missing yRefType") {
+		t.Errorf("first error %s: %s", errs[0].Path, errs[0].Msg)
+	}
+	if errs[1].Path != "/DERCurveList/DERCurve[4]" || !strings.Contains(errs[1].Msg, "inside opModHVRTMayTrip") {
+		t.Errorf("second error %s: %s", errs[1].Path, errs[1].Msg)
+	}
+}
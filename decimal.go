@@ -0,0 +1,180 @@
+package sep
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// The types pairing a value with a PowerOfTenMultiplierType represent the
+// exact decimal Value×10^Multiplier, a nil Multiplier counting as 0. Their
+// methods convert them to and from float64 and *big.Rat in the unit the
+// type documents, and add, subtract, scale and compare them exactly. Results
+// are normalised: the value is held with the multiplier closest to 0 that
+// represents it exactly, or failing that with the smallest multiplier whose
+// value fits the range of the type, rounding half to even. The power
+// factors, whose displacement is such a value, have no arithmetic. The
+// methods are generated into units.go from the table in internal/gen; the
+// helpers they share are here.
+
+// ErrValueRange is returned, wrapped, when a value cannot be represented by
+// a multiplier from -9 to 9 and a value in the range of the type.
+var ErrValueRange = errors.New("sep: value out of range")
+
+// valueRange is the range of the value field of a multiplier/value type.
+type valueRange struct {
+	name     string
+	min, max int64
+}
+
+var (
+	rangeInt16  = valueRange{"Int16", math.MinInt16, math.MaxInt16}
+	rangeUInt16 = valueRange{"UInt16", 0, math.MaxUint16}
+	rangeInt32  = valueRange{"Int32", math.MinInt32, math.MaxInt32}
+	rangeUInt48 = valueRange{"UInt48", 0, 1<<48 - 1}
+)
+
+func multiplierOf(m *PowerOfTenMultiplierType) int {
+	if m == nil {
+		return 0
+	}
+	return int(m.Value())
+}
+
+// pow10 returns 10^n as a rational.
+func pow10(n int) *big.Rat {
+	p := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(n))), nil)
+	if n < 0 {
+		return new(big.Rat).SetFrac(big.NewInt(1), p)
+	}
+	return new(big.Rat).SetInt(p)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// decimal returns the exact value of v×10^m.
+func decimal(v int64, m *PowerOfTenMultiplierType) *big.Rat {
+	return new(big.Rat).Mul(new(big.Rat).SetInt64(v), pow10(multiplierOf(m)))
+}
+
+// formatDecimal returns v×10^m in decimal notation, without exponent.
+func formatDecimal(v int64, m *PowerOfTenMultiplierType) string {
+	n := multiplierOf(m)
+	s := strconv.FormatInt(v, 10)
+	sign := ""
+	if v < 0 {
+		sign, s = "-", s[1:]
+	}
+	if v == 0 {
+		return "0"
+	}
+	if n >= 0 {
+		return sign + s + strings.Repeat("0", n)
+	}
+	if len(s) <= -n {
+		s = strings.Repeat("0", -n-len(s)+1) + s
+	}
+	i := len(s) + n
+	frac := strings.TrimRight(s[i:], "0")
+	if frac == "" {
+		return sign + s[:i]
+	}
+	return sign + s[:i] + "." + frac
+}
+
+// roundRat returns r rounded to an integer, half to even.
+func roundRat(r *big.Rat) *big.Int {
+	q, m := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	// Compare twice the remainder with the denominator.
+	c := new(big.Int).Mul(new(big.Int).Abs(m), big.NewInt(2)).Cmp(r.Denom())
+	if c > 0 || (c == 0 && q.Bit(0) == 1) {
+		if r.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// normalize returns the value and multiplier that represent r best in the
+// range vr.
+func normalize(r *big.Rat, vr valueRange) (int64, *PowerOfTenMultiplierType, error) {
+	if r == nil {
+		r = new(big.Rat)
+	}
+	fits := func(v *big.Int) bool {
+		return v.IsInt64() && v.Int64() >= vr.min && v.Int64() <= vr.max
+	}
+	// Exact representations, with the multiplier closest to 0 first.
+	for _, m := range []int{0, 1, -1, 2, -2, 3, -3, 4, -4, 5, -5, 6, -6, 7, -7, 8, -8, 9, -9} {
+		q := new(big.Rat).Mul(r, pow10(-m))
+		if q.IsInt() && fits(q.Num()) {
+			return q.Num().Int64(), NewPowerOfTenMultiplierType(int8(m)), nil
+		}
+	}
+	// Rounding must not take a negative r into the range of an unsigned
+	// type.
+	if vr.min < 0 || r.Sign() >= 0 {
+		for m := -9; m <= 9; m++ {
+			v := roundRat(new(big.Rat).Mul(r, pow10(-m)))
+			if v.Sign() == 0 {
+				return 0, NewPowerOfTenMultiplierType(0), nil
+			}
+			if fits(v) {
+				return v.Int64(), NewPowerOfTenMultiplierType(int8(m)), nil
+			}
+		}
+	}
+	return 0, nil, fmt.Errorf("%w: %s does not fit a multiplier and an %s value", ErrValueRange, r.FloatString(3), vr.name)
+}
+
+// ratOfFloat returns the shortest decimal that rounds to f, so that 0.1
+// converts to 1×10^-1 rather than to the nearest binary fraction.
+func ratOfFloat(f float64) (*big.Rat, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, fmt.Errorf("%w: %v", ErrValueRange, f)
+	}
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+	return r, nil
+}
+
+// parseDecimal parses a decimal number such as "-1.25" or "3e3".
+func parseDecimal(s string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok || strings.Contains(s, "/") {
+		return nil, fmt.Errorf("sep: invalid decimal %q", s)
+	}
+	return r, nil
+}
+
+func floatOf(r *big.Rat) float64 {
+	f, _ := r.Float64()
+	return f
+}
+
+// sameUnit reports whether u and v are in the same unit, an absent unit
+// matching any.
+func sameUnit(u, v *UnitValueType) bool {
+	if u == nil || v == nil || u.Unit == nil || v.Unit == nil {
+		return true
+	}
+	return u.Unit.Value() == v.Unit.Value()
+}
+
+// sameCurrency reports whether a and b are in the same currency, an absent
+// currency matching any.
+func sameCurrency(a, b *AccountingUnit) bool {
+	if a == nil || b == nil || a.MonetaryUnit == nil || b.MonetaryUnit == nil {
+		return true
+	}
+	return a.MonetaryUnit.Value() == b.MonetaryUnit.Value()
+}
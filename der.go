@@ -0,0 +1,167 @@
+package sep
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Modes returns the names of the modes b sets, the names of its opMod
+// elements such as "opModFixedW", in the order of its fields.
+func (b *DERControlBase) Modes() []string {
+	if b == nil {
+		return nil
+	}
+	var modes []string
+	v := reflect.ValueOf(b).Elem()
+	for i := range v.NumField() {
+		f := v.Type().Field(i)
+		if strings.HasPrefix(f.Name, "OpMod") && !v.Field(i).IsNil() {
+			modes = append(modes, elementName(f))
+		}
+	}
+	return modes
+}
+
+// mode returns the value of the mode of b named name, or nil if b has no
+// such mode.
+func (b *DERControlBase) mode(name string) any {
+	v := reflect.ValueOf(b).Elem()
+	for i := range v.NumField() {
+		if f := v.Type().Field(i); strings.HasPrefix(f.Name, "OpMod") && elementName(f) == name {
+			return v.Field(i).Interface()
+		}
+	}
+	return nil
+}
+
+// elementName returns the name of the element of the field f.
+func elementName(f reflect.StructField) string {
+	return strings.Split(f.Tag.Get("xml"), ",")[0]
+}
+
+// ModeEnabled reports whether s enables the mode named mode, such as
+// "opModVoltVar", in modesEnabled or modesEnabled2. All modes are enabled
+// if s is nil or has neither bitmap.
+func (s *DERSettings) ModeEnabled(mode string) bool {
+	if s == nil || (s.ModesEnabled == nil && s.ModesEnabled2 == nil) {
+		return true
+	}
+	if i := slices.Index(derControlModeNames, mode); i >= 0 {
+		return s.ModesEnabled.Has(DERControlMode(i))
+	}
+	if i := slices.Index(derControlMode2Names, mode); i >= 0 {
+		return s.ModesEnabled2.Has(DERControlMode2(i))
+	}
+	return false
+}
+
+// ModeSource is where the value of a mode of CurrentDERControls came from:
+// an active DERControl, or failing that the DefaultDERControl.
+type ModeSource struct {
+	// Mode is the name of the mode, such as "opModFixedW".
+	Mode    string
+	Control *DERControl
+	Default *DefaultDERControl
+	// NotEnabled is set if the DERSettings do not enable the mode, which
+	// is then left out of the CurrentDERControls.
+	NotEnabled bool
+}
+
+// String describes s.
+func (s ModeSource) String() string {
+	from := "DefaultDERControl " + s.Default.GetHref()
+	if s.Control != nil {
+		from = "DERControl " + s.Control.GetMRID().canonical()
+	}
+	if s.NotEnabled {
+		return fmt.Sprintf("%s from %s, not enabled", s.Mode, from)
+	}
+	return fmt.Sprintf("%s from %s", s.Mode, from)
+}
+
+// CurveResolver returns the DERCurve a DERCurveLink refers to, or nil if
+// it is not known.
+type CurveResolver func(*DERCurveLink) *DERCurve
+
+// EffectiveDERControls returns the CurrentDERControls of a DER, updated at
+// now, given the DERControls active on it in order of precedence, as
+// Resolution.Effective returns them, the DefaultDERControl of its program
+// and its DERSettings. Each mode comes from the first active control that
+// sets it, or else from def, and is left out if settings do not enable it.
+// curves resolves the curves the controls link to; an unresolved curve is
+// given by its href alone. The sources give the provenance of each mode, in
+// the order of the fields of CurrentDERControls.
+func EffectiveDERControls(now time.Time, active []*DERControl, def *DefaultDERControl, settings *DERSettings, curves CurveResolver) (*CurrentDERControls, []ModeSource) {
+	c := NewCurrentDERControls()
+	c.UpdatedTime = NewTimeTypeFromTime(now)
+	var sources []ModeSource
+	cv := reflect.ValueOf(c).Elem()
+	for i := range cv.NumField() {
+		f := cv.Type().Field(i)
+		if !strings.HasPrefix(f.Name, "OpMod") {
+			continue
+		}
+		src := ModeSource{Mode: elementName(f)}
+		var v reflect.Value
+		for _, a := range active {
+			if v = modeOf(a.DERControlBase, f.Name); v.IsValid() {
+				src.Control = a
+				break
+			}
+		}
+		if !v.IsValid() && def != nil {
+			if v = modeOf(def.DERControlBase, f.Name); v.IsValid() {
+				src.Default = def
+			}
+		}
+		if !v.IsValid() {
+			continue
+		}
+		if src.NotEnabled = !settings.ModeEnabled(src.Mode); !src.NotEnabled {
+			if l, ok := v.Interface().(*DERCurveLink); ok {
+				v = reflect.ValueOf(curveControl(l, curves))
+			}
+			cv.Field(i).Set(v)
+		}
+		sources = append(sources, src)
+	}
+	return c, sources
+}
+
+// modeOf returns the field name of b, or the zero Value if b does not set
+// it.
+func modeOf(b *DERControlBase, name string) reflect.Value {
+	if b == nil {
+		return reflect.Value{}
+	}
+	if v := reflect.ValueOf(b).Elem().FieldByName(name); v.IsValid() && !v.IsNil() {
+		return v
+	}
+	return reflect.Value{}
+}
+
+// curveControl returns the curve l links to as a DERCurveControlType.
+func curveControl(l *DERCurveLink, curves CurveResolver) *DERCurveControlType {
+	var curve *DERCurve
+	if curves != nil {
+		curve = curves(l)
+	}
+	if curve == nil {
+		curve = NewDERCurve()
+		if l.Link != nil {
+			curve.HrefAttr = l.HrefAttr
+		}
+	}
+	return &DERCurveControlType{DERCurve: curve, DisabledAttr: l.DisabledAttr}
+}
+
+// Kind returns the kind of the type of c, and false if it has none.
+func (c *DERCapability) Kind() (DERKind, bool) {
+	if c == nil || c.Type == nil || c.Type.UInt8 == nil {
+		return 0, false
+	}
+	return DERKind(c.Type.Value()), true
+}
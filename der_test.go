@@ -0,0 +1,164 @@
+package sep
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func maxLimW(v uint16) *PerCentControlType { return &PerCentControlType{PerCent: NewPerCent(v)} }
+
+func curveLink(href string) *DERCurveLink {
+	return &DERCurveLink{Link: &Link{HrefAttr: href}}
+}
+
+func TestEffectiveDERControls(t *testing.T) {
+	high := testControl(1, 0, 100)
+	high.DERControlBase = &DERControlBase{OpModMaxLimW: maxLimW(5000), OpModConnect: new(bool)}
+	low := testControl(2, 0, 100)
+	energize := true
+	low.DERControlBase = &DERControlBase{
+		OpModMaxLimW:  maxLimW(8000),
+		OpModEnergize: &energize,
+		OpModVoltVar:  curveLink("/dcr/1"),
+	}
+	def := NewDefaultDERControl()
+	def.HrefAttr = "/derp/0/dderc"
+	def.DERControlBase = &DERControlBase{
+		OpModMaxLimW:  maxLimW(10000),
+		OpModVoltWatt: &DERCurveLink{Link: &Link{HrefAttr: "/dcr/2"}, DisabledAttr: true},
+		OpModFixedW:   &SignedPerCentControlType{SignedPerCent: NewSignedPerCent(-5000)},
+	}
+	vv := NewDERCurve()
+	vv.HrefAttr = "/dcr/1"
+	vv.CurveType = NewDERCurveType(uint8(CurveVoltVar))
+	curves := func(l *DERCurveLink) *DERCurve {
+		if l.HrefAttr == "/dcr/1" {
+			return vv
+		}
+		return nil
+	}
+	now := time.Unix(1_700_000_000, 0)
+
+	c, sources := EffectiveDERControls(now, []*DERControl{high, low}, def, nil, curves)
+	if c.UpdatedTime.Value() != now.Unix() {
+		t.Errorf("updatedTime = %d", c.UpdatedTime.Value())
+	}
+	if c.OpModMaxLimW.Value() != 5000 || c.OpModConnect == nil || c.OpModEnergize == nil || !*c.OpModEnergize {
+		t.Errorf("active modes not taken from the first control that sets them: %+v", c)
+	}
+	if c.OpModVoltVar == nil || c.OpModVoltVar.DERCurve != vv {
+		t.Error("opModVoltVar does not hold the resolved curve")
+	}
+	if c.OpModVoltWatt == nil || c.OpModVoltWatt.HrefAttr != "/dcr/2" || !c.OpModVoltWatt.DisabledAttr {
+		t.Errorf("opModVoltWatt = %+v, want the unresolved href, disabled", c.OpModVoltWatt)
+	}
+	if c.OpModFixedW == nil || c.OpModFixedW.Value() != -5000 {
+		t.Error("opModFixedW not taken from the default")
+	}
+
+	want := []struct {
+		mode string
+		from any
+	}{
+		{"opModConnect", high},
+		{"opModEnergize", low},
+		{"opModFixedW", def},
+		{"opModMaxLimW", high},
+		{"opModVoltVar", low},
+		{"opModVoltWatt", def},
+	}
+	if len(sources) != len(want) {
+		t.Fatalf("sources = %v, want %d", sources, len(want))
+	}
+	for i, w := range want {
+		s := sources[i]
+		if s.Mode != w.mode || s.NotEnabled {
+			t.Errorf("source %d = %v, want %s", i, s, w.mode)
+		}
+		switch from := w.from.(type) {
+		case *DERControl:
+			if s.Control != from || s.Default != nil {
+				t.Errorf("%s from %v, want DERControl %s", s.Mode, s, from.MRID.Value())
+			}
+		case *DefaultDERControl:
+			if s.Control != nil || s.Default != from {
+				t.Errorf("%s from %v, want the default", s.Mode, s)
+			}
+		}
+	}
+	if got := sources[0].String(); got != "opModConnect from DERControl 00000000000000000000000000000001" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := sources[2].String(); got != "opModFixedW from DefaultDERControl /derp/0/dderc" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestEffectiveDERControlsNotEnabled(t *testing.T) {
+	ctl := testControl(1, 0, 100)
+	ctl.DERControlBase = &DERControlBase{OpModMaxLimW: maxLimW(5000), OpModConnect: new(bool)}
+	settings := NewDERSettings()
+	settings.ModesEnabled = NewDERControlModes(OpModConnect)
+
+	c, sources := EffectiveDERControls(time.Unix(0, 0), []*DERControl{ctl}, nil, settings, nil)
+	if c.OpModMaxLimW != nil || c.OpModConnect == nil {
+		t.Errorf("modes not enabled are kept, or enabled ones dropped: %+v", c)
+	}
+	if len(sources) != 2 || sources[0].NotEnabled || !sources[1].NotEnabled {
+		t.Fatalf("sources = %v", sources)
+	}
+	if got := sources[1].String(); got != "opModMaxLimW from DERControl 00000000000000000000000000000001, not enabled" {
+		t.Errorf("String() = %q", got)
+	}
+
+	// No active control and no default leave nothing.
+	c, sources = EffectiveDERControls(time.Unix(0, 0), nil, nil, nil, nil)
+	if len(sources) != 0 || c.OpModMaxLimW != nil || c.OpModConnect != nil {
+		t.Errorf("controls from nothing: %v", sources)
+	}
+}
+
+func TestModeEnabled(t *testing.T) {
+	var none *DERSettings
+	if !none.ModeEnabled("opModVoltVar") || !NewDERSettings().ModeEnabled("opModMaxLimWInject") {
+		t.Error("modes are not all enabled without modesEnabled")
+	}
+	s := NewDERSettings()
+	s.ModesEnabled2 = NewDERControlModes2(OpModMaxLimPctVAInject)
+	for mode, want := range map[string]bool{
+		"opModMaxLimPctVAInject": true,
+		"opModMaxLimPctVAAbsorb": false,
+		"opModVoltVar":           false,
+		"opModNoSuchMode":        false,
+	} {
+		if got := s.ModeEnabled(mode); got != want {
+			t.Errorf("ModeEnabled(%s) = %v, want %v", mode, got, want)
+		}
+	}
+}
+
+func TestDERControlBaseModes(t *testing.T) {
+	b := &DERControlBase{OpModVoltVar: curveLink("/dcr/1"), OpModConnect: new(bool), OpModTargetW: &ActivePowerControlType{}}
+	if got, want := b.Modes(), []string{"opModConnect", "opModTargetW", "opModVoltVar"}; !slices.Equal(got, want) {
+		t.Errorf("Modes() = %v, want %v", got, want)
+	}
+	if (*DERControlBase)(nil).Modes() != nil {
+		t.Error("a nil DERControlBase has modes")
+	}
+}
+
+func TestDERCapabilityKind(t *testing.T) {
+	c := NewDERCapability()
+	if _, ok := c.Kind(); ok {
+		t.Error("Kind of a DERCapability without type is known")
+	}
+	c.Type = NewDERType(83)
+	if k, ok := c.Kind(); !ok || k != DERKindPVStorage || k.String() != "PhotovoltaicAndStorage" {
+		t.Errorf("Kind() = %v, %v", k, ok)
+	}
+	c.Type = NewDERType(50)
+	if k, _ := c.Kind(); k.Valid() || k.String() != "Reserved(50)" {
+		t.Errorf("Kind() = %v, want a reserved value", k)
+	}
+}
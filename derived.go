@@ -0,0 +1,332 @@
+package sep
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/Tylores/sep/internal/xsd"
+)
+
+// Resourcer is implemented by *Resource and by a pointer to every type
+// derived from Resource. Notification.Resource holds a Resourcer so that a
+// notification carries the resource it reports in its concrete type.
+type Resourcer interface {
+	resource()
+}
+
+func (r *Resource) resource() {}
+
+// Responder is implemented by *Response and by a pointer to every type
+// derived from Response, such as *DERControlResponse and *PriceResponse.
+type Responder interface {
+	response()
+}
+
+func (r *Response) response() {}
+
+// xsiType is the name of the xsi:type attribute as the decoder reports it.
+var xsiType = xml.Name{Space: xsd.InstanceNamespace, Local: "type"}
+
+// encodeDerived encodes v as the element start. A value whose type is not
+// the declared type base of the element names its type in xsi:type.
+func encodeDerived(e *xml.Encoder, v any, base reflect.Type, start xml.StartElement) error {
+	rv := reflect.ValueOf(v)
+	if v == nil || rv.IsNil() {
+		return nil
+	}
+	if t := rv.Type().Elem(); t != base {
+		name, ok := complexTypeNames[t]
+		if !ok {
+			return fmt.Errorf("sep: %s is not a type of the schema", t)
+		}
+		start.Attr = append(start.Attr,
+			xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsd.InstanceNamespace},
+			xml.Attr{Name: xml.Name{Local: "xsi:type"}, Value: name})
+	}
+	return e.EncodeElement(v, start)
+}
+
+// decodeDerived decodes the element start into a new value of the type
+// named by its xsi:type attribute, or of base when the attribute is absent.
+// The result is a pointer to the decoded value. It is an error for xsi:type
+// to name a type that is not a type of the schema derived from base.
+func decodeDerived(d *xml.Decoder, base reflect.Type, start xml.StartElement) (any, error) {
+	t, name := base, complexTypeNames[base]
+	for _, a := range start.Attr {
+		if a.Name != xsiType {
+			continue
+		}
+		qname, err := resolveQName(strings.TrimSpace(a.Value), start)
+		if err != nil {
+			return nil, err
+		}
+		u, ok := complexTypes[qname.Local]
+		if qname.Space != Namespace || !ok {
+			return nil, fmt.Errorf("sep: xsi:type %q is not a type of %s", a.Value, Namespace)
+		}
+		if !derivesFrom(u, base) {
+			return nil, fmt.Errorf("sep: xsi:type %s does not derive from %s", qname.Local, name)
+		}
+		t, name = u, qname.Local
+	}
+	// The element is named after its declaration rather than after its
+	// type, so decode it under the name the type expects.
+	start.Name = xml.Name{Space: Namespace, Local: name}
+	v := reflect.New(t)
+	if err := d.DecodeElement(v.Interface(), &start); err != nil {
+		return nil, err
+	}
+	return v.Interface(), nil
+}
+
+// resolveQName resolves the QName value v of an attribute of start against
+// the namespace declarations of start. Unmarshal declares there the prefix
+// of every xsi:type; see declareTypePrefixes. Otherwise, as after
+// xml.Unmarshal, an unprefixed name without a default namespace declared on
+// start takes the namespace of start, which is the default namespace unless
+// start itself is prefixed.
+func resolveQName(v string, start xml.StartElement) (xml.Name, error) {
+	decls := declarations(start)
+	prefix, local, ok := strings.Cut(v, ":")
+	if !ok {
+		ns, ok := decls[""]
+		if !ok {
+			ns = start.Name.Space
+		}
+		return xml.Name{Space: ns, Local: v}, nil
+	}
+	ns, ok := decls[prefix]
+	if !ok {
+		return xml.Name{}, fmt.Errorf("sep: QName %q uses an undeclared prefix", v)
+	}
+	return xml.Name{Space: ns, Local: local}, nil
+}
+
+// declareTypePrefixes returns data with the namespace of the prefix of
+// every xsi:type value declared on the element carrying it, if it is
+// declared further out, so that resolveQName finds it on the element. It
+// returns data itself if there is nothing to declare or data is not
+// well-formed, which decoding then reports.
+func declareTypePrefixes(data []byte) []byte {
+	if !bytes.Contains(data, []byte(xsd.InstanceNamespace)) {
+		return data
+	}
+	d := xml.NewDecoder(bytes.NewReader(data))
+	var (
+		open  scopes
+		out   []byte
+		next  int64
+		added bool
+	)
+	for {
+		off := d.InputOffset()
+		tok, err := d.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return data
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			own := declarations(tok)
+			open = append(open, own)
+			for _, a := range tok.Attr {
+				if a.Name.Local != "type" || a.Name.Space == "" {
+					continue
+				}
+				if ns, _ := open.lookup(a.Name.Space); ns != xsd.InstanceNamespace {
+					continue
+				}
+				prefix, _, ok := strings.Cut(strings.TrimSpace(a.Value), ":")
+				if !ok {
+					prefix = ""
+				}
+				ns, ok := open.lookup(prefix)
+				if _, declared := own[prefix]; declared || !ok {
+					continue
+				}
+				name := tok.Name.Local
+				if tok.Name.Space != "" {
+					name = tok.Name.Space + ":" + name
+				}
+				at := off + int64(len("<"+name))
+				out = append(out, data[next:at]...)
+				var b strings.Builder
+				xml.EscapeText(&b, []byte(ns))
+				if prefix == "" {
+					out = fmt.Appendf(out, ` xmlns="%s"`, b.String())
+				} else {
+					out = fmt.Appendf(out, ` xmlns:%s="%s"`, prefix, b.String())
+				}
+				next, added = at, true
+			}
+		case xml.EndElement:
+			if len(open) > 0 {
+				open = open[:len(open)-1]
+			}
+		}
+	}
+	if !added {
+		return data
+	}
+	return append(out, data[next:]...)
+}
+
+// derivesFrom reports whether the Go type t is base or embeds it along its
+// chain of base types.
+func derivesFrom(t, base reflect.Type) bool {
+	for t != base {
+		if t.Kind() != reflect.Struct {
+			return false
+		}
+		var next reflect.Type
+		for i := 0; i < t.NumField(); i++ {
+			if f := t.Field(i); f.Anonymous && f.Type.Kind() == reflect.Pointer {
+				next = f.Type.Elem()
+				break
+			}
+		}
+		if next == nil {
+			return false
+		}
+		t = next
+	}
+	return true
+}
+
+// resourceElement encodes a Resource element that may hold any Resourcer.
+type resourceElement struct{ v Resourcer }
+
+func (r *resourceElement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeDerived(e, r.v, reflect.TypeFor[Resource](), start)
+}
+
+func (r *resourceElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v, err := decodeDerived(d, reflect.TypeFor[Resource](), start)
+	if err != nil {
+		return err
+	}
+	r.v = v.(Resourcer)
+	return nil
+}
+
+// responseElement encodes a Response element that may hold any Responder.
+type responseElement struct{ v Responder }
+
+func (r *responseElement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeDerived(e, r.v, reflect.TypeFor[Response](), start)
+}
+
+func (r *responseElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v, err := decodeDerived(d, reflect.TypeFor[Response](), start)
+	if err != nil {
+		return err
+	}
+	r.v = v.(Responder)
+	return nil
+}
+
+// notificationContent mirrors Notification with the polymorphic Resource
+// element.
+type notificationContent struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns Notification"`
+	*SubscriptionBase
+	noMarshalXML
+	SchemaVerAttr   SEPVersion       `xml:"schemaVer,attr,omitempty"`
+	CreatedDateTime *TimeType        `xml:"createdDateTime"`
+	NewResourceURI  *string          `xml:"newResourceURI"`
+	Resource        *resourceElement `xml:"Resource"`
+	Status          uint8            `xml:"status"`
+	SubscriptionURI string           `xml:"subscriptionURI"`
+	Notificationr23 *Revision23Type  `xml:"Notification_r2_3"`
+}
+
+// MarshalXML encodes n, naming the concrete type of n.Resource in xsi:type.
+func (n Notification) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	c := notificationContent{
+		XMLName:          n.XMLName,
+		SubscriptionBase: n.SubscriptionBase,
+		SchemaVerAttr:    n.SchemaVerAttr,
+		CreatedDateTime:  n.CreatedDateTime,
+		NewResourceURI:   n.NewResourceURI,
+		Status:           n.Status,
+		SubscriptionURI:  n.SubscriptionURI,
+		Notificationr23:  n.Notificationr23,
+	}
+	if n.Resource != nil {
+		c.Resource = &resourceElement{n.Resource}
+	}
+	return marshalInPlace(e, start, &c, func(c *notificationContent) any { return c })
+}
+
+// UnmarshalXML decodes n, giving n.Resource the type named by its xsi:type.
+func (n *Notification) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var c notificationContent
+	if err := d.DecodeElement(&c, &start); err != nil {
+		return err
+	}
+	*n = Notification{
+		XMLName:          c.XMLName,
+		SubscriptionBase: c.SubscriptionBase,
+		SchemaVerAttr:    c.SchemaVerAttr,
+		CreatedDateTime:  c.CreatedDateTime,
+		NewResourceURI:   c.NewResourceURI,
+		Status:           c.Status,
+		SubscriptionURI:  c.SubscriptionURI,
+		Notificationr23:  c.Notificationr23,
+	}
+	if c.Resource != nil {
+		n.Resource = c.Resource.v
+	}
+	return nil
+}
+
+// responseListContent mirrors ResponseList with polymorphic Response
+// elements.
+type responseListContent struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns ResponseList"`
+	*List
+	noMarshalXML
+	SchemaVerAttr   SEPVersion         `xml:"schemaVer,attr,omitempty"`
+	Response        []*responseElement `xml:"Response"`
+	ResponseListr23 *Revision23Type    `xml:"ResponseList_r2_3"`
+}
+
+// MarshalXML encodes l, naming the concrete type of each response in
+// xsi:type.
+func (l ResponseList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	c := responseListContent{
+		XMLName:         l.XMLName,
+		List:            l.List,
+		SchemaVerAttr:   l.SchemaVerAttr,
+		ResponseListr23: l.ResponseListr23,
+	}
+	for _, r := range l.Response {
+		c.Response = append(c.Response, &responseElement{r})
+	}
+	return marshalInPlace(e, start, &c, func(c *responseListContent) any { return c })
+}
+
+// UnmarshalXML decodes l, giving each response the type named by its
+// xsi:type.
+func (l *ResponseList) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var c responseListContent
+	if err := d.DecodeElement(&c, &start); err != nil {
+		return err
+	}
+	*l = ResponseList{
+		XMLName:         c.XMLName,
+		List:            c.List,
+		SchemaVerAttr:   c.SchemaVerAttr,
+		ResponseListr23: c.ResponseListr23,
+	}
+	for _, r := range c.Response {
+		l.Response = append(l.Response, r.v)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package sep
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// notification returns a Notification document declaring attrs on its root
+// and carrying resource.
+func notification(attrs, resource string) string {
+	return `<Notification xmlns="urn:ieee:std:2030.5:ns" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"` + attrs + ` href="/ntfy">` +
+		`<subscribedResource>/edev/1</subscribedResource>` + resource +
+		`<status>0</status><subscriptionURI>/notify</subscriptionURI></Notification>`
+}
+
+func TestNotificationResource(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		doc  string
+		// want is the type of the decoded resource, or "" for an error.
+		want string
+	}{
+		{"unprefixed", notification("", `<Resource xsi:type="EndDevice" href="/edev/1"><sFDI>5</sFDI></Resource>`), "*sep.EndDevice"},
+		{"prefix declared on the element", notification("", `<Resource xmlns:s="urn:ieee:std:2030.5:ns" xsi:type="s:DERControl" href="/c"/>`), "*sep.DERControl"},
+		{"prefix declared on the root", notification(` xmlns:s="urn:ieee:std:2030.5:ns"`, `<Resource xsi:type="s:EndDevice" href="/edev/1"/>`), "*sep.EndDevice"},
+		{"xsi under another prefix", notification(` xmlns:i="http://www.w3.org/2001/XMLSchema-instance"`, `<Resource i:type="EndDevice" href="/edev/1"/>`), "*sep.EndDevice"},
+		{"default namespace redeclared", `<s:Notification xmlns:s="urn:ieee:std:2030.5:ns" xmlns="urn:ieee:std:2030.5:ns" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">` +
+			`<s:Resource xsi:type="EndDevice"/></s:Notification>`, "*sep.EndDevice"},
+		{"without xsi:type", notification("", `<Resource href="/r"/>`), "*sep.Resource"},
+		{"unknown type", notification("", `<Resource xsi:type="Nothing"/>`), ""},
+		{"type of another namespace", notification(` xmlns:o="urn:other"`, `<Resource xsi:type="o:EndDevice"/>`), ""},
+		{"unprefixed in another default namespace", `<s:Notification xmlns:s="urn:ieee:std:2030.5:ns" xmlns="urn:other" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">` +
+			`<s:Resource xsi:type="EndDevice"/></s:Notification>`, ""},
+		{"undeclared prefix", notification("", `<Resource xsi:type="p:EndDevice"/>`), ""},
+		{"not derived from Resource", notification("", `<Resource xsi:type="Temperature"/>`), ""},
+	} {
+		var n Notification
+		err := Unmarshal([]byte(tt.doc), &n)
+		switch {
+		case tt.want == "" && err == nil:
+			t.Errorf("%s: decoded %T, want an error", tt.name, n.Resource)
+		case tt.want != "" && err != nil:
+			t.Errorf("%s: %v", tt.name, err)
+		case tt.want != "" && fmt.Sprintf("%T", n.Resource) != tt.want:
+			t.Errorf("%s: resource %T, want %s", tt.name, n.Resource, tt.want)
+		}
+	}
+
+	// xml.Unmarshal sees only the declarations on the element itself.
+	var n Notification
+	if err := xml.Unmarshal([]byte(notification("", `<Resource xsi:type="EndDevice"/>`)), &n); err != nil {
+		t.Errorf("xml.Unmarshal, unprefixed: %v", err)
+	} else if _, ok := n.Resource.(*EndDevice); !ok {
+		t.Errorf("xml.Unmarshal, unprefixed: resource %T", n.Resource)
+	}
+	doc := notification(` xmlns:s="urn:ieee:std:2030.5:ns"`, `<Resource xsi:type="s:EndDevice"/>`)
+	if err := xml.Unmarshal([]byte(doc), &n); err == nil || !strings.Contains(err.Error(), "undeclared prefix") {
+		t.Errorf("xml.Unmarshal, prefix declared on the root: %v", err)
+	}
+}
+
+func TestNotificationRoundTrip(t *testing.T) {
+	d := NewEndDevice()
+	d.HrefAttr, d.SFDI, d.ChangedTime = "/edev/1", NewSFDIType(5), NewTimeType(0)
+	n := NewNotification()
+	n.HrefAttr, n.SubscribedResource, n.SubscriptionURI, n.Resource = "/ntfy", "/edev/1", "/notify", d
+	out, err := xml.Marshal(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = `<Resource xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="EndDevice" href="/edev/1">`
+	if !strings.Contains(string(out), want) {
+		t.Errorf("marshalled %s, want it to contain %s", out, want)
+	}
+	if err := Validate(out); err != nil {
+		t.Error(err)
+	}
+	var back Notification
+	if err := Unmarshal(out, &back); err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := back.Resource.(*EndDevice); !ok || got.HrefAttr != "/edev/1" || got.SFDI.Value() != 5 {
+		t.Errorf("resource %#v", back.Resource)
+	}
+}
+
+func TestResponseList(t *testing.T) {
+	const doc = `<ResponseList xmlns="urn:ieee:std:2030.5:ns" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:s="urn:ieee:std:2030.5:ns" all="3" results="3">` +
+		`<Response xsi:type="s:DERControlResponse"><endDeviceLFDI>01</endDeviceLFDI><modesResponded>00000004</modesResponded></Response>` +
+		`<Response><endDeviceLFDI>02</endDeviceLFDI></Response>` +
+		`<Response xsi:type="PriceResponse"><endDeviceLFDI>03</endDeviceLFDI></Response>` +
+		`</ResponseList>`
+	var l ResponseList
+	if err := Unmarshal([]byte(doc), &l); err != nil {
+		t.Fatal(err)
+	}
+	var types []string
+	for _, r := range l.Response {
+		types = append(types, reflect.TypeOf(r).String())
+	}
+	if got := strings.Join(types, " "); got != "*sep.DERControlResponse *sep.Response *sep.PriceResponse" {
+		t.Fatalf("responses %s", got)
+	}
+	if r := l.Response[0].(*DERControlResponse); r.EndDeviceLFDI != "01" || !r.ModesResponded.Has(OpModConnect) {
+		t.Errorf("DERControlResponse %+v", r)
+	}
+
+	out, err := xml.Marshal(&l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`<Response xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="DERControlResponse">`,
+		`<Response xmlns="urn:ieee:std:2030.5:ns"><endDeviceLFDI>02</endDeviceLFDI>`,
+		`<Response xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="PriceResponse">`,
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("marshalled %s, want it to contain %s", out, want)
+		}
+	}
+	var back ResponseList
+	if err := Unmarshal(out, &back); err != nil || !reflect.DeepEqual(back.Response, l.Response) {
+		t.Errorf("round trip %+v, %v, want %+v", back.Response, err, l.Response)
+	}
+
+	bad := strings.Replace(doc, "s:DERControlResponse", "EndDevice", 1)
+	if err := Unmarshal([]byte(bad), &back); err == nil || !strings.Contains(err.Error(), "does not derive from Response") {
+		t.Errorf("Response of type EndDevice: %v", err)
+	}
+}
@@ -0,0 +1,23 @@
+// Package sep provides the IEEE 2030.5 (Smart Energy Profile) resource models.
+//
+// Every global element of the schema marshals as a root element in Namespace,
+// and decoding a root element requires it to carry that namespace.
+//
+// Struct fields follow the xs:sequence of their complex type, with the
+// embedded base type first so that inherited elements are emitted before the
+// derived ones. Optional elements of a scalar type are pointers, so an unset
+// element is left out rather than emitted as its zero value.
+//
+// The root element types carry the schemaVer attribute in SchemaVerAttr.
+// Encode and MarshalEXI write SchemaVersion there when it is unset; nested
+// elements leave it out.
+package sep
+
+//go:generate go run ./internal/gen
+
+// Namespace is the XML namespace of every element defined by the IEEE 2030.5 schema.
+const Namespace = "urn:ieee:std:2030.5:ns"
+
+// SchemaVersion is the version of the bundled sep.xsd, written in the
+// schemaVer attribute of root elements.
+const SchemaVersion = "2.2"
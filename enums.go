@@ -0,0 +1,632 @@
+package sep
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The UInt8 fields whose values the schema enumerates only in its
+// annotations have named types. They marshal and unmarshal as the same
+// decimal numbers, so documents are unchanged, and read as names in logs;
+// the Parse function of each type reads a name back. Decoding accepts
+// reserved values for forward compatibility; Valid and the Validate methods
+// report them.
+
+// enumeration is implemented by the named types of enumerated fields.
+type enumeration interface {
+	Valid() bool
+}
+
+func enumString[T ~uint8](v T, names map[T]string) string {
+	if s, ok := names[v]; ok {
+		return s
+	}
+	return "Reserved(" + strconv.Itoa(int(v)) + ")"
+}
+
+// unmarshalEnum sets v from its decimal value.
+func unmarshalEnum[T ~uint8](text []byte, v *T) error {
+	s := strings.TrimSpace(string(text))
+	n, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return fmt.Errorf("sep: invalid %T %q", *v, s)
+	}
+	*v = T(n)
+	return nil
+}
+
+// parseEnum returns the value named s, ignoring case, as enumString names
+// it: a name of names or Reserved(n).
+func parseEnum[T ~uint8](s string, names map[T]string) (T, error) {
+	for k, name := range names {
+		if strings.EqualFold(s, name) {
+			return k, nil
+		}
+	}
+	if d, ok := strings.CutPrefix(s, "Reserved("); ok {
+		if d, ok := strings.CutSuffix(d, ")"); ok {
+			if n, err := strconv.ParseUint(d, 10, 8); err == nil {
+				if _, named := names[T(n)]; !named {
+					return T(n), nil
+				}
+			}
+		}
+	}
+	var zero T
+	return zero, fmt.Errorf("sep: unknown %T %q", zero, s)
+}
+
+// EventStatusCode is the currentStatus of an EventStatus.
+type EventStatusCode uint8
+
+// Values of EventStatusCode.
+const (
+	EventScheduled                  EventStatusCode = 0
+	EventActive                     EventStatusCode = 1
+	EventCancelled                  EventStatusCode = 2
+	EventCancelledWithRandomization EventStatusCode = 3
+	// EventSuperseded is deprecated; servers no longer use it, but those
+	// of previous revisions may.
+	EventSuperseded EventStatusCode = 4
+	EventCompleted  EventStatusCode = 5
+)
+
+var eventStatusCodeNames = map[EventStatusCode]string{
+	EventScheduled:                  "Scheduled",
+	EventActive:                     "Active",
+	EventCancelled:                  "Cancelled",
+	EventCancelledWithRandomization: "CancelledWithRandomization",
+	EventSuperseded:                 "Superseded",
+	EventCompleted:                  "Completed",
+}
+
+// String returns the name of s, or Reserved(n) for a reserved value.
+func (s EventStatusCode) String() string { return enumString(s, eventStatusCodeNames) }
+
+// Valid reports whether s is not a reserved value.
+func (s EventStatusCode) Valid() bool { _, ok := eventStatusCodeNames[s]; return ok }
+
+// MarshalText implements encoding.TextMarshaler.
+func (s EventStatusCode) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(s), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the
+// decimal value of s; see ParseEventStatusCode for a name.
+func (s *EventStatusCode) UnmarshalText(text []byte) error {
+	return unmarshalEnum(text, s)
+}
+
+// ParseEventStatusCode returns the EventStatusCode named s, as String names
+// it, ignoring case.
+func ParseEventStatusCode(s string) (EventStatusCode, error) {
+	return parseEnum(s, eventStatusCodeNames)
+}
+
+// ResponseStatus is the status of a Response, as listed in the table of
+// response types by function set.
+type ResponseStatus uint8
+
+// Values of ResponseStatus.
+const (
+	ResponseEventReceived                 ResponseStatus = 1
+	ResponseEventStarted                  ResponseStatus = 2
+	ResponseEventCompleted                ResponseStatus = 3
+	ResponseEventOptOut                   ResponseStatus = 4
+	ResponseEventOptIn                    ResponseStatus = 5
+	ResponseEventCancelled                ResponseStatus = 6
+	ResponseEventSuperseded               ResponseStatus = 7
+	ResponseEventPartialOptOut            ResponseStatus = 8
+	ResponseEventPartialOptIn             ResponseStatus = 9
+	ResponseEventCompletedNoParticipation ResponseStatus = 10
+	ResponseUserAcknowledged              ResponseStatus = 11
+	ResponseMessageNotDisplayed           ResponseStatus = 12
+	ResponseEventAbortedServer            ResponseStatus = 13
+	ResponseEventAbortedProgram           ResponseStatus = 14
+	ResponseEventNotApplicable            ResponseStatus = 252
+	ResponseEventInvalid                  ResponseStatus = 253
+	ResponseEventExpired                  ResponseStatus = 254
+)
+
+var responseStatusNames = map[ResponseStatus]string{
+	ResponseEventReceived:                 "EventReceived",
+	ResponseEventStarted:                  "EventStarted",
+	ResponseEventCompleted:                "EventCompleted",
+	ResponseEventOptOut:                   "EventOptOut",
+	ResponseEventOptIn:                    "EventOptIn",
+	ResponseEventCancelled:                "EventCancelled",
+	ResponseEventSuperseded:               "EventSuperseded",
+	ResponseEventPartialOptOut:            "EventPartialOptOut",
+	ResponseEventPartialOptIn:             "EventPartialOptIn",
+	ResponseEventCompletedNoParticipation: "EventCompletedNoParticipation",
+	ResponseUserAcknowledged:              "UserAcknowledged",
+	ResponseMessageNotDisplayed:           "MessageNotDisplayed",
+	ResponseEventAbortedServer:            "EventAbortedServer",
+	ResponseEventAbortedProgram:           "EventAbortedProgram",
+	ResponseEventNotApplicable:            "EventNotApplicable",
+	ResponseEventInvalid:                  "EventInvalid",
+	ResponseEventExpired:                  "EventExpired",
+}
+
+// String returns the name of s, or Reserved(n) for a reserved value.
+func (s ResponseStatus) String() string { return enumString(s, responseStatusNames) }
+
+// Valid reports whether s is not a reserved value.
+func (s ResponseStatus) Valid() bool { _, ok := responseStatusNames[s]; return ok }
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ResponseStatus) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(s), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the
+// decimal value of s; see ParseResponseStatus for a name.
+func (s *ResponseStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnum(text, s)
+}
+
+// ParseResponseStatus returns the ResponseStatus named s, as String names
+// it, ignoring case.
+func ParseResponseStatus(s string) (ResponseStatus, error) {
+	return parseEnum(s, responseStatusNames)
+}
+
+// FileLoadStatus is the status of a FileStatus: the progress of loading and
+// activating the file indicated by its FileLink.
+type FileLoadStatus uint8
+
+// Values of FileLoadStatus.
+const (
+	FileLoadNone                 FileLoadStatus = 0
+	FileLoadInProgress           FileLoadStatus = 1
+	FileLoadFailed               FileLoadStatus = 2
+	FileLoadVerifying            FileLoadStatus = 3
+	FileLoadVerificationFailed   FileLoadStatus = 4
+	FileLoadVerified             FileLoadStatus = 5
+	FileLoadActivationFailed     FileLoadStatus = 6
+	FileLoadActivationInProgress FileLoadStatus = 7
+	FileLoadActivated            FileLoadStatus = 8
+)
+
+var fileLoadStatusNames = map[FileLoadStatus]string{
+	FileLoadNone:                 "None",
+	FileLoadInProgress:           "InProgress",
+	FileLoadFailed:               "Failed",
+	FileLoadVerifying:            "Verifying",
+	FileLoadVerificationFailed:   "VerificationFailed",
+	FileLoadVerified:             "Verified",
+	FileLoadActivationFailed:     "ActivationFailed",
+	FileLoadActivationInProgress: "ActivationInProgress",
+	FileLoadActivated:            "Activated",
+}
+
+// String returns the name of s, or Reserved(n) for a reserved value.
+func (s FileLoadStatus) String() string { return enumString(s, fileLoadStatusNames) }
+
+// Valid reports whether s is not a reserved value.
+func (s FileLoadStatus) Valid() bool { _, ok := fileLoadStatusNames[s]; return ok }
+
+// MarshalText implements encoding.TextMarshaler.
+func (s FileLoadStatus) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(s), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the
+// decimal value of s; see ParseFileLoadStatus for a name.
+func (s *FileLoadStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnum(text, s)
+}
+
+// ParseFileLoadStatus returns the FileLoadStatus named s, as String names
+// it, ignoring case.
+func ParseFileLoadStatus(s string) (FileLoadStatus, error) {
+	return parseEnum(s, fileLoadStatusNames)
+}
+
+// RequestStatusCode is the requestStatus of a RequestStatus.
+type RequestStatusCode uint8
+
+// Values of RequestStatusCode.
+const (
+	RequestRequested RequestStatusCode = 0
+	RequestCancelled RequestStatusCode = 1
+)
+
+var requestStatusCodeNames = map[RequestStatusCode]string{
+	RequestRequested: "Requested",
+	RequestCancelled: "Cancelled",
+}
+
+// String returns the name of s, or Reserved(n) for a reserved value.
+func (s RequestStatusCode) String() string { return enumString(s, requestStatusCodeNames) }
+
+// Valid reports whether s is not a reserved value.
+func (s RequestStatusCode) Valid() bool { _, ok := requestStatusCodeNames[s]; return ok }
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RequestStatusCode) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(s), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the
+// decimal value of s; see ParseRequestStatusCode for a name.
+func (s *RequestStatusCode) UnmarshalText(text []byte) error {
+	return unmarshalEnum(text, s)
+}
+
+// ParseRequestStatusCode returns the RequestStatusCode named s, as String
+// names it, ignoring case.
+func ParseRequestStatusCode(s string) (RequestStatusCode, error) {
+	return parseEnum(s, requestStatusCodeNames)
+}
+
+// InverterStatus is the value of an InverterStatusType.
+type InverterStatus uint8
+
+// Values of InverterStatus.
+const (
+	InverterNotApplicable InverterStatus = 0
+	InverterOff           InverterStatus = 1
+	// InverterSleeping is auto-shutdown, or low output power or voltage.
+	InverterSleeping InverterStatus = 2
+	// InverterStarting is starting up, or on but not producing power.
+	InverterStarting     InverterStatus = 3
+	InverterRunning      InverterStatus = 4
+	InverterDerating     InverterStatus = 5
+	InverterShuttingDown InverterStatus = 6
+	InverterFault        InverterStatus = 7
+	// InverterStandby is service on the unit; the DER may be at high output
+	// voltage or power.
+	InverterStandby      InverterStatus = 8
+	InverterTestMode     InverterStatus = 9
+	InverterManufacturer InverterStatus = 10
+)
+
+var inverterStatusNames = map[InverterStatus]string{
+	InverterNotApplicable: "NotApplicable",
+	InverterOff:           "Off",
+	InverterSleeping:      "Sleeping",
+	InverterStarting:      "Starting",
+	InverterRunning:       "Running",
+	InverterDerating:      "Derating",
+	InverterShuttingDown:  "ShuttingDown",
+	InverterFault:         "Fault",
+	InverterStandby:       "Standby",
+	InverterTestMode:      "TestMode",
+	InverterManufacturer:  "Manufacturer",
+}
+
+// String returns the name of s, or Reserved(n) for a reserved value.
+func (s InverterStatus) String() string { return enumString(s, inverterStatusNames) }
+
+// Valid reports whether s is not a reserved value.
+func (s InverterStatus) Valid() bool { _, ok := inverterStatusNames[s]; return ok }
+
+// MarshalText implements encoding.TextMarshaler.
+func (s InverterStatus) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(s), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the
+// decimal value of s; see ParseInverterStatus for a name.
+func (s *InverterStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnum(text, s)
+}
+
+// ParseInverterStatus returns the InverterStatus named s, as String names
+// it, ignoring case.
+func ParseInverterStatus(s string) (InverterStatus, error) {
+	return parseEnum(s, inverterStatusNames)
+}
+
+// SubscriptionEncoding is the encoding of the notifications of a
+// Subscription.
+type SubscriptionEncoding uint8
+
+// Values of SubscriptionEncoding.
+const (
+	EncodingXML SubscriptionEncoding = 0
+	EncodingEXI SubscriptionEncoding = 1
+)
+
+var subscriptionEncodingNames = map[SubscriptionEncoding]string{
+	EncodingXML: MediaTypeXML,
+	EncodingEXI: MediaTypeEXI,
+}
+
+// String returns the media type of e, or Reserved(n) for a reserved value.
+func (e SubscriptionEncoding) String() string { return enumString(e, subscriptionEncodingNames) }
+
+// Valid reports whether e is not a reserved value.
+func (e SubscriptionEncoding) Valid() bool { _, ok := subscriptionEncodingNames[e]; return ok }
+
+// MediaType returns the media type of e, for use with Encode, or "" for a
+// reserved value.
+func (e SubscriptionEncoding) MediaType() string { return subscriptionEncodingNames[e] }
+
+// MarshalText implements encoding.TextMarshaler.
+func (e SubscriptionEncoding) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(e), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the
+// decimal value of e; see ParseSubscriptionEncoding for a media type.
+func (e *SubscriptionEncoding) UnmarshalText(text []byte) error {
+	return unmarshalEnum(text, e)
+}
+
+// ParseSubscriptionEncoding returns the SubscriptionEncoding of the media
+// type s, ignoring case.
+func ParseSubscriptionEncoding(s string) (SubscriptionEncoding, error) {
+	return parseEnum(s, subscriptionEncodingNames)
+}
+
+// BatteryStatus is the batteryStatus of a PowerStatus.
+type BatteryStatus uint8
+
+// Values of BatteryStatus.
+const (
+	BatteryUnknown BatteryStatus = 0
+	// BatteryNormal is more than LowChargeThreshold remaining.
+	BatteryNormal BatteryStatus = 1
+	// BatteryLow is less than LowChargeThreshold remaining.
+	BatteryLow      BatteryStatus = 2
+	BatteryDepleted BatteryStatus = 3
+	// BatteryNotApplicable is mains powered only.
+	BatteryNotApplicable BatteryStatus = 4
+)
+
+var batteryStatusNames = map[BatteryStatus]string{
+	BatteryUnknown:       "Unknown",
+	BatteryNormal:        "Normal",
+	BatteryLow:           "Low",
+	BatteryDepleted:      "Depleted",
+	BatteryNotApplicable: "NotApplicable",
+}
+
+// String returns the name of s, or Reserved(n) for a reserved value.
+func (s BatteryStatus) String() string { return enumString(s, batteryStatusNames) }
+
+// Valid reports whether s is not a reserved value.
+func (s BatteryStatus) Valid() bool { _, ok := batteryStatusNames[s]; return ok }
+
+// MarshalText implements encoding.TextMarshaler.
+func (s BatteryStatus) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(s), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the
+// decimal value of s; see ParseBatteryStatus for a name.
+func (s *BatteryStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnum(text, s)
+}
+
+// ParseBatteryStatus returns the BatteryStatus named s, as String names it,
+// ignoring case.
+func ParseBatteryStatus(s string) (BatteryStatus, error) {
+	return parseEnum(s, batteryStatusNames)
+}
+
+// OperationalState is the opState of a DeviceStatus.
+type OperationalState uint8
+
+// Values of OperationalState.
+const (
+	OpStateUnknown         OperationalState = 0
+	OpStateNotOperating    OperationalState = 1
+	OpStateOperating       OperationalState = 2
+	OpStateStartingUp      OperationalState = 3
+	OpStateShuttingDown    OperationalState = 4
+	OpStateDisconnectLevel OperationalState = 5
+	OpStateKWRamping       OperationalState = 6
+	OpStateKVarRamping     OperationalState = 7
+)
+
+var operationalStateNames = map[OperationalState]string{
+	OpStateUnknown:         "Unknown",
+	OpStateNotOperating:    "NotOperating",
+	OpStateOperating:       "Operating",
+	OpStateStartingUp:      "StartingUp",
+	OpStateShuttingDown:    "ShuttingDown",
+	OpStateDisconnectLevel: "DisconnectLevel",
+	OpStateKWRamping:       "KWRamping",
+	OpStateKVarRamping:     "KVarRamping",
+}
+
+// String returns the name of s, or Reserved(n) for a reserved value.
+func (s OperationalState) String() string { return enumString(s, operationalStateNames) }
+
+// Valid reports whether s is not a reserved value.
+func (s OperationalState) Valid() bool { _, ok := operationalStateNames[s]; return ok }
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OperationalState) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(s), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the
+// decimal value of s; see ParseOperationalState for a name.
+func (s *OperationalState) UnmarshalText(text []byte) error {
+	return unmarshalEnum(text, s)
+}
+
+// ParseOperationalState returns the OperationalState named s, as String
+// names it, ignoring case.
+func ParseOperationalState(s string) (OperationalState, error) {
+	return parseEnum(s, operationalStateNames)
+}
+
+// DERKind is the type of a DERCapability: the kind of DER.
+type DERKind uint8
+
+// Values of DERKind.
+const (
+	DERKindNA DERKind = 0
+	// DERKindVirtual is a virtual or mixed DER.
+	DERKindVirtual         DERKind = 1
+	DERKindReciprocating   DERKind = 2
+	DERKindFuelCell        DERKind = 3
+	DERKindPV              DERKind = 4
+	DERKindCHP             DERKind = 5
+	DERKindOtherGeneration DERKind = 6
+	DERKindStorage         DERKind = 80
+	DERKindEV              DERKind = 81
+	DERKindEVSE            DERKind = 82
+	DERKindPVStorage       DERKind = 83
+)
+
+var derKindNames = map[DERKind]string{
+	DERKindNA:              "NotApplicable",
+	DERKindVirtual:         "Virtual",
+	DERKindReciprocating:   "ReciprocatingEngine",
+	DERKindFuelCell:        "FuelCell",
+	DERKindPV:              "Photovoltaic",
+	DERKindCHP:             "CombinedHeatAndPower",
+	DERKindOtherGeneration: "OtherGeneration",
+	DERKindStorage:         "Storage",
+	DERKindEV:              "ElectricVehicle",
+	DERKindEVSE:            "EVSE",
+	DERKindPVStorage:       "PhotovoltaicAndStorage",
+}
+
+// String returns the name of k, or Reserved(n) for a reserved value.
+func (k DERKind) String() string { return enumString(k, derKindNames) }
+
+// Valid reports whether k is not a reserved value.
+func (k DERKind) Valid() bool { _, ok := derKindNames[k]; return ok }
+
+// MarshalText implements encoding.TextMarshaler.
+func (k DERKind) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(k), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the
+// decimal value of k; see ParseDERKind for a name.
+func (k *DERKind) UnmarshalText(text []byte) error {
+	return unmarshalEnum(text, k)
+}
+
+// ParseDERKind returns the DERKind named s, as String names it, ignoring
+// case.
+func ParseDERKind(s string) (DERKind, error) {
+	return parseEnum(s, derKindNames)
+}
+
+// CurveMode is the curveType of a DERCurve: the DERControl mode the curve
+// is for.
+type CurveMode uint8
+
+// Values of CurveMode.
+const (
+	CurveFreqWatt               CurveMode = 0
+	CurveHFRTMayTrip            CurveMode = 1
+	CurveHFRTMustTrip           CurveMode = 2
+	CurveHVRTMayTrip            CurveMode = 3
+	CurveHVRTMomentaryCessation CurveMode = 4
+	CurveHVRTMustTrip           CurveMode = 5
+	CurveLFRTMayTrip            CurveMode = 6
+	CurveLFRTMustTrip           CurveMode = 7
+	CurveLVRTMayTrip            CurveMode = 8
+	CurveLVRTMomentaryCessation CurveMode = 9
+	CurveLVRTMustTrip           CurveMode = 10
+	CurveVoltVar                CurveMode = 11
+	CurveVoltWatt               CurveMode = 12
+	CurveWattPF                 CurveMode = 13
+	CurveWattVar                CurveMode = 14
+)
+
+var curveModeNames = map[CurveMode]string{
+	CurveFreqWatt:               "opModFreqWatt",
+	CurveHFRTMayTrip:            "opModHFRTMayTrip",
+	CurveHFRTMustTrip:           "opModHFRTMustTrip",
+	CurveHVRTMayTrip:            "opModHVRTMayTrip",
+	CurveHVRTMomentaryCessation: "opModHVRTMomentaryCessation",
+	CurveHVRTMustTrip:           "opModHVRTMustTrip",
+	CurveLFRTMayTrip:            "opModLFRTMayTrip",
+	CurveLFRTMustTrip:           "opModLFRTMustTrip",
+	CurveLVRTMayTrip:            "opModLVRTMayTrip",
+	CurveLVRTMomentaryCessation: "opModLVRTMomentaryCessation",
+	CurveLVRTMustTrip:           "opModLVRTMustTrip",
+	CurveVoltVar:                "opModVoltVar",
+	CurveVoltWatt:               "opModVoltWatt",
+	CurveWattPF:                 "opModWattPF",
+	CurveWattVar:                "opModWattVar",
+}
+
+// String returns the name of the mode of m, such as "opModVoltVar", or
+// Reserved(n) for a reserved value.
+func (m CurveMode) String() string { return enumString(m, curveModeNames) }
+
+// Valid reports whether m is not a reserved value.
+func (m CurveMode) Valid() bool { _, ok := curveModeNames[m]; return ok }
+
+// MarshalText implements encoding.TextMarshaler.
+func (m CurveMode) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(m), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the
+// decimal value of m; see ParseCurveMode for a name.
+func (m *CurveMode) UnmarshalText(text []byte) error {
+	return unmarshalEnum(text, m)
+}
+
+// ParseCurveMode returns the CurveMode named s, as String names it, ignoring
+// case.
+func ParseCurveMode(s string) (CurveMode, error) {
+	return parseEnum(s, curveModeNames)
+}
+
+// UnitRef is the yRefType of a DERCurve: what its percent Y values are a
+// percentage of.
+type UnitRef uint8
+
+// Values of UnitRef.
+const (
+	UnitRefNA                   UnitRef = 0
+	UnitRefSetMaxW              UnitRef = 1
+	UnitRefSetMaxVar            UnitRef = 2
+	UnitRefStatVarAvail         UnitRef = 3
+	UnitRefSetEffectiveV        UnitRef = 4
+	UnitRefSetMaxChargeRateW    UnitRef = 5
+	UnitRefSetMaxDischargeRateW UnitRef = 6
+	UnitRefStatWAvail           UnitRef = 7
+	UnitRefSetMaxVA             UnitRef = 8
+)
+
+var unitRefNames = map[UnitRef]string{
+	UnitRefNA:                   "N/A",
+	UnitRefSetMaxW:              "%setMaxW",
+	UnitRefSetMaxVar:            "%setMaxVar",
+	UnitRefStatVarAvail:         "%statVarAvail",
+	UnitRefSetEffectiveV:        "%setEffectiveV",
+	UnitRefSetMaxChargeRateW:    "%setMaxChargeRateW",
+	UnitRefSetMaxDischargeRateW: "%setMaxDischargeRateW",
+	UnitRefStatWAvail:           "%statWAvail",
+	UnitRefSetMaxVA:             "%setMaxVA",
+}
+
+// String returns the name of r, such as "%setMaxVar", or Reserved(n) for a
+// reserved value.
+func (r UnitRef) String() string { return enumString(r, unitRefNames) }
+
+// Valid reports whether r is not a reserved value.
+func (r UnitRef) Valid() bool { _, ok := unitRefNames[r]; return ok }
+
+// MarshalText implements encoding.TextMarshaler.
+func (r UnitRef) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(r), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the
+// decimal value of r; see ParseUnitRef for a name.
+func (r *UnitRef) UnmarshalText(text []byte) error {
+	return unmarshalEnum(text, r)
+}
+
+// ParseUnitRef returns the UnitRef named s, as String names it, ignoring
+// case.
+func ParseUnitRef(s string) (UnitRef, error) {
+	return parseEnum(s, unitRefNames)
+}
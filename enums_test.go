@@ -0,0 +1,182 @@
+package sep
+
+import (
+	"encoding/xml"
+	"strconv"
+	"testing"
+)
+
+func TestEnumText(t *testing.T) {
+	tests := []struct {
+		v     interface{ String() string }
+		name  string
+		text  string
+		valid bool
+	}{
+		{v: EventActive, name: "Active", text: "1", valid: true},
+		{v: EventStatusCode(6), name: "Reserved(6)", text: "6"},
+		{v: ResponseEventReceived, name: "EventReceived", text: "1", valid: true},
+		{v: ResponseStatus(0), name: "Reserved(0)", text: "0"},
+		{v: ResponseEventExpired, name: "EventExpired", text: "254", valid: true},
+		{v: ResponseStatus(255), name: "Reserved(255)", text: "255"},
+		{v: FileLoadActivated, name: "Activated", text: "8", valid: true},
+		{v: RequestCancelled, name: "Cancelled", text: "1", valid: true},
+		{v: RequestStatusCode(2), name: "Reserved(2)", text: "2"},
+		{v: InverterRunning, name: "Running", text: "4", valid: true},
+		{v: EncodingEXI, name: MediaTypeEXI, text: "1", valid: true},
+		{v: SubscriptionEncoding(2), name: "Reserved(2)", text: "2"},
+		{v: BatteryDepleted, name: "Depleted", text: "3", valid: true},
+		{v: OpStateKVarRamping, name: "KVarRamping", text: "7", valid: true},
+		{v: DERKindPV, name: "Photovoltaic", text: "4", valid: true},
+		{v: CurveWattVar, name: "opModWattVar", text: "14", valid: true},
+		{v: CurveMode(15), name: "Reserved(15)", text: "15"},
+		{v: UnitRefSetMaxVar, name: "%setMaxVar", text: "2", valid: true},
+	}
+	for _, tt := range tests {
+		if got := tt.v.String(); got != tt.name {
+			t.Errorf("%T(%s).String() = %q, want %q", tt.v, tt.text, got, tt.name)
+		}
+		text, err := tt.v.(interface{ MarshalText() ([]byte, error) }).MarshalText()
+		if err != nil || string(text) != tt.text {
+			t.Errorf("%T(%s).MarshalText() = %q, %v, want %q", tt.v, tt.text, text, err, tt.text)
+		}
+		if got := tt.v.(enumeration).Valid(); got != tt.valid {
+			t.Errorf("%T(%s).Valid() = %v, want %v", tt.v, tt.text, got, tt.valid)
+		}
+	}
+}
+
+func TestEnumUnmarshalText(t *testing.T) {
+	tests := []struct {
+		in   string
+		want EventStatusCode
+		err  bool
+	}{
+		{in: "1", want: EventActive},
+		{in: " 5\n", want: EventCompleted},
+		{in: "6", want: 6},
+		{in: "255", want: 255},
+		{in: "256", err: true},
+		{in: "-1", err: true},
+		{in: "", err: true},
+		{in: "0x1", err: true},
+		// Names are for Parse; documents carry numbers.
+		{in: "Active", err: true},
+		{in: "Reserved(6)", err: true},
+	}
+	for _, tt := range tests {
+		var got EventStatusCode
+		err := got.UnmarshalText([]byte(tt.in))
+		if tt.err {
+			if err == nil {
+				t.Errorf("UnmarshalText(%q) = %d, want an error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("UnmarshalText(%q) = %d, %v, want %d", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestEnumXML(t *testing.T) {
+	var s EventStatus
+	if err := xml.Unmarshal([]byte(`<EventStatus><currentStatus>7</currentStatus></EventStatus>`), &s); err != nil {
+		t.Fatal(err)
+	}
+	if s.CurrentStatus != 7 || s.CurrentStatus.Valid() {
+		t.Errorf("currentStatus = %v, want reserved 7", s.CurrentStatus)
+	}
+	if err := xml.Unmarshal([]byte(`<EventStatus><currentStatus>Active</currentStatus></EventStatus>`), &s); err == nil {
+		t.Error("Unmarshal accepted a name for currentStatus")
+	}
+	if err := xml.Unmarshal([]byte(`<EventStatus><currentStatus>300</currentStatus></EventStatus>`), &s); err == nil {
+		t.Error("Unmarshal accepted currentStatus 300")
+	}
+}
+
+func TestParseEnum(t *testing.T) {
+	tests := []struct {
+		in   string
+		want ResponseStatus
+		err  bool
+	}{
+		{in: "EventOptOut", want: ResponseEventOptOut},
+		{in: "eventoptout", want: ResponseEventOptOut},
+		{in: "EVENTEXPIRED", want: ResponseEventExpired},
+		{in: "Reserved(0)", want: 0},
+		{in: "Reserved(200)", want: 200},
+		// Named values are not reserved.
+		{in: "Reserved(4)", err: true},
+		{in: "Reserved(256)", err: true},
+		{in: "Reserved(-1)", err: true},
+		{in: "Reserved", err: true},
+		{in: "4", err: true},
+		{in: "", err: true},
+		{in: "OptOut", err: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseResponseStatus(tt.in)
+		if tt.err {
+			if err == nil {
+				t.Errorf("ParseResponseStatus(%q) = %v, want an error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseResponseStatus(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+		}
+	}
+	if e, err := ParseSubscriptionEncoding("Application/SEP-EXI"); err != nil || e != EncodingEXI {
+		t.Errorf("ParseSubscriptionEncoding = %v, %v, want %v", e, err, EncodingEXI)
+	}
+	if r, err := ParseUnitRef("%SETMAXW"); err != nil || r != UnitRefSetMaxW {
+		t.Errorf("ParseUnitRef = %v, %v, want %v", r, err, UnitRefSetMaxW)
+	}
+}
+
+// enumRoundTrip checks every value of an enumerated type: MarshalText and
+// UnmarshalText round-trip its decimal value, Valid agrees with names, and
+// parse reads String back.
+func enumRoundTrip[T interface {
+	~uint8
+	String() string
+	Valid() bool
+	MarshalText() ([]byte, error)
+}, PT interface {
+	*T
+	UnmarshalText([]byte) error
+}](t *testing.T, names map[T]string, parse func(string) (T, error)) {
+	t.Helper()
+	for n := range 256 {
+		v := T(n)
+		text, err := v.MarshalText()
+		if err != nil || string(text) != strconv.Itoa(n) {
+			t.Errorf("%T(%d).MarshalText() = %q, %v", v, n, text, err)
+		}
+		var got T
+		if err := PT(&got).UnmarshalText(text); err != nil || got != v {
+			t.Errorf("%T.UnmarshalText(%q) = %d, %v", v, text, got, err)
+		}
+		if _, named := names[v]; v.Valid() != named {
+			t.Errorf("%T(%d).Valid() = %v", v, n, v.Valid())
+		}
+		if got, err := parse(v.String()); err != nil || got != v {
+			t.Errorf("parse %T %q = %d, %v, want %d", v, v.String(), got, err, n)
+		}
+	}
+}
+
+func TestEnumRoundTrip(t *testing.T) {
+	enumRoundTrip(t, eventStatusCodeNames, ParseEventStatusCode)
+	enumRoundTrip(t, responseStatusNames, ParseResponseStatus)
+	enumRoundTrip(t, fileLoadStatusNames, ParseFileLoadStatus)
+	enumRoundTrip(t, requestStatusCodeNames, ParseRequestStatusCode)
+	enumRoundTrip(t, inverterStatusNames, ParseInverterStatus)
+	enumRoundTrip(t, subscriptionEncodingNames, ParseSubscriptionEncoding)
+	enumRoundTrip(t, batteryStatusNames, ParseBatteryStatus)
+	enumRoundTrip(t, operationalStateNames, ParseOperationalState)
+	enumRoundTrip(t, derKindNames, ParseDERKind)
+	enumRoundTrip(t, curveModeNames, ParseCurveMode)
+	enumRoundTrip(t, unitRefNames, ParseUnitRef)
+}
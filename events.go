@@ -0,0 +1,297 @@
+package sep
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/binary"
+	"slices"
+	"time"
+)
+
+// Eventer is implemented by *Event and by a pointer to every type derived
+// from Event: *RandomizableEvent, *DERControl, *EndDeviceControl,
+// *TimeTariffInterval and *TextMessage.
+type Eventer interface {
+	GetMRID() *MRIDType
+	GetCreationTime() *TimeType
+	GetEventStatus() *EventStatus
+	GetInterval() *DateTimeInterval
+}
+
+// randomizable is implemented by the Eventers derived from
+// RandomizableEvent.
+type randomizable interface {
+	GetRandomizeStart() *OneHourRangeType
+	GetRandomizeDuration() *OneHourRangeType
+}
+
+// EventState is the state of an event on a device, as an EventEngine
+// computes it from the EventStatus the server gives and the device's clock.
+type EventState uint8
+
+// Values of EventState.
+const (
+	// EventStateNone is the state of an event not yet seen.
+	EventStateNone EventState = iota
+	EventStateScheduled
+	EventStateActive
+	EventStateCompleted
+	EventStateCancelled
+	EventStateSuperseded
+)
+
+var eventStateNames = map[EventState]string{
+	EventStateNone:       "None",
+	EventStateScheduled:  "Scheduled",
+	EventStateActive:     "Active",
+	EventStateCompleted:  "Completed",
+	EventStateCancelled:  "Cancelled",
+	EventStateSuperseded: "Superseded",
+}
+
+// String returns the name of s.
+func (s EventState) String() string { return enumString(s, eventStateNames) }
+
+// Final reports whether s is a state an event does not leave: completed,
+// cancelled or superseded.
+func (s EventState) Final() bool { return s >= EventStateCompleted }
+
+// randomOffset returns the randomization device applies within bound to
+// the event m: a whole number of seconds from 0 to bound, of the sign of
+// bound, drawn from a hash of device, m and what it randomizes.
+func randomOffset(bound *OneHourRangeType, device, m, what string) time.Duration {
+	if bound == nil || bound.Value() == 0 {
+		return 0
+	}
+	b := int64(bound.Value())
+	sum := sha256.Sum256([]byte(device + "\x00" + m + "\x00" + what))
+	n := int64(binary.BigEndian.Uint64(sum[:8]) % uint64(max(b, -b)+1))
+	if b < 0 {
+		n = -n
+	}
+	return time.Duration(n) * time.Second
+}
+
+// EventInterval returns the interval over which device applies ev: its
+// interval with the start moved within randomizeStart and the duration
+// changed within randomizeDuration. device is any string unique to the
+// device, such as its LFDI. The randomization is drawn from device and the
+// mRID of ev, so a device applies the same randomization each time while
+// devices are spread across the bounds.
+func EventInterval(ev Eventer, device string) (start, end time.Time) {
+	start, end = scheduledInterval(ev)
+	if r, ok := ev.(randomizable); ok {
+		m := ev.GetMRID().canonical()
+		shift := randomOffset(r.GetRandomizeStart(), device, m, "start")
+		start, end = start.Add(shift), end.Add(shift+randomOffset(r.GetRandomizeDuration(), device, m, "duration"))
+		if end.Before(start) {
+			end = start
+		}
+	}
+	return start, end
+}
+
+// scheduledInterval returns the interval of ev as the server schedules it,
+// before randomization.
+func scheduledInterval(ev Eventer) (start, end time.Time) {
+	in := ev.GetInterval()
+	if in == nil || in.Start == nil {
+		return time.Time{}, time.Time{}
+	}
+	start = in.Start.Time()
+	return start, start.Add(time.Duration(in.Duration) * time.Second)
+}
+
+// EventTransition is the change of an event from one state to another.
+type EventTransition struct {
+	Event Eventer
+	From  EventState
+	To    EventState
+	// At is when the transition took effect: when the event was received,
+	// started, ended, or was cancelled or superseded.
+	At time.Time
+}
+
+// EventEngine tracks the state of events on one device. It is not safe for
+// concurrent use.
+type EventEngine struct {
+	device string
+	events map[string]*trackedEvent
+}
+
+type trackedEvent struct {
+	ev         Eventer
+	device     string
+	state      EventState
+	start, end time.Time
+	// received is when the engine first saw the current EventStatus of
+	// ev, the instant of a cancellation whose EventStatus has no dateTime.
+	received time.Time
+	// superseded is when Supersede superseded ev, or zero.
+	superseded time.Time
+}
+
+// NewEventEngine returns an EventEngine for device, identified as for
+// EventInterval.
+func NewEventEngine(device string) *EventEngine {
+	return &EventEngine{device: device, events: make(map[string]*trackedEvent)}
+}
+
+// Update adds events to those e tracks, replacing any with the same mRID,
+// as when the server changes their EventStatus. Events without a valid
+// mRID are ignored.
+func (e *EventEngine) Update(events ...Eventer) {
+	for _, ev := range events {
+		m := ev.GetMRID()
+		if !m.Valid() {
+			continue
+		}
+		t, ok := e.events[m.canonical()]
+		if !ok {
+			t = &trackedEvent{device: e.device}
+			e.events[m.canonical()] = t
+		} else if statusOf(t.ev) != statusOf(ev) {
+			t.received = time.Time{}
+		}
+		t.ev = ev
+		t.start, t.end = EventInterval(ev, e.device)
+	}
+}
+
+// statusOf returns the currentStatus of ev.
+func statusOf(ev Eventer) EventStatusCode {
+	if s := ev.GetEventStatus(); s != nil {
+		return s.CurrentStatus
+	}
+	return EventScheduled
+}
+
+// Remove stops tracking the event m, as when the server deletes it.
+func (e *EventEngine) Remove(m *MRIDType) {
+	delete(e.events, m.canonical())
+}
+
+// Supersede marks the event m superseded from at, as when another event
+// takes precedence over it.
+func (e *EventEngine) Supersede(m *MRIDType, at time.Time) {
+	if t, ok := e.events[m.canonical()]; ok && (t.superseded.IsZero() || at.Before(t.superseded)) {
+		t.superseded = at
+	}
+}
+
+// State returns the state of the event m as of the last call to Advance.
+func (e *EventEngine) State(m *MRIDType) EventState {
+	if t, ok := e.events[m.canonical()]; ok {
+		return t.state
+	}
+	return EventStateNone
+}
+
+// Advance moves the clock of e to now and returns the transitions of its
+// events since the last call, in the order they took effect. A new event
+// transitions from EventStateNone at now; one that started and ended
+// between calls transitions through EventStateActive. Events in a final
+// state stay in it.
+func (e *EventEngine) Advance(now time.Time) []EventTransition {
+	var ts []EventTransition
+	for _, t := range e.events {
+		if t.received.IsZero() {
+			t.received = now
+		}
+		if t.state.Final() {
+			continue
+		}
+		to, at := t.stateAt(now)
+		switch {
+		case to == t.state:
+			continue
+		case t.state == EventStateNone:
+			at = now
+		case t.state == EventStateScheduled && to != EventStateActive &&
+			(to == EventStateCompleted || at.After(t.start)):
+			ts = append(ts, EventTransition{t.ev, t.state, EventStateActive, t.start})
+			t.state = EventStateActive
+		}
+		ts = append(ts, EventTransition{t.ev, t.state, to, at})
+		t.state = to
+	}
+	slices.SortStableFunc(ts, func(a, b EventTransition) int {
+		if c := a.At.Compare(b.At); c != 0 {
+			return c
+		}
+		return cmp.Or(a.Event.GetMRID().Compare(b.Event.GetMRID()), cmp.Compare(a.To, b.To))
+	})
+	return ts
+}
+
+// Next returns the next instant after now at which the state of an event
+// of e changes, or false if none will without an update.
+func (e *EventEngine) Next(now time.Time) (time.Time, bool) {
+	var next time.Time
+	for _, t := range e.events {
+		if t.state.Final() {
+			continue
+		}
+		for _, at := range []time.Time{t.start, t.end, t.superseded, t.cancelAt()} {
+			if at.After(now) && (next.IsZero() || at.Before(next)) {
+				next = at
+			}
+		}
+	}
+	return next, !next.IsZero()
+}
+
+// cancelAt returns when a cancelled event is cancelled, or zero if it is
+// not. An event cancelled with randomization while active runs on for a
+// randomization within the larger of the absolute values of randomizeStart
+// and randomizeDuration, so that devices do not all stop at once.
+func (t *trackedEvent) cancelAt() time.Time {
+	at := t.received
+	if s := t.ev.GetEventStatus(); s != nil && s.DateTime != nil {
+		at = s.DateTime.Time()
+	}
+	switch statusOf(t.ev) {
+	case EventCancelled:
+		return at
+	case EventCancelledWithRandomization:
+		if r, ok := t.ev.(randomizable); ok && !at.Before(t.start) && at.Before(t.end) {
+			bound := NewOneHourRangeType(max(absBound(r.GetRandomizeStart()), absBound(r.GetRandomizeDuration())))
+			return at.Add(randomOffset(bound, t.device, t.ev.GetMRID().canonical(), "cancel"))
+		}
+		return at
+	}
+	return time.Time{}
+}
+
+// absBound returns the absolute value of the randomization bound b, or 0
+// if there is none.
+func absBound(b *OneHourRangeType) int16 {
+	if b == nil || b.Int16 == nil {
+		return 0
+	}
+	return max(b.Value(), -b.Value())
+}
+
+// stateAt returns the state of t at now and the instant it entered it.
+func (t *trackedEvent) stateAt(now time.Time) (EventState, time.Time) {
+	at := t.received
+	if s := t.ev.GetEventStatus(); s != nil && s.DateTime != nil {
+		at = s.DateTime.Time()
+	}
+	if statusOf(t.ev) == EventSuperseded && !now.Before(at) {
+		return EventStateSuperseded, at
+	}
+	if c := t.cancelAt(); !c.IsZero() && !now.Before(c) && c.Before(t.end) {
+		return EventStateCancelled, c
+	}
+	if !t.superseded.IsZero() && !now.Before(t.superseded) && t.superseded.Before(t.end) {
+		return EventStateSuperseded, t.superseded
+	}
+	switch {
+	case now.Before(t.start):
+		return EventStateScheduled, t.received
+	case now.Before(t.end):
+		return EventStateActive, t.start
+	}
+	return EventStateCompleted, t.end
+}
@@ -0,0 +1,267 @@
+package sep
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+var t0 = time.Unix(1_700_000_000, 0)
+
+// testControl returns a DERControl with the mRID ending in n, scheduled
+// from t0 plus start seconds for dur seconds.
+func testControl(n int, start, dur int64) *DERControl {
+	c := NewDERControl()
+	c.MRID = NewMRIDType(fmt.Sprintf("%032X", n))
+	c.Interval = &DateTimeInterval{Start: NewTimeTypeFromTime(t0.Add(time.Duration(start) * time.Second)), Duration: uint32(dur)}
+	return c
+}
+
+// sec returns t0 plus s seconds.
+func sec(s int64) time.Time { return t0.Add(time.Duration(s) * time.Second) }
+
+func withStatus(c *DERControl, code EventStatusCode, s int64) *DERControl {
+	c.EventStatus = &EventStatus{CurrentStatus: code, DateTime: NewTimeTypeFromTime(sec(s))}
+	return c
+}
+
+type wantTransition struct {
+	n        int
+	from, to EventState
+	at       int64
+}
+
+func checkTransitions(t *testing.T, got []EventTransition, want []wantTransition) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d transitions %v, want %d", len(got), got, len(want))
+	}
+	for i, w := range want {
+		g := got[i]
+		if g.Event.GetMRID().canonical() != fmt.Sprintf("%032X", w.n) || g.From != w.from || g.To != w.to || !g.At.Equal(sec(w.at)) {
+			t.Errorf("transition %d = %s %v->%v at %d, want %032X %v->%v at %d",
+				i, g.Event.GetMRID().canonical(), g.From, g.To, g.At.Sub(t0)/time.Second, w.n, w.from, w.to, w.at)
+		}
+	}
+}
+
+func TestEventEngineStates(t *testing.T) {
+	tests := []struct {
+		name  string
+		ev    *DERControl
+		steps []int64
+		want  [][]wantTransition
+	}{
+		{
+			name:  "scheduled, active, completed",
+			ev:    testControl(1, 100, 100),
+			steps: []int64{0, 100, 150, 200},
+			want: [][]wantTransition{
+				{{1, EventStateNone, EventStateScheduled, 0}},
+				{{1, EventStateScheduled, EventStateActive, 100}},
+				nil,
+				{{1, EventStateActive, EventStateCompleted, 200}},
+			},
+		},
+		{
+			name:  "first seen while active",
+			ev:    testControl(1, 0, 100),
+			steps: []int64{50, 100},
+			want: [][]wantTransition{
+				{{1, EventStateNone, EventStateActive, 50}},
+				{{1, EventStateActive, EventStateCompleted, 100}},
+			},
+		},
+		{
+			name:  "first seen after it ended",
+			ev:    testControl(1, 0, 100),
+			steps: []int64{150},
+			want: [][]wantTransition{
+				{{1, EventStateNone, EventStateCompleted, 150}},
+			},
+		},
+		{
+			name:  "started and ended between calls",
+			ev:    testControl(1, 100, 100),
+			steps: []int64{0, 300},
+			want: [][]wantTransition{
+				{{1, EventStateNone, EventStateScheduled, 0}},
+				{{1, EventStateScheduled, EventStateActive, 100}, {1, EventStateActive, EventStateCompleted, 200}},
+			},
+		},
+		{
+			name:  "cancelled while active",
+			ev:    withStatus(testControl(1, 0, 100), EventCancelled, 40),
+			steps: []int64{20, 50, 200},
+			want: [][]wantTransition{
+				{{1, EventStateNone, EventStateActive, 20}},
+				{{1, EventStateActive, EventStateCancelled, 40}},
+				nil,
+			},
+		},
+		{
+			name:  "cancelled before it started",
+			ev:    withStatus(testControl(1, 100, 100), EventCancelled, 40),
+			steps: []int64{20, 50, 200},
+			want: [][]wantTransition{
+				{{1, EventStateNone, EventStateScheduled, 20}},
+				{{1, EventStateScheduled, EventStateCancelled, 40}},
+				nil,
+			},
+		},
+		{
+			name:  "superseded by the server",
+			ev:    withStatus(testControl(1, 0, 100), EventSuperseded, 30),
+			steps: []int64{10, 30},
+			want: [][]wantTransition{
+				{{1, EventStateNone, EventStateActive, 10}},
+				{{1, EventStateActive, EventStateSuperseded, 30}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewEventEngine("device")
+			e.Update(tt.ev)
+			for i, s := range tt.steps {
+				checkTransitions(t, e.Advance(sec(s)), tt.want[i])
+			}
+		})
+	}
+}
+
+func TestEventEngineAdvanceOrder(t *testing.T) {
+	e := NewEventEngine("device")
+	e.Update(testControl(3, 50, 100), testControl(1, 100, 10), testControl(2, 50, 20))
+	checkTransitions(t, e.Advance(sec(0)), []wantTransition{
+		{1, EventStateNone, EventStateScheduled, 0},
+		{2, EventStateNone, EventStateScheduled, 0},
+		{3, EventStateNone, EventStateScheduled, 0},
+	})
+	checkTransitions(t, e.Advance(sec(500)), []wantTransition{
+		{2, EventStateScheduled, EventStateActive, 50},
+		{3, EventStateScheduled, EventStateActive, 50},
+		{2, EventStateActive, EventStateCompleted, 70},
+		{1, EventStateScheduled, EventStateActive, 100},
+		{1, EventStateActive, EventStateCompleted, 110},
+		{3, EventStateActive, EventStateCompleted, 150},
+	})
+	if s := e.State(NewMRIDType(fmt.Sprintf("%032X", 3))); s != EventStateCompleted {
+		t.Errorf("State = %v, want Completed", s)
+	}
+}
+
+func TestEventEngineNext(t *testing.T) {
+	e := NewEventEngine("device")
+	e.Update(testControl(1, 100, 100), testControl(2, 150, 100))
+	e.Advance(sec(0))
+	for _, w := range []struct{ now, next int64 }{{0, 100}, {100, 150}, {150, 200}, {200, 250}} {
+		got, ok := e.Next(sec(w.now))
+		if !ok || !got.Equal(sec(w.next)) {
+			t.Errorf("Next(%d) = %v, %v, want %d", w.now, got.Sub(t0)/time.Second, ok, w.next)
+		}
+		e.Advance(sec(w.next))
+	}
+	if _, ok := e.Next(sec(250)); ok {
+		t.Error("Next after every event completed reports an instant")
+	}
+	e.Remove(NewMRIDType(fmt.Sprintf("%032X", 1)))
+	if s := e.State(NewMRIDType(fmt.Sprintf("%032X", 1))); s != EventStateNone {
+		t.Errorf("State after Remove = %v, want None", s)
+	}
+}
+
+func TestEventEngineSupersede(t *testing.T) {
+	e := NewEventEngine("device")
+	ev := testControl(1, 0, 100)
+	e.Update(ev)
+	e.Advance(sec(10))
+	e.Supersede(ev.MRID, sec(60))
+	e.Supersede(ev.MRID, sec(40))
+	e.Supersede(ev.MRID, sec(80))
+	if next, _ := e.Next(sec(10)); !next.Equal(sec(40)) {
+		t.Errorf("Next = %d, want 40", next.Sub(t0)/time.Second)
+	}
+	checkTransitions(t, e.Advance(sec(50)), []wantTransition{
+		{1, EventStateActive, EventStateSuperseded, 40},
+	})
+	if got := e.Advance(sec(200)); len(got) != 0 {
+		t.Errorf("a superseded event transitioned again: %v", got)
+	}
+
+	// Superseding from after the end of an event does not cut it short.
+	e.Update(testControl(2, 0, 100))
+	e.Advance(sec(10))
+	e.Supersede(NewMRIDType(fmt.Sprintf("%032X", 2)), sec(100))
+	checkTransitions(t, e.Advance(sec(100)), []wantTransition{
+		{2, EventStateActive, EventStateCompleted, 100},
+	})
+}
+
+func TestEventInterval(t *testing.T) {
+	ev := testControl(1, 1000, 600)
+	if s, e := EventInterval(ev, "device"); !s.Equal(sec(1000)) || !e.Equal(sec(1600)) {
+		t.Errorf("EventInterval without randomization = %v, %v", s, e)
+	}
+	ev.RandomizeStart = NewOneHourRangeType(-300)
+	ev.RandomizeDuration = NewOneHourRangeType(120)
+	moved := false
+	for i := range 20 {
+		dev := fmt.Sprint("device", i)
+		s, e := EventInterval(ev, dev)
+		if s.Before(sec(700)) || s.After(sec(1000)) {
+			t.Errorf("%s: start %d outside [700, 1000]", dev, s.Sub(t0)/time.Second)
+		}
+		if d := e.Sub(s); d < 600*time.Second || d > 720*time.Second {
+			t.Errorf("%s: duration %v outside [600s, 720s]", dev, d)
+		}
+		if s2, e2 := EventInterval(ev, dev); !s2.Equal(s) || !e2.Equal(e) {
+			t.Errorf("%s: randomization is not stable", dev)
+		}
+		moved = moved || !s.Equal(sec(1000))
+	}
+	if !moved {
+		t.Error("no device moved its start")
+	}
+}
+
+func TestEventEngineCancelWithRandomization(t *testing.T) {
+	tests := []struct {
+		name          string
+		start, dur    int16
+		lo, hi, after int64
+	}{
+		// The larger bound is randomizeStart, whichever its sign.
+		{name: "start bound", start: -600, dur: 60, lo: 40, hi: 640, after: 100},
+		{name: "duration bound", start: 30, dur: -300, lo: 40, hi: 340, after: 70},
+		{name: "no randomization", lo: 40, hi: 40},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var spread bool
+			for i := range 20 {
+				dev := fmt.Sprint("device", i)
+				ev := testControl(1, 0, 3600)
+				ev.RandomizeStart = NewOneHourRangeType(tt.start)
+				ev.RandomizeDuration = NewOneHourRangeType(tt.dur)
+				s, _ := EventInterval(ev, dev)
+				withStatus(ev, EventCancelledWithRandomization, int64(s.Sub(t0)/time.Second)+40)
+				e := NewEventEngine(dev)
+				e.Update(ev)
+				e.Advance(s)
+				next, ok := e.Next(s)
+				if !ok {
+					t.Fatalf("%s: no next instant", dev)
+				}
+				c := int64(next.Sub(s) / time.Second)
+				if c < tt.lo || c > tt.hi {
+					t.Errorf("%s: cancelled %d s after start, want in [%d, %d]", dev, c, tt.lo, tt.hi)
+				}
+				spread = spread || c > tt.after
+			}
+			if tt.after != 0 && !spread {
+				t.Errorf("no device ran on for more than %d s, the smaller bound", tt.after-40)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package sep
+
+import (
+	"encoding/xml"
+	"io"
+	"sync"
+
+	"github.com/Tylores/sep/internal/exi"
+)
+
+// exiCodec returns the EXI codec informed by the bundled sep.xsd.
+var exiCodec = sync.OnceValue(func() *exi.Codec {
+	return exi.New(schema())
+})
+
+// MarshalEXI returns the application/sep-exi representation of v: the EXI
+// stream, strict, schema-informed by sep.xsd and without options, of the
+// XML document xml.Marshal returns for v, with schemaVer SchemaVersion
+// unless v sets it. Elements matched by a wildcard, such as vendor
+// extensions, must be global elements of sep.xsd.
+func MarshalEXI(v any) ([]byte, error) {
+	doc, err := xml.Marshal(versioned(v))
+	if err != nil {
+		return nil, err
+	}
+	return exiCodec().Encode(doc)
+}
+
+// UnmarshalEXI decodes the application/sep-exi representation data into v
+// like Unmarshal decodes its XML form.
+func UnmarshalEXI(data []byte, v any) error {
+	doc, err := exiCodec().Decode(data)
+	if err != nil {
+		return err
+	}
+	return Unmarshal(doc, v)
+}
+
+// decodeEXI decodes an application/sep-exi representation.
+func decodeEXI(data []byte) (any, error) {
+	doc, err := exiCodec().Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return decodeXML(doc)
+}
+
+// encodeEXI writes an application/sep-exi representation.
+func encodeEXI(w io.Writer, v any) error {
+	data, err := MarshalEXI(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
@@ -0,0 +1,159 @@
+package sep
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEXIRoundTrip(t *testing.T) {
+	for _, name := range ElementNames() {
+		var b strings.Builder
+		writeSample(&b, name, schema().Elements[name].Type, nil)
+		want, err := Decode(MediaTypeXML, strings.NewReader(b.String()))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		data, err := MarshalEXI(want)
+		if err != nil {
+			t.Errorf("%s: MarshalEXI: %v", name, err)
+			continue
+		}
+		got, err := Decode(MediaTypeEXI, bytes.NewReader(data))
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: decoded %+v, %v, want %+v", name, got, err, want)
+		}
+	}
+}
+
+// exiTwice returns v encoded as EXI and decoded both from that and from its
+// XML form.
+func exiTwice[T any](t *testing.T, v *T) (fromEXI, fromXML *T, data []byte) {
+	t.Helper()
+	var b bytes.Buffer
+	if err := Encode(&b, MediaTypeXML, v); err != nil {
+		t.Fatal(err)
+	}
+	fromXML = new(T)
+	if err := Unmarshal(b.Bytes(), fromXML); err != nil {
+		t.Fatal(err)
+	}
+	data, err := MarshalEXI(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromEXI = new(T)
+	if err := UnmarshalEXI(data, fromEXI); err != nil {
+		t.Fatal(err)
+	}
+	return fromEXI, fromXML, data
+}
+
+func TestEXINotification(t *testing.T) {
+	ctl := NewDERControl()
+	ctl.HrefAttr = "/derp/1/derc/1"
+	ctl.MRID = NewMRIDType("0123456789ABCDEF0123456789ABCDEF")
+	ctl.Description = NewString("curtail")
+	ctl.CreationTime = NewTimeTypeFromTime(t0)
+	ctl.EventStatus = &EventStatus{CurrentStatus: EventScheduled, DateTime: NewTimeTypeFromTime(t0)}
+	ctl.Interval = &DateTimeInterval{Start: NewTimeTypeFromTime(t0), Duration: 3600}
+	ctl.DERControlBase = &DERControlBase{OpModMaxLimW: maxLimW(5000)}
+	n := NewNotification()
+	n.HrefAttr = "/edev/1/ntfy/1"
+	n.SubscribedResource = "/derp/1/derc"
+	n.Resource = ctl
+	n.SubscriptionURI = "/notify"
+
+	fromEXI, fromXML, _ := exiTwice(t, n)
+	c, ok := fromEXI.Resource.(*DERControl)
+	if !ok {
+		t.Fatalf("resource decoded as %T, want the DERControl named by xsi:type", fromEXI.Resource)
+	}
+	if stringValue(c.Description) != "curtail" || c.DERControlBase.OpModMaxLimW.Value() != 5000 || c.HrefAttr != "/derp/1/derc/1" {
+		t.Errorf("resource %+v", c)
+	}
+	if !reflect.DeepEqual(fromEXI, fromXML) {
+		t.Errorf("EXI decoded %+v, XML %+v", fromEXI, fromXML)
+	}
+}
+
+func TestEXIStringTable(t *testing.T) {
+	list := func(hrefs ...string) *EndDeviceList {
+		l := NewEndDeviceList()
+		l.HrefAttr, l.AllAttr, l.ResultsAttr = "/edev", uint32(len(hrefs)), uint32(len(hrefs))
+		for _, h := range hrefs {
+			e := NewEndDevice()
+			e.HrefAttr, e.SFDI, e.ChangedTime = h, NewSFDIType(5), NewTimeTypeFromTime(t0)
+			l.EndDevice = append(l.EndDevice, e)
+		}
+		return l
+	}
+	// A repeated value is sent as its index in the string table: of the
+	// values of href, or of all values when it was first another's.
+	same, fromXML, sameData := exiTwice(t, list("/edev/1", "/edev/1"))
+	_, _, distinctData := exiTwice(t, list("/edev/1", "/edev/2"))
+	if !reflect.DeepEqual(same, fromXML) || same.EndDevice[1].HrefAttr != "/edev/1" {
+		t.Errorf("EXI decoded %+v, XML %+v", same, fromXML)
+	}
+	if len(sameData) >= len(distinctData) {
+		t.Errorf("repeated href takes %d bytes, distinct %d", len(sameData), len(distinctData))
+	}
+
+	n := NewNotification()
+	n.SubscribedResource, n.SubscriptionURI = "/edev/1/ntfy", "/edev/1/ntfy"
+	global, fromXMLn, globalData := exiTwice(t, n)
+	n.SubscriptionURI = "/edev/1/ntfx"
+	_, _, literalData := exiTwice(t, n)
+	if !reflect.DeepEqual(global, fromXMLn) || global.SubscriptionURI != "/edev/1/ntfy" {
+		t.Errorf("EXI decoded %+v, XML %+v", global, fromXMLn)
+	}
+	if len(globalData) >= len(literalData) {
+		t.Errorf("value repeated in another element takes %d bytes, distinct %d", len(globalData), len(literalData))
+	}
+}
+
+func TestEXIValues(t *testing.T) {
+	// schemaVer has the restricted character set of the SEPVersion pattern;
+	// characters outside it are escaped.
+	for _, ver := range []SEPVersion{"2.2", "10.0", "2.x", "é"} {
+		tm := NewTimeIn(t0.Location(), t0)
+		tm.SchemaVerAttr = ver
+		if got, _, _ := exiTwice(t, tm); got.SchemaVerAttr != ver {
+			t.Errorf("schemaVer %q decoded as %q", ver, got.SchemaVerAttr)
+		}
+	}
+
+	// hexBinary values are sent as octets and decoded in upper case.
+	r := NewResponse()
+	r.CreatedDateTime = NewTimeTypeFromTime(t0)
+	r.EndDeviceLFDI = strings.ToLower(testLFDI)
+	r.Subject = NewMRIDType("0123456789abcdef0123456789abcdef")
+	got, fromXML, _ := exiTwice(t, r)
+	if got.EndDeviceLFDI != testLFDI || got.Subject.Value() != "0123456789ABCDEF0123456789ABCDEF" {
+		t.Errorf("decoded LFDI %s, subject %s", got.EndDeviceLFDI, got.Subject.Value())
+	}
+	if !got.Subject.Equal(fromXML.Subject) {
+		t.Errorf("subject %s from EXI, %s from XML", got.Subject.Value(), fromXML.Subject.Value())
+	}
+	r.EndDeviceLFDI = "not hex"
+	if _, err := MarshalEXI(r); err == nil {
+		t.Error("MarshalEXI accepted an LFDI that is not hexBinary")
+	}
+}
+
+func TestEXIErrors(t *testing.T) {
+	var e EndDevice
+	if err := UnmarshalLossless([]byte(vendorEndDevice), &e); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := MarshalEXI(&e); err == nil {
+		t.Error("MarshalEXI encoded elements sep.xsd does not declare")
+	}
+	for _, data := range [][]byte{nil, {0x00}, {0xA0}, {0x80}} {
+		var tm Time
+		if err := UnmarshalEXI(data, &tm); err == nil {
+			t.Errorf("UnmarshalEXI(% X) succeeded", data)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package sep
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/Tylores/sep/internal/xsd"
+)
+
+// AnyElement is an element kept verbatim where the schema admits any
+// element, such as the extension content of a Revision23Type. Namespace
+// prefixes used within Content must be declared on the element itself or
+// bound to the 2030.5 namespace to survive re-encoding.
+type AnyElement struct {
+	XMLName xml.Name
+	Attr    []xml.Attr `xml:",any,attr"`
+	Content []byte     `xml:",innerxml"`
+
+	// src is where UnmarshalLossless found the element, or nil.
+	src *source
+}
+
+// A source is the place and text of an unknown element in a decoded
+// document.
+type source struct {
+	// pos is the number of modelled elements before the element among its
+	// siblings.
+	pos int
+	// text is the element as written, with the namespace declarations it
+	// relies on from outside added to its start tag.
+	text []byte
+	// name, attr and content are the fields of the element as decoded, to
+	// tell whether they have been changed since.
+	name    xml.Name
+	attr    []xml.Attr
+	content []byte
+}
+
+// text returns a as XML: the text it was decoded from if it is unchanged,
+// or else its fields encoded.
+func (a *AnyElement) text() ([]byte, error) {
+	if s := a.src; s != nil && a.XMLName == s.name && slices.Equal(a.Attr, s.attr) && bytes.Equal(a.Content, s.content) {
+		return s.text, nil
+	}
+	return xml.Marshal(a)
+}
+
+// Attr is an attribute kept verbatim in the AnyAttr field of a type,
+// either one the Go type does not model or a namespace declaration.
+type Attr struct {
+	Name  xml.Name
+	Value string
+
+	// prefix is the prefix of Name.Space in the decoded document, if it is
+	// declared on the same element.
+	prefix string
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (a *Attr) UnmarshalXMLAttr(attr xml.Attr) error {
+	*a = Attr{Name: attr.Name, Value: attr.Value}
+	return nil
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr. The xsi:type attribute and
+// the namespace declarations the encoder makes itself are dropped; other
+// declarations are kept under their original prefix, so that prefixes used
+// in Any elements stay bound, and so is the prefix of an attribute decoded
+// by UnmarshalLossless.
+func (a Attr) MarshalXMLAttr(xml.Name) (xml.Attr, error) {
+	switch {
+	case a.Name.Space == "" && a.Name.Local == "xmlns":
+		return xml.Attr{}, nil
+	case a.Name == xsiType:
+		return xml.Attr{}, nil
+	case a.Name.Space == "xmlns":
+		if a.Value == xsd.InstanceNamespace {
+			return xml.Attr{}, nil
+		}
+		return xml.Attr{Name: xml.Name{Local: "xmlns:" + a.Name.Local}, Value: a.Value}, nil
+	case a.prefix != "":
+		return xml.Attr{Name: xml.Name{Local: a.prefix + ":" + a.Name.Local}, Value: a.Value}, nil
+	}
+	return xml.Attr{Name: a.Name, Value: a.Value}, nil
+}
+
+// NewAnyElement encodes v, a value of one of the element types of the
+// package, as an AnyElement.
+func NewAnyElement(v any) (*AnyElement, error) {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	a := new(AnyElement)
+	if err := xml.Unmarshal(data, a); err != nil {
+		return nil, err
+	}
+	if a.XMLName.Space == "" {
+		// Types that are not global elements marshal unqualified.
+		a.XMLName.Space = Namespace
+	}
+	return a, nil
+}
+
+// MarshalXML re-encodes a as it was decoded.
+func (a AnyElement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = a.XMLName
+	start.Attr = nil
+	for _, attr := range a.Attr {
+		switch {
+		case attr.Name.Space == "" && attr.Name.Local == "xmlns":
+			// The encoder declares the namespace of start itself.
+			continue
+		case attr.Name.Space == "xmlns":
+			attr.Name = xml.Name{Local: "xmlns:" + attr.Name.Local}
+		}
+		start.Attr = append(start.Attr, attr)
+	}
+	return e.EncodeElement(struct {
+		Content []byte `xml:",innerxml"`
+	}{a.Content}, start)
+}
+
+// Decode decodes a into v, which must point to a value of the Go type of
+// the element, as Unmarshal does.
+func (a *AnyElement) Decode(v any) error {
+	data, err := xml.Marshal(a)
+	if err != nil {
+		return err
+	}
+	return Unmarshal(data, v)
+}
+
+// Value decodes a into a new value of the Go type of the complex type of the
+// 2030.5 namespace it is named after, and returns a pointer to it. Extension
+// elements are named after their type, as Extension expects, whether or not
+// the schema also declares a global element of that name; <Temperature>
+// decodes to a *Temperature.
+func (a *AnyElement) Value() (any, error) {
+	t, ok := complexTypes[a.XMLName.Local]
+	if a.XMLName.Space != Namespace || !ok {
+		return nil, fmt.Errorf("sep: <%s> does not name a type of %s", a.XMLName.Local, Namespace)
+	}
+	v := reflect.New(t).Interface()
+	if err := a.Decode(v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Add encodes v, a value of one of the element types of the package, and
+// appends it to the extension content of r.
+func (r *Revision23Type) Add(v any) error {
+	a, err := NewAnyElement(v)
+	if err != nil {
+		return err
+	}
+	r.Any = append(r.Any, a)
+	return nil
+}
+
+// Element returns the first extension element of r named local in the
+// 2030.5 namespace, or nil.
+func (r *Revision23Type) Element(local string) *AnyElement {
+	if r == nil {
+		return nil
+	}
+	for _, a := range r.Any {
+		if a.XMLName.Space == Namespace && a.XMLName.Local == local {
+			return a
+		}
+	}
+	return nil
+}
+
+// Extension decodes the first extension element of r that is an element of
+// the Go type T, such as DERControl or DERSettings. It returns nil if r holds
+// no such element.
+func Extension[T any](r *Revision23Type) (*T, error) {
+	name, ok := complexTypeNames[reflect.TypeFor[T]()]
+	if !ok {
+		return nil, fmt.Errorf("sep: %s is not a type of the schema", reflect.TypeFor[T]())
+	}
+	a := r.Element(name)
+	if a == nil {
+		return nil, nil
+	}
+	v := new(T)
+	if err := a.Decode(v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
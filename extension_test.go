@@ -0,0 +1,139 @@
+package sep
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+// vendorDeviceStatus carries a Temperature extension under a prefix bound to
+// the 2030.5 namespace, a vendor element and a vendor attribute.
+const vendorDeviceStatus = `<DeviceStatus xmlns="urn:ieee:std:2030.5:ns" href="/dstat"><changedTime>0</changedTime>` +
+	`<DeviceStatus_r2_3 xmlns:v="urn:vendor" v:rev="2">` +
+	`<sep:Temperature xmlns:sep="urn:ieee:std:2030.5:ns"><sep:subject>1</sep:subject><sep:value>25</sep:value></sep:Temperature>` +
+	`<v:note v:lang="en">hi</v:note></DeviceStatus_r2_3></DeviceStatus>`
+
+func TestNewAnyElement(t *testing.T) {
+	a, err := NewAnyElement(&Temperature{Subject: 1, Value: 25})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.XMLName != (xml.Name{Space: Namespace, Local: "Temperature"}) {
+		t.Errorf("name %v", a.XMLName)
+	}
+	v, err := a.Value()
+	if tv, ok := v.(*Temperature); err != nil || !ok || tv.Subject != 1 || tv.Value != 25 {
+		t.Errorf("Value() = %#v, %v", v, err)
+	}
+	var tv Temperature
+	if err := a.Decode(&tv); err != nil || tv.Value != 25 {
+		t.Errorf("Decode: %+v, %v", tv, err)
+	}
+
+	a, err = NewAnyElement(NewDERControl())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := a.Value(); err != nil {
+		t.Errorf("DERControl: %v", err)
+	} else if _, ok := v.(*DERControl); !ok {
+		t.Errorf("DERControl: Value() = %T", v)
+	}
+
+	for _, name := range []xml.Name{
+		{Space: "urn:vendor", Local: "Temperature"},
+		{Space: Namespace, Local: "Nothing"},
+	} {
+		if v, err := (&AnyElement{XMLName: name}).Value(); err == nil {
+			t.Errorf("%v: Value() = %#v", name, v)
+		}
+	}
+}
+
+func TestRevision23Type(t *testing.T) {
+	var r Revision23Type
+	if err := r.Add(&Temperature{Subject: 1, Value: 25}); err != nil {
+		t.Fatal(err)
+	}
+	if r.Element("Temperature") == nil || r.Element("DERControl") != nil {
+		t.Errorf("Element: %+v", r.Any)
+	}
+	if (*Revision23Type)(nil).Element("Temperature") != nil {
+		t.Error("Element on nil")
+	}
+	if tv, err := Extension[Temperature](&r); err != nil || tv == nil || tv.Value != 25 {
+		t.Errorf("Extension[Temperature] = %+v, %v", tv, err)
+	}
+	if v, err := Extension[DERControl](&r); v != nil || err != nil {
+		t.Errorf("Extension[DERControl] = %+v, %v", v, err)
+	}
+	if v, err := Extension[Temperature](nil); v != nil || err != nil {
+		t.Errorf("Extension on nil = %+v, %v", v, err)
+	}
+	if _, err := Extension[int](&r); err == nil {
+		t.Error("Extension[int]: no error")
+	}
+	if err := r.Add(make(chan int)); err == nil {
+		t.Error("Add(chan): no error")
+	}
+}
+
+func TestRevision23RoundTrip(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		unmarshal func([]byte, any) error
+		want      string
+	}{
+		{"UnmarshalLossless", UnmarshalLossless, vendorDeviceStatus},
+		// Without the prefixes recorded the elements declare their
+		// namespaces themselves.
+		{"Unmarshal", Unmarshal, `<DeviceStatus xmlns="urn:ieee:std:2030.5:ns" href="/dstat"><changedTime>0</changedTime>` +
+			`<DeviceStatus_r2_3 xmlns:v="urn:vendor" xmlns:_="urn:vendor" _:rev="2">` +
+			`<Temperature xmlns="urn:ieee:std:2030.5:ns" xmlns:sep="urn:ieee:std:2030.5:ns"><sep:subject>1</sep:subject><sep:value>25</sep:value></Temperature>` +
+			`<note xmlns="urn:vendor" xmlns:_="urn:vendor" _:lang="en">hi</note></DeviceStatus_r2_3></DeviceStatus>`},
+	} {
+		var ds DeviceStatus
+		if err := tt.unmarshal([]byte(vendorDeviceStatus), &ds); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		r := ds.DeviceStatusr23
+		if len(r.AnyAttr) != 2 || len(r.Any) != 2 {
+			t.Fatalf("%s: attributes %+v, elements %+v", tt.name, r.AnyAttr, r.Any)
+		}
+		if tv, err := Extension[Temperature](r); err != nil || tv == nil || tv.Subject != 1 || tv.Value != 25 {
+			t.Errorf("%s: Extension[Temperature] = %+v, %v", tt.name, tv, err)
+		}
+		if v, err := r.Any[0].Value(); err != nil || v.(*Temperature).Value != 25 {
+			t.Errorf("%s: Value() = %+v, %v", tt.name, v, err)
+		}
+		if r.Any[1].XMLName != (xml.Name{Space: "urn:vendor", Local: "note"}) {
+			t.Errorf("%s: vendor element %v", tt.name, r.Any[1].XMLName)
+		}
+		out, err := xml.Marshal(&ds)
+		if err != nil || string(out) != tt.want {
+			t.Errorf("%s: marshalled\n%s, %v\nwant\n%s", tt.name, out, err, tt.want)
+		}
+	}
+
+	// An extension added to a decoded resource follows the others.
+	var ds DeviceStatus
+	if err := UnmarshalLossless([]byte(vendorDeviceStatus), &ds); err != nil {
+		t.Fatal(err)
+	}
+	if err := ds.DeviceStatusr23.Add(&Temperature{Subject: 2, Value: 30}); err != nil {
+		t.Fatal(err)
+	}
+	out, err := xml.Marshal(&ds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var back DeviceStatus
+	if err := Unmarshal(out, &back); err != nil {
+		t.Fatal(err)
+	}
+	if a := back.DeviceStatusr23.Any; len(a) != 3 || a[2].XMLName.Local != "Temperature" {
+		t.Fatalf("elements %+v", a)
+	}
+	if tv, err := back.DeviceStatusr23.Any[2].Value(); err != nil || tv.(*Temperature).Value != 30 {
+		t.Errorf("added extension %+v, %v", tv, err)
+	}
+}
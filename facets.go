@@ -0,0 +1,195 @@
+package sep
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/Tylores/sep/internal/xsd"
+)
+
+// valueRanges holds the ranges the schema documents for types whose
+// restriction does not state them as facets.
+var valueRanges = map[string][2]int64{
+	"PerCent":                  {0, 10000},
+	"SignedPerCent":            {-10000, 10000},
+	"OneHourRangeType":         {-3600, 3600},
+	"UInt40":                   {0, 1<<40 - 1},
+	"PINType":                  {0, 999999},
+	"SFDIType":                 {0, 687194767359},
+	"PowerOfTenMultiplierType": {-9, 9},
+}
+
+// schemaTypeNames maps the Go types whose names differ from their schema type.
+var schemaTypeNames = map[string]string{
+	"IEEE802154":     "IEEE_802_15_4",
+	"LoWPAN":         "loWPAN",
+	"MRIDType":       "mRIDType",
+	"Revision23Type": "Revision2_3Type",
+}
+
+// validateFacets checks v, a value of the Go type named typeName, and every
+// value it contains against the facets of its type in sep.xsd. Unlike
+// Validate it does not check that required elements are present.
+func validateFacets(v any, typeName string) error {
+	name := typeName
+	if n, ok := schemaTypeNames[name]; ok {
+		name = n
+	}
+	t := schema().Types[name]
+	if e, ok := schema().Elements[typeName]; ok {
+		// The root element adds schemaVer to its type.
+		t = e.Type
+	}
+	var errs ValidationErrors
+	path := ""
+	if t.SimpleContent() == nil {
+		path = "/" + typeName
+	}
+	checkValue(reflect.ValueOf(v), t, path, &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func checkValue(v reflect.Value, t *xsd.Type, path string, errs *ValidationErrors) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		if v.Kind() == reflect.Interface && v.Elem().Kind() == reflect.Pointer {
+			// A polymorphic field holds a type derived from t.
+			if u := schema().Types[complexTypeNames[v.Elem().Type().Elem()]]; u != nil {
+				t = u
+			}
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Uint8 && v.CanInterface() {
+		if e, ok := v.Interface().(enumeration); ok && !e.Valid() {
+			*errs = append(*errs, &ValidationError{Path: path, Msg: strconv.FormatUint(v.Uint(), 10) +
+				" is a reserved value of " + v.Type().Name()})
+			return
+		}
+	}
+	if t.SimpleContent() != nil {
+		if text, ok := simpleText(v); ok {
+			checkText(text, t, path, errs)
+		}
+	}
+	if v.Kind() == reflect.Struct {
+		checkFields(v, t, path, errs)
+	}
+}
+
+// checkText checks the character data of a value of type t.
+func checkText(text string, t *xsd.Type, path string, errs *ValidationErrors) {
+	st := t.SimpleContent()
+	text = st.Normalize(text)
+	if err := st.ValidateValue(text); err != nil {
+		*errs = append(*errs, &ValidationError{Path: path, Msg: err.Error()})
+		return
+	}
+	for u := t; u != nil; u = u.Base {
+		r, ok := valueRanges[u.Name]
+		if !ok {
+			continue
+		}
+		if n, err := strconv.ParseInt(text, 10, 64); err != nil || n < r[0] || n > r[1] {
+			*errs = append(*errs, &ValidationError{Path: path, Msg: text + " is outside the range " +
+				strconv.FormatInt(r[0], 10) + " to " + strconv.FormatInt(r[1], 10) + " of " + u.Name})
+			return
+		}
+	}
+}
+
+// checkFields checks the element and attribute fields of the struct v,
+// including those of its embedded base types, against the type t.
+func checkFields(v reflect.Value, t *xsd.Type, path string, errs *ValidationErrors) {
+	typ := v.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if !f.IsExported() || f.Name == "XMLName" {
+			continue
+		}
+		fv := v.Field(i)
+		if f.Anonymous {
+			if fv.Kind() == reflect.Pointer && !fv.IsNil() {
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct && t.SimpleContent() == nil {
+				checkFields(fv, t, path, errs)
+			}
+			continue
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get("xml"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		if strings.Contains(","+opts+",", ",omitempty,") && fv.IsZero() {
+			continue
+		}
+		if strings.Contains(","+opts+",", ",attr,") {
+			a := t.Attribute(name)
+			if a == nil {
+				continue
+			}
+			if fv.Kind() == reflect.Pointer && fv.IsNil() {
+				continue
+			}
+			if text, ok := simpleText(fv); ok {
+				checkText(text, a.Type, path+"/@"+name, errs)
+			}
+			continue
+		}
+		e := elementOf(t, name)
+		if e == nil {
+			continue
+		}
+		if fv.Kind() == reflect.Slice {
+			for j := 0; j < fv.Len(); j++ {
+				checkValue(fv.Index(j), e.Type, path+"/"+name+"["+strconv.Itoa(j+1)+"]", errs)
+			}
+			continue
+		}
+		checkValue(fv, e.Type, path+"/"+name, errs)
+	}
+}
+
+// elementOf returns the declaration of the child element name of t.
+func elementOf(t *xsd.Type, name string) *xsd.Element {
+	for _, p := range t.Content() {
+		if p.Element != nil && p.Element.Name == name {
+			return p.Element
+		}
+	}
+	return nil
+}
+
+// simpleText returns the lexical form of a scalar value, looking through the
+// embedded pointers of the wrapped simple types.
+func simpleText(v reflect.Value) (string, bool) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return "", false
+		}
+		return simpleText(v.Elem())
+	case reflect.String:
+		return v.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).Anonymous {
+				return simpleText(v.Field(i))
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,88 @@
+package sep
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFacets(t *testing.T) {
+	powerStatus := func(v uint16) *PowerStatus {
+		p := NewPowerStatus()
+		p.EstimatedChargeRemaining = NewPerCent(v)
+		return p
+	}
+	fixedVar := func(v int16) *FixedVar {
+		return &FixedVar{Value: NewSignedPerCent(v)}
+	}
+	randomized := func(v int16) *DERControl {
+		c := NewDERControl()
+		c.RandomizeDuration = NewOneHourRangeType(v)
+		return c
+	}
+	supplyLimit := func(v uint64) *ReadingType {
+		r := NewReadingType()
+		r.SupplyLimit = &v
+		return r
+	}
+	description := func(s string) *FunctionSetAssignments {
+		f := NewFunctionSetAssignments()
+		f.Description = NewString(s)
+		return f
+	}
+	responseRequired := func(s string) *DERControl {
+		c := NewDERControl()
+		c.ResponseRequiredAttr = s
+		return c
+	}
+	lfdis := func(s string) *EndDeviceList {
+		l := NewEndDeviceList()
+		for _, lfdi := range []string{exampleLFDI, s} {
+			d := NewEndDevice()
+			d.LFDI = NewString(lfdi)
+			l.EndDevice = append(l.EndDevice, d)
+		}
+		return l
+	}
+
+	for _, tt := range []struct {
+		name string
+		v    interface{ Validate() error }
+		// path and msg are those of the one error, or "" for none.
+		path, msg string
+	}{
+		{"PerCent 100%", powerStatus(10000), "", ""},
+		{"PerCent above 100%", powerStatus(10001), "/PowerStatus/estimatedChargeRemaining", "10001 is outside the range 0 to 10000 of PerCent"},
+		{"SignedPerCent -100%", fixedVar(-10000), "", ""},
+		{"SignedPerCent below -100%", fixedVar(-10001), "/FixedVar/value", "-10001 is outside the range -10000 to 10000 of SignedPerCent"},
+		{"SignedPerCent above 100%", fixedVar(10001), "/FixedVar/value", "10001 is outside the range -10000 to 10000 of SignedPerCent"},
+		{"OneHourRangeType an hour", randomized(-3600), "", ""},
+		{"OneHourRangeType below an hour", randomized(-3601), "/DERControl/randomizeDuration", "-3601 is outside the range -3600 to 3600 of OneHourRangeType"},
+		{"OneHourRangeType above an hour", randomized(3601), "/DERControl/randomizeDuration", "3601 is outside the range -3600 to 3600 of OneHourRangeType"},
+		{"UInt40 maximum", UInt40(1<<40 - 1), "", ""},
+		{"UInt40 above maximum", UInt40(1 << 40), "", "1099511627776 is outside the range 0 to 1099511627775 of UInt40"},
+		{"UInt48 maximum", supplyLimit(1<<48 - 1), "", ""},
+		{"UInt48 above maximum", supplyLimit(1 << 48), "/ReadingType/supplyLimit", "281474976710656 is greater than the maximum 281474976710655 of UInt48"},
+		{"UInt48 value above maximum", UInt48(1 << 48), "", "281474976710656 is greater than the maximum 281474976710655 of UInt48"},
+		{"String32 of 32 characters", description(strings.Repeat("é", 32)), "", ""},
+		{"String32 too long", description(strings.Repeat("a", 33)), "/FunctionSetAssignments/description", "String32: length 33 characters exceeds the maximum 32"},
+		{"HexBinary8", responseRequired("01"), "", ""},
+		{"HexBinary8 too long", responseRequired("0102"), "/DERControl/@responseRequired", "HexBinary8: length 2 octets exceeds the maximum 1"},
+		{"HexBinary128", &IPAddr{Address: strings.Repeat("ff", 16)}, "", ""},
+		{"HexBinary128 too long", &IPAddr{Address: strings.Repeat("ff", 17)}, "/IPAddr/address", "HexBinary128: length 17 octets exceeds the maximum 16"},
+		{"HexBinary160", lfdis(exampleLFDI), "", ""},
+		{"HexBinary160 too long", lfdis(exampleLFDI + "00"), "/EndDeviceList/EndDevice[2]/lFDI", "HexBinary160: length 21 octets exceeds the maximum 20"},
+		{"HexBinary160 odd digits", lfdis("ABC"), "/EndDeviceList/EndDevice[2]/lFDI", `"ABC" is not a valid hexBinary`},
+	} {
+		err := tt.v.Validate()
+		if tt.msg == "" {
+			if err != nil {
+				t.Errorf("%s: %v", tt.name, err)
+			}
+			continue
+		}
+		errs, ok := err.(ValidationErrors)
+		if !ok || len(errs) != 1 || errs[0].Path != tt.path || errs[0].Msg != tt.msg {
+			t.Errorf("%s: Validate() = %v, want %s: %s", tt.name, err, tt.path, tt.msg)
+		}
+	}
+}
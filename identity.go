@@ -0,0 +1,124 @@
+package sep
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A device is identified by the fingerprint of its certificate, the
+// SHA-256 hash of the certificate's DER encoding. The long form device
+// identifier (LFDI) is the fingerprint truncated to its leftmost 160 bits;
+// the short form device identifier (SFDI) is the leftmost 36 bits, as a
+// decimal number, followed by a check digit.
+
+// ErrInvalidSFDI is returned, wrapped, for an SFDI whose check digit is
+// wrong or which is out of range.
+var ErrInvalidSFDI = errors.New("sep: invalid SFDI")
+
+const maxSFDI = 1<<36*10 - 1
+
+// LFDI returns the LFDI of cert as 40 uppercase hex digits, the form of
+// the lFDI elements.
+func LFDI(cert *x509.Certificate) string {
+	return LFDIFromDER(cert.Raw)
+}
+
+// LFDIFromDER returns the LFDI of the DER-encoded certificate der.
+func LFDIFromDER(der []byte) string {
+	sum := sha256.Sum256(der)
+	return strings.ToUpper(hex.EncodeToString(sum[:20]))
+}
+
+// SFDI returns the SFDI of cert.
+func SFDI(cert *x509.Certificate) uint64 {
+	return SFDIFromDER(cert.Raw)
+}
+
+// SFDIFromDER returns the SFDI of the DER-encoded certificate der.
+func SFDIFromDER(der []byte) uint64 {
+	sum := sha256.Sum256(der)
+	v := uint64(sum[0])<<28 | uint64(sum[1])<<20 | uint64(sum[2])<<12 | uint64(sum[3])<<4 | uint64(sum[4])>>4
+	return v*10 + checkDigit(v)
+}
+
+// SFDIFromLFDI returns the SFDI of the device whose LFDI is lfdi, in any
+// form ParseLFDI accepts.
+func SFDIFromLFDI(lfdi string) (uint64, error) {
+	l, err := ParseLFDI(lfdi)
+	if err != nil {
+		return 0, err
+	}
+	v, _ := strconv.ParseUint(l[:9], 16, 64)
+	return v*10 + checkDigit(v), nil
+}
+
+// checkDigit returns the digit that makes the sum of the decimal digits of
+// v and itself a multiple of 10.
+func checkDigit(v uint64) uint64 {
+	var sum uint64
+	for ; v > 0; v /= 10 {
+		sum += v % 10
+	}
+	return (10 - sum%10) % 10
+}
+
+// ValidSFDI reports whether sfdi is in range and ends in the check digit of
+// the digits before it.
+func ValidSFDI(sfdi uint64) bool {
+	return sfdi <= maxSFDI && checkDigit(sfdi/10) == sfdi%10
+}
+
+// ParseLFDI returns the canonical form of an LFDI: 40 uppercase hex digits.
+// It accepts either case and, as in the grouped form FormatLFDI returns,
+// hyphens between the digits.
+func ParseLFDI(s string) (string, error) {
+	l := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(s), "-", ""))
+	if _, err := hex.DecodeString(l); err != nil || len(l) != 40 {
+		return "", fmt.Errorf("sep: invalid LFDI %q", s)
+	}
+	return l, nil
+}
+
+// FormatLFDI returns lfdi in groups of four hex digits separated by hyphens,
+// the form for display to users, such as
+// "3E4F-45AB-31ED-FE5B-67E3-43E5-E456-2E31-984E-23E5".
+func FormatLFDI(lfdi string) string {
+	var b strings.Builder
+	for i, c := range lfdi {
+		if i > 0 && i%4 == 0 {
+			b.WriteByte('-')
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// ParseSFDI parses an SFDI in decimal, with or without the hyphens of the
+// form FormatSFDI returns, and checks its check digit.
+func ParseSFDI(s string) (uint64, error) {
+	v, err := strconv.ParseUint(strings.ReplaceAll(strings.TrimSpace(s), "-", ""), 10, 64)
+	if err != nil || !ValidSFDI(v) {
+		return 0, fmt.Errorf("%w %q", ErrInvalidSFDI, s)
+	}
+	return v, nil
+}
+
+// FormatSFDI returns sfdi as twelve decimal digits in groups of three
+// separated by hyphens, the form for display to users, such as
+// "167-261-211-391".
+func FormatSFDI(sfdi uint64) string {
+	s := fmt.Sprintf("%012d", sfdi)
+	var b strings.Builder
+	for i := 0; i < len(s); i += 3 {
+		if i > 0 {
+			b.WriteByte('-')
+		}
+		b.WriteString(s[i:min(i+3, len(s))])
+	}
+	return b.String()
+}
@@ -0,0 +1,124 @@
+package sep
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// The worked example of IEEE 2030.5: a certificate whose
+// fingerprint is 3E4F45AB...EE213A.
+const (
+	exampleLFDI = "3E4F45AB31EDFE5B67E343E5E4562E31984E23E5"
+	exampleSFDI = 167261211391
+)
+
+func TestSFDIFromLFDIExample(t *testing.T) {
+	for _, in := range []string{
+		exampleLFDI,
+		strings.ToLower(exampleLFDI),
+		"3E4F-45AB-31ED-FE5B-67E3-43E5-E456-2E31-984E-23E5",
+		" " + exampleLFDI + "\n",
+	} {
+		got, err := SFDIFromLFDI(in)
+		if err != nil || got != exampleSFDI {
+			t.Errorf("SFDIFromLFDI(%q) = %d, %v, want %d", in, got, err, uint64(exampleSFDI))
+		}
+	}
+	if got := FormatLFDI(exampleLFDI); got != "3E4F-45AB-31ED-FE5B-67E3-43E5-E456-2E31-984E-23E5" {
+		t.Errorf("FormatLFDI = %q", got)
+	}
+	if got := FormatSFDI(exampleSFDI); got != "167-261-211-391" {
+		t.Errorf("FormatSFDI = %q", got)
+	}
+	if !ValidSFDI(exampleSFDI) {
+		t.Error("the example SFDI is not valid")
+	}
+}
+
+func TestFromDER(t *testing.T) {
+	der := []byte("not really a certificate, but hashed all the same")
+	sum := sha256.Sum256(der)
+	cert := &x509.Certificate{Raw: der}
+
+	lfdi := LFDI(cert)
+	if want := strings.ToUpper(hex.EncodeToString(sum[:20])); lfdi != want || LFDIFromDER(der) != want {
+		t.Errorf("LFDI = %s, want %s", lfdi, want)
+	}
+	sfdi := SFDI(cert)
+	if want, err := SFDIFromLFDI(lfdi); err != nil || sfdi != want || SFDIFromDER(der) != want {
+		t.Errorf("SFDI = %d, want %d, the SFDI of the LFDI", sfdi, want)
+	}
+	if !ValidSFDI(sfdi) || sfdi > 1<<36*10-1 {
+		t.Errorf("SFDI %d is not valid", sfdi)
+	}
+}
+
+func TestParseLFDI(t *testing.T) {
+	for _, tt := range []struct {
+		in, want string
+	}{
+		{exampleLFDI, exampleLFDI},
+		{strings.ToLower(exampleLFDI), exampleLFDI},
+		{FormatLFDI(exampleLFDI), exampleLFDI},
+		{exampleLFDI[:38], ""},
+		{exampleLFDI + "00", ""},
+		{"G" + exampleLFDI[1:], ""},
+		{"", ""},
+	} {
+		got, err := ParseLFDI(tt.in)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("ParseLFDI(%q) = %q, want error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseLFDI(%q) = %q, %v, want %q", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseSFDI(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		want uint64
+		ok   bool
+	}{
+		{"167261211391", exampleSFDI, true},
+		{"167-261-211-391", exampleSFDI, true},
+		{" 167261211391 ", exampleSFDI, true},
+		// Every other check digit is wrong.
+		{"167261211390", 0, false},
+		{"167261211392", 0, false},
+		{"167261211399", 0, false},
+		// A changed digit.
+		{"167261211381", 0, false},
+		// 0 is the check digit of 0.
+		{"0", 0, true},
+		{"5", 0, false},
+		// The largest 36-bit value, 68719476735, has check digit 7; one
+		// more is out of range.
+		{"687194767357", 687194767357, true},
+		{"687194767366", 0, false},
+		{"-1", 0, false},
+		{"sfdi", 0, false},
+	} {
+		got, err := ParseSFDI(tt.in)
+		if !tt.ok {
+			if !errors.Is(err, ErrInvalidSFDI) {
+				t.Errorf("ParseSFDI(%q) = %d, %v, want ErrInvalidSFDI", tt.in, got, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseSFDI(%q) = %d, %v, want %d", tt.in, got, err, tt.want)
+		}
+	}
+	if got := FormatSFDI(1230); got != "000-000-001-230" {
+		t.Errorf("FormatSFDI(1230) = %q, want zero padding", got)
+	}
+}
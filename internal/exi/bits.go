@@ -0,0 +1,121 @@
+package exi
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// errTruncated is returned when a stream ends inside an event or value.
+var errTruncated = errors.New("exi: unexpected end of stream")
+
+// writer writes a bit-packed EXI stream, most significant bit first.
+type writer struct {
+	buf  []byte
+	used uint // bits used in the last byte of buf; 0 means it is full
+}
+
+// bits writes the n low-order bits of v.
+func (w *writer) bits(v uint64, n int) {
+	for i := n - 1; i >= 0; i-- {
+		if w.used == 0 {
+			w.buf = append(w.buf, 0)
+		}
+		if v>>uint(i)&1 == 1 {
+			w.buf[len(w.buf)-1] |= 0x80 >> w.used
+		}
+		w.used = (w.used + 1) % 8
+	}
+}
+
+// uint writes an Unsigned Integer: seven bits per octet, least
+// significant group first, the high bit set on all but the last octet.
+func (w *writer) uint(v uint64) {
+	for v >= 0x80 {
+		w.bits(v&0x7F|0x80, 8)
+		v >>= 7
+	}
+	w.bits(v, 8)
+}
+
+// int writes an Integer: a sign bit followed by the magnitude, which for a
+// negative value is its absolute value minus one.
+func (w *writer) int(v int64) {
+	if v < 0 {
+		w.bits(1, 1)
+		w.uint(uint64(-(v + 1)))
+		return
+	}
+	w.bits(0, 1)
+	w.uint(uint64(v))
+}
+
+// bytes returns the stream, padded with zero bits to a whole octet.
+func (w *writer) bytes() []byte {
+	return w.buf
+}
+
+// reader reads a bit-packed EXI stream.
+type reader struct {
+	buf []byte
+	pos uint // bit position
+}
+
+// bits reads an n-bit unsigned integer.
+func (r *reader) bits(n int) (uint64, error) {
+	if r.pos+uint(n) > uint(len(r.buf))*8 {
+		return 0, errTruncated
+	}
+	var v uint64
+	for i := 0; i < n; i++ {
+		b := r.buf[r.pos/8] >> (7 - r.pos%8) & 1
+		v = v<<1 | uint64(b)
+		r.pos++
+	}
+	return v, nil
+}
+
+// uint reads an Unsigned Integer.
+func (r *reader) uint() (uint64, error) {
+	var v uint64
+	for shift := uint(0); ; shift += 7 {
+		b, err := r.bits(8)
+		if err != nil {
+			return 0, err
+		}
+		if shift > 63 || (shift == 63 && b&0x7F > 1) {
+			return 0, errors.New("exi: unsigned integer overflows 64 bits")
+		}
+		v |= (b & 0x7F) << shift
+		if b&0x80 == 0 {
+			return v, nil
+		}
+	}
+}
+
+// int reads an Integer.
+func (r *reader) int() (int64, error) {
+	sign, err := r.bits(1)
+	if err != nil {
+		return 0, err
+	}
+	m, err := r.uint()
+	if err != nil {
+		return 0, err
+	}
+	if m > 1<<63-1 {
+		return 0, errors.New("exi: integer overflows 64 bits")
+	}
+	if sign == 1 {
+		return -int64(m) - 1, nil
+	}
+	return int64(m), nil
+}
+
+// width returns the number of bits of an n-bit code distinguishing n
+// values, ⌈log2 n⌉.
+func width(n int) int {
+	if n <= 1 {
+		return 0
+	}
+	return bits.Len(uint(n - 1))
+}
@@ -0,0 +1,420 @@
+// Package exi encodes XML documents as Efficient XML Interchange (EXI) 1.0
+// streams and decodes them back, using the strict schema-informed grammars
+// of a schema as IEEE 2030.5 requires: bit-packed alignment, no options in
+// the header, and no preservation of comments, processing instructions,
+// prefixes or undeclared content. Elements matched by a wildcard must have
+// a global declaration in the schema; attributes matched by a wildcard are
+// encoded as strings.
+package exi
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Tylores/sep/internal/xsd"
+)
+
+// header is the EXI header of a stream without options: the distinguishing
+// bits 10, the absent options bit and final version 1.
+const header = 0x80
+
+// Codec encodes and decodes the documents of a schema.
+type Codec struct {
+	s *xsd.Schema
+}
+
+// New returns a Codec for the documents of s.
+func New(s *xsd.Schema) *Codec {
+	return &Codec{s: s}
+}
+
+// Encode returns the EXI stream of the XML document doc.
+func (c *Codec) Encode(doc []byte) ([]byte, error) {
+	root, err := parse(bytes.NewReader(doc))
+	if err != nil {
+		return nil, err
+	}
+	e := &encoder{g: newGrammars(c.s), st: newStringTable(c.s)}
+	e.w.bits(header, 8)
+	if err := e.document(root); err != nil {
+		return nil, err
+	}
+	return e.w.bytes(), nil
+}
+
+// Decode returns the XML document of the EXI stream data.
+func (c *Codec) Decode(data []byte) ([]byte, error) {
+	if bytes.HasPrefix(data, []byte("$EXI")) {
+		data = data[4:]
+	}
+	d := &decoder{g: newGrammars(c.s), st: newStringTable(c.s), r: reader{buf: data}}
+	h, err := d.r.bits(8)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case h>>6 != 2:
+		return nil, errors.New("exi: stream does not start with an EXI header")
+	case h&0x20 != 0:
+		return nil, errors.New("exi: EXI options in the header are not supported")
+	case h&0x1F != 0:
+		return nil, fmt.Errorf("exi: unsupported EXI version in header %#x", h)
+	}
+	root, err := d.document()
+	if err != nil {
+		return nil, err
+	}
+	return format(root)
+}
+
+type encoder struct {
+	g  *grammars
+	st *stringTable
+	w  writer
+}
+
+// code writes the event code of production i of a state with n productions,
+// which has a second level when escape is set.
+func (e *encoder) code(i, n int, escape bool) {
+	if escape {
+		n++
+	}
+	e.w.bits(uint64(i), width(n))
+}
+
+// document writes the body of a document whose root element is root.
+func (e *encoder) document(root *element) error {
+	for i, decl := range e.g.document {
+		if root.name.Space == e.g.s.TargetNamespace && root.name.Local == decl.Name {
+			e.code(i, len(e.g.document)+1, false)
+			return e.element(root, e.g.of(decl.Type))
+		}
+	}
+	return fmt.Errorf("exi: no global declaration for the root element <%s>", root.name.Local)
+}
+
+// element writes the events of el, from its attributes to its end element,
+// according to the grammar g of its declared type.
+func (e *encoder) element(el *element, g *grammar) error {
+	s := state{}
+	if el.xsiType != nil {
+		t := e.g.s.Types[el.xsiType.Local]
+		if !g.subtyped || el.xsiType.Space != e.g.s.TargetNamespace || t == nil || !t.DerivesFrom(g.t) {
+			return fmt.Errorf("exi: xsi:type %s is not allowed on <%s>", el.xsiType.Local, el.name.Local)
+		}
+		e.code(len(g.productions(s)), len(g.productions(s)), true)
+		e.qname(*el.xsiType)
+		g = e.g.of(t)
+	}
+
+	// Attributes matched by the wildcard come first, while the grammar is
+	// in its first state, then the declared ones in order.
+	var declared []xml.Attr
+	for _, a := range el.attrs {
+		if a.Name.Space == "" && g.t.Attribute(a.Name.Local) != nil {
+			declared = append(declared, a)
+			continue
+		}
+		p := g.productions(s)
+		i := find(p, func(p production) bool { return p.event == evATAny })
+		if i < 0 {
+			return fmt.Errorf("exi: attribute %s is not allowed on <%s>", a.Name.Local, el.name.Local)
+		}
+		e.code(i, len(p), s == state{} && g.subtyped)
+		e.qname(a.Name)
+		e.writeString(a.Name, nil, a.Value)
+	}
+	sortAttrs(declared)
+	for _, a := range declared {
+		p := g.productions(s)
+		i := find(p, func(p production) bool { return p.event == evAT && p.attr.Name == a.Name.Local })
+		if i < 0 {
+			return fmt.Errorf("exi: attribute %s is not allowed on <%s>", a.Name.Local, el.name.Local)
+		}
+		e.code(i, len(p), s == state{} && g.subtyped)
+		if err := e.writeValue(a.Name, datatypeOf(p[i].attr.Type), a.Value); err != nil {
+			return fmt.Errorf("%w in attribute %s of <%s>", err, a.Name.Local, el.name.Local)
+		}
+		s = p[i].next
+	}
+
+	if g.simple != nil {
+		p := g.productions(s)
+		i := find(p, func(p production) bool { return p.event == evCH })
+		e.code(i, len(p), s == state{} && g.subtyped)
+		if err := e.writeValue(el.name, datatypeOf(g.simple), el.text); err != nil {
+			return fmt.Errorf("%w in <%s>", err, el.name.Local)
+		}
+		s = p[i].next
+	} else {
+		if strings.TrimSpace(el.text) != "" {
+			return fmt.Errorf("exi: <%s> has no character content", el.name.Local)
+		}
+		for _, c := range el.children {
+			p := g.productions(s)
+			escape := s == state{} && g.subtyped
+			i := find(p, func(p production) bool {
+				return p.event == evSE && c.name.Local == p.elem.Name && c.name.Space == g.target
+			})
+			if i >= 0 {
+				e.code(i, len(p), escape)
+				if err := e.element(c, e.g.of(p[i].elem.Type)); err != nil {
+					return err
+				}
+				s = p[i].next
+				continue
+			}
+			i = find(p, func(p production) bool {
+				return (p.event == evSEURI && p.uri == c.name.Space) || p.event == evSEAny
+			})
+			decl := e.g.s.Elements[c.name.Local]
+			if i < 0 || c.name.Space != g.target || decl == nil {
+				return fmt.Errorf("exi: element <%s> is not allowed in <%s>", c.name.Local, el.name.Local)
+			}
+			e.code(i, len(p), escape)
+			if p[i].event == evSEAny {
+				e.uri(c.name.Space)
+			}
+			e.localName(c.name.Space, c.name.Local)
+			if err := e.element(c, e.g.of(decl.Type)); err != nil {
+				return err
+			}
+			s = p[i].next
+		}
+	}
+	p := g.productions(s)
+	i := find(p, func(p production) bool { return p.event == evEE })
+	if i < 0 {
+		return fmt.Errorf("exi: <%s> is missing required content", el.name.Local)
+	}
+	e.code(i, len(p), s == state{} && g.subtyped)
+	return nil
+}
+
+// qname writes a qualified name as a URI and a local name.
+func (e *encoder) qname(n xml.Name) {
+	e.uri(n.Space)
+	e.localName(n.Space, n.Local)
+}
+
+func (e *encoder) uri(uri string) {
+	n := width(len(e.st.uris.list) + 1)
+	if id, ok := e.st.uris.lookup(uri); ok {
+		e.w.bits(uint64(id+1), n)
+		return
+	}
+	e.w.bits(0, n)
+	e.literal(uri)
+	e.st.addURI(uri)
+}
+
+func (e *encoder) localName(uri, name string) {
+	p := e.st.locals[uri]
+	if id, ok := p.lookup(name); ok {
+		e.w.uint(0)
+		e.w.bits(uint64(id), width(len(p.list)))
+		return
+	}
+	runes := []rune(name)
+	e.w.uint(uint64(len(runes)) + 1)
+	e.writeChars(nil, runes)
+	p.add(name)
+}
+
+// literal writes a string literal preceded by its length.
+func (e *encoder) literal(s string) {
+	runes := []rune(s)
+	e.w.uint(uint64(len(runes)))
+	e.writeChars(nil, runes)
+}
+
+type decoder struct {
+	g  *grammars
+	st *stringTable
+	r  reader
+}
+
+// code reads the event code of a state with n productions and reports the
+// index of its production, or n for the second-level xsi:type production.
+func (d *decoder) code(n int, escape bool) (int, error) {
+	m := n
+	if escape {
+		m++
+	}
+	i, err := d.r.bits(width(m))
+	if err != nil {
+		return 0, err
+	}
+	if int(i) >= m {
+		return 0, fmt.Errorf("exi: invalid event code %d", i)
+	}
+	return int(i), nil
+}
+
+// document reads the body of a document and returns its root element.
+func (d *decoder) document() (*element, error) {
+	i, err := d.code(len(d.g.document)+1, false)
+	if err != nil {
+		return nil, err
+	}
+	if i == len(d.g.document) {
+		return nil, errors.New("exi: root elements without a global declaration are not supported")
+	}
+	decl := d.g.document[i]
+	return d.element(xml.Name{Space: d.g.s.TargetNamespace, Local: decl.Name}, d.g.of(decl.Type))
+}
+
+// element reads the events of the element name, whose start element has
+// been read, according to the grammar g of its declared type.
+func (d *decoder) element(name xml.Name, g *grammar) (*element, error) {
+	el := &element{name: name}
+	s := state{}
+	for {
+		p := g.productions(s)
+		escape := s == state{} && g.subtyped
+		i, err := d.code(len(p), escape)
+		if err != nil {
+			return nil, err
+		}
+		if i == len(p) {
+			t, err := d.qname()
+			if err != nil {
+				return nil, err
+			}
+			u := d.g.s.Types[t.Local]
+			if t.Space != d.g.s.TargetNamespace || u == nil || !u.DerivesFrom(g.t) {
+				return nil, fmt.Errorf("exi: xsi:type %s is not allowed on <%s>", t.Local, name.Local)
+			}
+			el.xsiType = &t
+			g = d.g.of(u)
+			continue
+		}
+		switch prod := p[i]; prod.event {
+		case evAT:
+			an := xml.Name{Local: prod.attr.Name}
+			v, err := d.readValue(an, datatypeOf(prod.attr.Type))
+			if err != nil {
+				return nil, err
+			}
+			el.attrs = append(el.attrs, xml.Attr{Name: an, Value: v})
+		case evATAny:
+			an, err := d.qname()
+			if err != nil {
+				return nil, err
+			}
+			v, err := d.readString(an, nil)
+			if err != nil {
+				return nil, err
+			}
+			el.attrs = append(el.attrs, xml.Attr{Name: an, Value: v})
+		case evCH:
+			if el.text, err = d.readValue(name, datatypeOf(g.simple)); err != nil {
+				return nil, err
+			}
+		case evSE:
+			c, err := d.element(xml.Name{Space: g.target, Local: prod.elem.Name}, d.g.of(prod.elem.Type))
+			if err != nil {
+				return nil, err
+			}
+			el.children = append(el.children, c)
+		case evSEURI, evSEAny:
+			cn := xml.Name{Space: prod.uri}
+			if prod.event == evSEAny {
+				if cn.Space, err = d.uri(); err != nil {
+					return nil, err
+				}
+			}
+			if cn.Local, err = d.localName(cn.Space); err != nil {
+				return nil, err
+			}
+			decl := d.g.s.Elements[cn.Local]
+			if cn.Space != d.g.s.TargetNamespace || decl == nil {
+				return nil, fmt.Errorf("exi: element <%s> has no global declaration", cn.Local)
+			}
+			c, err := d.element(cn, d.g.of(decl.Type))
+			if err != nil {
+				return nil, err
+			}
+			el.children = append(el.children, c)
+		case evEE:
+			return el, nil
+		}
+		s = p[i].next
+	}
+}
+
+func (d *decoder) qname() (xml.Name, error) {
+	uri, err := d.uri()
+	if err != nil {
+		return xml.Name{}, err
+	}
+	local, err := d.localName(uri)
+	return xml.Name{Space: uri, Local: local}, err
+}
+
+func (d *decoder) uri() (string, error) {
+	id, err := d.r.bits(width(len(d.st.uris.list) + 1))
+	if err != nil {
+		return "", err
+	}
+	if id > 0 {
+		if id > uint64(len(d.st.uris.list)) {
+			return "", fmt.Errorf("exi: URI %d is not in the string table", id-1)
+		}
+		return d.st.uris.list[id-1], nil
+	}
+	n, err := d.r.uint()
+	if err != nil {
+		return "", err
+	}
+	uri, err := d.readChars(nil, n)
+	if err != nil {
+		return "", err
+	}
+	d.st.addURI(uri)
+	return uri, nil
+}
+
+func (d *decoder) localName(uri string) (string, error) {
+	p := d.st.locals[uri]
+	n, err := d.r.uint()
+	if err != nil {
+		return "", err
+	}
+	if n == 0 {
+		id, err := d.r.bits(width(len(p.list)))
+		if err != nil {
+			return "", err
+		}
+		if id >= uint64(len(p.list)) {
+			return "", fmt.Errorf("exi: local name %d is not in the string table", id)
+		}
+		return p.list[id], nil
+	}
+	name, err := d.readChars(nil, n-1)
+	if err != nil {
+		return "", err
+	}
+	p.add(name)
+	return name, nil
+}
+
+func find(p []production, match func(production) bool) int {
+	for i := range p {
+		if match(p[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+func sortAttrs(attrs []xml.Attr) {
+	for i := 1; i < len(attrs); i++ {
+		for j := i; j > 0 && attrs[j].Name.Local < attrs[j-1].Name.Local; j-- {
+			attrs[j], attrs[j-1] = attrs[j-1], attrs[j]
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package exi
+
+import (
+	"sort"
+
+	"github.com/Tylores/sep/internal/xsd"
+)
+
+// event is the kind of the terminal symbol of a production.
+type event int
+
+// The events are declared in the order productions are sorted in within a
+// grammar state.
+const (
+	evAT    event = iota // AT(qname)
+	evATAny              // AT(*)
+	evSE                 // SE(qname)
+	evSEURI              // SE(uri:*)
+	evSEAny              // SE(*)
+	evEE
+	evCH
+)
+
+// production is a schema-informed grammar production.
+type production struct {
+	event event
+	attr  *xsd.Attribute // AT(qname)
+	elem  *xsd.Element   // SE(qname)
+	uri   string         // SE(uri:*)
+	next  state
+}
+
+// state is a non-terminal of the grammar of a type. Before its content, it
+// is the attribute uses of the type from attr onwards; within its content,
+// it is the particles from part onwards, part having occurred count times.
+type state struct {
+	content bool
+	attr    int
+	part    int
+	count   int
+	// done is set once the character data of a simple type has been read.
+	done bool
+}
+
+// grammar is the strict schema-informed grammar of a type.
+type grammar struct {
+	t      *xsd.Type
+	attrs  []*xsd.Attribute // sorted by name
+	wild   bool             // the type has an attribute wildcard
+	simple *xsd.Type        // the type of the character data, if any
+	parts  []*xsd.Particle
+	// subtyped reports whether named types derive from the type, so that
+	// the first state admits AT(xsi:type).
+	subtyped bool
+	target   string
+	states   map[state][]production
+}
+
+// grammars builds and caches the grammars of the types of a schema.
+type grammars struct {
+	s        *xsd.Schema
+	byType   map[*xsd.Type]*grammar
+	document []*xsd.Element // the global elements, sorted by name
+}
+
+func newGrammars(s *xsd.Schema) *grammars {
+	g := &grammars{s: s, byType: make(map[*xsd.Type]*grammar)}
+	names := append([]string(nil), s.ElementNames...)
+	sort.Strings(names)
+	for _, n := range names {
+		g.document = append(g.document, s.Elements[n])
+	}
+	return g
+}
+
+// of returns the grammar of t.
+func (gs *grammars) of(t *xsd.Type) *grammar {
+	if g := gs.byType[t]; g != nil {
+		return g
+	}
+	g := &grammar{
+		t:      t,
+		attrs:  t.AttributeUses(),
+		wild:   t.AnyAttributes(),
+		simple: t.SimpleContent(),
+		target: gs.s.TargetNamespace,
+		states: make(map[state][]production),
+	}
+	if g.simple == nil {
+		g.parts = t.Content()
+	}
+	sort.SliceStable(g.attrs, func(i, j int) bool { return g.attrs[i].Name < g.attrs[j].Name })
+	if t.Name != "" {
+		for _, u := range gs.s.Types {
+			if u != t && u.Name != "" && u.DerivesFrom(t) {
+				g.subtyped = true
+				break
+			}
+		}
+	}
+	gs.byType[t] = g
+	return g
+}
+
+// productions returns the productions of state s, sorted in event code
+// order: declared attributes by name, the attribute wildcard, elements in
+// schema order, element wildcards, end element and character data.
+func (g *grammar) productions(s state) []production {
+	if p, ok := g.states[s]; ok {
+		return p
+	}
+	var p []production
+	if s.content {
+		p = g.content(s)
+	} else {
+		p = g.attributes(s.attr)
+	}
+	sort.SliceStable(p, func(i, j int) bool { return p[i].event < p[j].event })
+	g.states[s] = p
+	return p
+}
+
+// attributes returns the productions of the state before attribute use i:
+// the attribute uses that may come next, the attribute wildcard and, once
+// all remaining uses are optional, the productions that start the content.
+func (g *grammar) attributes(i int) []production {
+	var p []production
+	optional := true
+	for j := i; j < len(g.attrs) && optional; j++ {
+		p = append(p, production{event: evAT, attr: g.attrs[j], next: state{attr: j + 1}})
+		optional = !g.attrs[j].Required
+	}
+	if optional {
+		p = append(p, g.content(state{content: true})...)
+	}
+	if g.wild {
+		p = append(p, production{event: evATAny, next: state{attr: i}})
+	}
+	return p
+}
+
+// content returns the productions of the content state s.
+func (g *grammar) content(s state) []production {
+	if g.simple != nil {
+		if s.done {
+			return []production{{event: evEE}}
+		}
+		return []production{{event: evCH, next: state{content: true, done: true}}}
+	}
+	var p []production
+	k, c := s.part, s.count
+	for ; k < len(g.parts); k, c = k+1, 0 {
+		part := g.parts[k]
+		if c < part.MaxOccurs {
+			n := c + 1
+			if part.MaxOccurs == xsd.Unbounded && n > part.MinOccurs {
+				n = part.MinOccurs
+			}
+			next := state{content: true, part: k, count: n}
+			switch {
+			case part.Element != nil:
+				p = append(p, production{event: evSE, elem: part.Element, next: next})
+			case part.Any.Namespace == "##targetNamespace":
+				p = append(p, production{event: evSEURI, uri: g.target, next: next})
+			default:
+				p = append(p, production{event: evSEAny, next: next})
+			}
+		}
+		if c < part.MinOccurs {
+			return p
+		}
+	}
+	return append(p, production{event: evEE})
+}
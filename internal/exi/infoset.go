@@ -0,0 +1,171 @@
+package exi
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// element is an element of a document, with its namespace declarations
+// resolved away.
+type element struct {
+	name  xml.Name
+	attrs []xml.Attr
+	// xsiType is the value of the xsi:type attribute, if any.
+	xsiType  *xml.Name
+	children []*element
+	text     string
+}
+
+// parse reads the root element of an XML document.
+func parse(r io.Reader) (*element, error) {
+	d := xml.NewDecoder(r)
+	var stack []*element
+	var scopes []map[string]string
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil, fmt.Errorf("exi: document has no root element")
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			scope := make(map[string]string)
+			if len(scopes) > 0 {
+				for k, v := range scopes[len(scopes)-1] {
+					scope[k] = v
+				}
+			}
+			for _, a := range tok.Attr {
+				switch {
+				case a.Name.Space == "" && a.Name.Local == "xmlns":
+					scope[""] = a.Value
+				case a.Name.Space == "xmlns":
+					scope[a.Name.Local] = a.Value
+				}
+			}
+			e := &element{name: tok.Name}
+			for _, a := range tok.Attr {
+				switch {
+				case a.Name.Space == "xmlns" || (a.Name.Space == "" && a.Name.Local == "xmlns"):
+				case a.Name.Space == instanceNamespace && a.Name.Local == "type":
+					prefix, local, ok := strings.Cut(strings.TrimSpace(a.Value), ":")
+					if !ok {
+						prefix, local = "", prefix
+					}
+					uri, ok := scope[prefix]
+					if !ok && prefix != "" {
+						return nil, fmt.Errorf("exi: xsi:type %q uses an undeclared prefix", a.Value)
+					}
+					e.xsiType = &xml.Name{Space: uri, Local: local}
+				default:
+					e.attrs = append(e.attrs, a)
+				}
+			}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, e)
+			}
+			stack = append(stack, e)
+			scopes = append(scopes, scope)
+		case xml.EndElement:
+			e := stack[len(stack)-1]
+			stack, scopes = stack[:len(stack)-1], scopes[:len(scopes)-1]
+			if len(stack) == 0 {
+				return e, nil
+			}
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text += string(tok)
+			}
+		}
+	}
+}
+
+// format writes e as an XML document. The root element declares the
+// namespace of e as the default and prefixes for the other namespaces.
+func format(e *element) ([]byte, error) {
+	prefixes := map[string]string{e.name.Space: ""}
+	var decls []xml.Attr
+	if e.name.Space != "" {
+		decls = append(decls, xml.Attr{Name: xml.Name{Local: "xmlns"}, Value: e.name.Space})
+	}
+	unqualified := false
+	var declare func(*element)
+	declare = func(e *element) {
+		unqualified = unqualified || e.name.Space == ""
+		add := func(uri string) {
+			if _, ok := prefixes[uri]; !ok && uri != "" {
+				p := fmt.Sprintf("ns%d", len(prefixes))
+				if uri == instanceNamespace {
+					p = "xsi"
+				}
+				prefixes[uri] = p
+				decls = append(decls, xml.Attr{Name: xml.Name{Local: "xmlns:" + p}, Value: uri})
+			}
+		}
+		add(e.name.Space)
+		for _, a := range e.attrs {
+			add(a.Name.Space)
+		}
+		if e.xsiType != nil {
+			add(instanceNamespace)
+			add(e.xsiType.Space)
+		}
+		for _, c := range e.children {
+			declare(c)
+		}
+	}
+	declare(e)
+	if unqualified && e.name.Space != "" {
+		return nil, fmt.Errorf("exi: <%s> has unqualified descendants", e.name.Local)
+	}
+
+	var b bytes.Buffer
+	enc := xml.NewEncoder(&b)
+	qname := func(n xml.Name) xml.Name {
+		if p := prefixes[n.Space]; p != "" {
+			return xml.Name{Local: p + ":" + n.Local}
+		}
+		return xml.Name{Local: n.Local}
+	}
+	var write func(*element, []xml.Attr) error
+	write = func(e *element, decls []xml.Attr) error {
+		start := xml.StartElement{Name: qname(e.name), Attr: decls}
+		if e.xsiType != nil {
+			start.Attr = append(start.Attr, xml.Attr{Name: qname(xml.Name{Space: instanceNamespace, Local: "type"}), Value: qname(*e.xsiType).Local})
+		}
+		for _, a := range e.attrs {
+			if a.Name.Space == "" {
+				start.Attr = append(start.Attr, a)
+				continue
+			}
+			start.Attr = append(start.Attr, xml.Attr{Name: qname(a.Name), Value: a.Value})
+		}
+		if err := enc.EncodeToken(start); err != nil {
+			return err
+		}
+		if e.text != "" {
+			if err := enc.EncodeToken(xml.CharData(e.text)); err != nil {
+				return err
+			}
+		}
+		for _, c := range e.children {
+			if err := write(c, nil); err != nil {
+				return err
+			}
+		}
+		return enc.EncodeToken(start.End())
+	}
+	if err := write(e, decls); err != nil {
+		return nil, err
+	}
+	if err := enc.Flush(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
@@ -0,0 +1,126 @@
+package exi
+
+import (
+	"encoding/xml"
+	"sort"
+
+	"github.com/Tylores/sep/internal/xsd"
+)
+
+const (
+	xmlNamespace      = "http://www.w3.org/XML/1998/namespace"
+	instanceNamespace = xsd.InstanceNamespace
+)
+
+// builtinNames are the local names of the built-in types of XML Schema,
+// which pre-populate the partition of the XML Schema namespace.
+var builtinNames = []string{
+	"ENTITIES", "ENTITY", "ID", "IDREF", "IDREFS", "NCName", "NMTOKEN",
+	"NMTOKENS", "NOTATION", "Name", "QName", "anySimpleType", "anyType",
+	"anyURI", "base64Binary", "boolean", "byte", "date", "dateTime",
+	"decimal", "double", "duration", "float", "gDay", "gMonth", "gMonthDay",
+	"gYear", "gYearMonth", "hexBinary", "int", "integer", "language", "long",
+	"negativeInteger", "nonNegativeInteger", "nonPositiveInteger",
+	"normalizedString", "positiveInteger", "short", "string", "time", "token",
+	"unsignedByte", "unsignedInt", "unsignedLong", "unsignedShort",
+}
+
+// partition is a string table partition, whose entries are identified by
+// their compact identifiers, the order they were added in.
+type partition struct {
+	list []string
+	ids  map[string]int
+}
+
+func (p *partition) add(s string) {
+	if p.ids == nil {
+		p.ids = make(map[string]int)
+	}
+	p.ids[s] = len(p.list)
+	p.list = append(p.list, s)
+}
+
+func (p *partition) lookup(s string) (int, bool) {
+	id, ok := p.ids[s]
+	return id, ok
+}
+
+// stringTable holds the URI, local-name and value partitions of one stream.
+type stringTable struct {
+	uris   partition
+	locals map[string]*partition // by URI
+	global partition
+	values map[xml.Name]*partition // by qualified name of element or attribute
+}
+
+// newStringTable returns the initial string table of a stream informed by
+// s: the XML, XML Schema instance and XML Schema namespaces and the target
+// namespace of s, with the local names declared in each.
+func newStringTable(s *xsd.Schema) *stringTable {
+	st := &stringTable{
+		locals: make(map[string]*partition),
+		values: make(map[xml.Name]*partition),
+	}
+	names := map[string][]string{
+		"":                {},
+		xmlNamespace:      {"base", "id", "lang", "space"},
+		instanceNamespace: {"nil", "type"},
+		xsd.Namespace:     builtinNames,
+	}
+	target := make(map[string]bool)
+	local := make(map[string]bool)
+	for name, e := range s.Elements {
+		target[name] = true
+		localNames(e.Type, target, local)
+	}
+	for name, t := range s.Types {
+		target[name] = true
+		localNames(t, target, local)
+	}
+	names[s.TargetNamespace] = sorted(target)
+	names[""] = sorted(local)
+	for _, uri := range []string{"", xmlNamespace, instanceNamespace, xsd.Namespace, s.TargetNamespace} {
+		st.addURI(uri)
+		for _, n := range names[uri] {
+			st.locals[uri].add(n)
+		}
+	}
+	return st
+}
+
+// localNames adds the names of the element declarations of t, which are
+// qualified, to target and those of its attributes, which are not, to local.
+func localNames(t *xsd.Type, target, local map[string]bool) {
+	for _, p := range t.Particles {
+		if p.Element != nil {
+			target[p.Element.Name] = true
+		}
+	}
+	for _, a := range t.Attributes {
+		local[a.Name] = true
+	}
+}
+
+func sorted(set map[string]bool) []string {
+	list := make([]string, 0, len(set))
+	for s := range set {
+		list = append(list, s)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func (st *stringTable) addURI(uri string) {
+	st.uris.add(uri)
+	st.locals[uri] = new(partition)
+}
+
+// value returns the local value partition of the element or attribute name.
+func (st *stringTable) value(name xml.Name) *partition {
+	p := st.values[name]
+	if p == nil {
+		p = new(partition)
+		st.values[name] = p
+	}
+	return p
+}
@@ -0,0 +1,307 @@
+package exi
+
+import (
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"math/big"
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Tylores/sep/internal/xsd"
+)
+
+// kind is the EXI representation of the values of a simple type.
+type kind int
+
+const (
+	kindString kind = iota
+	kindBoolean
+	kindBinary
+	kindInteger
+	kindUnsigned
+	kindNBit
+)
+
+// datatype describes how the values of a simple type are represented.
+type datatype struct {
+	kind kind
+	// min and n are the lower bound and width of an n-bit integer.
+	min int64
+	n   int
+	// chars is the restricted character set of a string type, if any.
+	chars []rune
+}
+
+// datatypeOf returns the representation of the values of the simple type t.
+func datatypeOf(t *xsd.Type) datatype {
+	switch prim := t.Primitive(); {
+	case prim == "boolean":
+		return datatype{kind: kindBoolean}
+	case prim == "hexBinary":
+		return datatype{kind: kindBinary}
+	case xsd.IsInteger(prim):
+		min, max := t.IntegerRange()
+		if min != nil && max != nil {
+			r := new(big.Int).Sub(max, min)
+			if r.IsInt64() && r.Int64() < 4096 && min.IsInt64() {
+				return datatype{kind: kindNBit, min: min.Int64(), n: width(int(r.Int64()) + 1)}
+			}
+		}
+		if min != nil && min.Sign() >= 0 {
+			return datatype{kind: kindUnsigned}
+		}
+		return datatype{kind: kindInteger}
+	}
+	for u := t; u != nil; u = u.Base {
+		if u.Facets.Pattern != nil {
+			return datatype{kind: kindString, chars: restrictedChars(u.Facets.Pattern.String())}
+		}
+	}
+	return datatype{kind: kindString}
+}
+
+// restrictedChars returns the set of characters, in code point order, that
+// the regular expression pattern can match, or nil if there are 255 or
+// more of them.
+func restrictedChars(pattern string) []rune {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil
+	}
+	set := make(map[rune]bool)
+	if !collectChars(re, set) || len(set) >= 255 {
+		return nil
+	}
+	chars := make([]rune, 0, len(set))
+	for c := range set {
+		chars = append(chars, c)
+	}
+	sort.Slice(chars, func(i, j int) bool { return chars[i] < chars[j] })
+	return chars
+}
+
+func collectChars(re *syntax.Regexp, set map[rune]bool) bool {
+	switch re.Op {
+	case syntax.OpLiteral:
+		for _, c := range re.Rune {
+			set[c] = true
+		}
+	case syntax.OpCharClass:
+		for i := 0; i+1 < len(re.Rune); i += 2 {
+			if re.Rune[i+1]-re.Rune[i] >= 255 {
+				return false
+			}
+			for c := re.Rune[i]; c <= re.Rune[i+1]; c++ {
+				set[c] = true
+			}
+		}
+	case syntax.OpEmptyMatch, syntax.OpBeginText, syntax.OpEndText, syntax.OpBeginLine, syntax.OpEndLine:
+	case syntax.OpCapture, syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat, syntax.OpConcat, syntax.OpAlternate:
+		for _, sub := range re.Sub {
+			if !collectChars(sub, set) {
+				return false
+			}
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// writeValue writes the lexical value v of an element or attribute name
+// with datatype dt.
+func (e *encoder) writeValue(name xml.Name, dt datatype, v string) error {
+	if dt.kind != kindString {
+		v = strings.TrimSpace(v)
+	}
+	switch dt.kind {
+	case kindBoolean:
+		switch v {
+		case "true", "1":
+			e.w.bits(1, 1)
+		case "false", "0":
+			e.w.bits(0, 1)
+		default:
+			return fmt.Errorf("exi: %q is not a boolean", v)
+		}
+	case kindBinary:
+		b, err := hex.DecodeString(v)
+		if err != nil {
+			return fmt.Errorf("exi: %q is not hexBinary", v)
+		}
+		e.w.uint(uint64(len(b)))
+		for _, c := range b {
+			e.w.bits(uint64(c), 8)
+		}
+	case kindNBit:
+		n, err := strconv.ParseInt(strings.TrimPrefix(v, "+"), 10, 64)
+		if err != nil || n < dt.min || n-dt.min >= 1<<dt.n {
+			return fmt.Errorf("exi: %q is out of range", v)
+		}
+		e.w.bits(uint64(n-dt.min), dt.n)
+	case kindUnsigned:
+		n, err := strconv.ParseUint(strings.TrimPrefix(v, "+"), 10, 64)
+		if err != nil {
+			return fmt.Errorf("exi: %q is not an unsigned integer", v)
+		}
+		e.w.uint(n)
+	case kindInteger:
+		n, err := strconv.ParseInt(strings.TrimPrefix(v, "+"), 10, 64)
+		if err != nil {
+			return fmt.Errorf("exi: %q is not an integer", v)
+		}
+		e.w.int(n)
+	default:
+		e.writeString(name, dt.chars, v)
+	}
+	return nil
+}
+
+// writeString writes a string value through the value partitions of the
+// string table.
+func (e *encoder) writeString(name xml.Name, chars []rune, v string) {
+	local := e.st.value(name)
+	if id, ok := local.lookup(v); ok {
+		e.w.uint(0)
+		e.w.bits(uint64(id), width(len(local.list)))
+		return
+	}
+	if id, ok := e.st.global.lookup(v); ok {
+		e.w.uint(1)
+		e.w.bits(uint64(id), width(len(e.st.global.list)))
+		return
+	}
+	runes := []rune(v)
+	e.w.uint(uint64(len(runes)) + 2)
+	e.writeChars(chars, runes)
+	if len(runes) > 0 {
+		local.add(v)
+		e.st.global.add(v)
+	}
+}
+
+// writeChars writes the characters of a string literal, as indexes into
+// the restricted character set chars if there is one.
+func (e *encoder) writeChars(chars []rune, runes []rune) {
+	n := width(len(chars) + 1)
+	for _, c := range runes {
+		if chars == nil {
+			e.w.uint(uint64(c))
+			continue
+		}
+		i := sort.Search(len(chars), func(i int) bool { return chars[i] >= c })
+		if i < len(chars) && chars[i] == c {
+			e.w.bits(uint64(i), n)
+			continue
+		}
+		e.w.bits(uint64(len(chars)), n)
+		e.w.uint(uint64(c))
+	}
+}
+
+// readValue reads a value of datatype dt and returns its canonical
+// lexical form.
+func (d *decoder) readValue(name xml.Name, dt datatype) (string, error) {
+	switch dt.kind {
+	case kindBoolean:
+		b, err := d.r.bits(1)
+		return strconv.FormatBool(b == 1), err
+	case kindBinary:
+		n, err := d.r.uint()
+		if err != nil {
+			return "", err
+		}
+		if n > uint64(len(d.r.buf)) {
+			return "", errTruncated
+		}
+		b := make([]byte, n)
+		for i := range b {
+			c, err := d.r.bits(8)
+			if err != nil {
+				return "", err
+			}
+			b[i] = byte(c)
+		}
+		return strings.ToUpper(hex.EncodeToString(b)), nil
+	case kindNBit:
+		n, err := d.r.bits(dt.n)
+		return strconv.FormatInt(dt.min+int64(n), 10), err
+	case kindUnsigned:
+		n, err := d.r.uint()
+		return strconv.FormatUint(n, 10), err
+	case kindInteger:
+		n, err := d.r.int()
+		return strconv.FormatInt(n, 10), err
+	}
+	return d.readString(name, dt.chars)
+}
+
+func (d *decoder) readString(name xml.Name, chars []rune) (string, error) {
+	n, err := d.r.uint()
+	if err != nil {
+		return "", err
+	}
+	local := d.st.value(name)
+	switch n {
+	case 0:
+		id, err := d.r.bits(width(len(local.list)))
+		if err != nil {
+			return "", err
+		}
+		if id >= uint64(len(local.list)) {
+			return "", fmt.Errorf("exi: local value %d is not in the string table", id)
+		}
+		return local.list[id], nil
+	case 1:
+		id, err := d.r.bits(width(len(d.st.global.list)))
+		if err != nil {
+			return "", err
+		}
+		if id >= uint64(len(d.st.global.list)) {
+			return "", fmt.Errorf("exi: global value %d is not in the string table", id)
+		}
+		return d.st.global.list[id], nil
+	}
+	v, err := d.readChars(chars, n-2)
+	if err != nil {
+		return "", err
+	}
+	if v != "" {
+		local.add(v)
+		d.st.global.add(v)
+	}
+	return v, nil
+}
+
+// readChars reads a string literal of n characters.
+func (d *decoder) readChars(chars []rune, n uint64) (string, error) {
+	if n > uint64(len(d.r.buf))*8 {
+		return "", errTruncated
+	}
+	var b strings.Builder
+	w := width(len(chars) + 1)
+	for i := uint64(0); i < n; i++ {
+		if chars != nil {
+			c, err := d.r.bits(w)
+			if err != nil {
+				return "", err
+			}
+			if c < uint64(len(chars)) {
+				b.WriteRune(chars[c])
+				continue
+			}
+		}
+		c, err := d.r.uint()
+		if err != nil {
+			return "", err
+		}
+		if c > 0x10FFFF {
+			return "", fmt.Errorf("exi: %#x is not a character", c)
+		}
+		b.WriteRune(rune(c))
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,375 @@
+// Command gen writes the mechanical parts of package sep from the models in
+// sep.go and the types of sep.xsd:
+//
+//   - bases.go, the constructors and nil-safe base accessors;
+//   - marshalers.go, the MarshalXML method of every model type that has no
+//     hand-written one;
+//   - simpletypes.go, the constructors, Value and text methods of the
+//     simple types restricting another;
+//   - types.go, the map from complex type names to Go types;
+//   - units.go, the conversions and arithmetic of the types pairing a value
+//     with a multiplier, listed in quantities;
+//   - validators.go, the Validate method of every model type.
+//
+// It is run by go generate in the directory of package sep.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/Tylores/sep/internal/xsd"
+)
+
+const header = "// Code generated by go run ./internal/gen; DO NOT EDIT.\n\n"
+
+// files maps each generated file to the function writing it.
+var files = map[string]func(*bytes.Buffer, *model) error{
+	"bases.go":       genBases,
+	"marshalers.go":  genMarshalers,
+	"simpletypes.go": genSimpleTypes,
+	"types.go":       genTypes,
+	"units.go":       genUnits,
+	"validators.go":  genValidators,
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen: ")
+	m, err := load(".")
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name := range files {
+		src, err := generate(m, name)
+		if err != nil {
+			log.Fatalf("%s: %v", name, err)
+		}
+		if err := os.WriteFile(name, src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// generate returns the formatted source of the generated file name.
+func generate(m *model, name string) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(header)
+	if err := files[name](&b, m); err != nil {
+		return nil, err
+	}
+	return format.Source(b.Bytes())
+}
+
+// A model is the type declarations of sep.go with the schema they model.
+type model struct {
+	schema *xsd.Schema
+	// order lists the types of sep.go in declaration order.
+	order []string
+	decls map[string]ast.Expr
+	// schemaNames maps Go type names to the schema types they model.
+	schemaNames map[string]string
+	// marshalers holds the types with a hand-written MarshalXML method.
+	marshalers map[string]bool
+}
+
+// load reads sep.go and sep.xsd in the directory of package sep, and the
+// methods of its other hand-written files.
+func load(dir string) (*model, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filepath.Join(dir, "sep.go"), nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	r, err := os.Open(filepath.Join(dir, "sep.xsd"))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	s, err := xsd.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	m := &model{schema: s, decls: make(map[string]ast.Expr), schemaNames: make(map[string]string), marshalers: make(map[string]bool)}
+	for _, d := range f.Decls {
+		g, ok := d.(*ast.GenDecl)
+		if !ok || g.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range g.Specs {
+			ts := spec.(*ast.TypeSpec)
+			m.order = append(m.order, ts.Name.Name)
+			m.decls[ts.Name.Name] = ts.Type
+		}
+	}
+	for _, name := range s.TypeNames {
+		if g := goName(name); m.decls[g] != nil {
+			m.schemaNames[g] = name
+		}
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") || files[filepath.Base(path)] != nil {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range f.Decls {
+			fn, ok := d.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || fn.Name.Name != "MarshalXML" {
+				continue
+			}
+			t := fn.Recv.List[0].Type
+			if star, ok := t.(*ast.StarExpr); ok {
+				t = star.X
+			}
+			if id, ok := t.(*ast.Ident); ok {
+				m.marshalers[id.Name] = true
+			}
+		}
+	}
+	return m, nil
+}
+
+// goName returns the Go name of the schema type name.
+func goName(name string) string {
+	name = strings.ReplaceAll(name, "_", "")
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// receiver returns the receiver name of methods on the Go type name.
+func receiver(name string) string {
+	return string(unicode.ToLower(rune(name[0])))
+}
+
+// complexType reports whether the Go type name models a complex type.
+func (m *model) complexType(name string) bool {
+	s, ok := m.schemaNames[name]
+	return ok && !m.schema.Types[s].Simple
+}
+
+// structType reports whether the Go type name models a complex type with
+// fields of its own, rather than one that only adds attributes to a simple
+// type.
+func (m *model) structType(name string) bool {
+	if !m.complexType(name) {
+		return false
+	}
+	t := m.schema.Types[m.schemaNames[name]]
+	return t.Base == nil || !t.Base.Simple
+}
+
+// fields returns the fields of the struct type name.
+func (m *model) fields(name string) []*ast.Field {
+	if st, ok := m.decls[name].(*ast.StructType); ok {
+		return st.Fields.List
+	}
+	return nil
+}
+
+// base returns the complex type the struct type name embeds, if any.
+func (m *model) base(name string) string {
+	for _, f := range m.fields(name) {
+		if len(f.Names) > 0 {
+			continue
+		}
+		if star, ok := f.Type.(*ast.StarExpr); ok {
+			if id, ok := star.X.(*ast.Ident); ok && m.structType(id.Name) {
+				return id.Name
+			}
+		}
+	}
+	return ""
+}
+
+func genTypes(b *bytes.Buffer, m *model) error {
+	var names []string
+	for g, s := range m.schemaNames {
+		if m.complexType(g) {
+			names = append(names, s)
+		}
+	}
+	slices.Sort(names)
+	b.WriteString("package sep\n\nimport \"reflect\"\n\n")
+	b.WriteString("// complexTypes maps the name of every complex type of sep.xsd to its Go type.\n")
+	b.WriteString("var complexTypes = map[string]reflect.Type{\n")
+	for _, s := range names {
+		fmt.Fprintf(b, "\t%q: reflect.TypeFor[%s](),\n", s, goName(s))
+	}
+	b.WriteString(`}
+
+// complexTypeNames maps each Go type of complexTypes back to its schema name.
+var complexTypeNames = func() map[reflect.Type]string {
+	m := make(map[reflect.Type]string, len(complexTypes))
+	for name, t := range complexTypes {
+		m[t] = name
+	}
+	return m
+}()
+`)
+	return nil
+}
+
+func genValidators(b *bytes.Buffer, m *model) error {
+	b.WriteString("package sep\n")
+	for _, g := range m.order {
+		r := receiver(g)
+		if m.structType(g) {
+			fmt.Fprintf(b, "\n// Validate checks every value in %s against the facets of sep.xsd.\n", r)
+			fmt.Fprintf(b, "func (%s *%s) Validate() error {\n", r, g)
+		} else {
+			fmt.Fprintf(b, "\n// Validate checks %s against the facets of %s in sep.xsd.\n", r, g)
+			fmt.Fprintf(b, "func (%s %s) Validate() error {\n", r, g)
+		}
+		fmt.Fprintf(b, "\treturn validateFacets(%s, %q)\n}\n", r, g)
+	}
+	return nil
+}
+
+func genMarshalers(b *bytes.Buffer, m *model) error {
+	b.WriteString("package sep\n\nimport \"encoding/xml\"\n")
+	for _, g := range m.order {
+		if !m.structType(g) || m.marshalers[g] {
+			continue
+		}
+		r, e := receiver(g), "e"
+		if r == e {
+			e = "enc"
+		}
+		fmt.Fprintf(b, "\n// MarshalXML implements xml.Marshaler, writing unknown elements back in place.\n")
+		fmt.Fprintf(b, "func (%s %s) MarshalXML(%s *xml.Encoder, start xml.StartElement) error {\n", r, g, e)
+		fmt.Fprintf(b, "\ttype plain struct {\n\t\t*%s\n\t\tnoMarshalXML\n\t}\n", g)
+		fmt.Fprintf(b, "\treturn marshalInPlace(%s, start, &%s, func(%s *%s) any { return plain{%s: %s} })\n}\n", e, r, r, g, g, r)
+	}
+	return nil
+}
+
+// An accessor is a nil-safe Get method of a type derived from a base type.
+type accessor struct {
+	name, typ string
+	// field is the field of the type read by the accessor, or "" if the
+	// accessor delegates to the base.
+	field string
+}
+
+func genBases(b *bytes.Buffer, m *model) error {
+	// The accessors of a type read the fields of every base type it derives
+	// from, including its own if it is itself a base type.
+	isBase := make(map[string]bool)
+	for _, g := range m.order {
+		if m.structType(g) {
+			if e := m.base(g); e != "" {
+				isBase[e] = true
+			}
+		}
+	}
+	accessors := make(map[string][]accessor)
+	var accessorsOf func(g string) []accessor
+	accessorsOf = func(g string) []accessor {
+		if acc, ok := accessors[g]; ok {
+			return acc
+		}
+		var acc []accessor
+		if e := m.base(g); e != "" {
+			for _, a := range accessorsOf(e) {
+				acc = append(acc, accessor{name: a.name, typ: a.typ})
+			}
+		}
+		if isBase[g] {
+			for _, f := range m.fields(g) {
+				for _, n := range f.Names {
+					if !baseField(n.Name) {
+						continue
+					}
+					a := accessor{"Get" + strings.TrimSuffix(n.Name, "Attr"), types.ExprString(f.Type), n.Name}
+					if i := slices.IndexFunc(acc, func(x accessor) bool { return x.name == a.name }); i >= 0 {
+						acc[i] = a
+					} else {
+						acc = append(acc, a)
+					}
+				}
+			}
+		}
+		accessors[g] = acc
+		return acc
+	}
+
+	b.WriteString("package sep\n")
+	for _, g := range m.order {
+		if !m.structType(g) {
+			continue
+		}
+		r := receiver(g)
+		e := m.base(g)
+		if e != "" {
+			fmt.Fprintf(b, "\n// New%s returns %s %s with its chain of base types allocated.\n", g, article(g), g)
+			fmt.Fprintf(b, "func New%s() *%s {\n\treturn &%s{%s: New%s()}\n}\n", g, g, g, e, e)
+		} else {
+			fmt.Fprintf(b, "\n// New%s returns a new %s.\n", g, g)
+			fmt.Fprintf(b, "func New%s() *%s {\n\treturn &%s{}\n}\n", g, g, g)
+		}
+		for _, a := range accessorsOf(g) {
+			zero, err := m.zero(a.typ)
+			if err != nil {
+				return fmt.Errorf("%s.%s: %v", g, a.name, err)
+			}
+			if a.field != "" {
+				fmt.Fprintf(b, "\n// %s returns %s.%s, or %s if %s is nil.\n", a.name, r, a.field, zero, r)
+			} else {
+				fmt.Fprintf(b, "\n// %s returns the %s of %s, or %s if %s or one of its bases is nil.\n", a.name, a.name[3:], r, zero, r)
+			}
+			fmt.Fprintf(b, "func (%s *%s) %s() %s {\n\tif %s == nil {\n\t\treturn %s\n\t}\n", r, g, a.name, a.typ, r, zero)
+			if a.field != "" {
+				fmt.Fprintf(b, "\treturn %s.%s\n}\n", r, a.field)
+			} else {
+				fmt.Fprintf(b, "\treturn %s.%s.%s()\n}\n", r, e, a.name)
+			}
+		}
+	}
+	return nil
+}
+
+// baseField reports whether the field name of a base type gets accessors:
+// every attribute and element of sep.xsd except the revision 2.3 extension
+// elements and the schemaVer of root elements.
+func baseField(name string) bool {
+	switch name {
+	case "XMLName", "SchemaVerAttr", "Any", "AnyAttr":
+		return false
+	}
+	return !strings.HasSuffix(name, "r23")
+}
+
+// zero returns the zero value of the Go type typ as a Go expression.
+func (m *model) zero(typ string) (string, error) {
+	switch {
+	case strings.HasPrefix(typ, "*"), strings.HasPrefix(typ, "[]"):
+		return "nil", nil
+	case typ == "string":
+		return `""`, nil
+	case typ == "bool":
+		return "false", nil
+	case strings.HasPrefix(typ, "int"), strings.HasPrefix(typ, "uint"), strings.HasPrefix(typ, "float"):
+		return "0", nil
+	}
+	if id, ok := m.decls[typ].(*ast.Ident); ok {
+		return m.zero(id.Name)
+	}
+	return "", fmt.Errorf("no zero value for %s", typ)
+}
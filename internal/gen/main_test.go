@@ -0,0 +1,29 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUpToDate(t *testing.T) {
+	dir := filepath.Join("..", "..")
+	m, err := load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name := range files {
+		want, err := generate(m, name)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date; run go generate", name)
+		}
+	}
+}
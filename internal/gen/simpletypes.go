@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"strings"
+)
+
+// restricted returns the type the struct type name embeds a pointer to if
+// it models a type with the content of another simple type, such as UInt8
+// for PowerSourceType, and the Go type underlying it.
+func (m *model) restricted(name string) (base, underlying string, ok bool) {
+	s, ok := m.schemaNames[name]
+	if !ok {
+		return "", "", false
+	}
+	if t := m.schema.Types[s]; !t.Simple && (t.Base == nil || !t.Base.Simple) {
+		return "", "", false
+	}
+	fields := m.fields(name)
+	if len(fields) != 1 || len(fields[0].Names) != 0 {
+		return "", "", false
+	}
+	star, ok := fields[0].Type.(*ast.StarExpr)
+	if !ok {
+		return "", "", false
+	}
+	id, ok := star.X.(*ast.Ident)
+	if !ok {
+		return "", "", false
+	}
+	u, ok := m.decls[id.Name].(*ast.Ident)
+	if !ok {
+		return "", "", false
+	}
+	// An int, as Int32 is, takes the size of the restricted type.
+	if u.Name == "int" || u.Name == "uint" {
+		return id.Name, u.Name + bitSize(id.Name), true
+	}
+	return id.Name, u.Name, true
+}
+
+// bitSize returns the size of the integer type name, such as 40 for UInt40.
+func bitSize(name string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(name, "U"), "Int")
+}
+
+func genSimpleTypes(b *bytes.Buffer, m *model) error {
+	b.WriteString("package sep\n")
+	for _, g := range m.order {
+		base, underlying, ok := m.restricted(g)
+		if !ok {
+			continue
+		}
+		r := receiver(g)
+		fmt.Fprintf(b, "\n// New%s returns %s %s holding v.\n", g, article(g), g)
+		fmt.Fprintf(b, "func New%s(v %s) *%s {\n\tx := %s(v)\n\treturn &%s{%s: &x}\n}\n", g, underlying, g, base, g, base)
+
+		zero, empty := "0", "zero"
+		if underlying == "string" {
+			zero, empty = `""`, "the empty string"
+		}
+		fmt.Fprintf(b, "\n// Value returns the value held by %s, or %s if it is unset.\n", r, empty)
+		fmt.Fprintf(b, "func (%s %s) Value() %s {\n\tif %s.%s == nil {\n\t\treturn %s\n\t}\n", r, g, underlying, r, base, zero)
+		fmt.Fprintf(b, "\treturn %s(*%s.%s)\n}\n", underlying, r, base)
+
+		var format, parse string
+		switch {
+		case underlying == "string":
+			format = "formatString"
+		case strings.HasPrefix(underlying, "uint"):
+			format, parse = "formatUnsigned", "parseUnsigned"
+		case strings.HasPrefix(underlying, "int"):
+			format, parse = "formatSigned", "parseSigned"
+		default:
+			return fmt.Errorf("%s: no text form of %s", g, underlying)
+		}
+		fmt.Fprintf(b, "\n// MarshalText implements encoding.TextMarshaler.\n")
+		fmt.Fprintf(b, "func (%s %s) MarshalText() ([]byte, error) {\n\treturn %s(%s.%s)\n}\n", r, g, format, r, base)
+
+		fmt.Fprintf(b, "\n// UnmarshalText implements encoding.TextUnmarshaler.\n")
+		fmt.Fprintf(b, "func (%s *%s) UnmarshalText(text []byte) error {\n", r, g)
+		if parse == "" {
+			fmt.Fprintf(b, "\tx := %s(text)\n\t%s.%s = &x\n\treturn nil\n}\n", base, r, base)
+			continue
+		}
+		// The size of the restricted type bounds the value more tightly
+		// than its Go type.
+		fmt.Fprintf(b, "\t%s.%s = new(%s)\n\treturn %s(text, %s, %s.%s)\n}\n", r, base, base, parse, bitSize(base), r, base)
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"strings"
+)
+
+// A quantity is a model type pairing a value with a
+// PowerOfTenMultiplierType, which gets conversions and arithmetic in
+// units.go.
+type quantity struct {
+	name string
+	// unit is the unit of the value in the plural, such as "watts", or ""
+	// for a unitless value or one whose unit is held in other fields.
+	unit string
+	// keep lists the fields that hold the unit of the value. Values made
+	// from a number have none; arithmetic keeps those of the receiver and
+	// fails unless same, a func(a, b *T) bool, reports the operands to be
+	// in the same differ, such as "units".
+	keep         []string
+	same, differ string
+	// powerFactor marks the power factors, whose value is a displacement
+	// without arithmetic or parsing. param, if set, is a bool field that is
+	// part of the value, so that it is made with it and has no Cmp.
+	powerFactor bool
+	param       string
+}
+
+// quantities lists the types of units.go.
+var quantities = []quantity{
+	{name: "ActivePower", unit: "watts"},
+	{name: "ReactivePower", unit: "vars"},
+	{name: "UnsignedActivePower", unit: "watts"},
+	{name: "UnsignedReactivePower", unit: "vars"},
+	{name: "ApparentPower", unit: "volt-amperes"},
+	{name: "VoltageRMS", unit: "volts"},
+	{name: "CurrentRMS", unit: "amperes"},
+	{name: "WattHour", unit: "watt-hours"},
+	{name: "AmpereHour", unit: "ampere-hours"},
+	{name: "RealEnergy", unit: "watt-hours"},
+	{name: "UnitValueType", keep: []string{"Unit"}, same: "sameUnit", differ: "units"},
+	{name: "AccountingUnit", keep: []string{"EnergyUnit", "MonetaryUnit"}, same: "sameCurrency", differ: "currencies"},
+	{name: "FixedPointType"},
+	{name: "UnsignedFixedPointType"},
+	{name: "PowerFactor", powerFactor: true},
+	{name: "PowerFactorWithExcitation", powerFactor: true, param: "Excitation"},
+}
+
+// article returns the indefinite article of the type name.
+func article(name string) string {
+	for _, p := range []string{"One", "Uni", "Uom"} {
+		if strings.HasPrefix(name, p) {
+			return "a"
+		}
+	}
+	if strings.ContainsRune("AEIOU", rune(name[0])) {
+		return "an"
+	}
+	return "a"
+}
+
+// valueOf returns the Go type of the value field of q and the schema type
+// bounding it.
+func (m *model) valueOf(q quantity, field string) (goType, schemaType string, err error) {
+	for _, f := range m.fields(q.name) {
+		for _, n := range f.Names {
+			if n.Name == field {
+				goType = types.ExprString(f.Type)
+			}
+		}
+	}
+	t := m.schema.Types[m.schemaNames[q.name]]
+	if t == nil || goType == "" {
+		return "", "", fmt.Errorf("no field %s", field)
+	}
+	for _, p := range t.Particles {
+		if p.Element != nil && strings.EqualFold(p.Element.Name, field) {
+			return goType, p.Element.Type.Name, nil
+		}
+	}
+	return "", "", fmt.Errorf("no element %s in %s", field, t.Name)
+}
+
+func genUnits(b *bytes.Buffer, m *model) error {
+	b.WriteString("package sep\n\nimport (\n\t\"errors\"\n\t\"math/big\"\n)\n")
+	for _, q := range quantities {
+		if err := genQuantity(b, m, q); err != nil {
+			return fmt.Errorf("%s: %v", q.name, err)
+		}
+	}
+	return nil
+}
+
+func genQuantity(b *bytes.Buffer, m *model, q quantity) error {
+	g, r, a := q.name, receiver(q.name), article(q.name)
+	field, what, example := "Value", "value", "1.25"
+	if q.powerFactor {
+		field, what, example = "Displacement", "displacement", "0.95"
+	}
+	goType, schemaType, err := m.valueOf(q, field)
+	if err != nil {
+		return err
+	}
+	// of is the unit of a number, without, what a value made from one
+	// lacks, and in, the unit of the value of the receiver.
+	of, without, in := "", "", ""
+	if q.unit != "" {
+		of, in = " "+q.unit, " in "+q.unit
+	}
+	if len(q.keep) > 0 {
+		without = " without a unit"
+	}
+	params, args, fields, doc := "", "", "", ""
+	if q.param != "" {
+		p := strings.ToLower(q.param)
+		params, args = ", "+p+" bool", ", "+p
+		fields = ", " + q.param + ": " + p
+		doc = " of the " + p + " given"
+	}
+
+	fmt.Fprintf(b, "\n// %sFromRat returns r%s as %s %s%s%s.\n", g, of, a, g, without, doc)
+	fmt.Fprintf(b, "func %sFromRat(r *big.Rat%s) (*%s, error) {\n", g, params, g)
+	fmt.Fprintf(b, "\tv, m, err := normalize(r, range%s)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n", schemaType)
+	fmt.Fprintf(b, "\treturn &%s{Multiplier: m, %s: %s(v)%s}, nil\n}\n", g, field, goType, fields)
+
+	fmt.Fprintf(b, "\n// %sFromFloat returns f%s as %s %s%s%s.\n", g, of, a, g, without, doc)
+	fmt.Fprintf(b, "func %sFromFloat(f float64%s) (*%s, error) {\n", g, params, g)
+	fmt.Fprintf(b, "\tr, err := ratOfFloat(f)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+	fmt.Fprintf(b, "\treturn %sFromRat(r%s)\n}\n", g, args)
+
+	if !q.powerFactor {
+		number := "a decimal number"
+		if q.unit != "" {
+			number += " of " + q.unit
+		}
+		fmt.Fprintf(b, "\n// Parse%s parses %s, such as %q, as %s %s%s.\n", g, number, example, a, g, without)
+		fmt.Fprintf(b, "func Parse%s(s string) (*%s, error) {\n", g, g)
+		fmt.Fprintf(b, "\tr, err := parseDecimal(s)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+		fmt.Fprintf(b, "\treturn %sFromRat(r)\n}\n", g)
+	}
+
+	fmt.Fprintf(b, "\n// Rat returns the exact %s of %s%s, or 0 if %s is nil.\n", what, r, in, r)
+	fmt.Fprintf(b, "func (%s *%s) Rat() *big.Rat {\n\tif %s == nil {\n\t\treturn new(big.Rat)\n\t}\n", r, g, r)
+	fmt.Fprintf(b, "\treturn decimal(int64(%s.%s), %s.Multiplier)\n}\n", r, field, r)
+
+	fmt.Fprintf(b, "\n// Float64 returns the %s of %s%s, or 0 if %s is nil.\n", what, r, in, r)
+	fmt.Fprintf(b, "func (%s *%s) Float64() float64 { return floatOf(%s.Rat()) }\n", r, g, r)
+
+	fmt.Fprintf(b, "\n// String returns the %s of %s in decimal notation, such as %q.\n", what, r, example)
+	fmt.Fprintf(b, "func (%s *%s) String() string {\n\tif %s == nil {\n\t\treturn \"0\"\n\t}\n", r, g, r)
+	fmt.Fprintf(b, "\treturn formatDecimal(int64(%s.%s), %s.Multiplier)\n}\n", r, field, r)
+
+	if q.param != "" {
+		return nil
+	}
+	fmt.Fprintf(b, "\n// Cmp compares the %ss of %s and b, returning -1, 0 or +1.\n", what, r)
+	fmt.Fprintf(b, "func (%s *%s) Cmp(b *%s) int { return %s.Rat().Cmp(b.Rat()) }\n", r, g, g, r)
+	if q.powerFactor {
+		return nil
+	}
+
+	keeps := ""
+	if len(q.keep) > 0 {
+		keeps = " It keeps the unit of " + r + "."
+		if len(q.keep) > 1 {
+			keeps = " It keeps the units of " + r + "."
+		}
+	}
+	// result writes the return of the value of the *big.Rat expression x.
+	result := func(x string) {
+		if len(q.keep) == 0 {
+			fmt.Fprintf(b, "\treturn %sFromRat(%s)\n}\n", g, x)
+			return
+		}
+		dst := make([]string, len(q.keep))
+		src := make([]string, len(q.keep))
+		for i, f := range q.keep {
+			dst[i], src[i] = "x."+f, r+"."+f
+		}
+		fmt.Fprintf(b, "\tx, err := %sFromRat(%s)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n", g, x)
+		fmt.Fprintf(b, "\tif %s != nil {\n\t\t%s = %s\n\t}\n\treturn x, nil\n}\n", r, strings.Join(dst, ", "), strings.Join(src, ", "))
+	}
+	for _, op := range []struct{ name, sign string }{{"Add", "+"}, {"Sub", "-"}} {
+		fmt.Fprintf(b, "\n// %s returns %s %s b.%s\n", op.name, r, op.sign, keeps)
+		fmt.Fprintf(b, "func (%s *%s) %s(b *%s) (*%s, error) {\n", r, g, op.name, g, g)
+		if q.same != "" {
+			fmt.Fprintf(b, "\tif !%s(%s, b) {\n", q.same, r)
+			fmt.Fprintf(b, "\t\treturn nil, errors.New(\"sep: adding or subtracting %s values in different %s\")\n\t}\n", g, q.differ)
+		}
+		result(fmt.Sprintf("new(big.Rat).%s(%s.Rat(), b.Rat())", op.name, r))
+	}
+
+	fmt.Fprintf(b, "\n// Scale returns %s × k.%s\n", r, keeps)
+	fmt.Fprintf(b, "func (%s *%s) Scale(k float64) (*%s, error) {\n", r, g, g)
+	fmt.Fprintf(b, "\tq, err := ratOfFloat(k)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+	result(fmt.Sprintf("new(big.Rat).Mul(%s.Rat(), q)", r))
+
+	fmt.Fprintf(b, "\n// Normalize returns %s held with its best multiplier.%s\n", r, keeps)
+	fmt.Fprintf(b, "func (%s *%s) Normalize() (*%s, error) {\n", r, g, g)
+	result(r + ".Rat()")
+	return nil
+}
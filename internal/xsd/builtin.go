@@ -0,0 +1,146 @@
+package xsd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+)
+
+// integerRanges holds the value space of the built-in integer types.
+var integerRanges = map[string][2]string{
+	"integer":       {"", ""},
+	"long":          {"-9223372036854775808", "9223372036854775807"},
+	"int":           {"-2147483648", "2147483647"},
+	"short":         {"-32768", "32767"},
+	"byte":          {"-128", "127"},
+	"unsignedLong":  {"0", "18446744073709551615"},
+	"unsignedInt":   {"0", "4294967295"},
+	"unsignedShort": {"0", "65535"},
+	"unsignedByte":  {"0", "255"},
+}
+
+var builtins = map[string]*Type{}
+
+func init() {
+	for _, name := range []string{"anyType", "anySimpleType", "string", "boolean", "hexBinary", "anyURI", "decimal"} {
+		builtins[name] = &Type{Name: name, Builtin: name, Simple: name != "anyType"}
+	}
+	for name := range integerRanges {
+		builtins[name] = &Type{Name: name, Builtin: name, Simple: true}
+	}
+}
+
+// builtin returns the built-in type named name, or nil.
+func builtin(name string) *Type {
+	return builtins[name]
+}
+
+// IsInteger reports whether the built-in type name is an integer type.
+func IsInteger(name string) bool {
+	_, ok := integerRanges[name]
+	return ok
+}
+
+// IntegerRange returns the bounds of the value space of a simple type whose
+// primitive is an integer type, narrowed by any range facets along the way.
+// A nil bound is unbounded.
+func (t *Type) IntegerRange() (min, max *big.Int) {
+	for u := t; u != nil; u = u.Base {
+		if u.Facets.MinInclusive != nil && min == nil {
+			min, _ = new(big.Int).SetString(*u.Facets.MinInclusive, 10)
+		}
+		if u.Facets.MaxInclusive != nil && max == nil {
+			max, _ = new(big.Int).SetString(*u.Facets.MaxInclusive, 10)
+		}
+		if r, ok := integerRanges[u.Builtin]; ok {
+			if min == nil && r[0] != "" {
+				min, _ = new(big.Int).SetString(r[0], 10)
+			}
+			if max == nil && r[1] != "" {
+				max, _ = new(big.Int).SetString(r[1], 10)
+			}
+		}
+	}
+	return min, max
+}
+
+// Normalize applies the whitespace rule of a simple type to v: strings keep
+// their whitespace, every other type collapses it.
+func (t *Type) Normalize(v string) string {
+	if t.Primitive() == "string" {
+		return v
+	}
+	return strings.Join(strings.Fields(v), " ")
+}
+
+// ValidateValue checks v, already normalized, against the simple type t and
+// the facets of every type it derives from.
+func (t *Type) ValidateValue(v string) error {
+	prim := t.Primitive()
+	switch {
+	case prim == "boolean":
+		switch v {
+		case "true", "false", "1", "0":
+		default:
+			return fmt.Errorf("%q is not a valid boolean", v)
+		}
+	case prim == "hexBinary":
+		if _, err := hex.DecodeString(v); err != nil {
+			return fmt.Errorf("%q is not a valid hexBinary", v)
+		}
+	case prim == "decimal":
+		if _, ok := new(big.Float).SetString(v); !ok {
+			return fmt.Errorf("%q is not a valid decimal", v)
+		}
+	case IsInteger(prim):
+		n, ok := new(big.Int).SetString(strings.TrimPrefix(v, "+"), 10)
+		if !ok {
+			return fmt.Errorf("%q is not a valid %s", v, t)
+		}
+		min, max := t.IntegerRange()
+		if min != nil && n.Cmp(min) < 0 {
+			return fmt.Errorf("%s is less than the minimum %s of %s", v, min, t)
+		}
+		if max != nil && n.Cmp(max) > 0 {
+			return fmt.Errorf("%s is greater than the maximum %s of %s", v, max, t)
+		}
+	}
+	for u := t; u != nil; u = u.Base {
+		if err := u.Facets.check(v, prim); err != nil {
+			return fmt.Errorf("%s: %w", u, err)
+		}
+	}
+	return nil
+}
+
+// check applies the length, pattern and enumeration facets to v.
+func (f *Facets) check(v, prim string) error {
+	length := utf8.RuneCountInString(v)
+	unit := "characters"
+	if prim == "hexBinary" {
+		length, unit = len(v)/2, "octets"
+	}
+	if f.Length != nil && length != *f.Length {
+		return fmt.Errorf("length %d %s, want exactly %d", length, unit, *f.Length)
+	}
+	if f.MinLength != nil && length < *f.MinLength {
+		return fmt.Errorf("length %d %s is below the minimum %d", length, unit, *f.MinLength)
+	}
+	if f.MaxLength != nil && length > *f.MaxLength {
+		return fmt.Errorf("length %d %s exceeds the maximum %d", length, unit, *f.MaxLength)
+	}
+	if f.Pattern != nil && !f.Pattern.MatchString(v) {
+		return fmt.Errorf("%q does not match the pattern %s", v, f.Pattern)
+	}
+	if len(f.Enumeration) > 0 {
+		for _, e := range f.Enumeration {
+			if e == v {
+				return nil
+			}
+		}
+		return fmt.Errorf("%q is not one of %s", v, strings.Join(f.Enumeration, ", "))
+	}
+	return nil
+}
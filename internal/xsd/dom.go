@@ -0,0 +1,121 @@
+package xsd
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+// node is an element of a parsed XML document together with the namespace
+// prefixes in scope, which are needed to resolve QName-valued attributes such
+// as type="xs:string" or xsi:type="sep:DERControl".
+type node struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	children []*node
+	text     string
+	line     int
+	parent   *node
+	prefixes map[string]string
+}
+
+// attr returns the value of the unqualified attribute local.
+func (n *node) attr(local string) (string, bool) {
+	for _, a := range n.attrs {
+		if a.Name.Space == "" && a.Name.Local == local {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+// resolve returns the namespace bound to prefix in the scope of n. The empty
+// prefix resolves to the default namespace.
+func (n *node) resolve(prefix string) (string, bool) {
+	for ; n != nil; n = n.parent {
+		if ns, ok := n.prefixes[prefix]; ok {
+			return ns, true
+		}
+	}
+	if prefix == "" {
+		return "", true
+	}
+	return "", false
+}
+
+// qname resolves a QName-valued attribute value in the scope of n.
+func (n *node) qname(v string) (xml.Name, bool) {
+	prefix, local, ok := strings.Cut(strings.TrimSpace(v), ":")
+	if !ok {
+		prefix, local = "", prefix
+	}
+	ns, ok := n.resolve(prefix)
+	return xml.Name{Space: ns, Local: local}, ok
+}
+
+// parse reads a whole document and returns its root element.
+func parse(r io.Reader) (*node, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	d := xml.NewDecoder(bytes.NewReader(data))
+	var root, cur *node
+	var text strings.Builder
+	for {
+		line, _ := d.InputPos()
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if cur == nil && root != nil {
+				return nil, errors.New("xsd: multiple root elements")
+			}
+			n := &node{name: t.Name, parent: cur, line: line}
+			for _, a := range t.Attr {
+				switch {
+				case a.Name.Space == "xmlns":
+					n.setPrefix(a.Name.Local, a.Value)
+				case a.Name.Space == "" && a.Name.Local == "xmlns":
+					n.setPrefix("", a.Value)
+				default:
+					n.attrs = append(n.attrs, a)
+				}
+			}
+			if cur == nil {
+				root = n
+			} else {
+				cur.text += text.String()
+				cur.children = append(cur.children, n)
+			}
+			text.Reset()
+			cur = n
+		case xml.EndElement:
+			cur.text += text.String()
+			text.Reset()
+			cur = cur.parent
+		case xml.CharData:
+			if cur != nil {
+				text.Write(t)
+			}
+		}
+	}
+	if root == nil {
+		return nil, errors.New("xsd: empty document")
+	}
+	return root, nil
+}
+
+func (n *node) setPrefix(prefix, ns string) {
+	if n.prefixes == nil {
+		n.prefixes = make(map[string]string)
+	}
+	n.prefixes[prefix] = ns
+}
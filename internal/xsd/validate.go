@@ -0,0 +1,223 @@
+package xsd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// InstanceNamespace is the XML Schema instance namespace of xsi:type.
+const InstanceNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// Error is a single schema violation in an instance document.
+type Error struct {
+	// Path locates the offending element or attribute, for example
+	// /DERControlList/DERControl[2]/DERControlBase/opModFixedW or
+	// /EndDevice/@href.
+	Path string
+	// Line is the line of the document the offending element starts on.
+	Line int
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	if e.Path == "" {
+		return e.Msg
+	}
+	return fmt.Sprintf("%s: %s", e.Path, e.Msg)
+}
+
+// Errors is the list of violations found in a document.
+type Errors []*Error
+
+func (e Errors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Validate checks the document read from r against s. A document that is
+// not well-formed yields that error; otherwise every violation is reported
+// in an Errors.
+func (s *Schema) Validate(r io.Reader) error {
+	root, err := parse(r)
+	if err != nil {
+		return err
+	}
+	v := &validator{s: s}
+	path := "/" + root.name.Local
+	if root.name.Space != s.TargetNamespace {
+		v.errorf(root, path, "root element is in namespace %q, want %q", root.name.Space, s.TargetNamespace)
+	} else if e := s.Elements[root.name.Local]; e == nil {
+		v.errorf(root, path, "no global element declaration for <%s>", root.name.Local)
+	} else {
+		v.element(root, e.Type, "", path)
+	}
+	if len(v.errs) > 0 {
+		return v.errs
+	}
+	return nil
+}
+
+type validator struct {
+	s    *Schema
+	errs Errors
+}
+
+func (v *validator) errorf(n *node, path, format string, args ...any) {
+	v.errs = append(v.errs, &Error{Path: path, Line: n.line, Msg: fmt.Sprintf(format, args...)})
+}
+
+// resolveType returns the type an element declared with t is validated
+// against, honouring an xsi:type attribute that names a type derived from t.
+func (s *Schema) resolveType(n *node, t *Type) (*Type, error) {
+	for _, a := range n.attrs {
+		if a.Name.Space != InstanceNamespace || a.Name.Local != "type" {
+			continue
+		}
+		name, ok := n.qname(a.Value)
+		if !ok {
+			return t, fmt.Errorf("xsi:type %q uses an undeclared prefix", a.Value)
+		}
+		u := s.Types[name.Local]
+		if name.Space != s.TargetNamespace || u == nil {
+			return t, fmt.Errorf("xsi:type %q is not a type of the schema", a.Value)
+		}
+		if !u.DerivesFrom(t) && !(t.Name == "" && u.DerivesFrom(t.Base)) {
+			return t, fmt.Errorf("xsi:type %s does not derive from %s", u, t)
+		}
+		return u, nil
+	}
+	return t, nil
+}
+
+// element validates n against t. An empty element takes the default value
+// def of its declaration, if any.
+func (v *validator) element(n *node, t *Type, def, path string) {
+	t, err := v.s.resolveType(n, t)
+	if err != nil {
+		v.errorf(n, path, "%v", err)
+	}
+	if t.Abstract {
+		v.errorf(n, path, "type %s is abstract", t)
+	}
+	v.attributes(n, t, path)
+
+	if st := t.SimpleContent(); st != nil {
+		if len(n.children) > 0 {
+			v.errorf(n.children[0], path, "element content is not allowed in %s", t)
+			return
+		}
+		text := n.text
+		if text == "" && def != "" {
+			text = def
+		}
+		if err := st.ValidateValue(st.Normalize(text)); err != nil {
+			v.errorf(n, path, "%v", err)
+		}
+		return
+	}
+	if t.Builtin == "anyType" {
+		return
+	}
+	if strings.TrimSpace(n.text) != "" {
+		v.errorf(n, path, "character data is not allowed in %s", t)
+	}
+	v.content(n, t, path)
+}
+
+func (v *validator) attributes(n *node, t *Type, path string) {
+	seen := make(map[string]bool)
+	for _, a := range n.attrs {
+		if a.Name.Space == InstanceNamespace || a.Name.Space == "xml" {
+			continue
+		}
+		apath := path + "/@" + a.Name.Local
+		decl := t.Attribute(a.Name.Local)
+		if a.Name.Space != "" || decl == nil {
+			if !t.AnyAttributes() {
+				v.errorf(n, apath, "attribute is not allowed in %s", t)
+			}
+			continue
+		}
+		seen[a.Name.Local] = true
+		if err := decl.Type.ValidateValue(decl.Type.Normalize(a.Value)); err != nil {
+			v.errorf(n, apath, "%v", err)
+		}
+	}
+	for _, a := range t.AttributeUses() {
+		if a.Required && !seen[a.Name] {
+			v.errorf(n, path+"/@"+a.Name, "required attribute is missing")
+		}
+	}
+}
+
+// content matches the children of n against the content model of t. The
+// schema satisfies the Unique Particle Attribution constraint, so consuming
+// as many children as each particle allows in turn is exact.
+func (v *validator) content(n *node, t *Type, path string) {
+	particles := t.Content()
+	counts := make(map[string]int)
+	i := 0
+	// last is the particle that matched the child before i, or nil.
+	var last *Particle
+	for _, p := range particles {
+		count := 0
+		for i < len(n.children) && count < p.MaxOccurs && v.matches(p, n.children[i]) {
+			c := n.children[i]
+			counts[c.name.Local]++
+			cpath := path + "/" + c.name.Local
+			if p.MaxOccurs > 1 {
+				cpath += fmt.Sprintf("[%d]", counts[c.name.Local])
+			}
+			if p.Element != nil {
+				v.element(c, p.Element.Type, p.Element.Default, cpath)
+			} else if p.Any.ProcessContents != "skip" && c.name.Space == v.s.TargetNamespace {
+				// Lax and strict wildcards validate children that have a
+				// global declaration.
+				if e := v.s.Elements[c.name.Local]; e != nil {
+					v.element(c, e.Type, "", cpath)
+				} else if p.Any.ProcessContents == "strict" {
+					v.errorf(c, cpath, "no global element declaration for <%s>", c.name.Local)
+				}
+			}
+			i++
+			count++
+			last = p
+		}
+		if count < p.MinOccurs {
+			v.errorf(n, path, "missing required %s", p.describe())
+		}
+	}
+	if i < len(n.children) {
+		c := n.children[i]
+		cpath := path + "/" + c.name.Local
+		if last != nil && last.Element != nil && v.matches(last, c) {
+			v.errorf(c, cpath, "too many occurrences in %s (maxOccurs %d)", t, last.MaxOccurs)
+			return
+		}
+		for _, p := range particles {
+			if p.Element != nil && v.matches(p, c) {
+				v.errorf(c, cpath, "element is out of order in %s", t)
+				return
+			}
+		}
+		v.errorf(c, cpath, "element is not allowed in %s", t)
+	}
+}
+
+func (v *validator) matches(p *Particle, n *node) bool {
+	if p.Element != nil {
+		return n.name.Local == p.Element.Name && n.name.Space == v.s.TargetNamespace
+	}
+	return p.Any.Matches(n.name.Space, v.s.TargetNamespace)
+}
+
+func (p *Particle) describe() string {
+	if p.Element != nil {
+		return "element <" + p.Element.Name + ">"
+	}
+	return "element from namespace " + p.Any.Namespace
+}
@@ -0,0 +1,186 @@
+package xsd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const testSchema = `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns="urn:test" targetNamespace="urn:test">
+  <xs:simpleType name="String8">
+    <xs:restriction base="xs:string"><xs:maxLength value="8"/></xs:restriction>
+  </xs:simpleType>
+  <xs:simpleType name="HexBinary2">
+    <xs:restriction base="xs:hexBinary"><xs:length value="2"/></xs:restriction>
+  </xs:simpleType>
+  <xs:simpleType name="PerCent">
+    <xs:restriction base="xs:unsignedShort"><xs:maxInclusive value="10000"/></xs:restriction>
+  </xs:simpleType>
+  <xs:complexType name="Thing">
+    <xs:sequence>
+      <xs:element name="id" type="HexBinary2"/>
+      <xs:element name="name" type="String8" minOccurs="0"/>
+      <xs:element name="level" type="PerCent" minOccurs="0" maxOccurs="2"/>
+      <xs:element name="count" type="xs:byte" minOccurs="0"/>
+    </xs:sequence>
+    <xs:attribute name="href" type="xs:anyURI" use="required"/>
+  </xs:complexType>
+  <xs:complexType name="SpecialThing">
+    <xs:complexContent>
+      <xs:extension base="Thing">
+        <xs:sequence><xs:element name="extra" type="xs:boolean"/></xs:sequence>
+      </xs:extension>
+    </xs:complexContent>
+  </xs:complexType>
+  <xs:complexType name="Other">
+    <xs:sequence/>
+  </xs:complexType>
+  <xs:complexType name="Holder">
+    <xs:sequence>
+      <xs:element name="Thing" type="Thing" maxOccurs="unbounded"/>
+    </xs:sequence>
+  </xs:complexType>
+  <xs:element name="Holder" type="Holder"/>
+</xs:schema>`
+
+// holder returns a Holder document with the lines of content after its
+// start tag, one per line, so that the Holder starts on line 1 and the
+// content on line 2.
+func holder(content ...string) string {
+	return `<Holder xmlns="urn:test" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">` + "\n" +
+		strings.Join(content, "\n") + "\n</Holder>"
+}
+
+func TestValidate(t *testing.T) {
+	s, err := Parse(strings.NewReader(testSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name string
+		doc  string
+		// want lists each error as path:line: message.
+		want []string
+	}{
+		{name: "valid", doc: holder(
+			`<Thing href="/t/1"><id>0a0b</id><name>12345678</name><level>10000</level><level>0</level><count>-128</count></Thing>`,
+			`<Thing href="/t/2"><id>0A0B</id></Thing>`)},
+		{name: "out of order", doc: holder(
+			`<Thing href="/t">`,
+			`<id>0a0b</id>`,
+			`<count>1</count>`,
+			`<name>a</name>`,
+			`</Thing>`),
+			want: []string{"/Holder/Thing[1]/name:5: element is out of order in Thing"}},
+		{name: "maxOccurs 1 exceeded", doc: holder(
+			`<Thing href="/t">`,
+			`<id>0a0b</id>`,
+			`<name>a</name>`,
+			`<name>b</name>`,
+			`</Thing>`),
+			want: []string{"/Holder/Thing[1]/name:5: too many occurrences in Thing (maxOccurs 1)"}},
+		{name: "maxOccurs 2 exceeded", doc: holder(
+			`<Thing href="/t">`,
+			`<id>0a0b</id>`,
+			`<level>1</level>`,
+			`<level>2</level>`,
+			`<level>3</level>`,
+			`</Thing>`),
+			want: []string{"/Holder/Thing[1]/level:6: too many occurrences in Thing (maxOccurs 2)"}},
+		{name: "missing required element", doc: holder(
+			`<Thing href="/t"><name>a</name></Thing>`),
+			want: []string{"/Holder/Thing[1]:2: missing required element <id>"}},
+		{name: "missing required root child", doc: holder(),
+			want: []string{"/Holder:1: missing required element <Thing>"}},
+		{name: "unknown element", doc: holder(
+			`<Thing href="/t"><id>0a0b</id><colour>red</colour></Thing>`),
+			want: []string{"/Holder/Thing[1]/colour:2: element is not allowed in Thing"}},
+		{name: "missing required attribute", doc: holder(
+			`<Thing><id>0a0b</id></Thing>`),
+			want: []string{"/Holder/Thing[1]/@href:2: required attribute is missing"}},
+		{name: "undeclared attribute", doc: holder(
+			`<Thing href="/t" colour="red"><id>0a0b</id></Thing>`),
+			want: []string{"/Holder/Thing[1]/@colour:2: attribute is not allowed in Thing"}},
+		{name: "hexBinary length", doc: holder(
+			`<Thing href="/t"><id>0a0b0c</id></Thing>`),
+			want: []string{"/Holder/Thing[1]/id:2: HexBinary2: length 3 octets, want exactly 2"}},
+		{name: "invalid hexBinary", doc: holder(
+			`<Thing href="/t"><id>0g</id></Thing>`),
+			want: []string{`/Holder/Thing[1]/id:2: "0g" is not a valid hexBinary`}},
+		{name: "string maxLength", doc: holder(
+			`<Thing href="/t"><id>0a0b</id><name>123456789</name></Thing>`),
+			want: []string{"/Holder/Thing[1]/name:2: String8: length 9 characters exceeds the maximum 8"}},
+		{name: "restricted integer range", doc: holder(
+			`<Thing href="/t"><id>0a0b</id><level>10001</level></Thing>`),
+			want: []string{"/Holder/Thing[1]/level[1]:2: 10001 is greater than the maximum 10000 of PerCent"}},
+		{name: "built-in integer range", doc: holder(
+			`<Thing href="/t"><id>0a0b</id><count>-129</count></Thing>`),
+			want: []string{"/Holder/Thing[1]/count:2: -129 is less than the minimum -128 of byte"}},
+		{name: "invalid integer", doc: holder(
+			`<Thing href="/t"><id>0a0b</id><count>x</count></Thing>`),
+			want: []string{`/Holder/Thing[1]/count:2: "x" is not a valid byte`}},
+		{name: "xsi:type derived", doc: holder(
+			`<Thing xsi:type="SpecialThing" href="/t"><id>0a0b</id><extra>true</extra></Thing>`)},
+		{name: "xsi:type derived, content of the derived type", doc: holder(
+			`<Thing xsi:type="SpecialThing" href="/t"><id>0a0b</id></Thing>`),
+			want: []string{"/Holder/Thing[1]:2: missing required element <extra>"}},
+		{name: "xsi:type not derived", doc: holder(
+			`<Thing xsi:type="Other" href="/t"><id>0a0b</id></Thing>`),
+			want: []string{"/Holder/Thing[1]:2: xsi:type Other does not derive from Thing"}},
+		{name: "xsi:type unknown", doc: holder(
+			`<Thing xsi:type="Nothing" href="/t"><id>0a0b</id></Thing>`),
+			want: []string{`/Holder/Thing[1]:2: xsi:type "Nothing" is not a type of the schema`}},
+		{name: "xsi:type undeclared prefix", doc: holder(
+			`<Thing xsi:type="p:SpecialThing" href="/t"><id>0a0b</id></Thing>`),
+			want: []string{`/Holder/Thing[1]:2: xsi:type "p:SpecialThing" uses an undeclared prefix`}},
+		{name: "repeated element paths", doc: holder(
+			`<Thing href="/t/1"><id>0a0b</id></Thing>`,
+			`<Thing href="/t/2"><id>0a</id><level>1</level><level>20000</level></Thing>`),
+			want: []string{
+				"/Holder/Thing[2]/id:3: HexBinary2: length 1 octets, want exactly 2",
+				"/Holder/Thing[2]/level[2]:3: 20000 is greater than the maximum 10000 of PerCent",
+			}},
+		{name: "root in another namespace", doc: `<Holder xmlns="urn:other"/>`,
+			want: []string{`/Holder:1: root element is in namespace "urn:other", want "urn:test"`}},
+		{name: "root not declared", doc: `<Thing xmlns="urn:test" href="/t"><id>0a0b</id></Thing>`,
+			want: []string{"/Thing:1: no global element declaration for <Thing>"}},
+	} {
+		var got []string
+		err := s.Validate(strings.NewReader(tt.doc))
+		if err != nil {
+			errs, ok := err.(Errors)
+			if !ok {
+				t.Errorf("%s: %v is not Errors", tt.name, err)
+				continue
+			}
+			for _, e := range errs {
+				got = append(got, fmt.Sprintf("%s:%d: %s", e.Path, e.Line, e.Msg))
+			}
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: errors\n%s\nwant\n%s", tt.name, strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+		}
+	}
+}
+
+func TestValidateMalformed(t *testing.T) {
+	s, err := Parse(strings.NewReader(testSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Validate(strings.NewReader(`<Holder xmlns="urn:test">`))
+	if _, ok := err.(Errors); err == nil || ok {
+		t.Errorf("Validate of a truncated document = %v", err)
+	}
+}
+
+func TestErrorsError(t *testing.T) {
+	errs := Errors{
+		{Path: "/Holder/Thing/@href", Line: 2, Msg: "required attribute is missing"},
+		{Msg: "no path"},
+	}
+	if got, want := errs.Error(), "/Holder/Thing/@href: required attribute is missing\nno path"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
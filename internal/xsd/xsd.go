@@ -0,0 +1,461 @@
+// Package xsd is a reader for the subset of W3C XML Schema used by the
+// IEEE 2030.5 schema: named simple and complex types derived by extension
+// or restriction, sequences of elements and wildcards, attributes, and the
+// length, range, pattern and enumeration facets. It validates instance
+// documents against a parsed schema.
+package xsd
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Namespace is the namespace of the XML Schema language itself.
+const Namespace = "http://www.w3.org/2001/XMLSchema"
+
+// Unbounded is the MaxOccurs of a particle that may repeat without limit.
+const Unbounded = math.MaxInt
+
+// Schema is a parsed XML Schema document.
+type Schema struct {
+	TargetNamespace string
+	// Elements holds the global element declarations by name.
+	Elements map[string]*Element
+	// Types holds the named types of the target namespace by name.
+	Types map[string]*Type
+	// ElementNames lists the global element names in document order.
+	ElementNames []string
+	// TypeNames lists the named types in document order.
+	TypeNames []string
+}
+
+// Element is an element declaration.
+type Element struct {
+	Name    string
+	Type    *Type
+	Default string
+	Doc     string
+}
+
+// Particle is one entry of a type's content model: an element declaration or
+// a wildcard, with its occurrence constraints.
+type Particle struct {
+	Element   *Element
+	Any       *Wildcard
+	MinOccurs int
+	MaxOccurs int
+}
+
+// Wildcard is an xs:any particle.
+type Wildcard struct {
+	Namespace       string
+	ProcessContents string
+}
+
+// Attribute is an attribute declaration.
+type Attribute struct {
+	Name     string
+	Type     *Type
+	Required bool
+	Default  string
+	Doc      string
+}
+
+// Facets are the constraining facets of a simple type restriction.
+type Facets struct {
+	Length       *int
+	MinLength    *int
+	MaxLength    *int
+	MinInclusive *string
+	MaxInclusive *string
+	Pattern      *regexp.Regexp
+	Enumeration  []string
+}
+
+// Type is a simple or complex type definition.
+type Type struct {
+	// Name is empty for the anonymous types of global elements.
+	Name string
+	Doc  string
+	// Base is the type this one derives from; nil for built-in types.
+	Base *Type
+	// Extension reports whether Base is extended rather than restricted.
+	Extension bool
+	// Simple reports whether this is a simple type.
+	Simple bool
+	// Builtin is the name of the XML Schema built-in type this type is, if any.
+	Builtin string
+	Facets  Facets
+
+	Particles    []*Particle
+	Attributes   []*Attribute
+	AnyAttribute bool
+	Abstract     bool
+}
+
+// Content returns the full content model of t, inherited particles first.
+func (t *Type) Content() []*Particle {
+	if t.Simple {
+		return nil
+	}
+	if t.Base != nil && t.Extension {
+		return append(t.Base.Content(), t.Particles...)
+	}
+	return t.Particles
+}
+
+// AttributeUses returns the attributes of t, including inherited ones.
+func (t *Type) AttributeUses() []*Attribute {
+	if t.Simple {
+		return nil
+	}
+	var attrs []*Attribute
+	if t.Base != nil {
+		attrs = t.Base.AttributeUses()
+	}
+	for _, a := range t.Attributes {
+		replaced := false
+		for i, b := range attrs {
+			if b.Name == a.Name {
+				attrs[i], replaced = a, true
+			}
+		}
+		if !replaced {
+			attrs = append(attrs, a)
+		}
+	}
+	return attrs
+}
+
+// Attribute returns the attribute named name declared by t or its bases.
+func (t *Type) Attribute(name string) *Attribute {
+	for _, a := range t.AttributeUses() {
+		if a.Name == name {
+			return a
+		}
+	}
+	return nil
+}
+
+// AnyAttributes reports whether t accepts attributes it does not declare.
+func (t *Type) AnyAttributes() bool {
+	if t.Simple {
+		return false
+	}
+	return t.AnyAttribute || (t.Base != nil && t.Extension && t.Base.AnyAttributes())
+}
+
+// SimpleContent returns the simple type of the character data of t, or nil
+// if t has element content.
+func (t *Type) SimpleContent() *Type {
+	for u := t; u != nil; u = u.Base {
+		if u.Simple {
+			return u
+		}
+	}
+	return nil
+}
+
+// DerivesFrom reports whether t is base or derived from it.
+func (t *Type) DerivesFrom(base *Type) bool {
+	for u := t; u != nil; u = u.Base {
+		if u == base {
+			return true
+		}
+	}
+	return false
+}
+
+// Primitive returns the built-in type at the root of a simple type.
+func (t *Type) Primitive() string {
+	for u := t; u != nil; u = u.Base {
+		if u.Builtin != "" {
+			return u.Builtin
+		}
+	}
+	return ""
+}
+
+// String returns the name of t. Anonymous types are described by their base.
+func (t *Type) String() string {
+	if t.Name == "" && t.Base != nil {
+		return t.Base.String()
+	}
+	if t.Name == "" {
+		return "(anonymous)"
+	}
+	return t.Name
+}
+
+// Matches reports whether an element in namespace ns is allowed by w in a
+// schema whose target namespace is target.
+func (w *Wildcard) Matches(ns, target string) bool {
+	switch w.Namespace {
+	case "", "##any":
+		return true
+	case "##other":
+		return ns != target && ns != ""
+	case "##targetNamespace":
+		return ns == target
+	case "##local":
+		return ns == ""
+	}
+	for _, n := range strings.Fields(w.Namespace) {
+		if n == ns || (n == "##targetNamespace" && ns == target) || (n == "##local" && ns == "") {
+			return true
+		}
+	}
+	return false
+}
+
+// Parse reads a schema document.
+func Parse(r io.Reader) (*Schema, error) {
+	root, err := parse(r)
+	if err != nil {
+		return nil, err
+	}
+	if root.name.Space != Namespace || root.name.Local != "schema" {
+		return nil, fmt.Errorf("xsd: root element is <%s>, not <schema>", root.name.Local)
+	}
+	p := &parser{s: &Schema{
+		Elements: make(map[string]*Element),
+		Types:    make(map[string]*Type),
+	}}
+	p.s.TargetNamespace, _ = root.attr("targetNamespace")
+
+	// Register named types first so references may precede definitions.
+	for _, c := range root.children {
+		if c.name.Space != Namespace || (c.name.Local != "complexType" && c.name.Local != "simpleType") {
+			continue
+		}
+		name, _ := c.attr("name")
+		if _, dup := p.s.Types[name]; dup {
+			return nil, fmt.Errorf("xsd: line %d: type %s redefined", c.line, name)
+		}
+		p.s.Types[name] = &Type{Name: name, Simple: c.name.Local == "simpleType"}
+		p.s.TypeNames = append(p.s.TypeNames, name)
+	}
+	for _, c := range root.children {
+		if c.name.Space != Namespace {
+			continue
+		}
+		switch c.name.Local {
+		case "complexType", "simpleType":
+			name, _ := c.attr("name")
+			p.typeDef(c, p.s.Types[name])
+		case "element":
+			e := p.element(c)
+			p.s.Elements[e.Name] = e
+			p.s.ElementNames = append(p.s.ElementNames, e.Name)
+		}
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.s, nil
+}
+
+type parser struct {
+	s   *Schema
+	err error
+}
+
+func (p *parser) fail(n *node, format string, args ...any) {
+	if p.err == nil {
+		p.err = fmt.Errorf("xsd: line %d: %s", n.line, fmt.Sprintf(format, args...))
+	}
+}
+
+// ref resolves the QName-valued attribute attr of n to a type.
+func (p *parser) ref(n *node, attr string) *Type {
+	v, _ := n.attr(attr)
+	name, ok := n.qname(v)
+	if !ok {
+		p.fail(n, "undeclared prefix in %q", v)
+		return nil
+	}
+	if name.Space == Namespace {
+		if t := builtin(name.Local); t != nil {
+			return t
+		}
+	} else if name.Space == p.s.TargetNamespace {
+		if t := p.s.Types[name.Local]; t != nil {
+			return t
+		}
+	}
+	p.fail(n, "unknown type %q", v)
+	return nil
+}
+
+func doc(n *node) string {
+	for _, c := range n.children {
+		if c.name.Space == Namespace && c.name.Local == "annotation" {
+			for _, d := range c.children {
+				if d.name.Space == Namespace && d.name.Local == "documentation" {
+					return strings.TrimSpace(d.text)
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func (p *parser) typeDef(n *node, t *Type) {
+	t.Doc = doc(n)
+	if v, _ := n.attr("abstract"); v == "true" {
+		t.Abstract = true
+	}
+	for _, c := range n.children {
+		if c.name.Space != Namespace {
+			continue
+		}
+		switch c.name.Local {
+		case "complexContent", "simpleContent":
+			for _, d := range c.children {
+				if d.name.Space == Namespace && (d.name.Local == "extension" || d.name.Local == "restriction") {
+					t.Base = p.ref(d, "base")
+					t.Extension = d.name.Local == "extension"
+					p.content(d, t)
+				}
+			}
+		case "restriction":
+			t.Base = p.ref(c, "base")
+			p.content(c, t)
+		default:
+			p.item(c, t)
+		}
+	}
+}
+
+// content reads the children of a derivation into t.
+func (p *parser) content(n *node, t *Type) {
+	for _, c := range n.children {
+		if c.name.Space == Namespace {
+			p.item(c, t)
+		}
+	}
+}
+
+// item reads a particle, attribute or facet into t.
+func (p *parser) item(n *node, t *Type) {
+	switch n.name.Local {
+	case "sequence":
+		t.Particles = append(t.Particles, p.sequence(n)...)
+	case "attribute":
+		a := &Attribute{Doc: doc(n)}
+		a.Name, _ = n.attr("name")
+		a.Type = p.ref(n, "type")
+		use, _ := n.attr("use")
+		a.Required = use == "required"
+		a.Default, _ = n.attr("default")
+		t.Attributes = append(t.Attributes, a)
+	case "anyAttribute":
+		t.AnyAttribute = true
+	case "choice", "all", "group", "attributeGroup":
+		p.fail(n, "xs:%s is not supported", n.name.Local)
+	default:
+		p.facet(n, t)
+	}
+}
+
+func (p *parser) sequence(n *node) []*Particle {
+	var particles []*Particle
+	for _, c := range n.children {
+		if c.name.Space != Namespace {
+			continue
+		}
+		switch c.name.Local {
+		case "element":
+			particles = append(particles, &Particle{Element: p.element(c), MinOccurs: p.occurs(c, "minOccurs"), MaxOccurs: p.occurs(c, "maxOccurs")})
+		case "any":
+			w := &Wildcard{}
+			w.Namespace, _ = c.attr("namespace")
+			w.ProcessContents, _ = c.attr("processContents")
+			particles = append(particles, &Particle{Any: w, MinOccurs: p.occurs(c, "minOccurs"), MaxOccurs: p.occurs(c, "maxOccurs")})
+		case "sequence":
+			particles = append(particles, p.sequence(c)...)
+		case "choice", "all", "group":
+			p.fail(c, "xs:%s is not supported", c.name.Local)
+		}
+	}
+	return particles
+}
+
+func (p *parser) occurs(n *node, attr string) int {
+	v, ok := n.attr(attr)
+	if !ok {
+		return 1
+	}
+	if v == "unbounded" {
+		return Unbounded
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil || i < 0 {
+		p.fail(n, "invalid %s %q", attr, v)
+	}
+	return i
+}
+
+func (p *parser) element(n *node) *Element {
+	e := &Element{Doc: doc(n)}
+	e.Name, _ = n.attr("name")
+	e.Default, _ = n.attr("default")
+	if _, ok := n.attr("ref"); ok {
+		p.fail(n, "element references are not supported")
+		return e
+	}
+	if _, ok := n.attr("type"); ok {
+		e.Type = p.ref(n, "type")
+		return e
+	}
+	for _, c := range n.children {
+		if c.name.Space == Namespace && (c.name.Local == "complexType" || c.name.Local == "simpleType") {
+			e.Type = &Type{Simple: c.name.Local == "simpleType"}
+			p.typeDef(c, e.Type)
+			if e.Type.Doc == "" && e.Type.Base != nil {
+				e.Type.Doc = e.Type.Base.Doc
+			}
+		}
+	}
+	if e.Type == nil {
+		e.Type = builtin("anyType")
+	}
+	return e
+}
+
+func (p *parser) facet(n *node, t *Type) {
+	v, _ := n.attr("value")
+	switch n.name.Local {
+	case "length", "minLength", "maxLength":
+		i, err := strconv.Atoi(v)
+		if err != nil || i < 0 {
+			p.fail(n, "invalid %s %q", n.name.Local, v)
+			return
+		}
+		switch n.name.Local {
+		case "length":
+			t.Facets.Length = &i
+		case "minLength":
+			t.Facets.MinLength = &i
+		default:
+			t.Facets.MaxLength = &i
+		}
+	case "minInclusive":
+		t.Facets.MinInclusive = &v
+	case "maxInclusive":
+		t.Facets.MaxInclusive = &v
+	case "pattern":
+		re, err := regexp.Compile("^(?:" + v + ")$")
+		if err != nil {
+			p.fail(n, "invalid pattern %q: %v", v, err)
+			return
+		}
+		t.Facets.Pattern = re
+	case "enumeration":
+		t.Facets.Enumeration = append(t.Facets.Enumeration, v)
+	}
+}
@@ -0,0 +1,321 @@
+package sep
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// A Time resource gives the local time of a server as UTC currentTime plus
+// tzOffset, the standard offset of its time zone, plus dstOffset between
+// dstStartTime and dstEndTime. A TimeConfiguration gives the same zone as
+// offsets and DstRuleType rules from which those instants are calculated
+// for each year.
+
+// NewTimeIn returns the Time resource for now in the time zone loc, with
+// the daylight saving time period of the year of now. Quality is left for
+// the caller to set.
+func NewTimeIn(loc *time.Location, now time.Time) *Time {
+	z := zoneOf(loc, now.In(loc).Year())
+	t := NewTime()
+	t.CurrentTime = NewTimeTypeFromTime(now)
+	t.TzOffset = NewTimeOffsetType(int32(z.std / time.Second))
+	t.DstOffset = NewTimeOffsetType(int32(z.dst / time.Second))
+	t.DstStartTime = NewTimeTypeFromTime(z.start)
+	t.DstEndTime = NewTimeTypeFromTime(z.end)
+	t.LocalTime = NewTimeType(now.Unix() + int64(t.Offset(now)/time.Second))
+	return t
+}
+
+// Offset returns the offset of local time from UTC at the instant at: the
+// tzOffset of t plus its dstOffset if at falls within the daylight saving
+// time period. The period may span the new year, starting after it ends.
+func (t *Time) Offset(at time.Time) time.Duration {
+	if t == nil {
+		return 0
+	}
+	off := durationOf(t.TzOffset)
+	dst := durationOf(t.DstOffset)
+	if dst == 0 || t.DstStartTime == nil || t.DstEndTime == nil {
+		return off
+	}
+	s, e, u := t.DstStartTime.Value(), t.DstEndTime.Value(), at.Unix()
+	if (s <= e && u >= s && u < e) || (s > e && (u >= s || u < e)) {
+		off += dst
+	}
+	return off
+}
+
+// In returns the instant at in the local time of t.
+func (t *Time) In(at time.Time) time.Time {
+	off := t.Offset(at)
+	return at.In(time.FixedZone("", int(off/time.Second)))
+}
+
+// Local returns the currentTime of t in its local time, or the zero
+// time.Time if t has none.
+func (t *Time) Local() time.Time {
+	if t == nil || t.CurrentTime == nil {
+		return time.Time{}
+	}
+	return t.In(t.CurrentTime.Time())
+}
+
+// durationOf returns o as a time.Duration, or 0 if o is nil.
+func durationOf(o *TimeOffsetType) time.Duration {
+	if o == nil {
+		return 0
+	}
+	return o.Duration()
+}
+
+// zone is the standard offset of a time zone and its daylight saving time
+// offset and period in one year.
+type zone struct {
+	std, dst   time.Duration
+	start, end time.Time
+}
+
+// zoneOf returns the zone of loc in year. The period is empty if loc does
+// not observe daylight saving time that year.
+func zoneOf(loc *time.Location, year int) zone {
+	first := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	next := first.AddDate(1, 0, 0)
+	var z zone
+	stdFound := false
+	for at := first; at.Before(next); {
+		_, off := at.Zone()
+		if !at.IsDST() && !stdFound {
+			z.std, stdFound = time.Duration(off)*time.Second, true
+		}
+		_, end := at.ZoneBounds()
+		if end.IsZero() || !end.Before(next) {
+			break
+		}
+		// Record the transitions into and out of daylight saving time.
+		switch {
+		case !at.IsDST() && end.IsDST():
+			z.start = end
+			_, dstOff := end.Zone()
+			z.dst = time.Duration(dstOff)*time.Second - z.std
+		case at.IsDST() && !end.IsDST():
+			z.end = end
+		}
+		at = end
+	}
+	if !stdFound {
+		_, off := first.Zone()
+		z.std = time.Duration(off) * time.Second
+	}
+	if z.start.IsZero() || z.end.IsZero() {
+		return zone{std: z.std, start: first, end: first}
+	}
+	return z
+}
+
+// DstOperator is how a DstRule selects the day of its month.
+type DstOperator uint8
+
+// Values of DstOperator.
+const (
+	// DstOnDayOfMonth selects the DayOfMonth.
+	DstOnDayOfMonth DstOperator = iota
+	// DstOnOrAfterDayOfMonth selects the first DayOfWeek on or after the
+	// DayOfMonth.
+	DstOnOrAfterDayOfMonth
+	// DstFirst to DstFifth select the first to fifth DayOfWeek of the month.
+	DstFirst
+	DstSecond
+	DstThird
+	DstFourth
+	DstFifth
+	// DstLast selects the last DayOfWeek of the month.
+	DstLast
+)
+
+// DstRule is the rule a DstRuleType encodes: a day of a month and a time of
+// that day, in local time.
+type DstRule struct {
+	Month      time.Month
+	Operator   DstOperator
+	DayOfMonth int // 1 to 31, or 0 if not applicable
+	DayOfWeek  time.Weekday
+	// TimeOfDay is whole seconds under 24 hours.
+	TimeOfDay time.Duration
+}
+
+// dstRuleDisabled is the value of a DstRuleType that disables daylight
+// saving time.
+const dstRuleDisabled = 0xFFFFFFFF
+
+// DisabledDstRule returns the DstRuleType that disables daylight saving
+// time correction.
+func DisabledDstRule() *DstRuleType {
+	d := new(DstRuleType)
+	d.SetBits(dstRuleDisabled)
+	return d
+}
+
+// Disabled reports whether d disables daylight saving time correction.
+func (d *DstRuleType) Disabled() bool {
+	return d.Bits() == dstRuleDisabled
+}
+
+// Rule decodes the rule of d. It returns nil if d disables daylight saving
+// time correction.
+func (d *DstRuleType) Rule() (*DstRule, error) {
+	v := d.Bits()
+	if v == dstRuleDisabled {
+		return nil, nil
+	}
+	secs, hours := v&0xFFF, v>>12&0x1F
+	r := &DstRule{
+		Month:      time.Month(v >> 28),
+		Operator:   DstOperator(v >> 25 & 0x7),
+		DayOfMonth: int(v >> 20 & 0x1F),
+		DayOfWeek:  time.Weekday((v >> 17 & 0x7) % 7),
+		TimeOfDay:  time.Duration(hours)*time.Hour + time.Duration(secs)*time.Second,
+	}
+	if err := r.check(); err != nil {
+		return nil, fmt.Errorf("%w in DstRuleType %s", err, d.Value())
+	}
+	if secs >= 3600 {
+		return nil, fmt.Errorf("sep: seconds %d out of range in DstRuleType %s", secs, d.Value())
+	}
+	return r, nil
+}
+
+func (r *DstRule) check() error {
+	switch {
+	case r.Month < time.January || r.Month > time.December:
+		return fmt.Errorf("sep: month %d out of range", r.Month)
+	case r.DayOfMonth > 31 || (r.Operator <= DstOnOrAfterDayOfMonth && r.DayOfMonth < 1):
+		return fmt.Errorf("sep: day of month %d out of range", r.DayOfMonth)
+	case r.DayOfWeek < time.Sunday || r.DayOfWeek > time.Saturday:
+		return fmt.Errorf("sep: day of week %d out of range", r.DayOfWeek)
+	case r.TimeOfDay < 0 || r.TimeOfDay >= 24*time.Hour || r.TimeOfDay%time.Second != 0:
+		return fmt.Errorf("sep: time of day %v out of range", r.TimeOfDay)
+	case r.Operator > DstLast:
+		return fmt.Errorf("sep: operator %d out of range", r.Operator)
+	}
+	return nil
+}
+
+// Encode returns the DstRuleType of r.
+func (r DstRule) Encode() (*DstRuleType, error) {
+	if err := r.check(); err != nil {
+		return nil, err
+	}
+	dow := uint32(0)
+	if r.Operator != DstOnDayOfMonth {
+		// Monday is 1 and Sunday 7.
+		dow = uint32((r.DayOfWeek+6)%7) + 1
+	}
+	secs := uint32(r.TimeOfDay / time.Second)
+	v := uint32(r.Month)<<28 | uint32(r.Operator)<<25 | uint32(r.DayOfMonth)<<20 |
+		dow<<17 | secs/3600<<12 | secs%3600
+	d := new(DstRuleType)
+	d.SetBits(v)
+	return d, nil
+}
+
+// ErrNoSuchDay is returned, wrapped, by DstRule.In when the rule selects a
+// day its month does not have in that year, such as a fifth Monday.
+var ErrNoSuchDay = errors.New("sep: no such day")
+
+// In returns the instant r selects in year, its time of day being in a
+// zone offset from UTC by offset. For a rule starting daylight saving time
+// that is the standard offset; for one ending it, the offset during
+// daylight saving time.
+func (r DstRule) In(year int, offset time.Duration) (time.Time, error) {
+	if err := r.check(); err != nil {
+		return time.Time{}, err
+	}
+	first := time.Date(year, r.Month, 1, 0, 0, 0, 0, time.UTC)
+	days := first.AddDate(0, 1, -1).Day()
+	// after returns the first DayOfWeek on or after day.
+	after := func(day int) int {
+		wd := time.Date(year, r.Month, day, 0, 0, 0, 0, time.UTC).Weekday()
+		return day + int(r.DayOfWeek-wd+7)%7
+	}
+	var day int
+	switch op := r.Operator; op {
+	case DstOnDayOfMonth:
+		day = r.DayOfMonth
+	case DstOnOrAfterDayOfMonth:
+		day = after(r.DayOfMonth)
+	case DstLast:
+		day = after(days - 6)
+	default:
+		day = after(1) + 7*int(op-DstFirst)
+	}
+	if day > days {
+		return time.Time{}, fmt.Errorf("%w in %v %d", ErrNoSuchDay, r.Month, year)
+	}
+	wall := time.Date(year, r.Month, day, 0, 0, 0, 0, time.UTC).Add(r.TimeOfDay)
+	return wall.Add(-offset), nil
+}
+
+// ruleOf returns the rule that selects the instant at, whose time of day is
+// in a zone offset from UTC by offset: the nth weekday of the month, or the
+// last weekday if it falls in the last week.
+func ruleOf(at time.Time, offset time.Duration) DstRule {
+	wall := at.Add(offset).UTC()
+	days := time.Date(wall.Year(), wall.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	r := DstRule{
+		Month:     wall.Month(),
+		DayOfWeek: wall.Weekday(),
+		TimeOfDay: time.Duration(wall.Hour())*time.Hour + time.Duration(wall.Minute())*time.Minute + time.Duration(wall.Second())*time.Second,
+		Operator:  DstFirst + DstOperator((wall.Day()-1)/7),
+	}
+	if wall.Day() > days-7 {
+		r.Operator = DstLast
+	}
+	return r
+}
+
+// NewTimeConfigurationIn returns the TimeConfiguration of the time zone
+// loc, with the rules of its daylight saving time period in year, or
+// disabled rules if it has none that year.
+func NewTimeConfigurationIn(loc *time.Location, year int) (*TimeConfiguration, error) {
+	z := zoneOf(loc, year)
+	c := NewTimeConfiguration()
+	c.TzOffset = NewTimeOffsetType(int32(z.std / time.Second))
+	c.DstOffset = NewTimeOffsetType(int32(z.dst / time.Second))
+	if z.dst == 0 {
+		c.DstStartRule, c.DstEndRule = DisabledDstRule(), DisabledDstRule()
+		return c, nil
+	}
+	var err error
+	if c.DstStartRule, err = ruleOf(z.start, z.std).Encode(); err != nil {
+		return nil, err
+	}
+	if c.DstEndRule, err = ruleOf(z.end, z.std+z.dst).Encode(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// DSTPeriod returns the instants daylight saving time starts and ends in
+// year according to the rules of c, or ok false if c disables it.
+func (c *TimeConfiguration) DSTPeriod(year int) (start, end time.Time, ok bool, err error) {
+	if c == nil || c.DstStartRule.Disabled() || c.DstEndRule.Disabled() || durationOf(c.DstOffset) == 0 {
+		return time.Time{}, time.Time{}, false, nil
+	}
+	sr, err := c.DstStartRule.Rule()
+	if err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+	er, err := c.DstEndRule.Rule()
+	if err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+	std := durationOf(c.TzOffset)
+	if start, err = sr.In(year, std); err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+	if end, err = er.In(year, std+durationOf(c.DstOffset)); err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+	return start, end, true, nil
+}
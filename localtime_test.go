@@ -0,0 +1,209 @@
+package sep
+
+import (
+	"errors"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func utc(year int, month time.Month, day, hour int) time.Time {
+	return time.Date(year, month, day, hour, 0, 0, 0, time.UTC)
+}
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+// zoneTests are the zones of 2024 and the DST period their rules give in
+// 2025. Sydney's period spans the new year, starting after it ends.
+var zoneTests = []struct {
+	name               string
+	std, dst           time.Duration
+	start, end         time.Time
+	start25, end25     time.Time
+	startRule, endRule DstRule
+}{
+	{
+		name: "America/New_York", std: -5 * time.Hour, dst: time.Hour,
+		start: utc(2024, time.March, 10, 7), end: utc(2024, time.November, 3, 6),
+		start25: utc(2025, time.March, 9, 7), end25: utc(2025, time.November, 2, 6),
+		startRule: DstRule{Month: time.March, Operator: DstSecond, DayOfWeek: time.Sunday, TimeOfDay: 2 * time.Hour},
+		endRule:   DstRule{Month: time.November, Operator: DstFirst, DayOfWeek: time.Sunday, TimeOfDay: 2 * time.Hour},
+	},
+	{
+		name: "Europe/London", std: 0, dst: time.Hour,
+		start: utc(2024, time.March, 31, 1), end: utc(2024, time.October, 27, 1),
+		start25: utc(2025, time.March, 30, 1), end25: utc(2025, time.October, 26, 1),
+		startRule: DstRule{Month: time.March, Operator: DstLast, DayOfWeek: time.Sunday, TimeOfDay: time.Hour},
+		endRule:   DstRule{Month: time.October, Operator: DstLast, DayOfWeek: time.Sunday, TimeOfDay: 2 * time.Hour},
+	},
+	{
+		name: "Australia/Sydney", std: 10 * time.Hour, dst: time.Hour,
+		start: utc(2024, time.October, 5, 16), end: utc(2024, time.April, 6, 16),
+		start25: utc(2025, time.October, 4, 16), end25: utc(2025, time.April, 5, 16),
+		startRule: DstRule{Month: time.October, Operator: DstFirst, DayOfWeek: time.Sunday, TimeOfDay: 2 * time.Hour},
+		endRule:   DstRule{Month: time.April, Operator: DstFirst, DayOfWeek: time.Sunday, TimeOfDay: 3 * time.Hour},
+	},
+	{
+		name: "Asia/Tokyo", std: 9 * time.Hour,
+	},
+}
+
+func TestZoneOf(t *testing.T) {
+	for _, tt := range zoneTests {
+		z := zoneOf(mustLoad(t, tt.name), 2024)
+		if z.std != tt.std || z.dst != tt.dst {
+			t.Errorf("%s: offsets %v and %v, want %v and %v", tt.name, z.std, z.dst, tt.std, tt.dst)
+		}
+		if tt.dst == 0 {
+			if !z.start.Equal(z.end) {
+				t.Errorf("%s: period %v to %v, want empty", tt.name, z.start, z.end)
+			}
+			continue
+		}
+		if !z.start.Equal(tt.start) || !z.end.Equal(tt.end) {
+			t.Errorf("%s: period %v to %v, want %v to %v", tt.name, z.start.UTC(), z.end.UTC(), tt.start, tt.end)
+		}
+	}
+}
+
+func TestNewTimeIn(t *testing.T) {
+	for _, tt := range zoneTests {
+		loc := mustLoad(t, tt.name)
+		for _, now := range []time.Time{utc(2024, time.January, 15, 12), utc(2024, time.July, 15, 12), tt.start, tt.end} {
+			if tt.dst == 0 && (now.Equal(tt.start) || now.Equal(tt.end)) {
+				continue
+			}
+			tm := NewTimeIn(loc, now)
+			_, want := now.In(loc).Zone()
+			if got := tm.Offset(now); got != time.Duration(want)*time.Second {
+				t.Errorf("%s at %v: Offset %v, want %ds", tt.name, now, got, want)
+			}
+			if got := tm.LocalTime.Value() - now.Unix(); got != int64(want) {
+				t.Errorf("%s at %v: localTime %d s ahead, want %d", tt.name, now, got, want)
+			}
+			if got, want := tm.Local().Format(time.DateTime), now.In(loc).Format(time.DateTime); got != want {
+				t.Errorf("%s at %v: Local %s, want %s", tt.name, now, got, want)
+			}
+		}
+	}
+}
+
+func TestNewTimeConfigurationIn(t *testing.T) {
+	for _, tt := range zoneTests {
+		c, err := NewTimeConfigurationIn(mustLoad(t, tt.name), 2024)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if c.TzOffset.Duration() != tt.std || c.DstOffset.Duration() != tt.dst {
+			t.Errorf("%s: offsets %v and %v", tt.name, c.TzOffset.Duration(), c.DstOffset.Duration())
+		}
+		start, end, ok, err := c.DSTPeriod(2025)
+		if tt.dst == 0 {
+			if ok || err != nil || !c.DstStartRule.Disabled() || !c.DstEndRule.Disabled() {
+				t.Errorf("%s: DSTPeriod ok %v, %v, want disabled rules", tt.name, ok, err)
+			}
+			continue
+		}
+		for _, r := range []struct {
+			d    *DstRuleType
+			want DstRule
+		}{{c.DstStartRule, tt.startRule}, {c.DstEndRule, tt.endRule}} {
+			if got, err := r.d.Rule(); err != nil || *got != r.want {
+				t.Errorf("%s: rule %+v, %v, want %+v", tt.name, got, err, r.want)
+			}
+		}
+		if !ok || err != nil || !start.Equal(tt.start25) || !end.Equal(tt.end25) {
+			t.Errorf("%s: DSTPeriod(2025) = %v, %v, %v, %v, want %v to %v", tt.name, start, end, ok, err, tt.start25, tt.end25)
+		}
+	}
+}
+
+func TestDstRuleEncode(t *testing.T) {
+	for _, tt := range []struct {
+		rule DstRule
+		want string
+	}{
+		// The second Sunday of March at 02:00.
+		{DstRule{Month: time.March, Operator: DstSecond, DayOfWeek: time.Sunday, TimeOfDay: 2 * time.Hour}, "360E2000"},
+		// The first Monday on or after 25 May at 01:30:15.
+		{DstRule{Month: time.May, Operator: DstOnOrAfterDayOfMonth, DayOfMonth: 25, DayOfWeek: time.Monday,
+			TimeOfDay: time.Hour + 30*time.Minute + 15*time.Second}, "53921717"},
+		// 1 April at midnight: the day of the week is not encoded.
+		{DstRule{Month: time.April, Operator: DstOnDayOfMonth, DayOfMonth: 1, DayOfWeek: time.Friday}, "40100000"},
+	} {
+		d, err := tt.rule.Encode()
+		if err != nil || d.Value() != tt.want {
+			t.Errorf("Encode(%+v) = %v, %v, want %s", tt.rule, d, err, tt.want)
+			continue
+		}
+		got, err := d.Rule()
+		want := tt.rule
+		if want.Operator == DstOnDayOfMonth {
+			want.DayOfWeek = time.Sunday
+		}
+		if err != nil || *got != want {
+			t.Errorf("Rule(%s) = %+v, %v, want %+v", tt.want, got, err, want)
+		}
+	}
+	for _, bad := range []DstRule{
+		{Month: 13, Operator: DstFirst},
+		{Month: time.March, Operator: DstOnDayOfMonth},
+		{Month: time.March, Operator: DstFirst, TimeOfDay: 24 * time.Hour},
+		{Month: time.March, Operator: DstFirst, TimeOfDay: time.Millisecond},
+		{Month: time.March, Operator: DstLast + 1},
+	} {
+		if d, err := bad.Encode(); err == nil {
+			t.Errorf("Encode(%+v) = %s, want error", bad, d.Value())
+		}
+	}
+	var d DstRuleType
+	d.SetBits(0x36000E10) // 3600 seconds past the hour
+	if _, err := d.Rule(); err == nil {
+		t.Error("Rule accepted seconds past the hour of 3600")
+	}
+	if r, err := DisabledDstRule().Rule(); r != nil || err != nil {
+		t.Errorf("Rule of the disabled rule = %v, %v", r, err)
+	}
+}
+
+func TestDstRuleIn(t *testing.T) {
+	for _, tt := range []struct {
+		rule DstRule
+		year int
+		want time.Time
+		err  error
+	}{
+		{DstRule{Month: time.March, Operator: DstSecond, DayOfWeek: time.Sunday, TimeOfDay: 2 * time.Hour}, 2024,
+			utc(2024, time.March, 10, 2), nil},
+		{DstRule{Month: time.October, Operator: DstLast, DayOfWeek: time.Sunday, TimeOfDay: time.Hour}, 2023,
+			utc(2023, time.October, 29, 1), nil},
+		{DstRule{Month: time.September, Operator: DstOnOrAfterDayOfMonth, DayOfMonth: 29, DayOfWeek: time.Sunday}, 2024,
+			utc(2024, time.September, 29, 0), nil},
+		{DstRule{Month: time.April, Operator: DstFifth, DayOfWeek: time.Tuesday}, 2024,
+			utc(2024, time.April, 30, 0), nil},
+		// February 2023 has four Mondays, April thirty days, and no
+		// Sunday is on or after 30 September 2024.
+		{DstRule{Month: time.February, Operator: DstFifth, DayOfWeek: time.Monday}, 2023, time.Time{}, ErrNoSuchDay},
+		{DstRule{Month: time.April, Operator: DstOnDayOfMonth, DayOfMonth: 31}, 2024, time.Time{}, ErrNoSuchDay},
+		{DstRule{Month: time.September, Operator: DstOnOrAfterDayOfMonth, DayOfMonth: 30, DayOfWeek: time.Sunday}, 2024,
+			time.Time{}, ErrNoSuchDay},
+	} {
+		got, err := tt.rule.In(tt.year, -3*time.Hour)
+		if tt.err != nil {
+			if !errors.Is(err, tt.err) {
+				t.Errorf("In(%+v, %d) error = %v, want %v", tt.rule, tt.year, err, tt.err)
+			}
+			continue
+		}
+		if want := tt.want.Add(3 * time.Hour); err != nil || !got.Equal(want) {
+			t.Errorf("In(%+v, %d) = %v, %v, want %v", tt.rule, tt.year, got, err, want)
+		}
+	}
+}
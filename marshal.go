@@ -0,0 +1,575 @@
+package sep
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/Tylores/sep/internal/xsd"
+)
+
+// MarshalXML implements xml.Marshaler. DERCurveControlType embeds the global
+// DERCurve element, so it carries an untagged XMLName to take the name of the
+// field it is marshalled from; the namespace must then be set explicitly.
+func (c DERCurveControlType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERCurveControlType
+		noMarshalXML
+	}
+	if start.Name.Space == "" {
+		start.Name.Space = Namespace
+	}
+	return marshalInPlace(e, start, &c, func(c *DERCurveControlType) any { return plain{DERCurveControlType: c} })
+}
+
+// Unmarshal decodes the XML document data into v like xml.Unmarshal, then
+// allocates every embedded base type left nil because the document carried
+// none of its elements or attributes, so that promoted fields of v and of
+// every resource within it can be accessed directly. Elements and attributes
+// the types of v do not model are discarded; see UnmarshalLossless.
+func Unmarshal(data []byte, v any) error {
+	if err := xml.Unmarshal(declareTypePrefixes(data), v); err != nil {
+		return err
+	}
+	populate(reflect.ValueOf(v), false)
+	return nil
+}
+
+// UnmarshalLossless is Unmarshal, except that elements and attributes the
+// types of v do not model, such as vendor extensions, are kept in the Any and
+// AnyAttr fields and re-emitted when v is marshalled: each unknown element
+// in its place among the modelled ones, as it was written, and each unknown
+// attribute under its prefix. A resource can then be read, modified and
+// written back without losing them.
+func UnmarshalLossless(data []byte, v any) error {
+	data = declareTypePrefixes(data)
+	if err := xml.Unmarshal(data, v); err != nil {
+		return err
+	}
+	populate(reflect.ValueOf(v), true)
+	return placeUnknown(data, reflect.ValueOf(v))
+}
+
+// populate allocates the nil embedded base types of the value v and of the
+// values it contains and, unless keep is set, clears their unknown content.
+func populate(v reflect.Value, keep bool) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			populate(v.Elem(), keep)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			populate(v.Index(i), keep)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			f, fv := v.Type().Field(i), v.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			if f.Anonymous && fv.Kind() == reflect.Pointer && fv.IsNil() && hasComplexContent(f.Type.Elem()) {
+				fv.Set(reflect.New(f.Type.Elem()))
+			}
+			if !keep && isUnknownContent(v.Type(), f) {
+				fv.SetZero()
+				continue
+			}
+			populate(fv, keep)
+		}
+	}
+}
+
+// isUnknownContent reports whether the field f of the struct type t holds
+// unmodelled elements or attributes. The content of a Revision23Type is
+// modelled by the schema, so its elements and attributes are kept.
+func isUnknownContent(t reflect.Type, f reflect.StructField) bool {
+	switch f.Type {
+	case reflect.TypeFor[[]Attr](), reflect.TypeFor[[]*AnyElement]():
+		return t != reflect.TypeFor[Revision23Type]()
+	}
+	return false
+}
+
+// hasComplexContent reports whether the Go type t models a complex type
+// with element content, as opposed to a wrapped simple type.
+func hasComplexContent(t reflect.Type) bool {
+	name, ok := complexTypeNames[t]
+	return ok && schema().Types[name].SimpleContent() == nil
+}
+
+// xmlFields is the layout of the fields of a struct type as encoding/xml
+// sees it, with embedded structs flattened. Each field is given by its index
+// path, for fieldByIndex.
+type xmlFields struct {
+	// elems maps the local name of each modelled element to its field.
+	elems map[string][]int
+	// any and anyAttr are the fields holding unknown elements and
+	// attributes, or nil.
+	any, anyAttr []int
+}
+
+var xmlFieldsCache sync.Map // reflect.Type -> *xmlFields
+
+// xmlFieldsOf returns the layout of the struct type t.
+func xmlFieldsOf(t reflect.Type) *xmlFields {
+	if f, ok := xmlFieldsCache.Load(t); ok {
+		return f.(*xmlFields)
+	}
+	f := &xmlFields{elems: make(map[string][]int)}
+	depth := make(map[string]int)
+	var walk func(t reflect.Type, index []int)
+	walk = func(t reflect.Type, index []int) {
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			path := append(slices.Clip(index), i)
+			tag := sf.Tag.Get("xml")
+			if sf.Anonymous && tag == "" {
+				ft := sf.Type
+				if ft.Kind() == reflect.Pointer {
+					ft = ft.Elem()
+				}
+				if ft.Kind() == reflect.Struct {
+					walk(ft, path)
+				}
+				continue
+			}
+			if !sf.IsExported() || sf.Name == "XMLName" || tag == "-" {
+				continue
+			}
+			name, flags, _ := strings.Cut(tag, ",")
+			switch {
+			case flags == "any":
+				if f.any == nil {
+					f.any = path
+				}
+			case flags == "any,attr":
+				if f.anyAttr == nil {
+					f.anyAttr = path
+				}
+			case flags == "" || flags == "omitempty":
+				if name == "" {
+					name = sf.Name
+				}
+				// The shallowest field of a name wins, as in encoding/xml.
+				if d, ok := depth[name]; !ok || len(path) < d {
+					f.elems[name], depth[name] = path, len(path)
+				}
+			}
+		}
+	}
+	walk(t, nil)
+	f2, _ := xmlFieldsCache.LoadOrStore(t, f)
+	return f2.(*xmlFields)
+}
+
+// fieldByIndex returns the field of the struct v at the index path, or the
+// zero Value if an embedded pointer on the way is nil.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 {
+			if v.Kind() == reflect.Pointer {
+				if v.IsNil() {
+					return reflect.Value{}
+				}
+				v = v.Elem()
+			}
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+// xmlNamespace is the namespace bound to the prefix xml.
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+// placeUnknown walks the document data alongside v, into which it was
+// decoded, and records the place and text of every unknown element and the
+// prefix of every unknown attribute, for marshalInPlace.
+func placeUnknown(data []byte, v reflect.Value) error {
+	w := &sourceWalker{d: xml.NewDecoder(bytes.NewReader(data)), data: data}
+	for {
+		tok, err := w.d.RawToken()
+		if err != nil {
+			return err
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			return w.element(start, v)
+		}
+	}
+}
+
+// A sourceWalker reads a document token by token to place its unknown
+// content.
+type sourceWalker struct {
+	d     *xml.Decoder
+	data  []byte
+	decls scopes
+}
+
+// scopes holds the namespace declarations of each open element of a
+// document read with RawToken, by prefix; the default namespace has the
+// prefix "".
+type scopes []map[string]string
+
+// lookup returns the namespace bound to prefix in the open elements.
+func (s scopes) lookup(prefix string) (string, bool) {
+	for i := len(s) - 1; i >= 0; i-- {
+		if ns, ok := s[i][prefix]; ok {
+			return ns, true
+		}
+	}
+	return "", false
+}
+
+// declarations returns the namespace declarations of start by prefix.
+func declarations(start xml.StartElement) map[string]string {
+	m := make(map[string]string)
+	for _, a := range start.Attr {
+		switch {
+		case a.Name.Space == "xmlns":
+			m[a.Name.Local] = a.Value
+		case a.Name.Space == "" && a.Name.Local == "xmlns":
+			m[""] = a.Value
+		}
+	}
+	return m
+}
+
+// element consumes the content of the element start, decoded into v.
+func (w *sourceWalker) element(start xml.StartElement, v reflect.Value) error {
+	own := declarations(start)
+	w.decls = append(w.decls, own)
+	defer func() { w.decls = w.decls[:len(w.decls)-1] }()
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return w.skip()
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return w.skip()
+	}
+	fields := xmlFieldsOf(v.Type())
+	if fields.anyAttr != nil {
+		if f := fieldByIndex(v, fields.anyAttr); f.IsValid() {
+			w.attrs(start, f.Interface().([]Attr), own)
+		}
+	}
+	var unknown []*AnyElement
+	if fields.any != nil {
+		if f := fieldByIndex(v, fields.any); f.IsValid() {
+			unknown = f.Interface().([]*AnyElement)
+		}
+	}
+	seen := make(map[string]int)
+	modelled := 0
+	for {
+		off := w.d.InputOffset()
+		tok, err := w.d.RawToken()
+		if err != nil {
+			return err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			if index, ok := fields.elems[tok.Name.Local]; ok {
+				f := fieldByIndex(v, index)
+				if f.IsValid() && f.Kind() == reflect.Slice {
+					i := seen[tok.Name.Local]
+					seen[tok.Name.Local]++
+					if i < f.Len() {
+						f = f.Index(i)
+					} else {
+						f = reflect.Value{}
+					}
+				}
+				modelled++
+				if err := w.element(tok, f); err != nil {
+					return err
+				}
+				continue
+			}
+			if len(unknown) == 0 {
+				if err := w.skip(); err != nil {
+					return err
+				}
+				continue
+			}
+			a := unknown[0]
+			unknown = unknown[1:]
+			text, err := w.text(tok, off, own)
+			if err != nil {
+				return err
+			}
+			a.src = &source{pos: modelled, text: text, name: a.XMLName, attr: slices.Clone(a.Attr), content: bytes.Clone(a.Content)}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// attrs records in the unknown attributes of the element start the prefix
+// of each whose namespace is declared on the element itself, in own.
+func (w *sourceWalker) attrs(start xml.StartElement, attrs []Attr, own map[string]string) {
+	j := 0
+	for _, raw := range start.Attr {
+		if j == len(attrs) {
+			return
+		}
+		name := raw.Name
+		switch name.Space {
+		case "", "xmlns":
+		case "xml":
+			name.Space = xmlNamespace
+		default:
+			// Like encoding/xml, leave an unbound prefix as it is.
+			if ns, ok := w.decls.lookup(name.Space); ok {
+				name.Space = ns
+			}
+		}
+		if name != attrs[j].Name {
+			// A modelled attribute.
+			continue
+		}
+		if _, ok := own[raw.Name.Space]; ok && raw.Name.Space != "" && raw.Name.Space != "xmlns" {
+			attrs[j].prefix = raw.Name.Space
+		}
+		j++
+	}
+}
+
+// text consumes the rest of the element start, which began at offset off,
+// and returns it as written, declaring on it the prefixes it uses that are
+// bound outside it and not re-declared on the element holding it.
+func (w *sourceWalker) text(start xml.StartElement, off int64, holder map[string]string) ([]byte, error) {
+	used, declared := make(map[string]bool), make(map[string]bool)
+	note := func(s xml.StartElement) {
+		used[s.Name.Space] = true
+		for _, a := range s.Attr {
+			switch {
+			case a.Name.Space == "xmlns":
+				declared[a.Name.Local] = true
+			case a.Name.Space == "" && a.Name.Local == "xmlns":
+				declared[""] = true
+			case a.Name.Space != "":
+				used[a.Name.Space] = true
+			}
+		}
+	}
+	note(start)
+	for depth := 1; depth > 0; {
+		tok, err := w.d.RawToken()
+		if err != nil {
+			return nil, err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			depth++
+			note(tok)
+		case xml.EndElement:
+			depth--
+		}
+	}
+	text := w.data[off:w.d.InputOffset()]
+	var decls []string
+	for p := range used {
+		if p == "xml" || declared[p] {
+			continue
+		}
+		// The declarations of the holder are re-emitted with it, except
+		// that of xsi.
+		if ns, ok := holder[p]; ok && ns != xsd.InstanceNamespace {
+			continue
+		}
+		ns, ok := w.decls.lookup(p)
+		if !ok {
+			continue
+		}
+		var b strings.Builder
+		xml.EscapeText(&b, []byte(ns))
+		if p == "" {
+			decls = append(decls, fmt.Sprintf(` xmlns="%s"`, b.String()))
+		} else {
+			decls = append(decls, fmt.Sprintf(` xmlns:%s="%s"`, p, b.String()))
+		}
+	}
+	if len(decls) == 0 {
+		return bytes.Clone(text), nil
+	}
+	slices.Sort(decls)
+	name := start.Name.Local
+	if start.Name.Space != "" {
+		name = start.Name.Space + ":" + name
+	}
+	n := len("<" + name)
+	return slices.Concat(text[:n], []byte(strings.Join(decls, "")), text[n:]), nil
+}
+
+// skip consumes the rest of the current element.
+func (w *sourceWalker) skip() error {
+	for depth := 1; depth > 0; {
+		tok, err := w.d.RawToken()
+		if err != nil {
+			return err
+		}
+		switch tok.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+	return nil
+}
+
+// noMarshalXML is embedded next to a model type to hide the MarshalXML
+// method the type would otherwise promote, so that encoding/xml encodes its
+// fields.
+type noMarshalXML struct{}
+
+func (noMarshalXML) MarshalXML(*xml.Encoder, xml.StartElement) error {
+	return errors.New("sep: noMarshalXML is not an element")
+}
+
+// marshalInPlace encodes v as the element start through plain, which returns
+// v as a value encoding/xml encodes field by field. Unknown elements decoded
+// by UnmarshalLossless are written back in their place among the modelled
+// elements, and other unknown elements after them.
+func marshalInPlace[T any](e *xml.Encoder, start xml.StartElement, v *T, plain func(*T) any) error {
+	start = elementStart(reflect.TypeFor[T](), start)
+	c, unknown := detachUnknown(v)
+	if len(unknown) == 0 {
+		return e.EncodeElement(plain(v), start)
+	}
+	var b bytes.Buffer
+	if err := xml.NewEncoder(&b).EncodeElement(plain(c), start); err != nil {
+		return err
+	}
+	attr, content, err := spliceUnknown(b.Bytes(), unknown)
+	if err != nil {
+		return err
+	}
+	return e.EncodeElement(struct {
+		Attr    []xml.Attr `xml:",any,attr"`
+		Content []byte     `xml:",innerxml"`
+	}{attr, content}, xml.StartElement{Name: start.Name})
+}
+
+// elementStart returns start named after the XMLName tag of the type t, if
+// start is the one encoding/xml gives a Marshaler by default: the name of
+// its field or type, without a namespace.
+func elementStart(t reflect.Type, start xml.StartElement) xml.StartElement {
+	f, ok := t.FieldByName("XMLName")
+	if !ok || start.Name.Space != "" {
+		return start
+	}
+	tag, _, _ := strings.Cut(f.Tag.Get("xml"), ",")
+	ns, local, ok := strings.Cut(tag, " ")
+	if !ok {
+		ns, local = "", tag
+	}
+	if local != "" && (start.Name.Local == local || start.Name.Local == t.Name()) {
+		start.Name = xml.Name{Space: ns, Local: local}
+	}
+	return start
+}
+
+// detachUnknown returns the unknown elements of v and a copy of v without
+// them, sharing all but the structs on the way to them.
+func detachUnknown[T any](v *T) (*T, []*AnyElement) {
+	rv := reflect.ValueOf(v).Elem()
+	index := xmlFieldsOf(rv.Type()).any
+	if index == nil {
+		return v, nil
+	}
+	f := fieldByIndex(rv, index)
+	if !f.IsValid() || f.Len() == 0 {
+		return v, nil
+	}
+	c := reflect.New(rv.Type())
+	c.Elem().Set(rv)
+	s := c.Elem()
+	for _, i := range index[:len(index)-1] {
+		s = s.Field(i)
+		if s.Kind() == reflect.Pointer {
+			p := reflect.New(s.Type().Elem())
+			p.Elem().Set(s.Elem())
+			s.Set(p)
+			s = p.Elem()
+		}
+	}
+	s.Field(index[len(index)-1]).SetZero()
+	return c.Interface().(*T), f.Interface().([]*AnyElement)
+}
+
+// spliceUnknown returns the attributes and the content of the element doc
+// with the unknown elements inserted among its children.
+func spliceUnknown(doc []byte, unknown []*AnyElement) ([]xml.Attr, []byte, error) {
+	d := xml.NewDecoder(bytes.NewReader(doc))
+	var (
+		attr       []xml.Attr
+		children   []int64 // the offset of each child element
+		start, end int64   // the offsets of the content
+	)
+	for depth := 0; ; {
+		off := d.InputOffset()
+		tok, err := d.RawToken()
+		if err != nil {
+			return nil, nil, err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			if depth == 0 {
+				for _, a := range tok.Attr {
+					switch {
+					case a.Name.Space == "" && a.Name.Local == "xmlns":
+						// The encoder declares the namespace of the element.
+						continue
+					case a.Name.Space != "":
+						a.Name = xml.Name{Local: a.Name.Space + ":" + a.Name.Local}
+					}
+					attr = append(attr, a)
+				}
+				start = d.InputOffset()
+			} else if depth == 1 {
+				children = append(children, off)
+			}
+			depth++
+		case xml.EndElement:
+			if depth--; depth == 0 {
+				end = off
+			}
+		}
+		if end != 0 {
+			break
+		}
+	}
+	children = append(children, end)
+	n := len(children) - 1
+	var b bytes.Buffer
+	next := start
+	for i, c := range children {
+		b.Write(doc[next:c])
+		next = c
+		for _, a := range unknown {
+			pos := n
+			if a.src != nil {
+				pos = min(a.src.pos, n)
+			}
+			if pos != i {
+				continue
+			}
+			text, err := a.text()
+			if err != nil {
+				return nil, nil, err
+			}
+			b.Write(text)
+		}
+	}
+	return attr, b.Bytes(), nil
+}
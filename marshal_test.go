@@ -0,0 +1,143 @@
+package sep
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+// vendorEndDevice has a vendor attribute and vendor elements before and
+// between the elements of EndDevice.
+const vendorEndDevice = `<EndDevice xmlns="urn:ieee:std:2030.5:ns" xmlns:v="urn:vendor" href="/edev/1" v:flag="1">` +
+	`<v:first>a</v:first><sFDI>5</sFDI><v:tag n="1">b</v:tag><changedTime>0</changedTime><enabled>true</enabled></EndDevice>`
+
+func TestUnknownContent(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		unmarshal func([]byte, any) error
+		want      string
+	}{
+		// UnmarshalLossless keeps the elements in their place and the
+		// prefixes; only the modelled attribute moves ahead.
+		{"UnmarshalLossless", UnmarshalLossless, `<EndDevice xmlns="urn:ieee:std:2030.5:ns" href="/edev/1" xmlns:v="urn:vendor" v:flag="1">` +
+			`<v:first>a</v:first><sFDI>5</sFDI><v:tag n="1">b</v:tag><changedTime>0</changedTime><enabled>true</enabled></EndDevice>`},
+		// xml.Unmarshal does not record where the elements were, so they
+		// follow the modelled ones, each declaring its namespace.
+		{"xml.Unmarshal", xml.Unmarshal, `<EndDevice xmlns="urn:ieee:std:2030.5:ns" href="/edev/1" xmlns:v="urn:vendor" xmlns:_="urn:vendor" _:flag="1">` +
+			`<sFDI>5</sFDI><changedTime>0</changedTime><enabled>true</enabled>` +
+			`<first xmlns="urn:vendor">a</first><tag xmlns="urn:vendor" n="1">b</tag></EndDevice>`},
+		{"Unmarshal", Unmarshal, `<EndDevice xmlns="urn:ieee:std:2030.5:ns" href="/edev/1">` +
+			`<sFDI>5</sFDI><changedTime>0</changedTime><enabled>true</enabled></EndDevice>`},
+	} {
+		var e EndDevice
+		if err := tt.unmarshal([]byte(vendorEndDevice), &e); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		out, err := xml.Marshal(&e)
+		if err != nil || string(out) != tt.want {
+			t.Errorf("%s: marshalled\n%s, %v\nwant\n%s", tt.name, out, err, tt.want)
+		}
+	}
+
+	// The re-emitted document decodes to the same content.
+	var a, b EndDevice
+	if err := UnmarshalLossless([]byte(vendorEndDevice), &a); err != nil {
+		t.Fatal(err)
+	}
+	out, _ := xml.Marshal(&a)
+	if err := UnmarshalLossless(out, &b); err != nil {
+		t.Fatal(err)
+	}
+	if len(b.Any) != 2 || b.Any[0].XMLName != (xml.Name{Space: "urn:vendor", Local: "first"}) || string(b.Any[1].Content) != "b" {
+		t.Errorf("elements %+v", b.Any)
+	}
+	flag := false
+	for _, at := range b.AnyAttr {
+		if at.Name == (xml.Name{Space: "urn:vendor", Local: "flag"}) && at.Value == "1" {
+			flag = true
+		}
+	}
+	if !flag || b.SFDI.Value() != 5 {
+		t.Errorf("attributes %+v, sFDI %d", b.AnyAttr, b.SFDI.Value())
+	}
+}
+
+func TestUnknownContentInPlace(t *testing.T) {
+	for _, tt := range []struct {
+		name, in string
+		edit     func(*EndDeviceList)
+		want     string
+	}{
+		{
+			// The vendor prefix is declared again on the element that uses
+			// it, as EndDeviceList does not hold the element.
+			name: "nested, prefix declared on the root",
+			in: `<EndDeviceList xmlns="urn:ieee:std:2030.5:ns" xmlns:v="urn:vendor" all="1" results="1">` +
+				`<EndDevice><sFDI>5</sFDI><changedTime>0</changedTime><v:x v:a="1"><v:y/></v:x></EndDevice></EndDeviceList>`,
+			want: `<EndDeviceList xmlns="urn:ieee:std:2030.5:ns" xmlns:v="urn:vendor" all="1" results="1">` +
+				`<EndDevice xmlns="urn:ieee:std:2030.5:ns"><sFDI>5</sFDI><changedTime>0</changedTime><v:x xmlns:v="urn:vendor" v:a="1"><v:y/></v:x></EndDevice></EndDeviceList>`,
+		},
+		{
+			name: "edited element",
+			in: `<EndDeviceList xmlns="urn:ieee:std:2030.5:ns" all="1" results="1">` +
+				`<EndDevice><v:x xmlns:v="urn:vendor">a</v:x><sFDI>5</sFDI><changedTime>0</changedTime></EndDevice></EndDeviceList>`,
+			edit: func(l *EndDeviceList) { l.EndDevice[0].Any[0].Content = []byte("b") },
+			want: `<EndDeviceList xmlns="urn:ieee:std:2030.5:ns" all="1" results="1">` +
+				`<EndDevice xmlns="urn:ieee:std:2030.5:ns"><x xmlns="urn:vendor" xmlns:v="urn:vendor">b</x><sFDI>5</sFDI><changedTime>0</changedTime></EndDevice></EndDeviceList>`,
+		},
+		{
+			name: "added element",
+			in: `<EndDeviceList xmlns="urn:ieee:std:2030.5:ns" all="1" results="1">` +
+				`<EndDevice><v:x xmlns:v="urn:vendor"/><sFDI>5</sFDI><changedTime>0</changedTime></EndDevice></EndDeviceList>`,
+			edit: func(l *EndDeviceList) {
+				d := l.EndDevice[0]
+				d.Any = append(d.Any, &AnyElement{XMLName: xml.Name{Space: "urn:vendor", Local: "z"}})
+			},
+			want: `<EndDeviceList xmlns="urn:ieee:std:2030.5:ns" all="1" results="1">` +
+				`<EndDevice xmlns="urn:ieee:std:2030.5:ns"><v:x xmlns:v="urn:vendor"/><sFDI>5</sFDI><changedTime>0</changedTime><z xmlns="urn:vendor"></z></EndDevice></EndDeviceList>`,
+		},
+		{
+			name: "element after the last modelled one",
+			in: `<EndDeviceList xmlns="urn:ieee:std:2030.5:ns" all="1" results="1">` +
+				`<EndDevice><sFDI>5</sFDI><changedTime>0</changedTime><x xmlns="urn:vendor">a</x></EndDevice></EndDeviceList>`,
+			want: `<EndDeviceList xmlns="urn:ieee:std:2030.5:ns" all="1" results="1">` +
+				`<EndDevice xmlns="urn:ieee:std:2030.5:ns"><sFDI>5</sFDI><changedTime>0</changedTime><x xmlns="urn:vendor">a</x></EndDevice></EndDeviceList>`,
+		},
+	} {
+		var l EndDeviceList
+		if err := UnmarshalLossless([]byte(tt.in), &l); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if tt.edit != nil {
+			tt.edit(&l)
+		}
+		out, err := xml.Marshal(&l)
+		if err != nil || string(out) != tt.want {
+			t.Errorf("%s: marshalled\n%s, %v\nwant\n%s", tt.name, out, err, tt.want)
+		}
+	}
+}
+
+func TestOptionalString(t *testing.T) {
+	for _, tt := range []struct {
+		lfdi *string
+		want string
+	}{
+		{nil, `<EndDevice xmlns="urn:ieee:std:2030.5:ns"><sFDI>5</sFDI><changedTime>0</changedTime></EndDevice>`},
+		// Present but empty is distinct from absent.
+		{NewString(""), `<EndDevice xmlns="urn:ieee:std:2030.5:ns"><lFDI></lFDI><sFDI>5</sFDI><changedTime>0</changedTime></EndDevice>`},
+		{NewString(exampleLFDI), `<EndDevice xmlns="urn:ieee:std:2030.5:ns"><lFDI>` + exampleLFDI + `</lFDI><sFDI>5</sFDI><changedTime>0</changedTime></EndDevice>`},
+	} {
+		e := NewEndDevice()
+		e.LFDI, e.SFDI, e.ChangedTime = tt.lfdi, NewSFDIType(5), NewTimeType(0)
+		out, err := xml.Marshal(e)
+		if err != nil || string(out) != tt.want {
+			t.Errorf("marshalled\n%s, %v\nwant\n%s", out, err, tt.want)
+		}
+		fromEXI, fromXML, _ := exiTwice(t, e)
+		for _, got := range []*EndDevice{fromXML, fromEXI} {
+			if (got.LFDI == nil) != (tt.lfdi == nil) || stringValue(got.LFDI) != stringValue(tt.lfdi) {
+				t.Errorf("lFDI %q decoded as %v", stringValue(tt.lfdi), got.LFDI)
+			}
+		}
+	}
+}
@@ -0,0 +1,2828 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package sep
+
+import "encoding/xml"
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DeviceCapability) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DeviceCapability
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DeviceCapability) any { return plain{DeviceCapability: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a AbstractDevice) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*AbstractDevice
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *AbstractDevice) any { return plain{AbstractDevice: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DeviceStatus) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DeviceStatus
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DeviceStatus) any { return plain{DeviceStatus: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (e EndDeviceList) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*EndDeviceList
+		noMarshalXML
+	}
+	return marshalInPlace(enc, start, &e, func(e *EndDeviceList) any { return plain{EndDeviceList: e} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (e EndDevice) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*EndDevice
+		noMarshalXML
+	}
+	return marshalInPlace(enc, start, &e, func(e *EndDevice) any { return plain{EndDevice: e} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (e ExternalDevice) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ExternalDevice
+		noMarshalXML
+	}
+	return marshalInPlace(enc, start, &e, func(e *ExternalDevice) any { return plain{ExternalDevice: e} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r Registration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*Registration
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *Registration) any { return plain{Registration: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s SelfDevice) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*SelfDevice
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *SelfDevice) any { return plain{SelfDevice: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t Temperature) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*Temperature
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *Temperature) any { return plain{Temperature: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FunctionSetAssignmentsBase) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FunctionSetAssignmentsBase
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FunctionSetAssignmentsBase) any { return plain{FunctionSetAssignmentsBase: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FunctionSetAssignments) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FunctionSetAssignments
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FunctionSetAssignments) any { return plain{FunctionSetAssignments: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FunctionSetAssignmentsList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FunctionSetAssignmentsList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FunctionSetAssignmentsList) any { return plain{FunctionSetAssignmentsList: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c Condition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*Condition
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *Condition) any { return plain{Condition: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s SubscriptionBase) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*SubscriptionBase
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *SubscriptionBase) any { return plain{SubscriptionBase: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s Subscription) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*Subscription
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *Subscription) any { return plain{Subscription: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s SubscriptionList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*SubscriptionList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *SubscriptionList) any { return plain{SubscriptionList: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (n NotificationList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*NotificationList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &n, func(n *NotificationList) any { return plain{NotificationList: n} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r ResponseSetList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ResponseSetList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *ResponseSetList) any { return plain{ResponseSetList: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r ResponseSet) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ResponseSet
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *ResponseSet) any { return plain{ResponseSet: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*Response
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *Response) any { return plain{Response: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DefaultDERControlResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DefaultDERControlResponse
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DefaultDERControlResponse) any { return plain{DefaultDERControlResponse: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERControlResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERControlResponse
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERControlResponse) any { return plain{DERControlResponse: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DrResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DrResponse
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DrResponse) any { return plain{DrResponse: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a AppliedTargetReduction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*AppliedTargetReduction
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *AppliedTargetReduction) any { return plain{AppliedTargetReduction: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FlowReservationResponseResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FlowReservationResponseResponse
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FlowReservationResponseResponse) any { return plain{FlowReservationResponseResponse: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p PriceResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*PriceResponse
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *PriceResponse) any { return plain{PriceResponse: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t TextResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*TextResponse
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *TextResponse) any { return plain{TextResponse: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t Time) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*Time
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *Time) any { return plain{Time: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DeviceInformation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DeviceInformation
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DeviceInformation) any { return plain{DeviceInformation: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DRLCCapabilities) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DRLCCapabilities
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DRLCCapabilities) any { return plain{DRLCCapabilities: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s SupportedLocale) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*SupportedLocale
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *SupportedLocale) any { return plain{SupportedLocale: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s SupportedLocaleList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*SupportedLocaleList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *SupportedLocaleList) any { return plain{SupportedLocaleList: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p PowerStatus) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*PowerStatus
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *PowerStatus) any { return plain{PowerStatus: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p PEVInfo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*PEVInfo
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *PEVInfo) any { return plain{PEVInfo: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (i IEEE802154) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*IEEE802154
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &i, func(i *IEEE802154) any { return plain{IEEE802154: i} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (i IPAddr) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*IPAddr
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &i, func(i *IPAddr) any { return plain{IPAddr: i} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (i IPAddrList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*IPAddrList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &i, func(i *IPAddrList) any { return plain{IPAddrList: i} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (i IPInterface) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*IPInterface
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &i, func(i *IPInterface) any { return plain{IPInterface: i} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (i IPInterfaceList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*IPInterfaceList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &i, func(i *IPInterfaceList) any { return plain{IPInterfaceList: i} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (l LLInterface) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*LLInterface
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &l, func(l *LLInterface) any { return plain{LLInterface: l} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (l LLInterfaceList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*LLInterfaceList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &l, func(l *LLInterfaceList) any { return plain{LLInterfaceList: l} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (l LoWPAN) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*LoWPAN
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &l, func(l *LoWPAN) any { return plain{LoWPAN: l} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (n Neighbor) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*Neighbor
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &n, func(n *Neighbor) any { return plain{Neighbor: n} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (n NeighborList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*NeighborList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &n, func(n *NeighborList) any { return plain{NeighborList: n} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r RPLInstance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*RPLInstance
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *RPLInstance) any { return plain{RPLInstance: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r RPLInstanceList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*RPLInstanceList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *RPLInstanceList) any { return plain{RPLInstanceList: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r RPLSourceRoutes) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*RPLSourceRoutes
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *RPLSourceRoutes) any { return plain{RPLSourceRoutes: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r RPLSourceRoutesList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*RPLSourceRoutesList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *RPLSourceRoutesList) any { return plain{RPLSourceRoutesList: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (l LogEvent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*LogEvent
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &l, func(l *LogEvent) any { return plain{LogEvent: l} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (l LogEventList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*LogEventList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &l, func(l *LogEventList) any { return plain{LogEventList: l} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c Configuration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*Configuration
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *Configuration) any { return plain{Configuration: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p PowerConfiguration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*PowerConfiguration
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *PowerConfiguration) any { return plain{PowerConfiguration: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p PriceResponseCfg) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*PriceResponseCfg
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *PriceResponseCfg) any { return plain{PriceResponseCfg: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p PriceResponseCfgList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*PriceResponseCfgList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *PriceResponseCfgList) any { return plain{PriceResponseCfgList: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t TimeConfiguration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*TimeConfiguration
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *TimeConfiguration) any { return plain{TimeConfiguration: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f File) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*File
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *File) any { return plain{File: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FileList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FileList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FileList) any { return plain{FileList: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FileStatus) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FileStatus
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FileStatus) any { return plain{FileStatus: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (l LoadShedAvailabilityList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*LoadShedAvailabilityList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &l, func(l *LoadShedAvailabilityList) any { return plain{LoadShedAvailabilityList: l} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a ApplianceLoadReduction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ApplianceLoadReduction
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *ApplianceLoadReduction) any { return plain{ApplianceLoadReduction: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DemandResponseProgram) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DemandResponseProgram
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DemandResponseProgram) any { return plain{DemandResponseProgram: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DemandResponseProgramList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DemandResponseProgramList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DemandResponseProgramList) any { return plain{DemandResponseProgramList: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DutyCycle) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DutyCycle
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DutyCycle) any { return plain{DutyCycle: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (e EndDeviceControl) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*EndDeviceControl
+		noMarshalXML
+	}
+	return marshalInPlace(enc, start, &e, func(e *EndDeviceControl) any { return plain{EndDeviceControl: e} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (e EndDeviceControlList) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*EndDeviceControlList
+		noMarshalXML
+	}
+	return marshalInPlace(enc, start, &e, func(e *EndDeviceControlList) any { return plain{EndDeviceControlList: e} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (l LoadShedAvailability) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*LoadShedAvailability
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &l, func(l *LoadShedAvailability) any { return plain{LoadShedAvailability: l} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (o Offset) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*Offset
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &o, func(o *Offset) any { return plain{Offset: o} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s SetPoint) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*SetPoint
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *SetPoint) any { return plain{SetPoint: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t TargetReduction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*TargetReduction
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *TargetReduction) any { return plain{TargetReduction: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (m MeterReading) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*MeterReading
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &m, func(m *MeterReading) any { return plain{MeterReading: m} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (m MeterReadingList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*MeterReadingList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &m, func(m *MeterReadingList) any { return plain{MeterReadingList: m} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r Reading) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*Reading
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *Reading) any { return plain{Reading: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r ReadingList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ReadingList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *ReadingList) any { return plain{ReadingList: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r ReadingSet) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ReadingSet
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *ReadingSet) any { return plain{ReadingSet: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r ReadingSetList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ReadingSetList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *ReadingSetList) any { return plain{ReadingSetList: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r ReadingType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ReadingType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *ReadingType) any { return plain{ReadingType: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (u UsagePoint) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*UsagePoint
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &u, func(u *UsagePoint) any { return plain{UsagePoint: u} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (u UsagePointList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*UsagePointList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &u, func(u *UsagePointList) any { return plain{UsagePointList: u} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c ConsumptionTariffInterval) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ConsumptionTariffInterval
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *ConsumptionTariffInterval) any { return plain{ConsumptionTariffInterval: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c ConsumptionTariffIntervalList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ConsumptionTariffIntervalList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *ConsumptionTariffIntervalList) any { return plain{ConsumptionTariffIntervalList: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (e EnvironmentalCost) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*EnvironmentalCost
+		noMarshalXML
+	}
+	return marshalInPlace(enc, start, &e, func(e *EnvironmentalCost) any { return plain{EnvironmentalCost: e} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r RateComponent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*RateComponent
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *RateComponent) any { return plain{RateComponent: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r RateComponentList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*RateComponentList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *RateComponentList) any { return plain{RateComponentList: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t TariffProfile) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*TariffProfile
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *TariffProfile) any { return plain{TariffProfile: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t TariffProfileList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*TariffProfileList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *TariffProfileList) any { return plain{TariffProfileList: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t TimeTariffInterval) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*TimeTariffInterval
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *TimeTariffInterval) any { return plain{TimeTariffInterval: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t TimeTariffIntervalList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*TimeTariffIntervalList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *TimeTariffIntervalList) any { return plain{TimeTariffIntervalList: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (m MessagingProgram) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*MessagingProgram
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &m, func(m *MessagingProgram) any { return plain{MessagingProgram: m} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (m MessagingProgramList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*MessagingProgramList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &m, func(m *MessagingProgramList) any { return plain{MessagingProgramList: m} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t TextMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*TextMessage
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *TextMessage) any { return plain{TextMessage: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t TextMessageList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*TextMessageList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *TextMessageList) any { return plain{TextMessageList: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (b BillingPeriod) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*BillingPeriod
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &b, func(b *BillingPeriod) any { return plain{BillingPeriod: b} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (b BillingPeriodList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*BillingPeriodList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &b, func(b *BillingPeriodList) any { return plain{BillingPeriodList: b} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (b BillingMeterReadingBase) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*BillingMeterReadingBase
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &b, func(b *BillingMeterReadingBase) any { return plain{BillingMeterReadingBase: b} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (b BillingReading) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*BillingReading
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &b, func(b *BillingReading) any { return plain{BillingReading: b} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (b BillingReadingList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*BillingReadingList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &b, func(b *BillingReadingList) any { return plain{BillingReadingList: b} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (b BillingReadingSet) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*BillingReadingSet
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &b, func(b *BillingReadingSet) any { return plain{BillingReadingSet: b} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (b BillingReadingSetList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*BillingReadingSetList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &b, func(b *BillingReadingSetList) any { return plain{BillingReadingSetList: b} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c Charge) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*Charge
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *Charge) any { return plain{Charge: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c CustomerAccount) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*CustomerAccount
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *CustomerAccount) any { return plain{CustomerAccount: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c CustomerAccountList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*CustomerAccountList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *CustomerAccountList) any { return plain{CustomerAccountList: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c CustomerAgreement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*CustomerAgreement
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *CustomerAgreement) any { return plain{CustomerAgreement: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c CustomerAgreementList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*CustomerAgreementList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *CustomerAgreementList) any { return plain{CustomerAgreementList: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (h HistoricalReading) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*HistoricalReading
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &h, func(h *HistoricalReading) any { return plain{HistoricalReading: h} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (h HistoricalReadingList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*HistoricalReadingList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &h, func(h *HistoricalReadingList) any { return plain{HistoricalReadingList: h} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p ProjectionReading) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ProjectionReading
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *ProjectionReading) any { return plain{ProjectionReading: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p ProjectionReadingList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ProjectionReadingList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *ProjectionReadingList) any { return plain{ProjectionReadingList: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t TargetReading) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*TargetReading
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *TargetReading) any { return plain{TargetReading: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t TargetReadingList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*TargetReadingList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *TargetReadingList) any { return plain{TargetReadingList: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s ServiceSupplier) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ServiceSupplier
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *ServiceSupplier) any { return plain{ServiceSupplier: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a AccountBalance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*AccountBalance
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *AccountBalance) any { return plain{AccountBalance: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a AccountingUnit) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*AccountingUnit
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *AccountingUnit) any { return plain{AccountingUnit: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c CreditRegister) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*CreditRegister
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *CreditRegister) any { return plain{CreditRegister: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c CreditRegisterList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*CreditRegisterList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *CreditRegisterList) any { return plain{CreditRegisterList: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p Prepayment) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*Prepayment
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *Prepayment) any { return plain{Prepayment: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p PrepaymentList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*PrepaymentList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *PrepaymentList) any { return plain{PrepaymentList: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p PrepayOperationStatus) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*PrepayOperationStatus
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *PrepayOperationStatus) any { return plain{PrepayOperationStatus: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s ServiceChange) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ServiceChange
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *ServiceChange) any { return plain{ServiceChange: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s SupplyInterruptionOverride) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*SupplyInterruptionOverride
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *SupplyInterruptionOverride) any { return plain{SupplyInterruptionOverride: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s SupplyInterruptionOverrideList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*SupplyInterruptionOverrideList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *SupplyInterruptionOverrideList) any { return plain{SupplyInterruptionOverrideList: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c CreditTypeChange) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*CreditTypeChange
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *CreditTypeChange) any { return plain{CreditTypeChange: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r RequestStatus) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*RequestStatus
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *RequestStatus) any { return plain{RequestStatus: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FlowReservationRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FlowReservationRequest
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FlowReservationRequest) any { return plain{FlowReservationRequest: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FlowReservationRequestList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FlowReservationRequestList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FlowReservationRequestList) any { return plain{FlowReservationRequestList: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FlowReservationResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FlowReservationResponse
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FlowReservationResponse) any { return plain{FlowReservationResponse: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FlowReservationResponseList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FlowReservationResponseList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FlowReservationResponseList) any { return plain{FlowReservationResponseList: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERList) any { return plain{DERList: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DER) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DER
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DER) any { return plain{DER: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c CurrentDERControls) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*CurrentDERControls
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *CurrentDERControls) any { return plain{CurrentDERControls: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERComponentList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERComponentList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERComponentList) any { return plain{DERComponentList: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERComponentBase) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERComponentBase
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERComponentBase) any { return plain{DERComponentBase: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERComponent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERComponent
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERComponent) any { return plain{DERComponent: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERAvailability) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERAvailability
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERAvailability) any { return plain{DERAvailability: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERCapability) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERCapability
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERCapability) any { return plain{DERCapability: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERSettings) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERSettings
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERSettings) any { return plain{DERSettings: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERStatus) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERStatus
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERStatus) any { return plain{DERStatus: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERProgramList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERProgramList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERProgramList) any { return plain{DERProgramList: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERProgram) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERProgram
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERProgram) any { return plain{DERProgram: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERControlBase) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERControlBase
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERControlBase) any { return plain{DERControlBase: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DefaultDERControl) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DefaultDERControl
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DefaultDERControl) any { return plain{DefaultDERControl: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERControlList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERControlList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERControlList) any { return plain{DERControlList: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERControl) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERControl
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERControl) any { return plain{DERControl: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERCurveList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERCurveList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERCurveList) any { return plain{DERCurveList: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERCurve) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERCurve
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERCurve) any { return plain{DERCurve: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c CurveData) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*CurveData
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *CurveData) any { return plain{CurveData: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a ActivePower) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ActivePower
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *ActivePower) any { return plain{ActivePower: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a ActivePowerControlType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ActivePowerControlType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *ActivePowerControlType) any { return plain{ActivePowerControlType: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a ActivePowerDeltaControlType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ActivePowerDeltaControlType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *ActivePowerDeltaControlType) any { return plain{ActivePowerDeltaControlType: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (u UnsignedActivePower) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*UnsignedActivePower
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &u, func(u *UnsignedActivePower) any { return plain{UnsignedActivePower: u} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (u UnsignedActivePowerControlType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*UnsignedActivePowerControlType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &u, func(u *UnsignedActivePowerControlType) any { return plain{UnsignedActivePowerControlType: u} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a AmpereHour) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*AmpereHour
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *AmpereHour) any { return plain{AmpereHour: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a ApparentPower) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ApparentPower
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *ApparentPower) any { return plain{ApparentPower: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c CurrentRMS) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*CurrentRMS
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *CurrentRMS) any { return plain{CurrentRMS: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FixedPointType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FixedPointType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FixedPointType) any { return plain{FixedPointType: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (u UnsignedFixedPointType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*UnsignedFixedPointType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &u, func(u *UnsignedFixedPointType) any { return plain{UnsignedFixedPointType: u} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FixedVar) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FixedVar
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FixedVar) any { return plain{FixedVar: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FixedVarControlType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FixedVarControlType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FixedVarControlType) any { return plain{FixedVarControlType: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (u UnsignedFixedVar) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*UnsignedFixedVar
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &u, func(u *UnsignedFixedVar) any { return plain{UnsignedFixedVar: u} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (u UnsignedFixedVarControlType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*UnsignedFixedVarControlType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &u, func(u *UnsignedFixedVarControlType) any { return plain{UnsignedFixedVarControlType: u} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FreqDroopType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FreqDroopType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FreqDroopType) any { return plain{FreqDroopType: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p PowerFactor) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*PowerFactor
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *PowerFactor) any { return plain{PowerFactor: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p PowerFactorWithExcitation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*PowerFactorWithExcitation
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *PowerFactorWithExcitation) any { return plain{PowerFactorWithExcitation: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p PowerFactorWithExcitationControlType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*PowerFactorWithExcitationControlType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *PowerFactorWithExcitationControlType) any {
+		return plain{PowerFactorWithExcitationControlType: p}
+	})
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r ReactivePower) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ReactivePower
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *ReactivePower) any { return plain{ReactivePower: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r ReactivePowerControlType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ReactivePowerControlType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *ReactivePowerControlType) any { return plain{ReactivePowerControlType: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r ReactivePowerDeltaControlType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ReactivePowerDeltaControlType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *ReactivePowerDeltaControlType) any { return plain{ReactivePowerDeltaControlType: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (u UnsignedReactivePower) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*UnsignedReactivePower
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &u, func(u *UnsignedReactivePower) any { return plain{UnsignedReactivePower: u} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (u UnsignedReactivePowerControlType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*UnsignedReactivePowerControlType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &u, func(u *UnsignedReactivePowerControlType) any { return plain{UnsignedReactivePowerControlType: u} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r ReactiveSusceptance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ReactiveSusceptance
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *ReactiveSusceptance) any { return plain{ReactiveSusceptance: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (v VoltageRMS) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*VoltageRMS
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &v, func(v *VoltageRMS) any { return plain{VoltageRMS: v} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (v VoltageRMSControlType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*VoltageRMSControlType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &v, func(v *VoltageRMSControlType) any { return plain{VoltageRMSControlType: v} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (w WattHour) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*WattHour
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &w, func(w *WattHour) any { return plain{WattHour: w} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c ConnectStatusType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ConnectStatusType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *ConnectStatusType) any { return plain{ConnectStatusType: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c ConnectStatusType2) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ConnectStatusType2
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *ConnectStatusType2) any { return plain{ConnectStatusType2: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (i InverterStatusType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*InverterStatusType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &i, func(i *InverterStatusType) any { return plain{InverterStatusType: i} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (l LocalControlModeStatusType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*LocalControlModeStatusType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &l, func(l *LocalControlModeStatusType) any { return plain{LocalControlModeStatusType: l} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (m ManufacturerStatusType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ManufacturerStatusType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &m, func(m *ManufacturerStatusType) any { return plain{ManufacturerStatusType: m} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (o OperationalModeStatusType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*OperationalModeStatusType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &o, func(o *OperationalModeStatusType) any { return plain{OperationalModeStatusType: o} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s StateOfChargeStatusType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*StateOfChargeStatusType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *StateOfChargeStatusType) any { return plain{StateOfChargeStatusType: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s StorageModeStatusType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*StorageModeStatusType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *StorageModeStatusType) any { return plain{StorageModeStatusType: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c CurrentDERProgramLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*CurrentDERProgramLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *CurrentDERProgramLink) any { return plain{CurrentDERProgramLink: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a AggregationPriority) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*AggregationPriority
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *AggregationPriority) any { return plain{AggregationPriority: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p PriorityData) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*PriorityData
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *PriorityData) any { return plain{PriorityData: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a AggregatedDeviceList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*AggregatedDeviceList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *AggregatedDeviceList) any { return plain{AggregatedDeviceList: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a AggregatedDevice) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*AggregatedDevice
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *AggregatedDevice) any { return plain{AggregatedDevice: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p ProxiedDevice) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ProxiedDevice
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *ProxiedDevice) any { return plain{ProxiedDevice: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p ProxiedDeviceList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ProxiedDeviceList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *ProxiedDeviceList) any { return plain{ProxiedDeviceList: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a AccountBalanceLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*AccountBalanceLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *AccountBalanceLink) any { return plain{AccountBalanceLink: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a AggregatedDeviceListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*AggregatedDeviceListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *AggregatedDeviceListLink) any { return plain{AggregatedDeviceListLink: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a AggregationPriorityLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*AggregationPriorityLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *AggregationPriorityLink) any { return plain{AggregationPriorityLink: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a AssociatedDERProgramListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*AssociatedDERProgramListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *AssociatedDERProgramListLink) any { return plain{AssociatedDERProgramListLink: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a AssociatedUsagePointLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*AssociatedUsagePointLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *AssociatedUsagePointLink) any { return plain{AssociatedUsagePointLink: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (b BillingPeriodListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*BillingPeriodListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &b, func(b *BillingPeriodListLink) any { return plain{BillingPeriodListLink: b} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (b BillingReadingListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*BillingReadingListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &b, func(b *BillingReadingListLink) any { return plain{BillingReadingListLink: b} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (b BillingReadingSetListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*BillingReadingSetListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &b, func(b *BillingReadingSetListLink) any { return plain{BillingReadingSetListLink: b} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c ConfigurationLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ConfigurationLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *ConfigurationLink) any { return plain{ConfigurationLink: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c ConsumptionTariffIntervalListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ConsumptionTariffIntervalListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *ConsumptionTariffIntervalListLink) any { return plain{ConsumptionTariffIntervalListLink: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c CreditRegisterListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*CreditRegisterListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *CreditRegisterListLink) any { return plain{CreditRegisterListLink: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c CurrentDERControlsLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*CurrentDERControlsLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *CurrentDERControlsLink) any { return plain{CurrentDERControlsLink: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c CustomerAccountLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*CustomerAccountLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *CustomerAccountLink) any { return plain{CustomerAccountLink: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c CustomerAccountListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*CustomerAccountListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *CustomerAccountListLink) any { return plain{CustomerAccountListLink: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (c CustomerAgreementListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*CustomerAgreementListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &c, func(c *CustomerAgreementListLink) any { return plain{CustomerAgreementListLink: c} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DefaultDERControlLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DefaultDERControlLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DefaultDERControlLink) any { return plain{DefaultDERControlLink: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DemandResponseProgramLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DemandResponseProgramLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DemandResponseProgramLink) any { return plain{DemandResponseProgramLink: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DemandResponseProgramListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DemandResponseProgramListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DemandResponseProgramListLink) any { return plain{DemandResponseProgramListLink: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERAvailabilityLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERAvailabilityLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERAvailabilityLink) any { return plain{DERAvailabilityLink: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERCapabilityLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERCapabilityLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERCapabilityLink) any { return plain{DERCapabilityLink: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERComponentListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERComponentListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERComponentListLink) any { return plain{DERComponentListLink: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERControlListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERControlListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERControlListLink) any { return plain{DERControlListLink: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERCurveLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERCurveLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERCurveLink) any { return plain{DERCurveLink: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERCurveListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERCurveListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERCurveListLink) any { return plain{DERCurveListLink: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERLink) any { return plain{DERLink: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERListLink) any { return plain{DERListLink: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERProgramLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERProgramLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERProgramLink) any { return plain{DERProgramLink: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERProgramListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERProgramListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERProgramListLink) any { return plain{DERProgramListLink: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERSettingsLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERSettingsLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERSettingsLink) any { return plain{DERSettingsLink: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DERStatusLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DERStatusLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DERStatusLink) any { return plain{DERStatusLink: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DeviceCapabilityLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DeviceCapabilityLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DeviceCapabilityLink) any { return plain{DeviceCapabilityLink: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DeviceInformationLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DeviceInformationLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DeviceInformationLink) any { return plain{DeviceInformationLink: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DeviceStatusLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DeviceStatusLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DeviceStatusLink) any { return plain{DeviceStatusLink: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (e EndDeviceControlListLink) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*EndDeviceControlListLink
+		noMarshalXML
+	}
+	return marshalInPlace(enc, start, &e, func(e *EndDeviceControlListLink) any { return plain{EndDeviceControlListLink: e} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (e EndDeviceLink) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*EndDeviceLink
+		noMarshalXML
+	}
+	return marshalInPlace(enc, start, &e, func(e *EndDeviceLink) any { return plain{EndDeviceLink: e} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (e EndDeviceListLink) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*EndDeviceListLink
+		noMarshalXML
+	}
+	return marshalInPlace(enc, start, &e, func(e *EndDeviceListLink) any { return plain{EndDeviceListLink: e} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FileLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FileLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FileLink) any { return plain{FileLink: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FileListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FileListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FileListLink) any { return plain{FileListLink: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FileStatusLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FileStatusLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FileStatusLink) any { return plain{FileStatusLink: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FlowReservationRequestListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FlowReservationRequestListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FlowReservationRequestListLink) any { return plain{FlowReservationRequestListLink: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FlowReservationResponseListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FlowReservationResponseListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FlowReservationResponseListLink) any { return plain{FlowReservationResponseListLink: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (f FunctionSetAssignmentsListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*FunctionSetAssignmentsListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &f, func(f *FunctionSetAssignmentsListLink) any { return plain{FunctionSetAssignmentsListLink: f} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (h HistoricalReadingListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*HistoricalReadingListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &h, func(h *HistoricalReadingListLink) any { return plain{HistoricalReadingListLink: h} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (i IPAddrListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*IPAddrListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &i, func(i *IPAddrListLink) any { return plain{IPAddrListLink: i} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (i IPInterfaceListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*IPInterfaceListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &i, func(i *IPInterfaceListLink) any { return plain{IPInterfaceListLink: i} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (l LLInterfaceListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*LLInterfaceListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &l, func(l *LLInterfaceListLink) any { return plain{LLInterfaceListLink: l} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (l LoadShedAvailabilityListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*LoadShedAvailabilityListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &l, func(l *LoadShedAvailabilityListLink) any { return plain{LoadShedAvailabilityListLink: l} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (l LogEventListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*LogEventListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &l, func(l *LogEventListLink) any { return plain{LogEventListLink: l} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (m MessagingProgramListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*MessagingProgramListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &m, func(m *MessagingProgramListLink) any { return plain{MessagingProgramListLink: m} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (m MeterReadingLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*MeterReadingLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &m, func(m *MeterReadingLink) any { return plain{MeterReadingLink: m} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (m MeterReadingListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*MeterReadingListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &m, func(m *MeterReadingListLink) any { return plain{MeterReadingListLink: m} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (m MirrorUsagePointListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*MirrorUsagePointListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &m, func(m *MirrorUsagePointListLink) any { return plain{MirrorUsagePointListLink: m} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (n NeighborListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*NeighborListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &n, func(n *NeighborListLink) any { return plain{NeighborListLink: n} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (n NotificationListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*NotificationListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &n, func(n *NotificationListLink) any { return plain{NotificationListLink: n} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p PowerStatusLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*PowerStatusLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *PowerStatusLink) any { return plain{PowerStatusLink: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p PrepaymentLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*PrepaymentLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *PrepaymentLink) any { return plain{PrepaymentLink: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p PrepaymentListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*PrepaymentListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *PrepaymentListLink) any { return plain{PrepaymentListLink: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p PrepayOperationStatusLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*PrepayOperationStatusLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *PrepayOperationStatusLink) any { return plain{PrepayOperationStatusLink: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p PriceResponseCfgListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*PriceResponseCfgListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *PriceResponseCfgListLink) any { return plain{PriceResponseCfgListLink: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p ProjectionReadingListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ProjectionReadingListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *ProjectionReadingListLink) any { return plain{ProjectionReadingListLink: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (p ProxiedDeviceListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ProxiedDeviceListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &p, func(p *ProxiedDeviceListLink) any { return plain{ProxiedDeviceListLink: p} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r RateComponentLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*RateComponentLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *RateComponentLink) any { return plain{RateComponentLink: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r RateComponentListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*RateComponentListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *RateComponentListLink) any { return plain{RateComponentListLink: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r ReadingLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ReadingLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *ReadingLink) any { return plain{ReadingLink: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r ReadingListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ReadingListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *ReadingListLink) any { return plain{ReadingListLink: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r ReadingSetListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ReadingSetListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *ReadingSetListLink) any { return plain{ReadingSetListLink: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r ReadingTypeLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ReadingTypeLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *ReadingTypeLink) any { return plain{ReadingTypeLink: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r RegistrationLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*RegistrationLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *RegistrationLink) any { return plain{RegistrationLink: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r ResponseListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ResponseListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *ResponseListLink) any { return plain{ResponseListLink: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r ResponseSetListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ResponseSetListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *ResponseSetListLink) any { return plain{ResponseSetListLink: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r RPLInstanceListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*RPLInstanceListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *RPLInstanceListLink) any { return plain{RPLInstanceListLink: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r RPLSourceRoutesListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*RPLSourceRoutesListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *RPLSourceRoutesListLink) any { return plain{RPLSourceRoutesListLink: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s SelfDeviceLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*SelfDeviceLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *SelfDeviceLink) any { return plain{SelfDeviceLink: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s ServiceSupplierLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ServiceSupplierLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *ServiceSupplierLink) any { return plain{ServiceSupplierLink: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s SubscriptionListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*SubscriptionListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *SubscriptionListLink) any { return plain{SubscriptionListLink: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s SupplyInterruptionOverrideListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*SupplyInterruptionOverrideListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *SupplyInterruptionOverrideListLink) any { return plain{SupplyInterruptionOverrideListLink: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s SupportedLocaleListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*SupportedLocaleListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *SupportedLocaleListLink) any { return plain{SupportedLocaleListLink: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t TargetReadingListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*TargetReadingListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *TargetReadingListLink) any { return plain{TargetReadingListLink: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t TariffProfileLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*TariffProfileLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *TariffProfileLink) any { return plain{TariffProfileLink: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t TariffProfileListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*TariffProfileListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *TariffProfileListLink) any { return plain{TariffProfileListLink: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t TextMessageListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*TextMessageListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *TextMessageListLink) any { return plain{TextMessageListLink: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t TimeLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*TimeLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *TimeLink) any { return plain{TimeLink: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (t TimeTariffIntervalListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*TimeTariffIntervalListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &t, func(t *TimeTariffIntervalListLink) any { return plain{TimeTariffIntervalListLink: t} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (u UsagePointLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*UsagePointLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &u, func(u *UsagePointLink) any { return plain{UsagePointLink: u} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (u UsagePointListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*UsagePointListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &u, func(u *UsagePointListLink) any { return plain{UsagePointListLink: u} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a ActiveBillingPeriodListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ActiveBillingPeriodListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *ActiveBillingPeriodListLink) any { return plain{ActiveBillingPeriodListLink: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a ActiveCreditRegisterListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ActiveCreditRegisterListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *ActiveCreditRegisterListLink) any { return plain{ActiveCreditRegisterListLink: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a ActiveDERControlListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ActiveDERControlListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *ActiveDERControlListLink) any { return plain{ActiveDERControlListLink: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a ActiveEndDeviceControlListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ActiveEndDeviceControlListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *ActiveEndDeviceControlListLink) any { return plain{ActiveEndDeviceControlListLink: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a ActiveFlowReservationListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ActiveFlowReservationListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *ActiveFlowReservationListLink) any { return plain{ActiveFlowReservationListLink: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a ActiveProjectionReadingListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ActiveProjectionReadingListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *ActiveProjectionReadingListLink) any { return plain{ActiveProjectionReadingListLink: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a ActiveSupplyInterruptionOverrideListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ActiveSupplyInterruptionOverrideListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *ActiveSupplyInterruptionOverrideListLink) any {
+		return plain{ActiveSupplyInterruptionOverrideListLink: a}
+	})
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a ActiveTargetReadingListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ActiveTargetReadingListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *ActiveTargetReadingListLink) any { return plain{ActiveTargetReadingListLink: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a ActiveTextMessageListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ActiveTextMessageListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *ActiveTextMessageListLink) any { return plain{ActiveTextMessageListLink: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (a ActiveTimeTariffIntervalListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ActiveTimeTariffIntervalListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &a, func(a *ActiveTimeTariffIntervalListLink) any { return plain{ActiveTimeTariffIntervalListLink: a} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (i IdentifiedObject) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*IdentifiedObject
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &i, func(i *IdentifiedObject) any { return plain{IdentifiedObject: i} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (l Link) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*Link
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &l, func(l *Link) any { return plain{Link: l} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (l List) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*List
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &l, func(l *List) any { return plain{List: l} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (l ListLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ListLink
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &l, func(l *ListLink) any { return plain{ListLink: l} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r Resource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*Resource
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *Resource) any { return plain{Resource: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r RespondableIdentifiedObject) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*RespondableIdentifiedObject
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *RespondableIdentifiedObject) any { return plain{RespondableIdentifiedObject: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r RespondableResource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*RespondableResource
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *RespondableResource) any { return plain{RespondableResource: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r RespondableSubscribableIdentifiedObject) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*RespondableSubscribableIdentifiedObject
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *RespondableSubscribableIdentifiedObject) any {
+		return plain{RespondableSubscribableIdentifiedObject: r}
+	})
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s SubscribableIdentifiedObject) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*SubscribableIdentifiedObject
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *SubscribableIdentifiedObject) any { return plain{SubscribableIdentifiedObject: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s SubscribableList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*SubscribableList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *SubscribableList) any { return plain{SubscribableList: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s SubscribableResource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*SubscribableResource
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *SubscribableResource) any { return plain{SubscribableResource: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (e Error) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*Error
+		noMarshalXML
+	}
+	return marshalInPlace(enc, start, &e, func(e *Error) any { return plain{Error: e} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (e Event) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*Event
+		noMarshalXML
+	}
+	return marshalInPlace(enc, start, &e, func(e *Event) any { return plain{Event: e} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (e EventStatus) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*EventStatus
+		noMarshalXML
+	}
+	return marshalInPlace(enc, start, &e, func(e *EventStatus) any { return plain{EventStatus: e} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r RandomizableEvent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*RandomizableEvent
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *RandomizableEvent) any { return plain{RandomizableEvent: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (d DateTimeInterval) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*DateTimeInterval
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &d, func(d *DateTimeInterval) any { return plain{DateTimeInterval: d} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (g GeographicLocationType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*GeographicLocationType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &g, func(g *GeographicLocationType) any { return plain{GeographicLocationType: g} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (g GPSLocationType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*GPSLocationType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &g, func(g *GPSLocationType) any { return plain{GPSLocationType: g} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r RealEnergy) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*RealEnergy
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *RealEnergy) any { return plain{RealEnergy: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (s SignedRealEnergy) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*SignedRealEnergy
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &s, func(s *SignedRealEnergy) any { return plain{SignedRealEnergy: s} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (u UnitValueType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*UnitValueType
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &u, func(u *UnitValueType) any { return plain{UnitValueType: u} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (m MirrorMeterReading) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*MirrorMeterReading
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &m, func(m *MirrorMeterReading) any { return plain{MirrorMeterReading: m} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (m MirrorMeterReadingList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*MirrorMeterReadingList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &m, func(m *MirrorMeterReadingList) any { return plain{MirrorMeterReadingList: m} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (m MeterReadingBase) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*MeterReadingBase
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &m, func(m *MeterReadingBase) any { return plain{MeterReadingBase: m} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (m MirrorReadingSet) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*MirrorReadingSet
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &m, func(m *MirrorReadingSet) any { return plain{MirrorReadingSet: m} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (m MirrorUsagePoint) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*MirrorUsagePoint
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &m, func(m *MirrorUsagePoint) any { return plain{MirrorUsagePoint: m} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (m MirrorUsagePointList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*MirrorUsagePointList
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &m, func(m *MirrorUsagePointList) any { return plain{MirrorUsagePointList: m} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r ReadingBase) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ReadingBase
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *ReadingBase) any { return plain{ReadingBase: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r ReadingSetBase) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*ReadingSetBase
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *ReadingSetBase) any { return plain{ReadingSetBase: r} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (u UsagePointBase) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*UsagePointBase
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &u, func(u *UsagePointBase) any { return plain{UsagePointBase: u} })
+}
+
+// MarshalXML implements xml.Marshaler, writing unknown elements back in place.
+func (r Revision23Type) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain struct {
+		*Revision23Type
+		noMarshalXML
+	}
+	return marshalInPlace(e, start, &r, func(r *Revision23Type) any { return plain{Revision23Type: r} })
+}
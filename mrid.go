@@ -0,0 +1,117 @@
+package sep
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// An mRID is 128 bits, written as 32 hex digits: 96 bits identifying the
+// object, unique among those of its issuer, followed by the 32-bit IANA
+// Private Enterprise Number (PEN) of the issuer.
+
+// inProgress is the reserved identifier part of the mRID of an object that
+// is still being created.
+const inProgress = "FFFFFFFFFFFFFFFFFFFFFFFF"
+
+// MRIDGenerator mints mRIDs for one issuer. Its mRIDs are unique and sort,
+// as strings and by Compare, in the order they were generated: the 96-bit
+// identifier is a 48-bit Unix time in milliseconds followed by 48 bits that
+// are random for the first mRID of each millisecond and incremented for the
+// next. It is safe for concurrent use.
+type MRIDGenerator struct {
+	pen uint32
+
+	mu  sync.Mutex
+	ms  uint64
+	seq uint64
+}
+
+// NewMRIDGenerator returns a generator of mRIDs ending in pen.
+func NewMRIDGenerator(pen uint32) *MRIDGenerator {
+	return &MRIDGenerator{pen: pen}
+}
+
+// Next returns a new mRID.
+func (g *MRIDGenerator) Next() *MRIDType {
+	g.mu.Lock()
+	if ms := uint64(time.Now().UnixMilli()) & (1<<48 - 1); ms > g.ms {
+		var b [8]byte
+		rand.Read(b[2:])
+		// Leave room below 2^48 to increment within the millisecond.
+		g.ms, g.seq = ms, binary.BigEndian.Uint64(b[:])>>1
+	} else {
+		// The clock has not moved on, or went back: keep counting from the
+		// last mRID so that order is kept.
+		g.seq++
+		if g.seq == 1<<48 {
+			g.ms, g.seq = g.ms+1, 0
+		}
+	}
+	ms, seq := g.ms, g.seq
+	g.mu.Unlock()
+	return NewMRIDType(fmt.Sprintf("%012X%012X%08X", ms, seq, g.pen))
+}
+
+// InProgressMRID returns the reserved mRID of an object, such as a
+// ReadingSet still accumulating, that issuer pen is still creating.
+func InProgressMRID(pen uint32) *MRIDType {
+	return NewMRIDType(fmt.Sprintf("%s%08X", inProgress, pen))
+}
+
+// ParseMRID returns the mRID s in its canonical form, 32 uppercase hex
+// digits. It fails if s is not 32 hex digits.
+func ParseMRID(s string) (*MRIDType, error) {
+	m := strings.ToUpper(strings.TrimSpace(s))
+	if _, err := hex.DecodeString(m); err != nil || len(m) != 32 {
+		return nil, fmt.Errorf("sep: invalid mRID %q: not 32 hex digits", s)
+	}
+	return NewMRIDType(m), nil
+}
+
+// canonical returns the mRID held by m in uppercase, or "" if m is nil or
+// unset.
+func (m *MRIDType) canonical() string {
+	if m == nil {
+		return ""
+	}
+	return strings.ToUpper(strings.TrimSpace(m.Value()))
+}
+
+// Valid reports whether m holds 32 hex digits.
+func (m *MRIDType) Valid() bool {
+	_, err := ParseMRID(m.canonical())
+	return err == nil
+}
+
+// PEN returns the IANA Private Enterprise Number of the issuer of m, and
+// false if m is not a valid mRID.
+func (m *MRIDType) PEN() (uint32, bool) {
+	if !m.Valid() {
+		return 0, false
+	}
+	b, _ := hex.DecodeString(m.canonical()[24:])
+	return binary.BigEndian.Uint32(b), true
+}
+
+// InProgress reports whether m is the reserved mRID of an object still
+// being created.
+func (m *MRIDType) InProgress() bool {
+	return m.Valid() && strings.HasPrefix(m.canonical(), inProgress)
+}
+
+// Equal reports whether m and o are the same mRID, ignoring the case of
+// their hex digits. A nil or unset mRID equals only another.
+func (m *MRIDType) Equal(o *MRIDType) bool {
+	return m.canonical() == o.canonical()
+}
+
+// Compare returns -1, 0 or +1 as m sorts before, with or after o, by value
+// for valid mRIDs. A nil or unset mRID sorts first.
+func (m *MRIDType) Compare(o *MRIDType) int {
+	return strings.Compare(m.canonical(), o.canonical())
+}
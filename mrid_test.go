@@ -0,0 +1,140 @@
+package sep
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestMRIDGeneratorOrder(t *testing.T) {
+	g := NewMRIDGenerator(0x0000A5B7)
+	var ms []*MRIDType
+	for range 10000 {
+		ms = append(ms, g.Next())
+	}
+	for i, m := range ms {
+		if !m.Valid() || m.Value() != m.canonical() {
+			t.Fatalf("mRID %d, %q, is not canonical", i, m.Value())
+		}
+		if pen, ok := m.PEN(); !ok || pen != 0xA5B7 {
+			t.Fatalf("PEN of %s = %X, %v, want A5B7", m.Value(), pen, ok)
+		}
+		if i > 0 && (ms[i-1].Compare(m) >= 0 || ms[i-1].Value() >= m.Value()) {
+			t.Fatalf("mRID %d, %s, does not sort after %s", i, m.Value(), ms[i-1].Value())
+		}
+	}
+}
+
+func TestMRIDGeneratorClockBack(t *testing.T) {
+	g := NewMRIDGenerator(1)
+	a := g.Next()
+	// A clock far ahead of the real one, as after the real one went back.
+	g.mu.Lock()
+	g.ms += 1 << 20
+	g.seq = 1<<48 - 1
+	g.mu.Unlock()
+	b := g.Next()
+	c := g.Next()
+	if a.Compare(b) >= 0 || b.Compare(c) >= 0 {
+		t.Errorf("mRIDs out of order: %s, %s, %s", a.Value(), b.Value(), c.Value())
+	}
+	if !strings.HasSuffix(b.Value()[12:24], "000000000000") {
+		t.Errorf("sequence did not wrap into the next millisecond: %s", b.Value())
+	}
+}
+
+func TestMRIDGeneratorConcurrent(t *testing.T) {
+	g := NewMRIDGenerator(7)
+	var mu sync.Mutex
+	seen := make(map[string]bool)
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 1000 {
+				m := g.Next().Value()
+				mu.Lock()
+				if seen[m] {
+					t.Errorf("mRID %s generated twice", m)
+				}
+				seen[m] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestInProgressMRID(t *testing.T) {
+	m := InProgressMRID(0x12345678)
+	if m.Value() != "FFFFFFFFFFFFFFFFFFFFFFFF12345678" || !m.InProgress() {
+		t.Errorf("InProgressMRID = %s, InProgress %v", m.Value(), m.InProgress())
+	}
+	if pen, _ := m.PEN(); pen != 0x12345678 {
+		t.Errorf("PEN = %X", pen)
+	}
+	if lower := NewMRIDType(strings.ToLower(m.Value())); !lower.InProgress() {
+		t.Error("a lowercase in-progress mRID is not in progress")
+	}
+	for _, s := range []string{"FFFFFFFFFFFFFFFFFFFFFFFE12345678", "FFFFFFFFFFFFFFFFFFFFFFFF"} {
+		if NewMRIDType(s).InProgress() {
+			t.Errorf("%s is in progress", s)
+		}
+	}
+	if NewMRIDGenerator(1).Next().InProgress() {
+		t.Error("a generated mRID is in progress")
+	}
+}
+
+func TestParseMRID(t *testing.T) {
+	for _, tt := range []struct {
+		in, want string
+	}{
+		{"0123456789abcdef0123456789ABCDEF", "0123456789ABCDEF0123456789ABCDEF"},
+		{" 0123456789ABCDEF0123456789ABCDEF\n", "0123456789ABCDEF0123456789ABCDEF"},
+		{"0123456789ABCDEF0123456789ABCDE", ""},
+		{"0123456789ABCDEF0123456789ABCDEF0", ""},
+		{"0123456789ABCDEF0123456789ABCDEG", ""},
+		{"", ""},
+	} {
+		m, err := ParseMRID(tt.in)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("ParseMRID(%q) = %s, want error", tt.in, m.Value())
+			}
+			continue
+		}
+		if err != nil || m.Value() != tt.want {
+			t.Errorf("ParseMRID(%q) = %v, %v, want %s", tt.in, m, err, tt.want)
+		}
+	}
+}
+
+func TestMRIDCompare(t *testing.T) {
+	var unset MRIDType
+	a := NewMRIDType("0000000000000000000000000000000A")
+	upper := NewMRIDType("0000000000000000000000000000000B")
+	lower := NewMRIDType("0000000000000000000000000000000b")
+	if !upper.Equal(lower) || upper.Compare(lower) != 0 {
+		t.Error("mRIDs differing in case are not equal")
+	}
+	if a.Compare(lower) != -1 || lower.Compare(a) != 1 {
+		t.Error("mRIDs do not compare by value ignoring case")
+	}
+	if (*MRIDType)(nil).Compare(a) != -1 || unset.Compare(a) != -1 || !unset.Equal(nil) {
+		t.Error("a nil or unset mRID does not sort first or equal another")
+	}
+	if unset.Valid() || (*MRIDType)(nil).Valid() {
+		t.Error("a nil or unset mRID is valid")
+	}
+	if _, ok := (*MRIDType)(nil).PEN(); ok {
+		t.Error("a nil mRID has a PEN")
+	}
+	ms := []*MRIDType{lower, nil, a, &unset}
+	slices.SortFunc(ms, (*MRIDType).Compare)
+	if ms[2] != a || ms[3] != lower {
+		t.Errorf("sorted = %v", ms)
+	}
+}
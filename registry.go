@@ -0,0 +1,233 @@
+package sep
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Media types of IEEE 2030.5 resource representations.
+const (
+	MediaTypeXML = "application/sep+xml"
+	MediaTypeEXI = "application/sep-exi"
+)
+
+// ErrUnsupportedMediaType is returned, wrapped, by Decode and Encode for a
+// content type without a codec.
+var ErrUnsupportedMediaType = errors.New("sep: unsupported media type")
+
+// codec encodes and decodes the resources of one media type.
+type codec struct {
+	decode func(data []byte) (any, error)
+	encode func(w io.Writer, v any) error
+}
+
+// codecs holds the codec of every supported media type.
+var codecs = map[string]codec{
+	MediaTypeXML: {decodeXML, encodeXML},
+	MediaTypeEXI: {decodeEXI, encodeEXI},
+}
+
+// mediaTypes lists the media types in order of preference.
+var mediaTypes = []string{MediaTypeXML, MediaTypeEXI}
+
+// elementTypes maps the name of every global element of sep.xsd, the root
+// elements of a representation, to its Go type.
+var elementTypes = sync.OnceValue(func() map[string]reflect.Type {
+	m := make(map[string]reflect.Type, len(schema().ElementNames))
+	for _, name := range schema().ElementNames {
+		m[name] = complexTypes[name]
+	}
+	return m
+})
+
+// ElementNames returns the names of the root elements, in schema order.
+func ElementNames() []string {
+	return append([]string(nil), schema().ElementNames...)
+}
+
+// ElementType returns the Go type of the root element name, such as
+// DERControlList.
+func ElementType(name string) (reflect.Type, bool) {
+	t, ok := elementTypes()[name]
+	return t, ok
+}
+
+// NewElement returns a pointer to a new value of the Go type of the root
+// element name, with its chain of base types allocated.
+func NewElement(name string) (any, bool) {
+	t, ok := ElementType(name)
+	if !ok {
+		return nil, false
+	}
+	v := reflect.New(t)
+	populate(v, false)
+	return v.Interface(), true
+}
+
+// ElementName returns the name of the root element v is encoded as. v must
+// be a value of, or a pointer to, the Go type of a root element.
+func ElementName(v any) (string, bool) {
+	t := reflect.TypeOf(v)
+	if t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	name, ok := complexTypeNames[t]
+	if !ok || elementTypes()[name] != t {
+		return "", false
+	}
+	return name, true
+}
+
+// Decode reads a representation of the given content type from r and
+// decodes it into a new value of the Go type of its root element. It
+// returns a pointer to the value, so that callers can switch on its type.
+func Decode(contentType string, r io.Reader) (any, error) {
+	c, err := codecFor(contentType)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return c.decode(data)
+}
+
+// Encode writes v, a value of or pointer to the Go type of a root element,
+// to w in the given content type, with schemaVer SchemaVersion unless v sets
+// it.
+func Encode(w io.Writer, contentType string, v any) error {
+	c, err := codecFor(contentType)
+	if err != nil {
+		return err
+	}
+	if _, ok := ElementName(v); !ok {
+		return fmt.Errorf("sep: %T is not the type of a root element", v)
+	}
+	return c.encode(w, v)
+}
+
+// codecFor returns the codec of contentType, ignoring its parameters, such
+// as the level parameter of 2030.5 function set media types.
+func codecFor(contentType string) (codec, error) {
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return codec{}, fmt.Errorf("%w %q: %v", ErrUnsupportedMediaType, contentType, err)
+	}
+	c, ok := codecs[mt]
+	if !ok {
+		return codec{}, fmt.Errorf("%w %q", ErrUnsupportedMediaType, contentType)
+	}
+	return c, nil
+}
+
+// Negotiate returns the supported media type the Accept header accept
+// prefers, or "" if it accepts none. An empty header accepts any type.
+func Negotiate(accept string) string {
+	if strings.TrimSpace(accept) == "" {
+		accept = "*/*"
+	}
+	type rng struct {
+		typ, sub string
+		q        float64
+	}
+	var ranges []rng
+	for _, part := range strings.Split(accept, ",") {
+		mt, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		typ, sub, _ := strings.Cut(mt, "/")
+		q := 1.0
+		if s, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(s, 64); err != nil {
+				continue
+			}
+		}
+		ranges = append(ranges, rng{typ, sub, q})
+	}
+	// The most specific range matching a media type sets its quality.
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return strings.Count(ranges[i].typ+ranges[i].sub, "*") < strings.Count(ranges[j].typ+ranges[j].sub, "*")
+	})
+	best, bestQ := "", 0.0
+	for _, m := range mediaTypes {
+		if _, ok := codecs[m]; !ok {
+			continue
+		}
+		typ, sub, _ := strings.Cut(m, "/")
+		for _, r := range ranges {
+			if (r.typ == "*" || r.typ == typ) && (r.sub == "*" || r.sub == sub) {
+				if r.q > bestQ {
+					best, bestQ = m, r.q
+				}
+				break
+			}
+		}
+	}
+	return best
+}
+
+// decodeXML decodes an application/sep+xml representation.
+func decodeXML(data []byte) (any, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Space != Namespace {
+			return nil, fmt.Errorf("sep: root element <%s> is not in namespace %s", start.Name.Local, Namespace)
+		}
+		v, ok := NewElement(start.Name.Local)
+		if !ok {
+			return nil, fmt.Errorf("sep: unknown root element <%s>", start.Name.Local)
+		}
+		if err := Unmarshal(data, v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+// encodeXML writes an application/sep+xml representation.
+func encodeXML(w io.Writer, v any) error {
+	return xml.NewEncoder(w).Encode(versioned(v))
+}
+
+// versioned returns v or, if v is a root element that leaves schemaVer
+// unset, a pointer to a copy of it with schemaVer SchemaVersion, so that
+// encoding does not modify v.
+func versioned(v any) any {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return v
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return v
+	}
+	f, ok := rv.Type().FieldByName("SchemaVerAttr")
+	if !ok || len(f.Index) != 1 || rv.Field(f.Index[0]).String() != "" {
+		return v
+	}
+	c := reflect.New(rv.Type())
+	c.Elem().Set(rv)
+	c.Elem().Field(f.Index[0]).SetString(SchemaVersion)
+	return c.Interface()
+}
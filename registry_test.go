@@ -0,0 +1,81 @@
+package sep
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		accept, want string
+	}{
+		{"", MediaTypeXML},
+		{"  ", MediaTypeXML},
+		{"*/*", MediaTypeXML},
+		{"application/sep-exi", MediaTypeEXI},
+		{"application/sep+xml; level=-S1", MediaTypeXML},
+		{"text/html", ""},
+		// The highest quality wins; ties go to the preferred XML.
+		{"application/sep+xml;q=0.5, application/sep-exi", MediaTypeEXI},
+		{"application/sep-exi;q=0.8, application/sep+xml;q=0.9", MediaTypeXML},
+		{"application/sep-exi, application/sep+xml", MediaTypeXML},
+		{"text/html, application/sep-exi;q=0.1", MediaTypeEXI},
+		// A more specific range sets the quality whatever its order.
+		{"*/*;q=0.1, application/*;q=0.5, application/sep-exi", MediaTypeEXI},
+		{"application/sep-exi;q=0.9, application/*;q=0.5", MediaTypeEXI},
+		{"*/*, application/*;q=0.2", MediaTypeXML},
+		{"*/*;q=0.9, application/sep+xml;q=0.3", MediaTypeEXI},
+		// q=0 excludes a type even when a wider range accepts it.
+		{"application/sep+xml;q=0, */*", MediaTypeEXI},
+		{"application/*, application/sep-exi;q=0", MediaTypeXML},
+		{"application/sep+xml;q=0, application/sep-exi;q=0, */*", ""},
+		{"*/*;q=0", ""},
+		// Malformed ranges are ignored.
+		{"garbage/, application/sep-exi", MediaTypeEXI},
+		{"application/sep+xml;q=high, application/sep-exi;q=0.1", MediaTypeEXI},
+	}
+	for _, tt := range tests {
+		if got := Negotiate(tt.accept); got != tt.want {
+			t.Errorf("Negotiate(%q) = %q, want %q", tt.accept, got, tt.want)
+		}
+	}
+}
+
+func TestCodecErrors(t *testing.T) {
+	doc := `<DERControlList xmlns="urn:ieee:std:2030.5:ns" all="0" results="0"/>`
+	for _, ct := range []string{"text/xml", "application/json", "", "application/sep+xml;;"} {
+		if _, err := Decode(ct, strings.NewReader(doc)); !errors.Is(err, ErrUnsupportedMediaType) {
+			t.Errorf("Decode(%q) = %v, want ErrUnsupportedMediaType", ct, err)
+		}
+		if err := Encode(new(bytes.Buffer), ct, NewDERControlList()); !errors.Is(err, ErrUnsupportedMediaType) {
+			t.Errorf("Encode(%q) = %v, want ErrUnsupportedMediaType", ct, err)
+		}
+	}
+	if v, err := Decode(MediaTypeXML+"; level=-S1", strings.NewReader(doc)); err != nil {
+		t.Errorf("Decode with a level parameter: %v", err)
+	} else if _, ok := v.(*DERControlList); !ok {
+		t.Errorf("Decode = %T, want *DERControlList", v)
+	}
+
+	// Only root elements can be encoded, in either media type.
+	for _, v := range []any{NewDERControlBase(), &IdentifiedObject{}, "DERControl", nil} {
+		for _, ct := range []string{MediaTypeXML, MediaTypeEXI} {
+			err := Encode(new(bytes.Buffer), ct, v)
+			if err == nil || errors.Is(err, ErrUnsupportedMediaType) {
+				t.Errorf("Encode(%s, %T) = %v, want a root element error", ct, v, err)
+			}
+		}
+	}
+	for _, doc := range []string{
+		`<DERControlList all="0" results="0"/>`,
+		`<DERControlBase xmlns="urn:ieee:std:2030.5:ns"/>`,
+		`<v:DERControlList xmlns:v="urn:vendor"/>`,
+		``,
+	} {
+		if v, err := Decode(MediaTypeXML, strings.NewReader(doc)); err == nil {
+			t.Errorf("Decode(%s) = %T, want an error", doc, v)
+		}
+	}
+}
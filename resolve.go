@@ -0,0 +1,354 @@
+package sep
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Overlapping events are resolved as IEEE 2030.5 requires. Of two events
+// that overlap in time and control the same thing, the one whose program
+// has the lower primacy value takes precedence while both apply. Of two
+// events of the same program, the one created later supersedes the other,
+// which then does not apply at all; of two events of different programs
+// sharing a primacy, the one created later takes precedence while both
+// apply. DERControls control the same thing if they
+// set a mode in common for a device category in common, EndDeviceControls
+// if they are for a device category in common, and any other two events of
+// the same type always do. Events of different function sets never compete.
+
+// Program is the events of one program, with the primacy that ranks them
+// against the events of other programs. Programs are told apart by MRID;
+// each Program without one is a program of its own.
+type Program struct {
+	MRID    *MRIDType
+	Primacy uint8
+	Events  []Eventer
+}
+
+// primacyOf returns the value of p, or 0 if p is nil.
+func primacyOf(p *PrimacyType) uint8 {
+	if p == nil {
+		return 0
+	}
+	return p.Value()
+}
+
+// DERProgramEvents returns the Program of p and its controls.
+func DERProgramEvents(p *DERProgram, controls ...*DERControl) Program {
+	pr := Program{MRID: p.GetMRID(), Primacy: primacyOf(p.Primacy)}
+	for _, c := range controls {
+		pr.Events = append(pr.Events, c)
+	}
+	return pr
+}
+
+// DemandResponseProgramEvents returns the Program of p and its controls.
+func DemandResponseProgramEvents(p *DemandResponseProgram, controls ...*EndDeviceControl) Program {
+	pr := Program{MRID: p.GetMRID(), Primacy: primacyOf(p.Primacy)}
+	for _, c := range controls {
+		pr.Events = append(pr.Events, c)
+	}
+	return pr
+}
+
+// TariffProfileEvents returns the Program of p and its intervals.
+func TariffProfileEvents(p *TariffProfile, intervals ...*TimeTariffInterval) Program {
+	pr := Program{MRID: p.GetMRID(), Primacy: primacyOf(p.Primacy)}
+	for _, t := range intervals {
+		pr.Events = append(pr.Events, t)
+	}
+	return pr
+}
+
+// LossReason is why an event does not apply.
+type LossReason uint8
+
+// Values of LossReason.
+const (
+	// LossSuperseded is the reason of an event superseded by a later one of
+	// the same program. It does not apply at all.
+	LossSuperseded LossReason = iota + 1
+	// LossPrimacy is the reason of an event that yields to one of a program
+	// of lower primacy value, or to a later one of another program of the
+	// same primacy. It does not apply while that one does.
+	LossPrimacy
+)
+
+var lossReasonNames = map[LossReason]string{
+	LossSuperseded: "Superseded",
+	LossPrimacy:    "Primacy",
+}
+
+// String returns the name of r.
+func (r LossReason) String() string { return enumString(r, lossReasonNames) }
+
+// EventLoss is an event not applying, between Start and End, because of
+// another.
+type EventLoss struct {
+	Event  Eventer
+	By     Eventer
+	Reason LossReason
+	Start  time.Time
+	End    time.Time
+
+	primacy, byPrimacy uint8
+}
+
+// String explains l.
+func (l EventLoss) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "event %s ", l.Event.GetMRID().canonical())
+	switch l.Reason {
+	case LossSuperseded:
+		fmt.Fprintf(&b, "is superseded by event %s: both are of the same program and ", l.By.GetMRID().canonical())
+		l.writeCreation(&b)
+	case LossPrimacy:
+		fmt.Fprintf(&b, "yields to event %s from %d to %d: ", l.By.GetMRID().canonical(), l.Start.Unix(), l.End.Unix())
+		if l.byPrimacy != l.primacy {
+			fmt.Fprintf(&b, "the program of that event has the lower primacy value, %d against %d, and so takes precedence", l.byPrimacy, l.primacy)
+		} else {
+			fmt.Fprintf(&b, "their programs share primacy %d and ", l.primacy)
+			l.writeCreation(&b)
+		}
+	}
+	if shared := sharedControls(l.Event, l.By); shared != "" {
+		fmt.Fprintf(&b, " (both set %s)", shared)
+	}
+	return b.String()
+}
+
+// writeCreation explains why l.By takes precedence over l.Event among events
+// of the same primacy.
+func (l EventLoss) writeCreation(b *strings.Builder) {
+	if c, bc := creationOf(l.Event), creationOf(l.By); c != bc {
+		fmt.Fprintf(b, "the latter was created later, at %d rather than %d", bc, c)
+	} else {
+		fmt.Fprintf(b, "were created at %d, and the latter has the greater mRID", c)
+	}
+}
+
+// EventSlice is the events that apply between Start and End, in order of
+// precedence.
+type EventSlice struct {
+	Start  time.Time
+	End    time.Time
+	Events []Eventer
+}
+
+// Resolution is the result of ResolveEvents.
+type Resolution struct {
+	// Slices are the spans of time in which some event applies, in order;
+	// consecutive slices differ in their events.
+	Slices []EventSlice
+	// Losses are the events that do not apply, when and why, in order of
+	// their Start.
+	Losses []EventLoss
+}
+
+// Effective returns the events that apply at at, in order of precedence.
+func (r *Resolution) Effective(at time.Time) []Eventer {
+	i, _ := slices.BinarySearchFunc(r.Slices, at, func(s EventSlice, at time.Time) int {
+		if !s.End.After(at) {
+			return -1
+		}
+		if s.Start.After(at) {
+			return 1
+		}
+		return 0
+	})
+	if i < len(r.Slices) && !r.Slices[i].Start.After(at) && r.Slices[i].End.After(at) {
+		return r.Slices[i].Events
+	}
+	return nil
+}
+
+// Lost returns the losses of ev.
+func (r *Resolution) Lost(ev Eventer) []EventLoss {
+	var ls []EventLoss
+	for _, l := range r.Losses {
+		if l.Event == ev {
+			ls = append(ls, l)
+		}
+	}
+	return ls
+}
+
+type candidate struct {
+	ev      Eventer
+	primacy uint8
+	// program is the index of the first Program of the same program.
+	program    int
+	start, end time.Time
+	superseded bool
+}
+
+// creationOf returns the creationTime of ev, or 0 if it has none.
+func creationOf(ev Eventer) int64 {
+	if t := ev.GetCreationTime(); t != nil {
+		return t.Value()
+	}
+	return 0
+}
+
+// precedence orders a before b if a takes precedence: by lower primacy
+// value, then later creationTime, then greater mRID.
+func precedence(a, b *candidate) int {
+	return cmp.Or(
+		cmp.Compare(a.primacy, b.primacy),
+		cmp.Compare(creationOf(b.ev), creationOf(a.ev)),
+		b.ev.GetMRID().Compare(a.ev.GetMRID()))
+}
+
+// ResolveEvents resolves the overlapping events of programs, using the
+// intervals the server schedules. Cancelled events are left out. Each
+// event that loses is marked potentiallySuperseded, as of now if it was
+// not already, and each that does not is unmarked.
+func ResolveEvents(now time.Time, programs ...Program) *Resolution {
+	var cs []*candidate
+	firsts := make(map[string]int)
+	for i, p := range programs {
+		program := i
+		if p.MRID != nil {
+			if j, ok := firsts[p.MRID.canonical()]; ok {
+				program = j
+			} else {
+				firsts[p.MRID.canonical()] = i
+			}
+		}
+		for _, ev := range p.Events {
+			switch statusOf(ev) {
+			case EventCancelled, EventCancelledWithRandomization:
+				continue
+			}
+			s, e := scheduledInterval(ev)
+			cs = append(cs, &candidate{ev: ev, primacy: p.Primacy, program: program, start: s, end: e})
+		}
+	}
+	slices.SortStableFunc(cs, precedence)
+
+	r := new(Resolution)
+	var bounds []time.Time
+	for i, c := range cs {
+		for _, w := range cs[:i] {
+			if !w.superseded && w.program == c.program && w.primacy == c.primacy && w.start.Before(c.end) && c.start.Before(w.end) &&
+				controlsOverlap(w.ev, c.ev) {
+				c.superseded = true
+				r.Losses = append(r.Losses, EventLoss{c.ev, w.ev, LossSuperseded, c.start, c.end, c.primacy, w.primacy})
+				break
+			}
+		}
+		if !c.superseded && c.start.Before(c.end) {
+			bounds = append(bounds, c.start, c.end)
+		}
+	}
+	slices.SortFunc(bounds, time.Time.Compare)
+	bounds = slices.CompactFunc(bounds, time.Time.Equal)
+
+	type pair struct{ ev, by Eventer }
+	open := make(map[pair]int)
+	for k := 0; k+1 < len(bounds); k++ {
+		s, e := bounds[k], bounds[k+1]
+		var in []Eventer
+		for _, c := range cs {
+			if c.superseded || s.Before(c.start) || c.end.Before(e) {
+				continue
+			}
+			i := slices.IndexFunc(in, func(w Eventer) bool { return controlsOverlap(w, c.ev) })
+			if i < 0 {
+				in = append(in, c.ev)
+				continue
+			}
+			// Extend the loss to the previous slice if it continues it.
+			p := pair{c.ev, in[i]}
+			if j, ok := open[p]; ok && r.Losses[j].End.Equal(s) {
+				r.Losses[j].End = e
+				continue
+			}
+			open[p] = len(r.Losses)
+			r.Losses = append(r.Losses, EventLoss{c.ev, in[i], LossPrimacy, s, e, c.primacy, primacyIn(cs, in[i])})
+		}
+		if len(in) == 0 {
+			continue
+		}
+		if n := len(r.Slices); n > 0 && r.Slices[n-1].End.Equal(s) && slices.Equal(r.Slices[n-1].Events, in) {
+			r.Slices[n-1].End = e
+			continue
+		}
+		r.Slices = append(r.Slices, EventSlice{s, e, in})
+	}
+	slices.SortStableFunc(r.Losses, func(a, b EventLoss) int { return a.Start.Compare(b.Start) })
+
+	for _, c := range cs {
+		st := c.ev.GetEventStatus()
+		if st == nil {
+			continue
+		}
+		lost := slices.ContainsFunc(r.Losses, func(l EventLoss) bool { return l.Event == c.ev })
+		switch {
+		case lost && !st.PotentiallySuperseded:
+			st.PotentiallySuperseded, st.PotentiallySupersededTime = true, NewTimeTypeFromTime(now)
+		case !lost:
+			st.PotentiallySuperseded, st.PotentiallySupersededTime = false, nil
+		}
+	}
+	return r
+}
+
+// primacyIn returns the primacy of ev among cs.
+func primacyIn(cs []*candidate, ev Eventer) uint8 {
+	for _, c := range cs {
+		if c.ev == ev {
+			return c.primacy
+		}
+	}
+	return 0
+}
+
+// controlsOverlap reports whether a and b control the same thing. Events of
+// different types belong to different function sets and never do.
+func controlsOverlap(a, b Eventer) bool {
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return false
+	}
+	switch a := a.(type) {
+	case *DERControl:
+		if b, ok := b.(*DERControl); ok {
+			return categoriesOverlap(a.DeviceCategory, b.DeviceCategory) &&
+				slices.ContainsFunc(a.DERControlBase.Modes(), func(m string) bool {
+					return slices.Contains(b.DERControlBase.Modes(), m)
+				})
+		}
+	case *EndDeviceControl:
+		if b, ok := b.(*EndDeviceControl); ok {
+			return categoriesOverlap(a.DeviceCategory, b.DeviceCategory)
+		}
+	}
+	return true
+}
+
+// categoriesOverlap reports whether a and b have a device category in
+// common. An absent deviceCategory is all of them.
+func categoriesOverlap(a, b *DeviceCategoryType) bool {
+	return a == nil || b == nil || a.Bits()&b.Bits() != 0
+}
+
+// sharedControls returns the modes a and b both set, if they are
+// DERControls.
+func sharedControls(a, b Eventer) string {
+	ac, ok := a.(*DERControl)
+	bc, ok2 := b.(*DERControl)
+	if !ok || !ok2 {
+		return ""
+	}
+	var shared []string
+	for _, m := range ac.DERControlBase.Modes() {
+		if slices.Contains(bc.DERControlBase.Modes(), m) {
+			shared = append(shared, m)
+		}
+	}
+	return strings.Join(shared, ", ")
+}
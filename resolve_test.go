@@ -0,0 +1,377 @@
+package sep
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// resolveEvent describes an event of a test of ResolveEvents.
+type resolveEvent struct {
+	n          int
+	primacy    uint8
+	start, dur int64
+	created    int64
+	// kind is "der", "edc" or "tti".
+	kind     string
+	modes    []string
+	category string
+	status   EventStatusCode
+	// program puts the events with the same non-zero program in one
+	// Program; the others are each in a Program of their own.
+	program int
+}
+
+func (e resolveEvent) build() Eventer {
+	base := testControl(e.n, e.start, e.dur).RandomizableEvent
+	base.CreationTime = NewTimeTypeFromTime(sec(e.created))
+	base.EventStatus = &EventStatus{CurrentStatus: e.status}
+	var category *DeviceCategoryType
+	if e.category != "" {
+		category = NewDeviceCategoryType(e.category)
+	}
+	switch e.kind {
+	case "edc":
+		return &EndDeviceControl{RandomizableEvent: base, DeviceCategory: category}
+	case "tti":
+		return &TimeTariffInterval{RandomizableEvent: base}
+	}
+	b := new(DERControlBase)
+	for _, m := range e.modes {
+		switch m {
+		case "opModConnect":
+			b.OpModConnect = new(bool)
+		case "opModEnergize":
+			b.OpModEnergize = new(bool)
+		}
+	}
+	return &DERControl{RandomizableEvent: base, DERControlBase: b, DeviceCategory: category}
+}
+
+type wantLoss struct {
+	n, by      int
+	reason     LossReason
+	start, end int64
+}
+
+func TestResolveEvents(t *testing.T) {
+	connect := []string{"opModConnect"}
+	tests := []struct {
+		name   string
+		events []resolveEvent
+		losses []wantLoss
+		// effective maps instants to the events that apply then.
+		effective map[int64][]int
+	}{
+		{
+			name: "lower primacy value wins while both apply",
+			events: []resolveEvent{
+				{n: 1, primacy: 1, start: 0, dur: 100, modes: connect},
+				{n: 2, primacy: 0, start: 50, dur: 100, modes: connect},
+			},
+			losses:    []wantLoss{{1, 2, LossPrimacy, 50, 100}},
+			effective: map[int64][]int{10: {1}, 60: {2}, 120: {2}, 150: nil},
+		},
+		{
+			name: "later creation supersedes in the same program",
+			events: []resolveEvent{
+				{n: 1, program: 1, primacy: 1, start: 0, dur: 100, created: 2, modes: connect},
+				{n: 2, program: 1, primacy: 1, start: 50, dur: 100, created: 1, modes: connect},
+			},
+			losses:    []wantLoss{{2, 1, LossSuperseded, 50, 150}},
+			effective: map[int64][]int{10: {1}, 60: {1}, 120: nil},
+		},
+		{
+			name: "greater mRID supersedes on equal creation",
+			events: []resolveEvent{
+				{n: 1, program: 1, primacy: 1, start: 0, dur: 100, modes: connect},
+				{n: 2, program: 1, primacy: 1, start: 0, dur: 100, modes: connect},
+			},
+			losses:    []wantLoss{{1, 2, LossSuperseded, 0, 100}},
+			effective: map[int64][]int{10: {2}},
+		},
+		{
+			name: "programs sharing a primacy do not supersede",
+			events: []resolveEvent{
+				{n: 1, program: 1, primacy: 1, start: 0, dur: 100, created: 2, modes: connect},
+				{n: 2, program: 2, primacy: 1, start: 50, dur: 100, created: 1, modes: connect},
+			},
+			losses:    []wantLoss{{2, 1, LossPrimacy, 50, 100}},
+			effective: map[int64][]int{10: {1}, 60: {1}, 120: {2}},
+		},
+		{
+			name: "Programs with the same MRID are one program",
+			events: []resolveEvent{
+				{n: 1, program: 1, primacy: 1, start: 0, dur: 100, created: 2, modes: connect},
+				{n: 2, program: 1, primacy: 1, start: 50, dur: 100, created: 1, modes: connect},
+				{n: 3, program: 2, primacy: 1, start: 120, dur: 50, created: 3, modes: connect},
+			},
+			losses:    []wantLoss{{2, 1, LossSuperseded, 50, 150}},
+			effective: map[int64][]int{60: {1}, 110: nil, 130: {3}},
+		},
+		{
+			name: "partial overlap in time",
+			events: []resolveEvent{
+				{n: 1, primacy: 0, start: 0, dur: 100, modes: connect},
+				{n: 2, primacy: 1, start: 80, dur: 100, modes: connect},
+				{n: 3, primacy: 2, start: 50, dur: 200, modes: connect},
+			},
+			losses: []wantLoss{
+				{3, 1, LossPrimacy, 50, 100},
+				{2, 1, LossPrimacy, 80, 100},
+				{3, 2, LossPrimacy, 100, 180},
+			},
+			effective: map[int64][]int{40: {1}, 90: {1}, 150: {2}, 200: {3}},
+		},
+		{
+			name: "adjacent events do not overlap",
+			events: []resolveEvent{
+				{n: 1, primacy: 1, start: 0, dur: 100, created: 1, modes: connect},
+				{n: 2, primacy: 1, start: 100, dur: 100, created: 2, modes: connect},
+			},
+			effective: map[int64][]int{50: {1}, 100: {2}},
+		},
+		{
+			name: "DERControls with no mode in common",
+			events: []resolveEvent{
+				{n: 1, primacy: 1, start: 0, dur: 100, modes: connect},
+				{n: 2, primacy: 0, start: 0, dur: 100, modes: []string{"opModEnergize"}},
+			},
+			effective: map[int64][]int{50: {2, 1}},
+		},
+		{
+			name: "DERControls for different device categories",
+			events: []resolveEvent{
+				{n: 1, primacy: 1, start: 0, dur: 100, modes: connect, category: "01"},
+				{n: 2, primacy: 0, start: 0, dur: 100, modes: connect, category: "02"},
+			},
+			effective: map[int64][]int{50: {2, 1}},
+		},
+		{
+			name: "EndDeviceControls for different device categories",
+			events: []resolveEvent{
+				{n: 1, kind: "edc", primacy: 1, start: 0, dur: 100, category: "01"},
+				{n: 2, kind: "edc", primacy: 0, start: 0, dur: 100, category: "02"},
+				{n: 3, kind: "edc", primacy: 2, start: 0, dur: 100, category: "03"},
+			},
+			losses:    []wantLoss{{3, 2, LossPrimacy, 0, 100}},
+			effective: map[int64][]int{50: {2, 1}},
+		},
+		{
+			name: "EndDeviceControl without a device category",
+			events: []resolveEvent{
+				{n: 1, kind: "edc", primacy: 1, start: 0, dur: 100, category: "01"},
+				{n: 2, kind: "edc", primacy: 0, start: 0, dur: 100},
+			},
+			losses:    []wantLoss{{1, 2, LossPrimacy, 0, 100}},
+			effective: map[int64][]int{50: {2}},
+		},
+		{
+			name: "events of different function sets",
+			events: []resolveEvent{
+				{n: 1, primacy: 1, start: 0, dur: 100, created: 1, modes: connect},
+				{n: 2, kind: "tti", primacy: 1, start: 0, dur: 100, created: 2},
+				{n: 3, kind: "edc", primacy: 0, start: 0, dur: 100},
+			},
+			effective: map[int64][]int{50: {3, 2, 1}},
+		},
+		{
+			name: "cancelled events are left out",
+			events: []resolveEvent{
+				{n: 1, primacy: 0, start: 0, dur: 100, modes: connect, status: EventCancelled},
+				{n: 2, primacy: 0, start: 0, dur: 100, modes: connect, status: EventCancelledWithRandomization},
+				{n: 3, primacy: 1, start: 0, dur: 100, modes: connect},
+			},
+			effective: map[int64][]int{50: {3}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var programs []Program
+			byN := make(map[int]Eventer)
+			for _, e := range tt.events {
+				ev := e.build()
+				byN[e.n] = ev
+				p := Program{Primacy: e.primacy, Events: []Eventer{ev}}
+				if e.program != 0 {
+					p.MRID = NewMRIDType(fmt.Sprintf("%032X", 0x100+e.program))
+				}
+				programs = append(programs, p)
+			}
+			r := ResolveEvents(sec(0), programs...)
+			if len(r.Losses) != len(tt.losses) {
+				t.Fatalf("got losses %v, want %v", r.Losses, tt.losses)
+			}
+			for i, w := range tt.losses {
+				l := r.Losses[i]
+				if l.Event != byN[w.n] || l.By != byN[w.by] || l.Reason != w.reason ||
+					!l.Start.Equal(sec(w.start)) || !l.End.Equal(sec(w.end)) {
+					t.Errorf("loss %d = %v, want %v", i, l, w)
+				}
+			}
+			for s, want := range tt.effective {
+				got := r.Effective(sec(s))
+				if len(got) != len(want) {
+					t.Errorf("Effective(%d) = %d events, want %v", s, len(got), want)
+					continue
+				}
+				for i, n := range want {
+					if got[i] != byN[n] {
+						t.Errorf("Effective(%d)[%d] = %s, want %032X", s, i, got[i].GetMRID().canonical(), n)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestEventLossString(t *testing.T) {
+	connect := []string{"opModConnect"}
+	for _, tt := range []struct {
+		name   string
+		events []resolveEvent
+		want   string
+	}{
+		{
+			name: "lower primacy value",
+			events: []resolveEvent{
+				{n: 1, primacy: 1, start: 0, dur: 100, modes: connect},
+				{n: 2, primacy: 0, start: 50, dur: 100, modes: connect},
+			},
+			want: fmt.Sprintf("event %032X yields to event %032X from %d to %d: "+
+				"the program of that event has the lower primacy value, 0 against 1, and so takes precedence (both set opModConnect)",
+				1, 2, sec(50).Unix(), sec(100).Unix()),
+		},
+		{
+			name: "programs sharing a primacy",
+			events: []resolveEvent{
+				{n: 1, primacy: 1, start: 0, dur: 100, created: 1, kind: "edc"},
+				{n: 2, primacy: 1, start: 0, dur: 100, created: 2, kind: "edc"},
+			},
+			want: fmt.Sprintf("event %032X yields to event %032X from %d to %d: "+
+				"their programs share primacy 1 and the latter was created later, at %d rather than %d",
+				1, 2, sec(0).Unix(), sec(100).Unix(), sec(2).Unix(), sec(1).Unix()),
+		},
+		{
+			name: "superseded by a later event",
+			events: []resolveEvent{
+				{n: 1, program: 1, primacy: 1, start: 0, dur: 100, created: 1, modes: connect},
+				{n: 2, program: 1, primacy: 1, start: 0, dur: 100, created: 2, modes: connect},
+			},
+			want: fmt.Sprintf("event %032X is superseded by event %032X: both are of the same program and "+
+				"the latter was created later, at %d rather than %d (both set opModConnect)",
+				1, 2, sec(2).Unix(), sec(1).Unix()),
+		},
+		{
+			name: "superseded by a greater mRID",
+			events: []resolveEvent{
+				{n: 1, program: 1, primacy: 1, start: 0, dur: 100, kind: "tti"},
+				{n: 2, program: 1, primacy: 1, start: 0, dur: 100, kind: "tti"},
+			},
+			want: fmt.Sprintf("event %032X is superseded by event %032X: both are of the same program and "+
+				"were created at %d, and the latter has the greater mRID",
+				1, 2, sec(0).Unix()),
+		},
+	} {
+		var programs []Program
+		var first Eventer
+		for _, e := range tt.events {
+			ev := e.build()
+			if first == nil {
+				first = ev
+			}
+			p := Program{Primacy: e.primacy, Events: []Eventer{ev}}
+			if e.program != 0 {
+				p.MRID = NewMRIDType(fmt.Sprintf("%032X", 0x100+e.program))
+			}
+			programs = append(programs, p)
+		}
+		ls := ResolveEvents(sec(0), programs...).Lost(first)
+		if len(ls) != 1 {
+			t.Errorf("%s: Lost = %v, want one loss", tt.name, ls)
+			continue
+		}
+		if got := ls[0].String(); got != tt.want {
+			t.Errorf("%s: String() =\n%s\nwant\n%s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestProgramEvents(t *testing.T) {
+	mrid := NewMRIDType(fmt.Sprintf("%032X", 0x100))
+	der := NewDERProgram()
+	der.MRID, der.Primacy = mrid, NewPrimacyType(2)
+	dr := NewDemandResponseProgram()
+	dr.MRID, dr.Primacy = mrid, NewPrimacyType(3)
+	tp := NewTariffProfile()
+	tp.MRID, tp.Primacy = mrid, NewPrimacyType(4)
+	c := testControl(1, 0, 100)
+	edc := &EndDeviceControl{RandomizableEvent: c.RandomizableEvent}
+	tti := &TimeTariffInterval{RandomizableEvent: c.RandomizableEvent}
+
+	for _, tt := range []struct {
+		name    string
+		p       Program
+		primacy uint8
+		events  []Eventer
+	}{
+		{"DERProgramEvents", DERProgramEvents(der, c, c), 2, []Eventer{c, c}},
+		{"DemandResponseProgramEvents", DemandResponseProgramEvents(dr, edc), 3, []Eventer{edc}},
+		{"TariffProfileEvents", TariffProfileEvents(tp, tti), 4, []Eventer{tti}},
+		{"no events", DERProgramEvents(der), 2, nil},
+		{"no primacy", DERProgramEvents(NewDERProgram(), c), 0, []Eventer{c}},
+	} {
+		if tt.p.Primacy != tt.primacy || !slices.Equal(tt.p.Events, tt.events) {
+			t.Errorf("%s: primacy %d, events %v, want %d, %v", tt.name, tt.p.Primacy, tt.p.Events, tt.primacy, tt.events)
+		}
+		if tt.name != "no primacy" && !tt.p.MRID.Equal(mrid) {
+			t.Errorf("%s: MRID %v", tt.name, tt.p.MRID)
+		}
+	}
+	if p := DERProgramEvents(NewDERProgram()); p.MRID != nil {
+		t.Errorf("MRID of a program without one = %v", p.MRID)
+	}
+}
+
+func TestPrimacyOf(t *testing.T) {
+	for _, tt := range []struct {
+		p    *PrimacyType
+		want uint8
+	}{
+		{nil, 0},
+		{NewPrimacyType(0), 0},
+		{NewPrimacyType(7), 7},
+		{NewPrimacyType(255), 255},
+	} {
+		if got := primacyOf(tt.p); got != tt.want {
+			t.Errorf("primacyOf(%v) = %d, want %d", tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestResolveEventsPotentiallySuperseded(t *testing.T) {
+	loser := resolveEvent{n: 1, primacy: 1, start: 0, dur: 100, modes: []string{"opModConnect"}}.build()
+	winner := resolveEvent{n: 2, primacy: 0, start: 50, dur: 100, modes: []string{"opModConnect"}}.build()
+	lp := Program{Primacy: 1, Events: []Eventer{loser}}
+	wp := Program{Primacy: 0, Events: []Eventer{winner}}
+
+	ResolveEvents(sec(10), lp, wp)
+	st := loser.GetEventStatus()
+	if !st.PotentiallySuperseded || st.PotentiallySupersededTime == nil || st.PotentiallySupersededTime.Value() != sec(10).Unix() {
+		t.Fatalf("after losing: potentiallySuperseded %v at %v, want true at 10", st.PotentiallySuperseded, st.PotentiallySupersededTime)
+	}
+	if winner.GetEventStatus().PotentiallySuperseded {
+		t.Error("the winner is marked potentiallySuperseded")
+	}
+
+	// A loss that continues keeps the time it was first marked.
+	ResolveEvents(sec(20), lp, wp)
+	if st.PotentiallySupersededTime.Value() != sec(10).Unix() {
+		t.Errorf("potentiallySupersededTime moved to %d", st.PotentiallySupersededTime.Value()-t0.Unix())
+	}
+
+	ResolveEvents(sec(30), lp)
+	if st.PotentiallySuperseded || st.PotentiallySupersededTime != nil {
+		t.Errorf("after the winner is gone: potentiallySuperseded %v at %v, want false", st.PotentiallySuperseded, st.PotentiallySupersededTime)
+	}
+}
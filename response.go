@@ -0,0 +1,146 @@
+package sep
+
+import "time"
+
+// Respondable is implemented by a pointer to every type derived from
+// RespondableResource that has an mRID, such as *DERControl,
+// *EndDeviceControl, *TimeTariffInterval and *TextMessage.
+type Respondable interface {
+	GetMRID() *MRIDType
+	GetReplyTo() string
+	GetResponseRequired() string
+}
+
+// PendingResponse is a response a device is to post to the replyTo of the
+// resource it responds to.
+type PendingResponse struct {
+	ReplyTo  string
+	Subject  Respondable
+	Response Responder
+}
+
+// EventResponder builds the responses a device sends to the resources it
+// receives, as their responseRequired bits ask. It sends each status at
+// most once for each subject. It is not safe for concurrent use.
+type EventResponder struct {
+	lfdi string
+	sent map[string]map[ResponseStatus]bool
+}
+
+// NewEventResponder returns an EventResponder for the device whose LFDI is
+// lfdi, as LFDI returns it.
+func NewEventResponder(lfdi string) *EventResponder {
+	return &EventResponder{lfdi: lfdi, sent: make(map[string]map[ResponseStatus]bool)}
+}
+
+// requirementOf returns the responseRequired bit that asks for status: the
+// user response for opting in or out and acknowledging, the receipt for
+// EventReceived, and the specific response for any other.
+func requirementOf(status ResponseStatus) ResponseRequirement {
+	switch status {
+	case ResponseEventReceived:
+		return ResponseReceipt
+	case ResponseEventOptOut, ResponseEventOptIn, ResponseEventPartialOptOut, ResponseEventPartialOptIn,
+		ResponseUserAcknowledged:
+		return ResponseUser
+	}
+	return ResponseSpecific
+}
+
+// Respond returns the response of status to subject at at, or nil if the
+// responseRequired bits of subject do not ask for it, subject has no
+// replyTo or valid mRID, or r already sent status for it. The response is
+// of the type that answers subject: a DERControlResponse, whose modes
+// responded the caller may fill in from AdmitDERControl, for a DERControl;
+// a DrResponse for an EndDeviceControl; a PriceResponse for a
+// TimeTariffInterval; a TextResponse for a TextMessage; and a Response for
+// any other. Rejections, EventNotApplicable, EventInvalid and EventExpired,
+// are sent if any response is asked for.
+func (r *EventResponder) Respond(subject Respondable, status ResponseStatus, at time.Time) *PendingResponse {
+	m := subject.GetMRID()
+	if subject.GetReplyTo() == "" || !m.Valid() {
+		return nil
+	}
+	req, _ := ParseResponseRequirements(subject.GetResponseRequired())
+	switch status {
+	case ResponseEventNotApplicable, ResponseEventInvalid, ResponseEventExpired:
+		if req == 0 {
+			return nil
+		}
+	default:
+		if !req.Has(requirementOf(status)) {
+			return nil
+		}
+	}
+	sent := r.sent[m.canonical()]
+	if sent == nil {
+		sent = make(map[ResponseStatus]bool)
+		r.sent[m.canonical()] = sent
+	}
+	if sent[status] {
+		return nil
+	}
+	sent[status] = true
+
+	base := NewResponse()
+	base.CreatedDateTime = NewTimeTypeFromTime(at)
+	base.EndDeviceLFDI = r.lfdi
+	base.Status = &status
+	base.Subject = NewMRIDType(m.canonical())
+	var resp Responder = base
+	switch subject.(type) {
+	case *DERControl:
+		resp = &DERControlResponse{Response: base}
+	case *EndDeviceControl:
+		resp = &DrResponse{Response: base}
+	case *TimeTariffInterval:
+		resp = &PriceResponse{Response: base}
+	case *TextMessage:
+		resp = &TextResponse{Response: base}
+	}
+	return &PendingResponse{ReplyTo: subject.GetReplyTo(), Subject: subject, Response: resp}
+}
+
+// Transitions returns the responses to the event transitions ts, as an
+// EventEngine reports them, in order. An event first seen gets
+// EventReceived, or EventExpired if it has already ended; one that starts
+// gets EventStarted, and one that ends EventCompleted, EventCancelled or
+// EventSuperseded. Events that are not Respondable are skipped.
+func (r *EventResponder) Transitions(ts []EventTransition) []*PendingResponse {
+	var ps []*PendingResponse
+	respond := func(ev Respondable, status ResponseStatus, at time.Time) {
+		if p := r.Respond(ev, status, at); p != nil {
+			ps = append(ps, p)
+		}
+	}
+	for _, t := range ts {
+		ev, ok := t.Event.(Respondable)
+		if !ok {
+			continue
+		}
+		if t.From == EventStateNone {
+			if t.To == EventStateCompleted {
+				respond(ev, ResponseEventExpired, t.At)
+				continue
+			}
+			respond(ev, ResponseEventReceived, t.At)
+		}
+		switch t.To {
+		case EventStateActive:
+			respond(ev, ResponseEventStarted, t.At)
+		case EventStateCompleted:
+			respond(ev, ResponseEventCompleted, t.At)
+		case EventStateCancelled:
+			respond(ev, ResponseEventCancelled, t.At)
+		case EventStateSuperseded:
+			respond(ev, ResponseEventSuperseded, t.At)
+		}
+	}
+	return ps
+}
+
+// Forget drops what r sent for the subject m, as when the server deletes
+// it.
+func (r *EventResponder) Forget(m *MRIDType) {
+	delete(r.sent, m.canonical())
+}
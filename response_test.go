@@ -0,0 +1,170 @@
+package sep
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+const testLFDI = "3E4F45AB31EDFE5B67E343E5E4562E31984E23E5"
+
+// respondableControl returns testControl(n, 0, 100) with a replyTo and the
+// responseRequired bits required.
+func respondableControl(n int, required string) *DERControl {
+	c := testControl(n, 0, 100)
+	c.ReplyToAttr, c.ResponseRequiredAttr = "/rsps/1/rsp", required
+	return c
+}
+
+func TestEventResponderRespond(t *testing.T) {
+	ctl := respondableControl(0xA, "03")
+	r := NewEventResponder(testLFDI)
+	p := r.Respond(ctl, ResponseEventReceived, t0)
+	if p == nil {
+		t.Fatal("no response")
+	}
+	resp, ok := p.Response.(*DERControlResponse)
+	if !ok {
+		t.Fatalf("response to a DERControl is %T", p.Response)
+	}
+	if p.ReplyTo != "/rsps/1/rsp" || p.Subject != ctl || resp.EndDeviceLFDI != testLFDI || *resp.Status != ResponseEventReceived ||
+		resp.CreatedDateTime.Value() != t0.Unix() || !resp.Subject.Equal(ctl.MRID) {
+		t.Errorf("response %+v to %s", resp.Response, p.ReplyTo)
+	}
+	if r.Respond(ctl, ResponseEventReceived, t0.Add(time.Second)) != nil {
+		t.Error("a status was sent twice")
+	}
+	if r.Respond(ctl, ResponseEventStarted, t0) == nil {
+		t.Error("a second status was not sent")
+	}
+
+	// The same subject in another case is the same subject.
+	lower := respondableControl(0xA, "03")
+	lower.MRID = NewMRIDType("0000000000000000000000000000000a")
+	if r.Respond(lower, ResponseEventReceived, t0) != nil {
+		t.Error("a status was sent twice to an mRID in another case")
+	}
+	r.Forget(ctl.MRID)
+	if r.Respond(ctl, ResponseEventReceived, t0) == nil {
+		t.Error("a status was not sent again after Forget")
+	}
+}
+
+func TestEventResponderTypes(t *testing.T) {
+	edc := NewEndDeviceControl()
+	tti := NewTimeTariffInterval()
+	msg := NewTextMessage()
+	frr := NewFlowReservationResponse()
+	for i, s := range []struct {
+		res  *RespondableResource
+		mrid **MRIDType
+	}{
+		{edc.RespondableResource, &edc.MRID},
+		{tti.RespondableResource, &tti.MRID},
+		{msg.RespondableResource, &msg.MRID},
+		{frr.RespondableResource, &frr.MRID},
+	} {
+		s.res.ReplyToAttr, s.res.ResponseRequiredAttr = "/rsps/1/rsp", "01"
+		*s.mrid = NewMRIDType(fmt.Sprintf("%032X", i+1))
+	}
+
+	r := NewEventResponder(testLFDI)
+	check := func(subject Respondable, want func(Responder) bool, name string) {
+		t.Helper()
+		p := r.Respond(subject, ResponseEventReceived, t0)
+		if p == nil || !want(p.Response) {
+			t.Errorf("response to %T is not a %s: %+v", subject, name, p)
+		}
+	}
+	check(edc, func(r Responder) bool { _, ok := r.(*DrResponse); return ok }, "DrResponse")
+	check(tti, func(r Responder) bool { _, ok := r.(*PriceResponse); return ok }, "PriceResponse")
+	check(msg, func(r Responder) bool { _, ok := r.(*TextResponse); return ok }, "TextResponse")
+	check(frr, func(r Responder) bool { _, ok := r.(*Response); return ok }, "Response")
+}
+
+func TestEventResponderRequired(t *testing.T) {
+	for _, tt := range []struct {
+		required string
+		status   ResponseStatus
+		want     bool
+	}{
+		{"01", ResponseEventReceived, true},
+		{"02", ResponseEventReceived, false},
+		{"02", ResponseEventStarted, true},
+		{"02", ResponseEventCompleted, true},
+		{"01", ResponseEventCompleted, false},
+		{"04", ResponseEventOptOut, true},
+		{"04", ResponseUserAcknowledged, true},
+		{"02", ResponseEventOptIn, false},
+		// Rejections are sent if any response is asked for.
+		{"01", ResponseEventInvalid, true},
+		{"04", ResponseEventExpired, true},
+		{"02", ResponseEventNotApplicable, true},
+		{"00", ResponseEventInvalid, false},
+		{"", ResponseEventNotApplicable, false},
+		{"", ResponseEventReceived, false},
+		{"zz", ResponseEventReceived, false},
+	} {
+		p := NewEventResponder(testLFDI).Respond(respondableControl(1, tt.required), tt.status, t0)
+		if got := p != nil; got != tt.want {
+			t.Errorf("responseRequired %q, %s: response %v, want %v", tt.required, tt.status, got, tt.want)
+		}
+	}
+
+	noReply := respondableControl(1, "07")
+	noReply.ReplyToAttr = ""
+	noMRID := respondableControl(2, "07")
+	noMRID.MRID = nil
+	for _, c := range []*DERControl{noReply, noMRID} {
+		if NewEventResponder(testLFDI).Respond(c, ResponseEventReceived, t0) != nil {
+			t.Errorf("a response to a DERControl without replyTo or mRID")
+		}
+	}
+}
+
+func TestEventResponderTransitions(t *testing.T) {
+	a, b, c := respondableControl(1, "03"), respondableControl(2, "03"), respondableControl(3, "03")
+	d := respondableControl(4, "01")
+	r := NewEventResponder(testLFDI)
+	ps := r.Transitions([]EventTransition{
+		{Event: a, From: EventStateNone, To: EventStateScheduled, At: sec(0)},
+		{Event: b, From: EventStateNone, To: EventStateActive, At: sec(0)},
+		{Event: c, From: EventStateNone, To: EventStateCompleted, At: sec(0)},
+		{Event: d, From: EventStateNone, To: EventStateActive, At: sec(0)},
+		{Event: a, From: EventStateScheduled, To: EventStateActive, At: sec(10)},
+		{Event: b, From: EventStateActive, To: EventStateSuperseded, At: sec(10)},
+		{Event: a, From: EventStateActive, To: EventStateCancelled, At: sec(20)},
+		{Event: d, From: EventStateActive, To: EventStateCompleted, At: sec(100)},
+	})
+	want := []struct {
+		subject *DERControl
+		status  ResponseStatus
+		at      int64
+	}{
+		{a, ResponseEventReceived, 0},
+		{b, ResponseEventReceived, 0},
+		{b, ResponseEventStarted, 0},
+		{c, ResponseEventExpired, 0},
+		// d asks only for receipts.
+		{d, ResponseEventReceived, 0},
+		{a, ResponseEventStarted, 10},
+		{b, ResponseEventSuperseded, 10},
+		{a, ResponseEventCancelled, 20},
+	}
+	if len(ps) != len(want) {
+		t.Fatalf("%d responses, want %d", len(ps), len(want))
+	}
+	for i, w := range want {
+		p := ps[i]
+		resp := p.Response.(*DERControlResponse)
+		if p.Subject != w.subject || *resp.Status != w.status || resp.CreatedDateTime.Value() != sec(w.at).Unix() {
+			t.Errorf("response %d: %s %s at %d, want %s %s at %d", i, resp.Subject.Value(), resp.Status,
+				resp.CreatedDateTime.Value()-t0.Unix(), w.subject.MRID.Value(), w.status, w.at)
+		}
+	}
+
+	// A completed event seen again is not answered again.
+	if ps := r.Transitions([]EventTransition{{Event: c, From: EventStateNone, To: EventStateCompleted, At: sec(200)}}); len(ps) != 0 {
+		t.Errorf("responses %v to an event already answered", ps)
+	}
+}
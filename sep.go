@@ -1,5 +1,3 @@
-// Code generated by xgen. DO NOT EDIT.
-
 package sep
 
 import (
@@ -8,16 +6,19 @@ import (
 
 // DeviceCapability is Returned by the URI provided by DNS-SD, to allow clients to find the URIs to the resources in which they are interested.
 type DeviceCapability struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DeviceCapability"`
+	*FunctionSetAssignmentsBase
 	PollRateAttr             uint32                    `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr            SEPVersion                `xml:"schemaVer,attr,omitempty"`
 	EndDeviceListLink        *EndDeviceListLink        `xml:"EndDeviceListLink"`
 	MirrorUsagePointListLink *MirrorUsagePointListLink `xml:"MirrorUsagePointListLink"`
 	SelfDeviceLink           *SelfDeviceLink           `xml:"SelfDeviceLink"`
 	DeviceCapabilityr23      *Revision23Type           `xml:"DeviceCapability_r2_3"`
-	*FunctionSetAssignmentsBase
 }
 
 // AbstractDevice is Short form of device identifier, WITH the checksum digit. See the Security section for additional details.
 type AbstractDevice struct {
+	*SubscribableResource
 	AggregatedDeviceListLink     *AggregatedDeviceListLink     `xml:"AggregatedDeviceListLink"`
 	AggregationPriorityLink      *AggregationPriorityLink      `xml:"AggregationPriorityLink"`
 	ConfigurationLink            *ConfigurationLink            `xml:"ConfigurationLink"`
@@ -28,73 +29,82 @@ type AbstractDevice struct {
 	Distribution                 *AggregationDistributionType  `xml:"distribution"`
 	FileStatusLink               *FileStatusLink               `xml:"FileStatusLink"`
 	IPInterfaceListLink          *IPInterfaceListLink          `xml:"IPInterfaceListLink"`
-	LFDI                         string                        `xml:"lFDI"`
+	LFDI                         *string                       `xml:"lFDI"`
 	LoadShedAvailabilityListLink *LoadShedAvailabilityListLink `xml:"LoadShedAvailabilityListLink"`
 	LogEventListLink             *LogEventListLink             `xml:"LogEventListLink"`
 	Phase                        *PhaseCode                    `xml:"phase"`
 	PowerStatusLink              *PowerStatusLink              `xml:"PowerStatusLink"`
 	SFDI                         *SFDIType                     `xml:"sFDI"`
 	AbstractDevicer23            *Revision23Type               `xml:"AbstractDevice_r2_3"`
-	*SubscribableResource
 }
 
 // DeviceStatus is Total time device has operated: re-settable: Accumulated time in seconds since the last time the counter was reset.
 type DeviceStatus struct {
-	PollRateAttr    uint32          `xml:"pollRate,attr,omitempty"`
-	ChangedTime     *TimeType       `xml:"changedTime"`
-	OnCount         uint16          `xml:"onCount"`
-	OpState         uint8           `xml:"opState"`
-	OpTime          uint32          `xml:"opTime"`
-	Temperature     []*Temperature  `xml:"Temperature"`
-	TimeLink        *TimeLink       `xml:"TimeLink"`
-	DeviceStatusr23 *Revision23Type `xml:"DeviceStatus_r2_3"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DeviceStatus"`
 	*Resource
+	PollRateAttr    uint32            `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr   SEPVersion        `xml:"schemaVer,attr,omitempty"`
+	ChangedTime     *TimeType         `xml:"changedTime"`
+	OnCount         *uint16           `xml:"onCount"`
+	OpState         *OperationalState `xml:"opState"`
+	OpTime          *uint32           `xml:"opTime"`
+	Temperature     []*Temperature    `xml:"Temperature"`
+	TimeLink        *TimeLink         `xml:"TimeLink"`
+	DeviceStatusr23 *Revision23Type   `xml:"DeviceStatus_r2_3"`
 }
 
 // EndDeviceList is A List element to hold EndDevice objects.
 type EndDeviceList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns EndDeviceList"`
+	*SubscribableList
 	PollRateAttr     uint32          `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr    SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	EndDevice        []*EndDevice    `xml:"EndDevice"`
 	EndDeviceListr23 *Revision23Type `xml:"EndDeviceList_r2_3"`
-	*SubscribableList
 }
 
 // EndDevice is Asset container that performs one or more end device functions. Contains information about individual devices in the network.
 type EndDevice struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns EndDevice"`
+	*ExternalDevice
+	SchemaVerAttr         SEPVersion             `xml:"schemaVer,attr,omitempty"`
 	ProxiedDeviceListLink *ProxiedDeviceListLink `xml:"ProxiedDeviceListLink"`
 	SubscriptionListLink  *SubscriptionListLink  `xml:"SubscriptionListLink"`
 	EndDevicer23          *Revision23Type        `xml:"EndDevice_r2_3"`
-	*ExternalDevice
 }
 
 // ExternalDevice is POST rate, or how often EndDevice and subordinate resources should be POSTed, in seconds. A client MAY indicate a preferred postRate when POSTing EndDevice. A server MAY add or modify postRate to indicate its preferred posting rate. If not specified, a default of 900 seconds (15 minutes) is used.
 type ExternalDevice struct {
+	*AbstractDevice
 	ChangedTime                     *TimeType                        `xml:"changedTime"`
-	Enabled                         bool                             `xml:"enabled"`
+	Enabled                         *bool                            `xml:"enabled"`
 	FlowReservationRequestListLink  *FlowReservationRequestListLink  `xml:"FlowReservationRequestListLink"`
 	FlowReservationResponseListLink *FlowReservationResponseListLink `xml:"FlowReservationResponseListLink"`
 	FunctionSetAssignmentsListLink  *FunctionSetAssignmentsListLink  `xml:"FunctionSetAssignmentsListLink"`
-	PostRate                        uint32                           `xml:"postRate"`
+	PostRate                        *uint32                          `xml:"postRate"`
 	RegistrationLink                *RegistrationLink                `xml:"RegistrationLink"`
 	ExternalDevicer23               *Revision23Type                  `xml:"ExternalDevice_r2_3"`
-	*AbstractDevice
 }
 
 // Registration is Contains the registration PIN number associated with the device, including the checksum digit.
 type Registration struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns Registration"`
+	*Resource
 	PollRateAttr       uint32          `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr      SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	DateTimeRegistered *TimeType       `xml:"dateTimeRegistered"`
 	PIN                *PINType        `xml:"pIN"`
 	Registrationr23    *Revision23Type `xml:"Registration_r2_3"`
-	*Resource
 }
 
 // SelfDevice is Asset container for the host serving the resources available within DeviceCapability. Contains information about the given host device/entity.
 type SelfDevice struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns SelfDevice"`
+	*AbstractDevice
 	PollRateAttr          uint32                 `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr         SEPVersion             `xml:"schemaVer,attr,omitempty"`
 	ProxiedDeviceListLink *ProxiedDeviceListLink `xml:"ProxiedDeviceListLink"`
 	SelfDevicer23         *Revision23Type        `xml:"SelfDevice_r2_3"`
-	*AbstractDevice
 }
 
 // Temperature is Value in Degrees Celsius (uom 23).
@@ -103,10 +113,13 @@ type Temperature struct {
 	Subject        uint8                     `xml:"subject"`
 	Value          int16                     `xml:"value"`
 	Temperaturer23 *Revision23Type           `xml:"Temperature_r2_3"`
+	AnyAttr        []Attr                    `xml:",any,attr"`
+	Any            []*AnyElement             `xml:",any"`
 }
 
 // FunctionSetAssignmentsBase is Defines a collection of function set instances that are to be used by one or more devices as indicated by the EndDevice object(s) of the server.
 type FunctionSetAssignmentsBase struct {
+	*Resource
 	CustomerAccountListLink       *CustomerAccountListLink       `xml:"CustomerAccountListLink"`
 	DemandResponseProgramListLink *DemandResponseProgramListLink `xml:"DemandResponseProgramListLink"`
 	DERProgramListLink            *DERProgramListLink            `xml:"DERProgramListLink"`
@@ -118,25 +131,28 @@ type FunctionSetAssignmentsBase struct {
 	TimeLink                      *TimeLink                      `xml:"TimeLink"`
 	UsagePointListLink            *UsagePointListLink            `xml:"UsagePointListLink"`
 	FunctionSetAssignmentsBaser23 *Revision23Type                `xml:"FunctionSetAssignmentsBase_r2_3"`
-	*Resource
 }
 
 // FunctionSetAssignments is Contains the version number of the object. See the type definition for details.
 type FunctionSetAssignments struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns FunctionSetAssignments"`
+	*FunctionSetAssignmentsBase
+	SchemaVerAttr             SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	SubscribableAttr          *UInt8          `xml:"subscribable,attr,omitempty"`
 	MRID                      *MRIDType       `xml:"mRID"`
-	Description               string          `xml:"description"`
+	Description               *string         `xml:"description"`
 	Version                   *VersionType    `xml:"version"`
 	FunctionSetAssignmentsr23 *Revision23Type `xml:"FunctionSetAssignments_r2_3"`
-	*FunctionSetAssignmentsBase
 }
 
 // FunctionSetAssignmentsList is A List element to hold FunctionSetAssignments objects.
 type FunctionSetAssignmentsList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns FunctionSetAssignmentsList"`
+	*SubscribableList
 	PollRateAttr                  uint32                    `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr                 SEPVersion                `xml:"schemaVer,attr,omitempty"`
 	FunctionSetAssignments        []*FunctionSetAssignments `xml:"FunctionSetAssignments"`
 	FunctionSetAssignmentsListr23 *Revision23Type           `xml:"FunctionSetAssignmentsList_r2_3"`
-	*SubscribableList
 }
 
 // Condition is The value of the upper threshold
@@ -145,111 +161,135 @@ type Condition struct {
 	LowerThreshold      int64           `xml:"lowerThreshold"`
 	UpperThreshold      int64           `xml:"upperThreshold"`
 	Conditionr23        *Revision23Type `xml:"Condition_r2_3"`
+	AnyAttr             []Attr          `xml:",any,attr"`
+	Any                 []*AnyElement   `xml:",any"`
 }
 
 // SubscriptionBase is The resource for which the subscription applies. Query string parameters SHALL NOT be specified when subscribing to list resources.  Should a query string parameter be specified, servers SHALL ignore them.
 type SubscriptionBase struct {
+	*Resource
 	SubscribedResource  string          `xml:"subscribedResource"`
 	SubscriptionBaser23 *Revision23Type `xml:"SubscriptionBase_r2_3"`
-	*Resource
 }
 
 // Subscription is The resource to which to post the notifications about the requested subscribed resource. Because this URI will exist on a server other than the one being POSTed to, this attribute SHALL be a fully-qualified absolute URI, not a relative reference.
 type Subscription struct {
-	Condition       *Condition      `xml:"Condition"`
-	Encoding        uint8           `xml:"encoding"`
-	Level           string          `xml:"level"`
-	Limit           uint32          `xml:"limit"`
-	NotificationURI string          `xml:"notificationURI"`
-	Subscriptionr23 *Revision23Type `xml:"Subscription_r2_3"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns Subscription"`
 	*SubscriptionBase
+	SchemaVerAttr   SEPVersion           `xml:"schemaVer,attr,omitempty"`
+	Condition       *Condition           `xml:"Condition"`
+	Encoding        SubscriptionEncoding `xml:"encoding"`
+	Level           string               `xml:"level"`
+	Limit           uint32               `xml:"limit"`
+	NotificationURI string               `xml:"notificationURI"`
+	Subscriptionr23 *Revision23Type      `xml:"Subscription_r2_3"`
 }
 
 // SubscriptionList is A List element to hold Subscription objects.
 type SubscriptionList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns SubscriptionList"`
+	*List
 	PollRateAttr        uint32          `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr       SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	Subscription        []*Subscription `xml:"Subscription"`
 	SubscriptionListr23 *Revision23Type `xml:"SubscriptionList_r2_3"`
-	*List
 }
 
 // Notification is The subscription from which this notification was triggered. This attribute SHALL be a fully-qualified absolute URI, not a relative reference.
 type Notification struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns Notification"`
+	*SubscriptionBase
+	SchemaVerAttr   SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	CreatedDateTime *TimeType       `xml:"createdDateTime"`
-	NewResourceURI  string          `xml:"newResourceURI"`
-	Resource        *Resource       `xml:"Resource"`
+	NewResourceURI  *string         `xml:"newResourceURI"`
+	Resource        Resourcer       `xml:"Resource"`
 	Status          uint8           `xml:"status"`
 	SubscriptionURI string          `xml:"subscriptionURI"`
 	Notificationr23 *Revision23Type `xml:"Notification_r2_3"`
-	*SubscriptionBase
 }
 
 // NotificationList is A List element to hold Notification objects.
 type NotificationList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns NotificationList"`
+	*List
+	SchemaVerAttr       SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	Notification        []*Notification `xml:"Notification"`
 	NotificationListr23 *Revision23Type `xml:"NotificationList_r2_3"`
-	*List
 }
 
 // ResponseSetList is A List element to hold ResponseSet objects.
 type ResponseSetList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns ResponseSetList"`
+	*List
 	PollRateAttr       uint32          `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr      SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	ResponseSet        []*ResponseSet  `xml:"ResponseSet"`
 	ResponseSetListr23 *Revision23Type `xml:"ResponseSetList_r2_3"`
-	*List
 }
 
 // ResponseSet is A container for a ResponseList.
 type ResponseSet struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns ResponseSet"`
+	*IdentifiedObject
+	SchemaVerAttr    SEPVersion        `xml:"schemaVer,attr,omitempty"`
 	ResponseListLink *ResponseListLink `xml:"ResponseListLink"`
 	ResponseSetr23   *Revision23Type   `xml:"ResponseSet_r2_3"`
-	*IdentifiedObject
 }
 
 // ResponseList is A List element to hold Response objects.
 type ResponseList struct {
-	Response        []*Response     `xml:"Response"`
-	ResponseListr23 *Revision23Type `xml:"ResponseList_r2_3"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns ResponseList"`
 	*List
+	SchemaVerAttr   SEPVersion      `xml:"schemaVer,attr,omitempty"`
+	Response        []Responder     `xml:"Response"`
+	ResponseListr23 *Revision23Type `xml:"ResponseList_r2_3"`
 }
 
 // Response is The subject field provides a method to match the response with the originating event. It is populated with the mRID of the original object.
 type Response struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns Response"`
+	*Resource
+	SchemaVerAttr   SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	CreatedDateTime *TimeType       `xml:"createdDateTime"`
 	EndDeviceLFDI   string          `xml:"endDeviceLFDI"`
-	Status          uint8           `xml:"status"`
+	Status          *ResponseStatus `xml:"status"`
 	Subject         *MRIDType       `xml:"subject"`
 	Responser23     *Revision23Type `xml:"Response_r2_3"`
-	*Resource
 }
 
 // DefaultDERControlResponse is Indicates additional individual DERControl Modes for which the DefaultDERControlResponse applies.
 type DefaultDERControlResponse struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DefaultDERControlResponse"`
+	*Response
+	SchemaVerAttr                SEPVersion             `xml:"schemaVer,attr,omitempty"`
 	DefaultsResponded            *DefaultDERControlType `xml:"defaultsResponded"`
 	ModesResponded               *DERControlType        `xml:"modesResponded"`
 	ModesResponded2              *DERControlType2       `xml:"modesResponded2"`
 	DefaultDERControlResponser23 *Revision23Type        `xml:"DefaultDERControlResponse_r2_3"`
-	*Response
 }
 
 // DERControlResponse is Indicates additional individual DERControl Modes for which the DERControlResponse applies. It should be noted that in previous revisions of IEEE 2030.5 this field was not defined. When the field is not present, the additional individual DERControl Modes for which the DERControlResponse applies is none (as none of those DERControl Modes existed in previous revisions of IEEE 2030.5).
 type DERControlResponse struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DERControlResponse"`
+	*Response
+	SchemaVerAttr         SEPVersion       `xml:"schemaVer,attr,omitempty"`
 	ModesResponded        *DERControlType  `xml:"modesResponded"`
 	ModesResponded2       *DERControlType2 `xml:"modesResponded2"`
 	DERControlResponser23 *Revision23Type  `xml:"DERControlResponse_r2_3"`
-	*Response
 }
 
 // DrResponse is Indicates the amount of time, in seconds, that the client partially opts-out during the demand response event. When overriding within the allowed override duration, the client SHALL send a partial opt-out (Response status code 8) for partial opt-out upon completion, with the total time the event was overridden (this attribute) populated. The client SHALL send a no participation status response (status type 10) if the user partially opts-out for longer than EndDeviceControl.overrideDuration.
 type DrResponse struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DrResponse"`
+	*Response
+	SchemaVerAttr          SEPVersion              `xml:"schemaVer,attr,omitempty"`
 	ApplianceLoadReduction *ApplianceLoadReduction `xml:"ApplianceLoadReduction"`
 	AppliedTargetReduction *AppliedTargetReduction `xml:"AppliedTargetReduction"`
 	DutyCycle              *DutyCycle              `xml:"DutyCycle"`
 	Offset                 *Offset                 `xml:"Offset"`
-	OverrideDuration       uint16                  `xml:"overrideDuration"`
+	OverrideDuration       *uint16                 `xml:"overrideDuration"`
 	SetPoint               *SetPoint               `xml:"SetPoint"`
 	DrResponser23          *Revision23Type         `xml:"DrResponse_r2_3"`
-	*Response
 }
 
 // AppliedTargetReduction is Indicates the requested amount of the relevant commodity to be reduced.
@@ -257,52 +297,65 @@ type AppliedTargetReduction struct {
 	Type                      *UnitType       `xml:"type"`
 	Value                     uint16          `xml:"value"`
 	AppliedTargetReductionr23 *Revision23Type `xml:"AppliedTargetReduction_r2_3"`
+	AnyAttr                   []Attr          `xml:",any,attr"`
+	Any                       []*AnyElement   `xml:",any"`
 }
 
 // FlowReservationResponseResponse is A response to a FlowReservationResponse
 type FlowReservationResponseResponse struct {
-	FlowReservationResponseResponser23 *Revision23Type `xml:"FlowReservationResponseResponse_r2_3"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns FlowReservationResponseResponse"`
 	*Response
+	SchemaVerAttr                      SEPVersion      `xml:"schemaVer,attr,omitempty"`
+	FlowReservationResponseResponser23 *Revision23Type `xml:"FlowReservationResponseResponse_r2_3"`
 }
 
 // PriceResponse is A response related to a price message.
 type PriceResponse struct {
-	PriceResponser23 *Revision23Type `xml:"PriceResponse_r2_3"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns PriceResponse"`
 	*Response
+	SchemaVerAttr    SEPVersion      `xml:"schemaVer,attr,omitempty"`
+	PriceResponser23 *Revision23Type `xml:"PriceResponse_r2_3"`
 }
 
 // TextResponse is A response to a text message
 type TextResponse struct {
-	TextResponser23 *Revision23Type `xml:"TextResponse_r2_3"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns TextResponse"`
 	*Response
+	SchemaVerAttr   SEPVersion      `xml:"schemaVer,attr,omitempty"`
+	TextResponser23 *Revision23Type `xml:"TextResponse_r2_3"`
 }
 
 // Time is Local time zone offset from currentTime. Does not include any daylight savings time offsets. For American time zones, a negative tzOffset SHALL be used (eg, EST = GMT-5 which is -18000).
 type Time struct {
-	PollRateAttr uint32          `xml:"pollRate,attr,omitempty"`
-	CurrentTime  *TimeType       `xml:"currentTime"`
-	DstEndTime   *TimeType       `xml:"dstEndTime"`
-	DstOffset    *TimeOffsetType `xml:"dstOffset"`
-	DstStartTime *TimeType       `xml:"dstStartTime"`
-	LocalTime    *TimeType       `xml:"localTime"`
-	Quality      uint8           `xml:"quality"`
-	TzOffset     *TimeOffsetType `xml:"tzOffset"`
-	Timer23      *Revision23Type `xml:"Time_r2_3"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns Time"`
 	*Resource
+	PollRateAttr  uint32          `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr SEPVersion      `xml:"schemaVer,attr,omitempty"`
+	CurrentTime   *TimeType       `xml:"currentTime"`
+	DstEndTime    *TimeType       `xml:"dstEndTime"`
+	DstOffset     *TimeOffsetType `xml:"dstOffset"`
+	DstStartTime  *TimeType       `xml:"dstStartTime"`
+	LocalTime     *TimeType       `xml:"localTime"`
+	Quality       uint8           `xml:"quality"`
+	TzOffset      *TimeOffsetType `xml:"tzOffset"`
+	Timer23       *Revision23Type `xml:"Time_r2_3"`
 }
 
 // DeviceInformation is Currently running software version
 type DeviceInformation struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DeviceInformation"`
+	*Resource
 	PollRateAttr            uint32                   `xml:"pollRate,attr,omitempty"`
-	ConnectionPointID       string                   `xml:"connectionPointID"`
+	SchemaVerAttr           SEPVersion               `xml:"schemaVer,attr,omitempty"`
+	ConnectionPointID       *string                  `xml:"connectionPointID"`
 	DRLCCapabilities        *DRLCCapabilities        `xml:"DRLCCapabilities"`
-	FunctionsImplemented    string                   `xml:"functionsImplemented"`
+	FunctionsImplemented    *string                  `xml:"functionsImplemented"`
 	GpsLocation             *GPSLocationType         `xml:"gpsLocation"`
 	LFDI                    string                   `xml:"lFDI"`
 	MfDate                  *TimeType                `xml:"mfDate"`
 	MfHwVer                 string                   `xml:"mfHwVer"`
 	MfID                    *PENType                 `xml:"mfID"`
-	MfInfo                  string                   `xml:"mfInfo"`
+	MfInfo                  *string                  `xml:"mfInfo"`
 	MfModel                 string                   `xml:"mfModel"`
 	MfSerNum                string                   `xml:"mfSerNum"`
 	PrimaryPower            *PowerSourceType         `xml:"primaryPower"`
@@ -311,7 +364,6 @@ type DeviceInformation struct {
 	SwActTime               *TimeType                `xml:"swActTime"`
 	SwVer                   string                   `xml:"swVer"`
 	DeviceInformationr23    *Revision23Type          `xml:"DeviceInformation_r2_3"`
-	*Resource
 }
 
 // DRLCCapabilities is Bitmap indicating the DRLC options implemented by the device.
@@ -340,35 +392,43 @@ type DRLCCapabilities struct {
 	MaxDemand           *ActivePower    `xml:"maxDemand"`
 	OptionsImplemented  string          `xml:"optionsImplemented"`
 	DRLCCapabilitiesr23 *Revision23Type `xml:"DRLCCapabilities_r2_3"`
+	AnyAttr             []Attr          `xml:",any,attr"`
+	Any                 []*AnyElement   `xml:",any"`
 }
 
 // SupportedLocale is The code for a locale that is supported
 type SupportedLocale struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns SupportedLocale"`
+	*Resource
+	SchemaVerAttr      SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	Locale             *LocaleType     `xml:"locale"`
 	SupportedLocaler23 *Revision23Type `xml:"SupportedLocale_r2_3"`
-	*Resource
 }
 
 // SupportedLocaleList is A List element to hold SupportedLocale objects.
 type SupportedLocaleList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns SupportedLocaleList"`
+	*List
+	SchemaVerAttr          SEPVersion         `xml:"schemaVer,attr,omitempty"`
 	SupportedLocale        []*SupportedLocale `xml:"SupportedLocale"`
 	SupportedLocaleListr23 *Revision23Type    `xml:"SupportedLocaleList_r2_3"`
-	*List
 }
 
 // PowerStatus is If the device has a battery, this is the total time the device has been on battery power, in seconds. It may be reset when the battery is replaced.
 type PowerStatus struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns PowerStatus"`
+	*Resource
 	PollRateAttr             uint32           `xml:"pollRate,attr,omitempty"`
-	BatteryStatus            uint8            `xml:"batteryStatus"`
+	SchemaVerAttr            SEPVersion       `xml:"schemaVer,attr,omitempty"`
+	BatteryStatus            BatteryStatus    `xml:"batteryStatus"`
 	ChangedTime              *TimeType        `xml:"changedTime"`
 	CurrentPowerSource       *PowerSourceType `xml:"currentPowerSource"`
 	EstimatedChargeRemaining *PerCent         `xml:"estimatedChargeRemaining"`
-	EstimatedTimeRemaining   uint32           `xml:"estimatedTimeRemaining"`
+	EstimatedTimeRemaining   *uint32          `xml:"estimatedTimeRemaining"`
 	PEVInfo                  *PEVInfo         `xml:"PEVInfo"`
-	SessionTimeOnBattery     uint32           `xml:"sessionTimeOnBattery"`
-	TotalTimeOnBattery       uint32           `xml:"totalTimeOnBattery"`
+	SessionTimeOnBattery     *uint32          `xml:"sessionTimeOnBattery"`
+	TotalTimeOnBattery       *uint32          `xml:"totalTimeOnBattery"`
 	PowerStatusr23           *Revision23Type  `xml:"PowerStatus_r2_3"`
-	*Resource
 }
 
 // PowerSourceType is 0 - none
@@ -392,6 +452,8 @@ type PEVInfo struct {
 	TimeChargeIsNeeded      *TimeType       `xml:"timeChargeIsNeeded"`
 	TimeChargingStatusPEV   *TimeType       `xml:"timeChargingStatusPEV"`
 	PEVInfor23              *Revision23Type `xml:"PEVInfo_r2_3"`
+	AnyAttr                 []Attr          `xml:",any,attr"`
+	Any                     []*AnyElement   `xml:",any"`
 }
 
 // IEEE802154 is As defined by IEEE 802.15.4
@@ -401,121 +463,144 @@ type IEEE802154 struct {
 	NeighborListLink *NeighborListLink `xml:"NeighborListLink"`
 	ShortAddress     uint16            `xml:"shortAddress"`
 	IEEE802154r23    *Revision23Type   `xml:"IEEE_802_15_4_r2_3"`
+	AnyAttr          []Attr            `xml:",any,attr"`
+	Any              []*AnyElement     `xml:",any"`
 }
 
 // IPAddr is An IP address value.
 type IPAddr struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns IPAddr"`
+	*Resource
+	SchemaVerAttr       SEPVersion           `xml:"schemaVer,attr,omitempty"`
 	Address             string               `xml:"address"`
 	RPLInstanceListLink *RPLInstanceListLink `xml:"RPLInstanceListLink"`
 	IPAddrr23           *Revision23Type      `xml:"IPAddr_r2_3"`
-	*Resource
 }
 
 // IPAddrList is List of IPAddr instances.
 type IPAddrList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns IPAddrList"`
+	*List
+	SchemaVerAttr SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	IPAddr        []*IPAddr       `xml:"IPAddr"`
 	IPAddrListr23 *Revision23Type `xml:"IPAddrList_r2_3"`
-	*List
 }
 
 // IPInterface is The date/time of the reported status.
 type IPInterface struct {
-	IfDescr             string               `xml:"ifDescr"`
-	IfHighSpeed         uint32               `xml:"ifHighSpeed"`
-	IfInBroadcastPkts   uint32               `xml:"ifInBroadcastPkts"`
-	IfIndex             uint32               `xml:"ifIndex"`
-	IfInDiscards        uint32               `xml:"ifInDiscards"`
-	IfInErrors          uint32               `xml:"ifInErrors"`
-	IfInMulticastPkts   uint32               `xml:"ifInMulticastPkts"`
-	IfInOctets          uint32               `xml:"ifInOctets"`
-	IfInUcastPkts       uint32               `xml:"ifInUcastPkts"`
-	IfInUnknownProtos   uint32               `xml:"ifInUnknownProtos"`
-	IfMtu               uint32               `xml:"ifMtu"`
-	IfName              string               `xml:"ifName"`
-	IfOperStatus        uint8                `xml:"ifOperStatus"`
-	IfOutBroadcastPkts  uint32               `xml:"ifOutBroadcastPkts"`
-	IfOutDiscards       uint32               `xml:"ifOutDiscards"`
-	IfOutErrors         uint32               `xml:"ifOutErrors"`
-	IfOutMulticastPkts  uint32               `xml:"ifOutMulticastPkts"`
-	IfOutOctets         uint32               `xml:"ifOutOctets"`
-	IfOutUcastPkts      uint32               `xml:"ifOutUcastPkts"`
-	IfPromiscuousMode   bool                 `xml:"ifPromiscuousMode"`
-	IfSpeed             uint32               `xml:"ifSpeed"`
-	IfType              uint16               `xml:"ifType"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns IPInterface"`
+	*Resource
+	SchemaVerAttr       SEPVersion           `xml:"schemaVer,attr,omitempty"`
+	IfDescr             *string              `xml:"ifDescr"`
+	IfHighSpeed         *uint32              `xml:"ifHighSpeed"`
+	IfInBroadcastPkts   *uint32              `xml:"ifInBroadcastPkts"`
+	IfIndex             *uint32              `xml:"ifIndex"`
+	IfInDiscards        *uint32              `xml:"ifInDiscards"`
+	IfInErrors          *uint32              `xml:"ifInErrors"`
+	IfInMulticastPkts   *uint32              `xml:"ifInMulticastPkts"`
+	IfInOctets          *uint32              `xml:"ifInOctets"`
+	IfInUcastPkts       *uint32              `xml:"ifInUcastPkts"`
+	IfInUnknownProtos   *uint32              `xml:"ifInUnknownProtos"`
+	IfMtu               *uint32              `xml:"ifMtu"`
+	IfName              *string              `xml:"ifName"`
+	IfOperStatus        *uint8               `xml:"ifOperStatus"`
+	IfOutBroadcastPkts  *uint32              `xml:"ifOutBroadcastPkts"`
+	IfOutDiscards       *uint32              `xml:"ifOutDiscards"`
+	IfOutErrors         *uint32              `xml:"ifOutErrors"`
+	IfOutMulticastPkts  *uint32              `xml:"ifOutMulticastPkts"`
+	IfOutOctets         *uint32              `xml:"ifOutOctets"`
+	IfOutUcastPkts      *uint32              `xml:"ifOutUcastPkts"`
+	IfPromiscuousMode   *bool                `xml:"ifPromiscuousMode"`
+	IfSpeed             *uint32              `xml:"ifSpeed"`
+	IfType              *uint16              `xml:"ifType"`
 	IPAddrListLink      *IPAddrListLink      `xml:"IPAddrListLink"`
-	LastResetTime       int64                `xml:"lastResetTime"`
-	LastUpdatedTime     int64                `xml:"lastUpdatedTime"`
+	LastResetTime       *int64               `xml:"lastResetTime"`
+	LastUpdatedTime     *int64               `xml:"lastUpdatedTime"`
 	LLInterfaceListLink *LLInterfaceListLink `xml:"LLInterfaceListLink"`
 	IPInterfacer23      *Revision23Type      `xml:"IPInterface_r2_3"`
-	*Resource
 }
 
 // IPInterfaceList is List of IPInterface instances.
 type IPInterfaceList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns IPInterfaceList"`
+	*List
 	PollRateAttr       uint32          `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr      SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	IPInterface        []*IPInterface  `xml:"IPInterface"`
 	IPInterfaceListr23 *Revision23Type `xml:"IPInterfaceList_r2_3"`
-	*List
 }
 
 // LLInterface is Number of receive security errors.
 type LLInterface struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns LLInterface"`
+	*Resource
+	SchemaVerAttr     SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	CRCerrors         uint32          `xml:"CRCerrors"`
 	EUI64             string          `xml:"EUI64"`
 	IEEE802154        *IEEE802154     `xml:"IEEE_802_15_4"`
 	LinkLayerType     uint8           `xml:"linkLayerType"`
-	LLAckNotRx        uint32          `xml:"LLAckNotRx"`
-	LLCSMAFail        uint32          `xml:"LLCSMAFail"`
-	LLFramesDropRx    uint32          `xml:"LLFramesDropRx"`
-	LLFramesDropTx    uint32          `xml:"LLFramesDropTx"`
-	LLFramesRx        uint32          `xml:"LLFramesRx"`
-	LLFramesTx        uint32          `xml:"LLFramesTx"`
-	LLMediaAccessFail uint32          `xml:"LLMediaAccessFail"`
-	LLOctetsRx        uint32          `xml:"LLOctetsRx"`
-	LLOctetsTx        uint32          `xml:"LLOctetsTx"`
-	LLRetryCount      uint32          `xml:"LLRetryCount"`
-	LLSecurityErrorRx uint32          `xml:"LLSecurityErrorRx"`
+	LLAckNotRx        *uint32         `xml:"LLAckNotRx"`
+	LLCSMAFail        *uint32         `xml:"LLCSMAFail"`
+	LLFramesDropRx    *uint32         `xml:"LLFramesDropRx"`
+	LLFramesDropTx    *uint32         `xml:"LLFramesDropTx"`
+	LLFramesRx        *uint32         `xml:"LLFramesRx"`
+	LLFramesTx        *uint32         `xml:"LLFramesTx"`
+	LLMediaAccessFail *uint32         `xml:"LLMediaAccessFail"`
+	LLOctetsRx        *uint32         `xml:"LLOctetsRx"`
+	LLOctetsTx        *uint32         `xml:"LLOctetsTx"`
+	LLRetryCount      *uint32         `xml:"LLRetryCount"`
+	LLSecurityErrorRx *uint32         `xml:"LLSecurityErrorRx"`
 	LoWPAN            *LoWPAN         `xml:"loWPAN"`
 	LLInterfacer23    *Revision23Type `xml:"LLInterface_r2_3"`
-	*Resource
 }
 
 // LLInterfaceList is List of LLInterface instances.
 type LLInterfaceList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns LLInterfaceList"`
+	*List
+	SchemaVerAttr      SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	LLInterface        []*LLInterface  `xml:"LLInterface"`
 	LLInterfaceListr23 *Revision23Type `xml:"LLInterfaceList_r2_3"`
-	*List
 }
 
 // LoWPAN is Number of errors receiving fragments
 type LoWPAN struct {
 	XMLName     xml.Name        `xml:"loWPAN"`
-	OctetsRx    uint32          `xml:"octetsRx"`
-	OctetsTx    uint32          `xml:"octetsTx"`
+	OctetsRx    *uint32         `xml:"octetsRx"`
+	OctetsTx    *uint32         `xml:"octetsTx"`
 	PacketsRx   uint32          `xml:"packetsRx"`
 	PacketsTx   uint32          `xml:"packetsTx"`
 	RxFragError uint32          `xml:"rxFragError"`
 	LoWPANr23   *Revision23Type `xml:"loWPAN_r2_3"`
+	AnyAttr     []Attr          `xml:",any,attr"`
+	Any         []*AnyElement   `xml:",any"`
 }
 
 // Neighbor is As defined by IEEE 802.15.4
 type Neighbor struct {
-	IsChild      bool            `xml:"isChild"`
-	LinkQuality  uint8           `xml:"linkQuality"`
-	ShortAddress uint16          `xml:"shortAddress"`
-	Neighborr23  *Revision23Type `xml:"Neighbor_r2_3"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns Neighbor"`
 	*Resource
+	SchemaVerAttr SEPVersion      `xml:"schemaVer,attr,omitempty"`
+	IsChild       bool            `xml:"isChild"`
+	LinkQuality   uint8           `xml:"linkQuality"`
+	ShortAddress  uint16          `xml:"shortAddress"`
+	Neighborr23   *Revision23Type `xml:"Neighbor_r2_3"`
 }
 
 // NeighborList is List of 15.4 neighbors.
 type NeighborList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns NeighborList"`
+	*List
+	SchemaVerAttr   SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	Neighbor        []*Neighbor     `xml:"Neighbor"`
 	NeighborListr23 *Revision23Type `xml:"NeighborList_r2_3"`
-	*List
 }
 
 // RPLInstance is See [RFC 6550].
 type RPLInstance struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns RPLInstance"`
+	*Resource
+	SchemaVerAttr           SEPVersion               `xml:"schemaVer,attr,omitempty"`
 	DODAGid                 uint8                    `xml:"DODAGid"`
 	DODAGroot               bool                     `xml:"DODAGroot"`
 	Flags                   uint8                    `xml:"flags"`
@@ -527,29 +612,34 @@ type RPLInstance struct {
 	RPLSourceRoutesListLink *RPLSourceRoutesListLink `xml:"RPLSourceRoutesListLink"`
 	VersionNumber           uint8                    `xml:"versionNumber"`
 	RPLInstancer23          *Revision23Type          `xml:"RPLInstance_r2_3"`
-	*Resource
 }
 
 // RPLInstanceList is List of RPLInstances associated with the IPinterface.
 type RPLInstanceList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns RPLInstanceList"`
+	*List
+	SchemaVerAttr      SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	RPLInstance        []*RPLInstance  `xml:"RPLInstance"`
 	RPLInstanceListr23 *Revision23Type `xml:"RPLInstanceList_r2_3"`
-	*List
 }
 
 // RPLSourceRoutes is See [RFC 6554].
 type RPLSourceRoutes struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns RPLSourceRoutes"`
+	*Resource
+	SchemaVerAttr      SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	DestAddress        string          `xml:"DestAddress"`
 	SourceRoute        string          `xml:"SourceRoute"`
 	RPLSourceRoutesr23 *Revision23Type `xml:"RPLSourceRoutes_r2_3"`
-	*Resource
 }
 
 // RPLSourceRoutesList is List or RPL source routes if the hosting device is the DODAGroot
 type RPLSourceRoutesList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns RPLSourceRoutesList"`
+	*List
+	SchemaVerAttr          SEPVersion         `xml:"schemaVer,attr,omitempty"`
 	RPLSourceRoutes        []*RPLSourceRoutes `xml:"RPLSourceRoutes"`
 	RPLSourceRoutesListr23 *Revision23Type    `xml:"RPLSourceRoutesList_r2_3"`
-	*List
 }
 
 // LogEvent is The profileID identifies which profile (HA, BA, SE, etc) defines the following event information.
@@ -560,59 +650,71 @@ type RPLSourceRoutesList struct {
 // 4Building Automation
 // All other values are reserved.
 type LogEvent struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns LogEvent"`
+	*Resource
+	SchemaVerAttr   SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	CreatedDateTime *TimeType       `xml:"createdDateTime"`
-	Details         string          `xml:"details"`
-	ExtendedData    uint32          `xml:"extendedData"`
+	Details         *string         `xml:"details"`
+	ExtendedData    *uint32         `xml:"extendedData"`
 	FunctionSet     uint8           `xml:"functionSet"`
 	LogEventCode    uint8           `xml:"logEventCode"`
 	LogEventID      uint16          `xml:"logEventID"`
 	LogEventPEN     *PENType        `xml:"logEventPEN"`
 	ProfileID       uint8           `xml:"profileID"`
 	LogEventr23     *Revision23Type `xml:"LogEvent_r2_3"`
-	*Resource
 }
 
 // LogEventList is A List element to hold LogEvent objects.
 type LogEventList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns LogEventList"`
+	*SubscribableList
 	PollRateAttr    uint32          `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr   SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	LogEvent        []*LogEvent     `xml:"LogEvent"`
 	LogEventListr23 *Revision23Type `xml:"LogEventList_r2_3"`
-	*SubscribableList
 }
 
 // Configuration is User assigned, convenience name used for network browsing displays, etc.  Example "My Thermostat"
 type Configuration struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns Configuration"`
+	*SubscribableResource
 	PollRateAttr             uint32                    `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr            SEPVersion                `xml:"schemaVer,attr,omitempty"`
 	CurrentLocale            *LocaleType               `xml:"currentLocale"`
 	PowerConfiguration       *PowerConfiguration       `xml:"PowerConfiguration"`
 	PriceResponseCfgListLink *PriceResponseCfgListLink `xml:"PriceResponseCfgListLink"`
 	TimeConfiguration        *TimeConfiguration        `xml:"TimeConfiguration"`
 	UserDeviceName           string                    `xml:"userDeviceName"`
 	Configurationr23         *Revision23Type           `xml:"Configuration_r2_3"`
-	*SubscribableResource
 }
 
 // PowerConfiguration is In context of the PowerStatus resource, this is the value of EstimatedTimeRemaining below which BatteryStatus "low" is indicated and the PS_LOW_BATTERY is raised.
 type PowerConfiguration struct {
 	BatteryInstallTime    *TimeType       `xml:"batteryInstallTime"`
-	LowChargeThreshold    uint32          `xml:"lowChargeThreshold"`
+	LowChargeThreshold    *uint32         `xml:"lowChargeThreshold"`
 	PowerConfigurationr23 *Revision23Type `xml:"PowerConfiguration_r2_3"`
+	AnyAttr               []Attr          `xml:",any,attr"`
+	Any                   []*AnyElement   `xml:",any"`
 }
 
 // PriceResponseCfg is Price responsive clients acting upon the associated RateComponent SHOULD reduce consumption to the maximum extent possible while the price is greater than this threshold.
 type PriceResponseCfg struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns PriceResponseCfg"`
+	*Resource
+	SchemaVerAttr         SEPVersion         `xml:"schemaVer,attr,omitempty"`
 	ConsumeThreshold      int                `xml:"consumeThreshold"`
 	MaxReductionThreshold int                `xml:"maxReductionThreshold"`
 	RateComponentLink     *RateComponentLink `xml:"RateComponentLink"`
 	PriceResponseCfgr23   *Revision23Type    `xml:"PriceResponseCfg_r2_3"`
-	*Resource
 }
 
 // PriceResponseCfgList is A List element to hold PriceResponseCfg objects.
 type PriceResponseCfgList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns PriceResponseCfgList"`
+	*List
+	SchemaVerAttr           SEPVersion          `xml:"schemaVer,attr,omitempty"`
 	PriceResponseCfg        []*PriceResponseCfg `xml:"PriceResponseCfg"`
 	PriceResponseCfgListr23 *Revision23Type     `xml:"PriceResponseCfgList_r2_3"`
-	*List
 }
 
 // TimeConfiguration is Local time zone offset from UTCTime. Does not include any daylight savings time offsets.
@@ -622,6 +724,8 @@ type TimeConfiguration struct {
 	DstStartRule         *DstRuleType    `xml:"dstStartRule"`
 	TzOffset             *TimeOffsetType `xml:"tzOffset"`
 	TimeConfigurationr23 *Revision23Type `xml:"TimeConfiguration_r2_3"`
+	AnyAttr              []Attr          `xml:",any,attr"`
+	Any                  []*AnyElement   `xml:",any"`
 }
 
 // File is A value indicating the type of the file.  SHALL be one of the following values:
@@ -632,127 +736,153 @@ type TimeConfiguration struct {
 // 04–7FFF = reserved
 // 8000-FFFF = Manufacturer defined
 type File struct {
-	ActivateTime *TimeType       `xml:"activateTime"`
-	FileURI      string          `xml:"fileURI"`
-	LFDI         string          `xml:"lFDI"`
-	MfHwVer      string          `xml:"mfHwVer"`
-	MfID         *PENType        `xml:"mfID"`
-	MfModel      string          `xml:"mfModel"`
-	MfSerNum     string          `xml:"mfSerNum"`
-	MfVer        string          `xml:"mfVer"`
-	Size         uint32          `xml:"size"`
-	Type         string          `xml:"type"`
-	Filer23      *Revision23Type `xml:"File_r2_3"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns File"`
 	*Resource
+	SchemaVerAttr SEPVersion      `xml:"schemaVer,attr,omitempty"`
+	ActivateTime  *TimeType       `xml:"activateTime"`
+	FileURI       string          `xml:"fileURI"`
+	LFDI          *string         `xml:"lFDI"`
+	MfHwVer       *string         `xml:"mfHwVer"`
+	MfID          *PENType        `xml:"mfID"`
+	MfModel       string          `xml:"mfModel"`
+	MfSerNum      *string         `xml:"mfSerNum"`
+	MfVer         string          `xml:"mfVer"`
+	Size          uint32          `xml:"size"`
+	Type          string          `xml:"type"`
+	Filer23       *Revision23Type `xml:"File_r2_3"`
 }
 
 // FileList is A List element to hold File objects.
 type FileList struct {
-	PollRateAttr uint32          `xml:"pollRate,attr,omitempty"`
-	File         []*File         `xml:"File"`
-	FileListr23  *Revision23Type `xml:"FileList_r2_3"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns FileList"`
 	*List
+	PollRateAttr  uint32          `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr SEPVersion      `xml:"schemaVer,attr,omitempty"`
+	File          []*File         `xml:"File"`
+	FileListr23   *Revision23Type `xml:"FileList_r2_3"`
 }
 
 // FileStatus is This element SHALL be set to the time at which file status transitioned to the value indicated in the status element.
 type FileStatus struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns FileStatus"`
+	*Resource
 	PollRateAttr       uint32          `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr      SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	ActivateTime       *TimeType       `xml:"activateTime"`
 	FileLink           *FileLink       `xml:"FileLink"`
 	LoadPercent        uint8           `xml:"loadPercent"`
 	NextRequestAttempt *TimeType       `xml:"nextRequestAttempt"`
 	Request503Count    uint16          `xml:"request503Count"`
 	RequestFailCount   uint16          `xml:"requestFailCount"`
-	Status             uint8           `xml:"status"`
+	Status             FileLoadStatus  `xml:"status"`
 	StatusTime         *TimeType       `xml:"statusTime"`
 	FileStatusr23      *Revision23Type `xml:"FileStatus_r2_3"`
-	*Resource
 }
 
 // LoadShedAvailabilityList is A List element to hold LoadShedAvailability objects.
 type LoadShedAvailabilityList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns LoadShedAvailabilityList"`
+	*List
 	PollRateAttr                uint32                  `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr               SEPVersion              `xml:"schemaVer,attr,omitempty"`
 	LoadShedAvailability        []*LoadShedAvailability `xml:"LoadShedAvailability"`
 	LoadShedAvailabilityListr23 *Revision23Type         `xml:"LoadShedAvailabilityList_r2_3"`
-	*List
 }
 
 // ApplianceLoadReduction is Indicates the type of appliance load reduction requested.
 type ApplianceLoadReduction struct {
 	Type                      *ApplianceLoadReductionType `xml:"type"`
 	ApplianceLoadReductionr23 *Revision23Type             `xml:"ApplianceLoadReduction_r2_3"`
+	AnyAttr                   []Attr                      `xml:",any,attr"`
+	Any                       []*AnyElement               `xml:",any"`
 }
 
 // DemandResponseProgram is Indicates the relative primacy of the provider of this program.
 type DemandResponseProgram struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DemandResponseProgram"`
+	*IdentifiedObject
+	SchemaVerAttr                            SEPVersion                      `xml:"schemaVer,attr,omitempty"`
 	ActiveEndDeviceControlListLink           *ActiveEndDeviceControlListLink `xml:"ActiveEndDeviceControlListLink"`
 	AvailabilityUpdatePercentChangeThreshold *PerCent                        `xml:"availabilityUpdatePercentChangeThreshold"`
 	AvailabilityUpdatePowerChangeThreshold   *ActivePower                    `xml:"availabilityUpdatePowerChangeThreshold"`
 	EndDeviceControlListLink                 *EndDeviceControlListLink       `xml:"EndDeviceControlListLink"`
 	Primacy                                  *PrimacyType                    `xml:"primacy"`
 	DemandResponseProgramr23                 *Revision23Type                 `xml:"DemandResponseProgram_r2_3"`
-	*IdentifiedObject
 }
 
 // DemandResponseProgramList is A List element to hold DemandResponseProgram objects.
 type DemandResponseProgramList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DemandResponseProgramList"`
+	*SubscribableList
 	PollRateAttr                 uint32                   `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr                SEPVersion               `xml:"schemaVer,attr,omitempty"`
 	DemandResponseProgram        []*DemandResponseProgram `xml:"DemandResponseProgram"`
 	DemandResponseProgramListr23 *Revision23Type          `xml:"DemandResponseProgramList_r2_3"`
-	*SubscribableList
 }
 
 // DutyCycle is Contains the maximum On state duty cycle applied by the end device, as a percentage of time.  The field not present indicates that this field has not been used by the end device.
 type DutyCycle struct {
 	NormalValue  uint8           `xml:"normalValue"`
 	DutyCycler23 *Revision23Type `xml:"DutyCycle_r2_3"`
+	AnyAttr      []Attr          `xml:",any,attr"`
+	Any          []*AnyElement   `xml:",any"`
 }
 
 // EndDeviceControl is The overrideDuration attribute provides a duration, in seconds, for which a client device is allowed to override this EndDeviceControl and still meet the contractual agreement with a service provider without opting out. If overrideDuration is not specified, then it SHALL default to 0.
 type EndDeviceControl struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns EndDeviceControl"`
+	*RandomizableEvent
+	SchemaVerAttr          SEPVersion              `xml:"schemaVer,attr,omitempty"`
 	ApplianceLoadReduction *ApplianceLoadReduction `xml:"ApplianceLoadReduction"`
 	DeviceCategory         *DeviceCategoryType     `xml:"deviceCategory"`
 	DrProgramMandatory     bool                    `xml:"drProgramMandatory"`
 	DutyCycle              *DutyCycle              `xml:"DutyCycle"`
 	LoadShiftForward       bool                    `xml:"loadShiftForward"`
 	Offset                 *Offset                 `xml:"Offset"`
-	OverrideDuration       uint16                  `xml:"overrideDuration"`
+	OverrideDuration       *uint16                 `xml:"overrideDuration"`
 	SetPoint               *SetPoint               `xml:"SetPoint"`
 	TargetReduction        *TargetReduction        `xml:"TargetReduction"`
 	EndDeviceControlr23    *Revision23Type         `xml:"EndDeviceControl_r2_3"`
-	*RandomizableEvent
 }
 
 // EndDeviceControlList is A List element to hold EndDeviceControl objects.
 type EndDeviceControlList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns EndDeviceControlList"`
+	*SubscribableList
+	SchemaVerAttr           SEPVersion          `xml:"schemaVer,attr,omitempty"`
 	EndDeviceControl        []*EndDeviceControl `xml:"EndDeviceControl"`
 	EndDeviceControlListr23 *Revision23Type     `xml:"EndDeviceControlList_r2_3"`
-	*SubscribableList
 }
 
 // LoadShedAvailability is Maximum amount of current operating load that is estimated to be sheddable, in Watts.
 type LoadShedAvailability struct {
-	AvailabilityDuration      uint32                     `xml:"availabilityDuration"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns LoadShedAvailability"`
+	*Resource
+	SchemaVerAttr             SEPVersion                 `xml:"schemaVer,attr,omitempty"`
+	AvailabilityDuration      *uint32                    `xml:"availabilityDuration"`
 	DemandResponseProgramLink *DemandResponseProgramLink `xml:"DemandResponseProgramLink"`
 	SheddablePercent          *PerCent                   `xml:"sheddablePercent"`
 	SheddablePower            *ActivePower               `xml:"sheddablePower"`
 	LoadShedAvailabilityr23   *Revision23Type            `xml:"LoadShedAvailability_r2_3"`
-	*Resource
 }
 
 // Offset is The value change requested for the load adjustment percentage. The value should be subtracted from the normal setting, or if loadShiftForward is true, then the value should be added to the normal setting.
 type Offset struct {
-	CoolingOffset                  uint8           `xml:"coolingOffset"`
-	HeatingOffset                  uint8           `xml:"heatingOffset"`
+	CoolingOffset                  *uint8          `xml:"coolingOffset"`
+	HeatingOffset                  *uint8          `xml:"heatingOffset"`
 	LoadAdjustmentPercentageOffset *PerCent        `xml:"loadAdjustmentPercentageOffset"`
 	Offsetr23                      *Revision23Type `xml:"Offset_r2_3"`
+	AnyAttr                        []Attr          `xml:",any,attr"`
+	Any                            []*AnyElement   `xml:",any"`
 }
 
 // SetPoint is This attribute represents the heating temperature set point in degrees Celsius / 100. (Hundredths of a degree C)
 type SetPoint struct {
-	CoolingSetpoint int16           `xml:"coolingSetpoint"`
-	HeatingSetpoint int16           `xml:"heatingSetpoint"`
+	CoolingSetpoint *int16          `xml:"coolingSetpoint"`
+	HeatingSetpoint *int16          `xml:"heatingSetpoint"`
 	SetPointr23     *Revision23Type `xml:"SetPoint_r2_3"`
+	AnyAttr         []Attr          `xml:",any,attr"`
+	Any             []*AnyElement   `xml:",any"`
 }
 
 // TargetReduction is Indicates the requested amount of the relevant commodity to be reduced.
@@ -760,110 +890,134 @@ type TargetReduction struct {
 	Type               *UnitType       `xml:"type"`
 	Value              uint16          `xml:"value"`
 	TargetReductionr23 *Revision23Type `xml:"TargetReduction_r2_3"`
+	AnyAttr            []Attr          `xml:",any,attr"`
+	Any                []*AnyElement   `xml:",any"`
 }
 
 // MeterReading is Set of values obtained from the meter.
 type MeterReading struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns MeterReading"`
+	*MeterReadingBase
+	SchemaVerAttr         SEPVersion             `xml:"schemaVer,attr,omitempty"`
 	RateComponentListLink *RateComponentListLink `xml:"RateComponentListLink"`
 	ReadingLink           *ReadingLink           `xml:"ReadingLink"`
 	ReadingSetListLink    *ReadingSetListLink    `xml:"ReadingSetListLink"`
 	ReadingTypeLink       *ReadingTypeLink       `xml:"ReadingTypeLink"`
 	MeterReadingr23       *Revision23Type        `xml:"MeterReading_r2_3"`
-	*MeterReadingBase
 }
 
 // MeterReadingList is A List element to hold MeterReading objects.
 type MeterReadingList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns MeterReadingList"`
+	*SubscribableList
+	SchemaVerAttr       SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	MeterReading        []*MeterReading `xml:"MeterReading"`
 	MeterReadingListr23 *Revision23Type `xml:"MeterReadingList_r2_3"`
-	*SubscribableList
 }
 
 // Reading is The local identifier for this reading within the reading set. localIDs are assigned in order of creation time. For interval data, this value SHALL increase with each interval time, and for block/tier readings, localID SHALL not be specified.
 type Reading struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns Reading"`
+	*ReadingBase
+	SchemaVerAttr    SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	SubscribableAttr *UInt8          `xml:"subscribable,attr,omitempty"`
-	LocalID          string          `xml:"localID"`
+	LocalID          *string         `xml:"localID"`
 	Readingr23       *Revision23Type `xml:"Reading_r2_3"`
-	*ReadingBase
 }
 
 // ReadingList is A List element to hold Reading objects.
 type ReadingList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns ReadingList"`
+	*SubscribableList
+	SchemaVerAttr  SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	Reading        []*Reading      `xml:"Reading"`
 	ReadingListr23 *Revision23Type `xml:"ReadingList_r2_3"`
-	*SubscribableList
 }
 
 // ReadingSet is A set of Readings of the ReadingType indicated by the parent MeterReading.
 type ReadingSet struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns ReadingSet"`
+	*ReadingSetBase
+	SchemaVerAttr   SEPVersion       `xml:"schemaVer,attr,omitempty"`
 	ReadingListLink *ReadingListLink `xml:"ReadingListLink"`
 	ReadingSetr23   *Revision23Type  `xml:"ReadingSet_r2_3"`
-	*ReadingSetBase
 }
 
 // ReadingSetList is A List element to hold ReadingSet objects.
 type ReadingSetList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns ReadingSetList"`
+	*SubscribableList
+	SchemaVerAttr     SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	ReadingSet        []*ReadingSet   `xml:"ReadingSet"`
 	ReadingSetListr23 *Revision23Type `xml:"ReadingSetList_r2_3"`
-	*SubscribableList
 }
 
 // ReadingType is Indicates the measurement type for the units of measure for the readings of this type.
 type ReadingType struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns ReadingType"`
+	*Resource
+	SchemaVerAttr             SEPVersion                 `xml:"schemaVer,attr,omitempty"`
 	AccumulationBehaviour     *AccumulationBehaviourType `xml:"accumulationBehaviour"`
 	CalorificValue            *UnitValueType             `xml:"calorificValue"`
 	Commodity                 *CommodityType             `xml:"commodity"`
 	ConversionFactor          *UnitValueType             `xml:"conversionFactor"`
 	DataQualifier             *DataQualifierType         `xml:"dataQualifier"`
 	FlowDirection             *FlowDirectionType         `xml:"flowDirection"`
-	IntervalLength            uint32                     `xml:"intervalLength"`
+	IntervalLength            *uint32                    `xml:"intervalLength"`
 	Kind                      *KindType                  `xml:"kind"`
-	MaxNumberOfIntervals      uint8                      `xml:"maxNumberOfIntervals"`
-	NumberOfConsumptionBlocks uint8                      `xml:"numberOfConsumptionBlocks"`
-	NumberOfTouTiers          uint8                      `xml:"numberOfTouTiers"`
+	MaxNumberOfIntervals      *uint8                     `xml:"maxNumberOfIntervals"`
+	NumberOfConsumptionBlocks *uint8                     `xml:"numberOfConsumptionBlocks"`
+	NumberOfTouTiers          *uint8                     `xml:"numberOfTouTiers"`
 	Phase                     *PhaseCode                 `xml:"phase"`
 	PowerOfTenMultiplier      *PowerOfTenMultiplierType  `xml:"powerOfTenMultiplier"`
-	SubIntervalLength         uint32                     `xml:"subIntervalLength"`
-	SupplyLimit               uint64                     `xml:"supplyLimit"`
-	TieredConsumptionBlocks   bool                       `xml:"tieredConsumptionBlocks"`
+	SubIntervalLength         *uint32                    `xml:"subIntervalLength"`
+	SupplyLimit               *uint64                    `xml:"supplyLimit"`
+	TieredConsumptionBlocks   *bool                      `xml:"tieredConsumptionBlocks"`
 	Uom                       *UomType                   `xml:"uom"`
 	ReadingTyper23            *Revision23Type            `xml:"ReadingType_r2_3"`
-	*Resource
 }
 
 // UsagePoint is The LFDI of the source device. This attribute SHALL be present when mirroring.
 type UsagePoint struct {
-	DeviceLFDI           string                `xml:"deviceLFDI"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns UsagePoint"`
+	*UsagePointBase
+	SchemaVerAttr        SEPVersion            `xml:"schemaVer,attr,omitempty"`
+	DeviceLFDI           *string               `xml:"deviceLFDI"`
 	MeterReadingListLink *MeterReadingListLink `xml:"MeterReadingListLink"`
 	UsagePointr23        *Revision23Type       `xml:"UsagePoint_r2_3"`
-	*UsagePointBase
 }
 
 // UsagePointList is A List element to hold UsagePoint objects.
 type UsagePointList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns UsagePointList"`
+	*SubscribableList
 	PollRateAttr      uint32          `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr     SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	UsagePoint        []*UsagePoint   `xml:"UsagePoint"`
 	UsagePointListr23 *Revision23Type `xml:"UsagePointList_r2_3"`
-	*SubscribableList
 }
 
 // ConsumptionTariffInterval is The lowest level of consumption that defines the starting point of this consumption step or block. Thresholds start at zero for each billing period.
 //
 // If specified, the first ConsumptionTariffInterval.startValue for a TimeTariffInteral instance SHALL begin at "0." Subsequent ConsumptionTariffInterval.startValue elements SHALL be greater than the previous one.
 type ConsumptionTariffInterval struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns ConsumptionTariffInterval"`
+	*Resource
+	SchemaVerAttr                SEPVersion            `xml:"schemaVer,attr,omitempty"`
 	ConsumptionBlock             *ConsumptionBlockType `xml:"consumptionBlock"`
 	EnvironmentalCost            []*EnvironmentalCost  `xml:"EnvironmentalCost"`
-	Price                        int                   `xml:"price"`
+	Price                        *int                  `xml:"price"`
 	StartValue                   uint64                `xml:"startValue"`
 	ConsumptionTariffIntervalr23 *Revision23Type       `xml:"ConsumptionTariffInterval_r2_3"`
-	*Resource
 }
 
 // ConsumptionTariffIntervalList is A List element to hold ConsumptionTariffInterval objects.
 type ConsumptionTariffIntervalList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns ConsumptionTariffIntervalList"`
+	*List
+	SchemaVerAttr                    SEPVersion                   `xml:"schemaVer,attr,omitempty"`
 	ConsumptionTariffInterval        []*ConsumptionTariffInterval `xml:"ConsumptionTariffInterval"`
 	ConsumptionTariffIntervalListr23 *Revision23Type              `xml:"ConsumptionTariffIntervalList_r2_3"`
-	*List
 }
 
 // CostKindType is 0 - Carbon Dioxide emissions, in grams per unit
@@ -884,10 +1038,15 @@ type EnvironmentalCost struct {
 	CostLevel            uint8           `xml:"costLevel"`
 	NumCostLevels        uint8           `xml:"numCostLevels"`
 	EnvironmentalCostr23 *Revision23Type `xml:"EnvironmentalCost_r2_3"`
+	AnyAttr              []Attr          `xml:",any,attr"`
+	Any                  []*AnyElement   `xml:",any"`
 }
 
 // RateComponent is Specifies the roles that this usage point has been assigned.
 type RateComponent struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns RateComponent"`
+	*IdentifiedObject
+	SchemaVerAttr                    SEPVersion                        `xml:"schemaVer,attr,omitempty"`
 	ActiveTimeTariffIntervalListLink *ActiveTimeTariffIntervalListLink `xml:"ActiveTimeTariffIntervalListLink"`
 	FlowRateEndLimit                 *UnitValueType                    `xml:"flowRateEndLimit"`
 	FlowRateStartLimit               *UnitValueType                    `xml:"flowRateStartLimit"`
@@ -895,76 +1054,89 @@ type RateComponent struct {
 	RoleFlags                        *RoleFlagsType                    `xml:"roleFlags"`
 	TimeTariffIntervalListLink       *TimeTariffIntervalListLink       `xml:"TimeTariffIntervalListLink"`
 	RateComponentr23                 *Revision23Type                   `xml:"RateComponent_r2_3"`
-	*IdentifiedObject
 }
 
 // RateComponentList is A List element to hold RateComponent objects.
 type RateComponentList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns RateComponentList"`
+	*List
+	SchemaVerAttr        SEPVersion       `xml:"schemaVer,attr,omitempty"`
 	RateComponent        []*RateComponent `xml:"RateComponent"`
 	RateComponentListr23 *Revision23Type  `xml:"RateComponentList_r2_3"`
-	*List
 }
 
 // TariffProfile is URI for information regarding the tariff. This may be a web page with a description of the tariff in machine or human readable form. This should describe the current tariff if there are multiple versions.
 type TariffProfile struct {
-	BindingPrices                bool                      `xml:"bindingPrices"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns TariffProfile"`
+	*IdentifiedObject
+	SchemaVerAttr                SEPVersion                `xml:"schemaVer,attr,omitempty"`
+	BindingPrices                *bool                     `xml:"bindingPrices"`
 	Currency                     *CurrencyCode             `xml:"currency"`
 	DateAnnounced                *TimeType                 `xml:"dateAnnounced"`
 	DateEffective                *TimeType                 `xml:"dateEffective"`
-	LocalPrice                   bool                      `xml:"localPrice"`
+	LocalPrice                   *bool                     `xml:"localPrice"`
 	Location                     *GeographicLocationType   `xml:"location"`
 	PricePowerOfTenMultiplier    *PowerOfTenMultiplierType `xml:"pricePowerOfTenMultiplier"`
 	Primacy                      *PrimacyType              `xml:"primacy"`
-	RateCode                     string                    `xml:"rateCode"`
-	RateCodeLong                 string                    `xml:"rateCodeLong"`
+	RateCode                     *string                   `xml:"rateCode"`
+	RateCodeLong                 *string                   `xml:"rateCodeLong"`
 	RateComponentListLink        *RateComponentListLink    `xml:"RateComponentListLink"`
-	Retailer                     string                    `xml:"retailer"`
-	RetailerLong                 string                    `xml:"retailerLong"`
+	Retailer                     *string                   `xml:"retailer"`
+	RetailerLong                 *string                   `xml:"retailerLong"`
 	ServiceCategoryKind          *ServiceKind              `xml:"serviceCategoryKind"`
-	TariffDescriptionExternalURI string                    `xml:"tariffDescriptionExternalURI"`
+	TariffDescriptionExternalURI *string                   `xml:"tariffDescriptionExternalURI"`
 	TariffProfiler23             *Revision23Type           `xml:"TariffProfile_r2_3"`
-	*IdentifiedObject
 }
 
 // TariffProfileList is A List element to hold TariffProfile objects.
 type TariffProfileList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns TariffProfileList"`
+	*SubscribableList
 	PollRateAttr         uint32           `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr        SEPVersion       `xml:"schemaVer,attr,omitempty"`
 	TariffProfile        []*TariffProfile `xml:"TariffProfile"`
 	TariffProfileListr23 *Revision23Type  `xml:"TariffProfileList_r2_3"`
-	*SubscribableList
 }
 
 // TimeTariffInterval is Indicates the time of use tier related to the reading. If not specified, is assumed to be "0 - N/A".
 type TimeTariffInterval struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns TimeTariffInterval"`
+	*RandomizableEvent
+	SchemaVerAttr                     SEPVersion                         `xml:"schemaVer,attr,omitempty"`
 	ConsumptionTariffIntervalListLink *ConsumptionTariffIntervalListLink `xml:"ConsumptionTariffIntervalListLink"`
 	TouTier                           *TOUType                           `xml:"touTier"`
 	TimeTariffIntervalr23             *Revision23Type                    `xml:"TimeTariffInterval_r2_3"`
-	*RandomizableEvent
 }
 
 // TimeTariffIntervalList is A List element to hold TimeTariffInterval objects.
 type TimeTariffIntervalList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns TimeTariffIntervalList"`
+	*SubscribableList
+	SchemaVerAttr             SEPVersion            `xml:"schemaVer,attr,omitempty"`
 	TimeTariffInterval        []*TimeTariffInterval `xml:"TimeTariffInterval"`
 	TimeTariffIntervalListr23 *Revision23Type       `xml:"TimeTariffIntervalList_r2_3"`
-	*SubscribableList
 }
 
 // MessagingProgram is Indicates the relative primacy of the provider of this program.
 type MessagingProgram struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns MessagingProgram"`
+	*SubscribableIdentifiedObject
+	SchemaVerAttr             SEPVersion                 `xml:"schemaVer,attr,omitempty"`
 	ActiveTextMessageListLink *ActiveTextMessageListLink `xml:"ActiveTextMessageListLink"`
 	Locale                    *LocaleType                `xml:"locale"`
 	Primacy                   *PrimacyType               `xml:"primacy"`
 	TextMessageListLink       *TextMessageListLink       `xml:"TextMessageListLink"`
 	MessagingProgramr23       *Revision23Type            `xml:"MessagingProgram_r2_3"`
-	*SubscribableIdentifiedObject
 }
 
 // MessagingProgramList is A List element to hold MessagingProgram objects.
 type MessagingProgramList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns MessagingProgramList"`
+	*SubscribableList
 	PollRateAttr            uint32              `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr           SEPVersion          `xml:"schemaVer,attr,omitempty"`
 	MessagingProgram        []*MessagingProgram `xml:"MessagingProgram"`
 	MessagingProgramListr23 *Revision23Type     `xml:"MessagingProgramList_r2_3"`
-	*SubscribableList
 }
 
 // PriorityType is Indicates the priority of a message:
@@ -979,79 +1151,97 @@ type PriorityType struct {
 
 // TextMessage is The textMessage attribute contains the actual UTF-8 encoded text to be displayed in conjunction with the messageLength attribute which contains the overall length of the textMessage attribute.  Clients and servers SHALL support a reception of a Message of 100 bytes in length.  Messages that exceed the clients display size will be left to the client to choose what method to handle the message (truncation, scrolling, etc.).
 type TextMessage struct {
-	Originator     string          `xml:"originator"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns TextMessage"`
+	*Event
+	SchemaVerAttr  SEPVersion      `xml:"schemaVer,attr,omitempty"`
+	Originator     *string         `xml:"originator"`
 	Priority       *PriorityType   `xml:"priority"`
 	TextMessage    string          `xml:"textMessage"`
 	TextMessager23 *Revision23Type `xml:"TextMessage_r2_3"`
-	*Event
 }
 
 // TextMessageList is A List element to hold TextMessage objects.
 type TextMessageList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns TextMessageList"`
+	*SubscribableList
+	SchemaVerAttr      SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	TextMessage        []*TextMessage  `xml:"TextMessage"`
 	TextMessageListr23 *Revision23Type `xml:"TextMessageList_r2_3"`
-	*SubscribableList
 }
 
 // BillingPeriod is The date / time of the last update of this resource.
 type BillingPeriod struct {
-	BillLastPeriod   int64             `xml:"billLastPeriod"`
-	BillToDate       int64             `xml:"billToDate"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns BillingPeriod"`
+	*Resource
+	SchemaVerAttr    SEPVersion        `xml:"schemaVer,attr,omitempty"`
+	BillLastPeriod   *int64            `xml:"billLastPeriod"`
+	BillToDate       *int64            `xml:"billToDate"`
 	Interval         *DateTimeInterval `xml:"interval"`
 	StatusTimeStamp  *TimeType         `xml:"statusTimeStamp"`
 	BillingPeriodr23 *Revision23Type   `xml:"BillingPeriod_r2_3"`
-	*Resource
 }
 
 // BillingPeriodList is A List element to hold BillingPeriod objects.
 type BillingPeriodList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns BillingPeriodList"`
+	*SubscribableList
+	SchemaVerAttr        SEPVersion       `xml:"schemaVer,attr,omitempty"`
 	BillingPeriod        []*BillingPeriod `xml:"BillingPeriod"`
 	BillingPeriodListr23 *Revision23Type  `xml:"BillingPeriodList_r2_3"`
-	*SubscribableList
 }
 
 // BillingMeterReadingBase is Contains historical, target, and projection readings of various types, possibly associated with charges.
 type BillingMeterReadingBase struct {
+	*MeterReadingBase
 	BillingReadingSetListLink  *BillingReadingSetListLink `xml:"BillingReadingSetListLink"`
 	ReadingTypeLink            *ReadingTypeLink           `xml:"ReadingTypeLink"`
 	BillingMeterReadingBaser23 *Revision23Type            `xml:"BillingMeterReadingBase_r2_3"`
-	*MeterReadingBase
 }
 
 // BillingReading is Data captured at regular intervals of time. Interval data could be captured as incremental data, absolute data, or relative data. The source for the data is usually a tariff quantity or an engineering quantity. Data is typically captured in time-tagged, uniform, fixed-length intervals of 5 min, 10 min, 15 min, 30 min, or 60 min. However, consumption aggregations can also be represented with this class.
 type BillingReading struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns BillingReading"`
+	*ReadingBase
+	SchemaVerAttr     SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	Charge            []*Charge       `xml:"Charge"`
 	BillingReadingr23 *Revision23Type `xml:"BillingReading_r2_3"`
-	*ReadingBase
 }
 
 // BillingReadingList is A List element to hold BillingReading objects.
 type BillingReadingList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns BillingReadingList"`
+	*List
+	SchemaVerAttr         SEPVersion        `xml:"schemaVer,attr,omitempty"`
 	BillingReading        []*BillingReading `xml:"BillingReading"`
 	BillingReadingListr23 *Revision23Type   `xml:"BillingReadingList_r2_3"`
-	*List
 }
 
 // BillingReadingSet is Time sequence of readings of the same reading type.
 type BillingReadingSet struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns BillingReadingSet"`
+	*ReadingSetBase
+	SchemaVerAttr          SEPVersion              `xml:"schemaVer,attr,omitempty"`
 	BillingReadingListLink *BillingReadingListLink `xml:"BillingReadingListLink"`
 	BillingReadingSetr23   *Revision23Type         `xml:"BillingReadingSet_r2_3"`
-	*ReadingSetBase
 }
 
 // BillingReadingSetList is A List element to hold BillingReadingSet objects.
 type BillingReadingSetList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns BillingReadingSetList"`
+	*SubscribableList
+	SchemaVerAttr            SEPVersion           `xml:"schemaVer,attr,omitempty"`
 	BillingReadingSet        []*BillingReadingSet `xml:"BillingReadingSet"`
 	BillingReadingSetListr23 *Revision23Type      `xml:"BillingReadingSetList_r2_3"`
-	*SubscribableList
 }
 
 // Charge is A monetary charge.
 type Charge struct {
-	Description string          `xml:"description"`
+	Description *string         `xml:"description"`
 	Kind        *ChargeKind     `xml:"kind"`
 	Value       int             `xml:"value"`
 	Charger23   *Revision23Type `xml:"Charge_r2_3"`
+	AnyAttr     []Attr          `xml:",any,attr"`
+	Any         []*AnyElement   `xml:",any"`
 }
 
 // ChargeKind is Kind of charge.
@@ -1066,26 +1256,33 @@ type ChargeKind struct {
 
 // CustomerAccount is Indicates the power of ten multiplier for the prices in this function set.
 type CustomerAccount struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns CustomerAccount"`
+	*IdentifiedObject
+	SchemaVerAttr             SEPVersion                 `xml:"schemaVer,attr,omitempty"`
 	Currency                  uint16                     `xml:"currency"`
-	CustomerAccount           string                     `xml:"customerAccount"`
+	CustomerAccount           *string                    `xml:"customerAccount"`
 	CustomerAgreementListLink *CustomerAgreementListLink `xml:"CustomerAgreementListLink"`
-	CustomerName              string                     `xml:"customerName"`
+	CustomerName              *string                    `xml:"customerName"`
 	PricePowerOfTenMultiplier *PowerOfTenMultiplierType  `xml:"pricePowerOfTenMultiplier"`
 	ServiceSupplierLink       *ServiceSupplierLink       `xml:"ServiceSupplierLink"`
 	CustomerAccountr23        *Revision23Type            `xml:"CustomerAccount_r2_3"`
-	*IdentifiedObject
 }
 
 // CustomerAccountList is A List element to hold CustomerAccount objects.
 type CustomerAccountList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns CustomerAccountList"`
+	*SubscribableList
 	PollRateAttr           uint32             `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr          SEPVersion         `xml:"schemaVer,attr,omitempty"`
 	CustomerAccount        []*CustomerAccount `xml:"CustomerAccount"`
 	CustomerAccountListr23 *Revision23Type    `xml:"CustomerAccountList_r2_3"`
-	*SubscribableList
 }
 
 // CustomerAgreement is The address or textual description of the service location.
 type CustomerAgreement struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns CustomerAgreement"`
+	*IdentifiedObject
+	SchemaVerAttr                   SEPVersion                       `xml:"schemaVer,attr,omitempty"`
 	ActiveBillingPeriodListLink     *ActiveBillingPeriodListLink     `xml:"ActiveBillingPeriodListLink"`
 	ActiveProjectionReadingListLink *ActiveProjectionReadingListLink `xml:"ActiveProjectionReadingListLink"`
 	ActiveTargetReadingListLink     *ActiveTargetReadingListLink     `xml:"ActiveTargetReadingListLink"`
@@ -1093,79 +1290,96 @@ type CustomerAgreement struct {
 	HistoricalReadingListLink       *HistoricalReadingListLink       `xml:"HistoricalReadingListLink"`
 	PrepaymentLink                  *PrepaymentLink                  `xml:"PrepaymentLink"`
 	ProjectionReadingListLink       *ProjectionReadingListLink       `xml:"ProjectionReadingListLink"`
-	ServiceAccount                  string                           `xml:"serviceAccount"`
-	ServiceLocation                 string                           `xml:"serviceLocation"`
+	ServiceAccount                  *string                          `xml:"serviceAccount"`
+	ServiceLocation                 *string                          `xml:"serviceLocation"`
 	TargetReadingListLink           *TargetReadingListLink           `xml:"TargetReadingListLink"`
 	TariffProfileLink               *TariffProfileLink               `xml:"TariffProfileLink"`
 	UsagePointLink                  *UsagePointLink                  `xml:"UsagePointLink"`
 	CustomerAgreementr23            *Revision23Type                  `xml:"CustomerAgreement_r2_3"`
-	*IdentifiedObject
 }
 
 // CustomerAgreementList is A List element to hold CustomerAgreement objects.
 type CustomerAgreementList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns CustomerAgreementList"`
+	*SubscribableList
+	SchemaVerAttr            SEPVersion           `xml:"schemaVer,attr,omitempty"`
 	CustomerAgreement        []*CustomerAgreement `xml:"CustomerAgreement"`
 	CustomerAgreementListr23 *Revision23Type      `xml:"CustomerAgreementList_r2_3"`
-	*SubscribableList
 }
 
 // HistoricalReading is To be used to present readings that have been processed and possibly corrected (as allowed, due to missing or incorrect data) by backend systems. This includes quality codes valid, verified, estimated, and derived / corrected.
 type HistoricalReading struct {
-	HistoricalReadingr23 *Revision23Type `xml:"HistoricalReading_r2_3"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns HistoricalReading"`
 	*BillingMeterReadingBase
+	SchemaVerAttr        SEPVersion      `xml:"schemaVer,attr,omitempty"`
+	HistoricalReadingr23 *Revision23Type `xml:"HistoricalReading_r2_3"`
 }
 
 // HistoricalReadingList is A List element to hold HistoricalReading objects.
 type HistoricalReadingList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns HistoricalReadingList"`
+	*List
+	SchemaVerAttr            SEPVersion           `xml:"schemaVer,attr,omitempty"`
 	HistoricalReading        []*HistoricalReading `xml:"HistoricalReading"`
 	HistoricalReadingListr23 *Revision23Type      `xml:"HistoricalReadingList_r2_3"`
-	*List
 }
 
 // ProjectionReading is Contains values that forecast a future reading for the time or interval specified.
 type ProjectionReading struct {
-	ProjectionReadingr23 *Revision23Type `xml:"ProjectionReading_r2_3"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns ProjectionReading"`
 	*BillingMeterReadingBase
+	SchemaVerAttr        SEPVersion      `xml:"schemaVer,attr,omitempty"`
+	ProjectionReadingr23 *Revision23Type `xml:"ProjectionReading_r2_3"`
 }
 
 // ProjectionReadingList is A List element to hold ProjectionReading objects.
 type ProjectionReadingList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns ProjectionReadingList"`
+	*List
+	SchemaVerAttr            SEPVersion           `xml:"schemaVer,attr,omitempty"`
 	ProjectionReading        []*ProjectionReading `xml:"ProjectionReading"`
 	ProjectionReadingListr23 *Revision23Type      `xml:"ProjectionReadingList_r2_3"`
-	*List
 }
 
 // TargetReading is Contains readings that specify a target or goal, such as a consumption target, to which billing incentives or other contractual ramifications may be associated.
 type TargetReading struct {
-	TargetReadingr23 *Revision23Type `xml:"TargetReading_r2_3"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns TargetReading"`
 	*BillingMeterReadingBase
+	SchemaVerAttr    SEPVersion      `xml:"schemaVer,attr,omitempty"`
+	TargetReadingr23 *Revision23Type `xml:"TargetReading_r2_3"`
 }
 
 // TargetReadingList is A List element to hold TargetReading objects.
 type TargetReadingList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns TargetReadingList"`
+	*List
+	SchemaVerAttr        SEPVersion       `xml:"schemaVer,attr,omitempty"`
 	TargetReading        []*TargetReading `xml:"TargetReading"`
 	TargetReadingListr23 *Revision23Type  `xml:"TargetReadingList_r2_3"`
-	*List
 }
 
 // ServiceSupplier is Website URI address for this service supplier.
 type ServiceSupplier struct {
-	Email              string          `xml:"email"`
-	Phone              string          `xml:"phone"`
-	ProviderID         uint32          `xml:"providerID"`
-	Web                string          `xml:"web"`
-	ServiceSupplierr23 *Revision23Type `xml:"ServiceSupplier_r2_3"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns ServiceSupplier"`
 	*IdentifiedObject
+	SchemaVerAttr      SEPVersion      `xml:"schemaVer,attr,omitempty"`
+	Email              *string         `xml:"email"`
+	Phone              *string         `xml:"phone"`
+	ProviderID         *uint32         `xml:"providerID"`
+	Web                *string         `xml:"web"`
+	ServiceSupplierr23 *Revision23Type `xml:"ServiceSupplier_r2_3"`
 }
 
 // AccountBalance is EmergencyCreditStatus identifies whether the present value of emergencyCredit is considered OK, low, exhausted, or negative.
 type AccountBalance struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns AccountBalance"`
+	*Resource
+	SchemaVerAttr         SEPVersion        `xml:"schemaVer,attr,omitempty"`
 	AvailableCredit       *AccountingUnit   `xml:"availableCredit"`
 	CreditStatus          *CreditStatusType `xml:"creditStatus"`
 	EmergencyCredit       *AccountingUnit   `xml:"emergencyCredit"`
 	EmergencyCreditStatus *CreditStatusType `xml:"emergencyCreditStatus"`
 	AccountBalancer23     *Revision23Type   `xml:"AccountBalance_r2_3"`
-	*Resource
 }
 
 // AccountingUnit is Value of the monetary aspect
@@ -1175,27 +1389,36 @@ type AccountingUnit struct {
 	Multiplier        *PowerOfTenMultiplierType `xml:"multiplier"`
 	Value             int                       `xml:"value"`
 	AccountingUnitr23 *Revision23Type           `xml:"AccountingUnit_r2_3"`
+	AnyAttr           []Attr                    `xml:",any,attr"`
+	Any               []*AnyElement             `xml:",any"`
 }
 
 // CreditRegister is Token is security data that authenticates the legitimacy of the transaction. The details of this token are not defined by IEEE 2030.5. How a Prepayment server handles this field is left as vendor specific implementation or will be defined by one or more other standards.
 type CreditRegister struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns CreditRegister"`
+	*IdentifiedObject
+	SchemaVerAttr     SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	CreditAmount      *AccountingUnit `xml:"creditAmount"`
 	CreditType        *CreditTypeType `xml:"creditType"`
 	EffectiveTime     *TimeType       `xml:"effectiveTime"`
 	Token             string          `xml:"token"`
 	CreditRegisterr23 *Revision23Type `xml:"CreditRegister_r2_3"`
-	*IdentifiedObject
 }
 
 // CreditRegisterList is A List element to hold CreditRegister objects.
 type CreditRegisterList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns CreditRegisterList"`
+	*List
+	SchemaVerAttr         SEPVersion        `xml:"schemaVer,attr,omitempty"`
 	CreditRegister        []*CreditRegister `xml:"CreditRegister"`
 	CreditRegisterListr23 *Revision23Type   `xml:"CreditRegisterList_r2_3"`
-	*List
 }
 
 // Prepayment is PrepayMode specifies whether the given Prepayment instance is operating in Credit, Central Wallet, ESI, or Local prepayment mode. The Credit mode indicates that prepayment is not presently in effect. The other modes are described in the Overview Section above.
 type Prepayment struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns Prepayment"`
+	*IdentifiedObject
+	SchemaVerAttr                            SEPVersion                                `xml:"schemaVer,attr,omitempty"`
 	AccountBalanceLink                       *AccountBalanceLink                       `xml:"AccountBalanceLink"`
 	ActiveCreditRegisterListLink             *ActiveCreditRegisterListLink             `xml:"ActiveCreditRegisterListLink"`
 	ActiveSupplyInterruptionOverrideListLink *ActiveSupplyInterruptionOverrideListLink `xml:"ActiveSupplyInterruptionOverrideListLink"`
@@ -1209,15 +1432,16 @@ type Prepayment struct {
 	UsagePoint                               []*UsagePoint                             `xml:"UsagePoint"`
 	UsagePointLink                           *UsagePointLink                           `xml:"UsagePointLink"`
 	Prepaymentr23                            *Revision23Type                           `xml:"Prepayment_r2_3"`
-	*IdentifiedObject
 }
 
 // PrepaymentList is A List element to hold Prepayment objects.
 type PrepaymentList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns PrepaymentList"`
+	*SubscribableList
 	PollRateAttr      uint32          `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr     SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	Prepayment        []*Prepayment   `xml:"Prepayment"`
 	PrepaymentListr23 *Revision23Type `xml:"PrepaymentList_r2_3"`
-	*SubscribableList
 }
 
 // PrepayModeType is 0 - Central Wallet
@@ -1231,12 +1455,14 @@ type PrepayModeType struct {
 
 // PrepayOperationStatus is ServiceStatus identifies whether the service is connected or disconnected, or armed for connection or disconnection.
 type PrepayOperationStatus struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns PrepayOperationStatus"`
+	*Resource
+	SchemaVerAttr            SEPVersion         `xml:"schemaVer,attr,omitempty"`
 	CreditTypeChange         *CreditTypeChange  `xml:"creditTypeChange"`
 	CreditTypeInUse          *CreditTypeType    `xml:"creditTypeInUse"`
 	ServiceChange            *ServiceChange     `xml:"serviceChange"`
 	ServiceStatus            *ServiceStatusType `xml:"serviceStatus"`
 	PrepayOperationStatusr23 *Revision23Type    `xml:"PrepayOperationStatus_r2_3"`
-	*Resource
 }
 
 // ServiceChange is The date/time when the change is to take effect.
@@ -1244,21 +1470,27 @@ type ServiceChange struct {
 	NewStatus        *ServiceStatusType `xml:"newStatus"`
 	StartTime        *TimeType          `xml:"startTime"`
 	ServiceChanger23 *Revision23Type    `xml:"ServiceChange_r2_3"`
+	AnyAttr          []Attr             `xml:",any,attr"`
+	Any              []*AnyElement      `xml:",any"`
 }
 
 // SupplyInterruptionOverride is Interval defines the period of time during which supply should not be interrupted.
 type SupplyInterruptionOverride struct {
-	Description                   string            `xml:"description"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns SupplyInterruptionOverride"`
+	*Resource
+	SchemaVerAttr                 SEPVersion        `xml:"schemaVer,attr,omitempty"`
+	Description                   *string           `xml:"description"`
 	Interval                      *DateTimeInterval `xml:"interval"`
 	SupplyInterruptionOverrider23 *Revision23Type   `xml:"SupplyInterruptionOverride_r2_3"`
-	*Resource
 }
 
 // SupplyInterruptionOverrideList is A List element to hold SupplyInterruptionOverride objects.
 type SupplyInterruptionOverrideList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns SupplyInterruptionOverrideList"`
+	*List
+	SchemaVerAttr                     SEPVersion                    `xml:"schemaVer,attr,omitempty"`
 	SupplyInterruptionOverride        []*SupplyInterruptionOverride `xml:"SupplyInterruptionOverride"`
 	SupplyInterruptionOverrideListr23 *Revision23Type               `xml:"SupplyInterruptionOverrideList_r2_3"`
-	*List
 }
 
 // CreditStatusType is 0 - Credit Ok
@@ -1284,6 +1516,8 @@ type CreditTypeChange struct {
 	NewType             *CreditTypeType `xml:"newType"`
 	StartTime           *TimeType       `xml:"startTime"`
 	CreditTypeChanger23 *Revision23Type `xml:"CreditTypeChange_r2_3"`
+	AnyAttr             []Attr          `xml:",any,attr"`
+	Any                 []*AnyElement   `xml:",any"`
 }
 
 // ServiceStatusType is 0 - Connected
@@ -1302,58 +1536,73 @@ type ServiceStatusType struct {
 // 1 = Cancelled
 // All other values reserved.
 type RequestStatus struct {
-	DateTime         *TimeType       `xml:"dateTime"`
-	RequestStatus    uint8           `xml:"requestStatus"`
-	RequestStatusr23 *Revision23Type `xml:"RequestStatus_r2_3"`
+	DateTime         *TimeType         `xml:"dateTime"`
+	RequestStatus    RequestStatusCode `xml:"requestStatus"`
+	RequestStatusr23 *Revision23Type   `xml:"RequestStatus_r2_3"`
+	AnyAttr          []Attr            `xml:",any,attr"`
+	Any              []*AnyElement     `xml:",any"`
 }
 
 // FlowReservationRequest is Indicates the sustained level of power, in Watts, that is requested. For charging this is calculated by the storage device and it represents the charging system capability (which for an electric vehicle must also account for any power limitations due to the EVSE control pilot). For discharging, a lower value than the inverter capability can be used as a target.
 type FlowReservationRequest struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns FlowReservationRequest"`
+	*IdentifiedObject
+	SchemaVerAttr             SEPVersion        `xml:"schemaVer,attr,omitempty"`
 	CreationTime              *TimeType         `xml:"creationTime"`
-	DurationRequested         uint16            `xml:"durationRequested"`
+	DurationRequested         *uint16           `xml:"durationRequested"`
 	EnergyRequested           *SignedRealEnergy `xml:"energyRequested"`
 	IntervalRequested         *DateTimeInterval `xml:"intervalRequested"`
 	PowerRequested            *ActivePower      `xml:"powerRequested"`
 	RequestStatus             *RequestStatus    `xml:"RequestStatus"`
 	FlowReservationRequestr23 *Revision23Type   `xml:"FlowReservationRequest_r2_3"`
-	*IdentifiedObject
 }
 
 // FlowReservationRequestList is A List element to hold FlowReservationRequest objects.
 type FlowReservationRequestList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns FlowReservationRequestList"`
+	*List
 	PollRateAttr                  uint32                    `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr                 SEPVersion                `xml:"schemaVer,attr,omitempty"`
 	FlowReservationRequest        []*FlowReservationRequest `xml:"FlowReservationRequest"`
 	FlowReservationRequestListr23 *Revision23Type           `xml:"FlowReservationRequestList_r2_3"`
-	*List
 }
 
 // FlowReservationResponse is The subject field provides a method to match the response with the originating event. It is populated with the mRID of the corresponding FlowReservationRequest object.
 type FlowReservationResponse struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns FlowReservationResponse"`
+	*Event
+	SchemaVerAttr              SEPVersion        `xml:"schemaVer,attr,omitempty"`
 	EnergyAvailable            *SignedRealEnergy `xml:"energyAvailable"`
 	PowerAvailable             *ActivePower      `xml:"powerAvailable"`
 	Subject                    *MRIDType         `xml:"subject"`
 	FlowReservationResponser23 *Revision23Type   `xml:"FlowReservationResponse_r2_3"`
-	*Event
 }
 
 // FlowReservationResponseList is A List element to hold FlowReservationResponse objects.
 type FlowReservationResponseList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns FlowReservationResponseList"`
+	*SubscribableList
 	PollRateAttr                   uint32                     `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr                  SEPVersion                 `xml:"schemaVer,attr,omitempty"`
 	FlowReservationResponse        []*FlowReservationResponse `xml:"FlowReservationResponse"`
 	FlowReservationResponseListr23 *Revision23Type            `xml:"FlowReservationResponseList_r2_3"`
-	*SubscribableList
 }
 
 // DERList is A List element to hold a DER object. More than one DER object SHALL NOT be included, but it should be noted that previous revisions of IEEE 2030.5 allowed more than one DER object. This single DER object represents the entire DER for the EndDevice and is the DER that acts upon DERControls. Components of this DER MAY be represented in the DERComponentList.
 type DERList struct {
-	PollRateAttr uint32          `xml:"pollRate,attr,omitempty"`
-	DER          []*DER          `xml:"DER"`
-	DERListr23   *Revision23Type `xml:"DERList_r2_3"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DERList"`
 	*List
+	PollRateAttr  uint32          `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr SEPVersion      `xml:"schemaVer,attr,omitempty"`
+	DER           []*DER          `xml:"DER"`
+	DERListr23    *Revision23Type `xml:"DERList_r2_3"`
 }
 
 // DER is Contains links to DER resources.
 type DER struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DER"`
+	*SubscribableResource
+	SchemaVerAttr                SEPVersion                    `xml:"schemaVer,attr,omitempty"`
 	AssociatedDERProgramListLink *AssociatedDERProgramListLink `xml:"AssociatedDERProgramListLink"`
 	AssociatedUsagePointLink     *AssociatedUsagePointLink     `xml:"AssociatedUsagePointLink"`
 	CurrentDERControlsLink       *CurrentDERControlsLink       `xml:"CurrentDERControlsLink"`
@@ -1364,15 +1613,17 @@ type DER struct {
 	DERSettingsLink              *DERSettingsLink              `xml:"DERSettingsLink"`
 	DERStatusLink                *DERStatusLink                `xml:"DERStatusLink"`
 	DERr23                       *Revision23Type               `xml:"DER_r2_3"`
-	*SubscribableResource
 }
 
 // CurrentDERControls is Specifies the time at which the CurrentDERControls information was last updated.
 type CurrentDERControls struct {
-	OpModConnect                bool                                  `xml:"opModConnect"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns CurrentDERControls"`
+	*SubscribableResource
+	SchemaVerAttr               SEPVersion                            `xml:"schemaVer,attr,omitempty"`
+	OpModConnect                *bool                                 `xml:"opModConnect"`
 	OpModDeltaVar               *ReactivePowerDeltaControlType        `xml:"opModDeltaVar"`
 	OpModDeltaW                 *ActivePowerDeltaControlType          `xml:"opModDeltaW"`
-	OpModEnergize               bool                                  `xml:"opModEnergize"`
+	OpModEnergize               *bool                                 `xml:"opModEnergize"`
 	OpModFixedPFAbsorbW         *PowerFactorWithExcitationControlType `xml:"opModFixedPFAbsorbW"`
 	OpModFixedPFInjectW         *PowerFactorWithExcitationControlType `xml:"opModFixedPFInjectW"`
 	OpModFixedV                 *SignedPerCentControlType             `xml:"opModFixedV"`
@@ -1380,13 +1631,13 @@ type CurrentDERControls struct {
 	OpModFixedW                 *SignedPerCentControlType             `xml:"opModFixedW"`
 	OpModFreqDroop              *FreqDroopType                        `xml:"opModFreqDroop"`
 	OpModFreqWatt               *DERCurveControlType                  `xml:"opModFreqWatt"`
-	OpModGridConnectPermit      bool                                  `xml:"opModGridConnectPermit"`
+	OpModGridConnectPermit      *bool                                 `xml:"opModGridConnectPermit"`
 	OpModHFRTMayTrip            *DERCurveControlType                  `xml:"opModHFRTMayTrip"`
 	OpModHFRTMustTrip           *DERCurveControlType                  `xml:"opModHFRTMustTrip"`
 	OpModHVRTMayTrip            *DERCurveControlType                  `xml:"opModHVRTMayTrip"`
 	OpModHVRTMomentaryCessation *DERCurveControlType                  `xml:"opModHVRTMomentaryCessation"`
 	OpModHVRTMustTrip           *DERCurveControlType                  `xml:"opModHVRTMustTrip"`
-	OpModIslandPermit           bool                                  `xml:"opModIslandPermit"`
+	OpModIslandPermit           *bool                                 `xml:"opModIslandPermit"`
 	OpModLFRTMayTrip            *DERCurveControlType                  `xml:"opModLFRTMayTrip"`
 	OpModLFRTMustTrip           *DERCurveControlType                  `xml:"opModLFRTMustTrip"`
 	OpModLVRTMayTrip            *DERCurveControlType                  `xml:"opModLVRTMayTrip"`
@@ -1411,38 +1662,44 @@ type CurrentDERControls struct {
 	OpModWattVar                *DERCurveControlType                  `xml:"opModWattVar"`
 	UpdatedTime                 *TimeType                             `xml:"updatedTime"`
 	CurrentDERControlsr23       *Revision23Type                       `xml:"CurrentDERControls_r2_3"`
-	*SubscribableResource
 }
 
 // DERComponentList is A List element to hold DERComponent resources. These DERComponents are components of their parent DER.
 type DERComponentList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DERComponentList"`
+	*List
+	SchemaVerAttr       SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	DERComponent        []*DERComponent `xml:"DERComponent"`
 	DERComponentListr23 *Revision23Type `xml:"DERComponentList_r2_3"`
-	*List
 }
 
 // DERComponentBase is DER and DERComponent common base.
 type DERComponentBase struct {
+	*SubscribableResource
 	AssociatedUsagePointLink *AssociatedUsagePointLink `xml:"AssociatedUsagePointLink"`
 	DERAvailabilityLink      *DERAvailabilityLink      `xml:"DERAvailabilityLink"`
 	DERCapabilityLink        *DERCapabilityLink        `xml:"DERCapabilityLink"`
 	DERSettingsLink          *DERSettingsLink          `xml:"DERSettingsLink"`
 	DERStatusLink            *DERStatusLink            `xml:"DERStatusLink"`
 	DERComponentBaser23      *Revision23Type           `xml:"DERComponentBase_r2_3"`
-	*SubscribableResource
 }
 
 // DERComponent is The LFDI of the DERComponent.
 type DERComponent struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DERComponent"`
+	*DERComponentBase
+	SchemaVerAttr   SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	LFDI            string          `xml:"lFDI"`
 	DERComponentr23 *Revision23Type `xml:"DERComponent_r2_3"`
-	*DERComponentBase
 }
 
 // DERAvailability is Estimated reserve active power for injection / delivery, in watts. This value is equal to (estimated maximum possible output at readingTime) - (current output at readingTime). Note that this value SHALL always be positive (defined as ActivePower for legacy reasons). Also note that "current output" is defined to be greater than or equal to zero (not negative).
 type DERAvailability struct {
-	AvailabilityDuration uint32                 `xml:"availabilityDuration"`
-	MaxChargeDuration    uint32                 `xml:"maxChargeDuration"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DERAvailability"`
+	*SubscribableResource
+	SchemaVerAttr        SEPVersion             `xml:"schemaVer,attr,omitempty"`
+	AvailabilityDuration *uint32                `xml:"availabilityDuration"`
+	MaxChargeDuration    *uint32                `xml:"maxChargeDuration"`
 	ReadingTime          *TimeType              `xml:"readingTime"`
 	ReserveChargePercent *PerCent               `xml:"reserveChargePercent"`
 	ReservePercent       *PerCent               `xml:"reservePercent"`
@@ -1451,14 +1708,16 @@ type DERAvailability struct {
 	StatWAbsorbAvail     *UnsignedActivePower   `xml:"statWAbsorbAvail"`
 	StatWAvail           *ActivePower           `xml:"statWAvail"`
 	DERAvailabilityr23   *Revision23Type        `xml:"DERAvailability_r2_3"`
-	*SubscribableResource
 }
 
 // DERCapability is Type of DER; see DERType object
 type DERCapability struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DERCapability"`
+	*Resource
+	SchemaVerAttr          SEPVersion           `xml:"schemaVer,attr,omitempty"`
 	ModesSupported         *DERControlType      `xml:"modesSupported"`
 	ModesSupported2        *DERControlType2     `xml:"modesSupported2"`
-	RtgAbnormalCategory    uint8                `xml:"rtgAbnormalCategory"`
+	RtgAbnormalCategory    *uint8               `xml:"rtgAbnormalCategory"`
 	RtgMaxA                *CurrentRMS          `xml:"rtgMaxA"`
 	RtgMaxAh               *AmpereHour          `xml:"rtgMaxAh"`
 	RtgMaxChargeRateVA     *ApparentPower       `xml:"rtgMaxChargeRateVA"`
@@ -1474,7 +1733,7 @@ type DERCapability struct {
 	RtgMinPFOverExcited    *PowerFactor         `xml:"rtgMinPFOverExcited"`
 	RtgMinPFUnderExcited   *PowerFactor         `xml:"rtgMinPFUnderExcited"`
 	RtgMinV                *VoltageRMS          `xml:"rtgMinV"`
-	RtgNormalCategory      uint8                `xml:"rtgNormalCategory"`
+	RtgNormalCategory      *uint8               `xml:"rtgNormalCategory"`
 	RtgOverExcitedPF       *PowerFactor         `xml:"rtgOverExcitedPF"`
 	RtgOverExcitedW        *ActivePower         `xml:"rtgOverExcitedW"`
 	RtgReactiveSusceptance *ReactiveSusceptance `xml:"rtgReactiveSusceptance"`
@@ -1483,20 +1742,22 @@ type DERCapability struct {
 	RtgVNom                *VoltageRMS          `xml:"rtgVNom"`
 	Type                   *DERType             `xml:"type"`
 	DERCapabilityr23       *Revision23Type      `xml:"DERCapability_r2_3"`
-	*Resource
 }
 
 // DERSettings is Specifies the time at which the DER information was last updated.
 type DERSettings struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DERSettings"`
+	*SubscribableResource
+	SchemaVerAttr         SEPVersion       `xml:"schemaVer,attr,omitempty"`
 	ModesEnabled          *DERControlType  `xml:"modesEnabled"`
 	ModesEnabled2         *DERControlType2 `xml:"modesEnabled2"`
-	SetESDelay            uint32           `xml:"setESDelay"`
-	SetESHighFreq         uint16           `xml:"setESHighFreq"`
-	SetESHighVolt         int16            `xml:"setESHighVolt"`
-	SetESLowFreq          uint16           `xml:"setESLowFreq"`
-	SetESLowVolt          int16            `xml:"setESLowVolt"`
-	SetESRampTms          uint32           `xml:"setESRampTms"`
-	SetESRandomDelay      uint32           `xml:"setESRandomDelay"`
+	SetESDelay            *uint32          `xml:"setESDelay"`
+	SetESHighFreq         *uint16          `xml:"setESHighFreq"`
+	SetESHighVolt         *int16           `xml:"setESHighVolt"`
+	SetESLowFreq          *uint16          `xml:"setESLowFreq"`
+	SetESLowVolt          *int16           `xml:"setESLowVolt"`
+	SetESRampTms          *uint32          `xml:"setESRampTms"`
+	SetESRandomDelay      *uint32          `xml:"setESRandomDelay"`
 	SetGradW              uint16           `xml:"setGradW"`
 	SetMaxA               *CurrentRMS      `xml:"setMaxA"`
 	SetMaxAh              *AmpereHour      `xml:"setMaxAh"`
@@ -1513,19 +1774,21 @@ type DERSettings struct {
 	SetMinPFOverExcited   *PowerFactor     `xml:"setMinPFOverExcited"`
 	SetMinPFUnderExcited  *PowerFactor     `xml:"setMinPFUnderExcited"`
 	SetMinV               *VoltageRMS      `xml:"setMinV"`
-	SetSoftGradW          uint16           `xml:"setSoftGradW"`
+	SetSoftGradW          *uint16          `xml:"setSoftGradW"`
 	SetVNom               *VoltageRMS      `xml:"setVNom"`
 	SetVRef               *VoltageRMS      `xml:"setVRef"`
 	SetVRefOfs            *VoltageRMS      `xml:"setVRefOfs"`
 	UpdatedTime           *TimeType        `xml:"updatedTime"`
 	DERSettingsr23        *Revision23Type  `xml:"DERSettings_r2_3"`
-	*SubscribableResource
 }
 
 // DERStatus is DEPRECATED
 // SHALL NOT be included, but note that it may be included by devices compliant with previous revisions of IEEE 2030.5.
 type DERStatus struct {
-	AlarmStatus            string                      `xml:"alarmStatus"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DERStatus"`
+	*SubscribableResource
+	SchemaVerAttr          SEPVersion                  `xml:"schemaVer,attr,omitempty"`
+	AlarmStatus            *string                     `xml:"alarmStatus"`
 	ConnectStatus          *ConnectStatusType2         `xml:"connectStatus"`
 	GenConnectStatus       *ConnectStatusType          `xml:"genConnectStatus"`
 	InverterStatus         *InverterStatusType         `xml:"inverterStatus"`
@@ -1537,34 +1800,37 @@ type DERStatus struct {
 	StorageModeStatus      *StorageModeStatusType      `xml:"storageModeStatus"`
 	StorConnectStatus      *ConnectStatusType          `xml:"storConnectStatus"`
 	DERStatusr23           *Revision23Type             `xml:"DERStatus_r2_3"`
-	*SubscribableResource
 }
 
 // DERProgramList is A List element to hold DERProgram objects.
 type DERProgramList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DERProgramList"`
+	*SubscribableList
 	PollRateAttr      uint32          `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr     SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	DERProgram        []*DERProgram   `xml:"DERProgram"`
 	DERProgramListr23 *Revision23Type `xml:"DERProgramList_r2_3"`
-	*SubscribableList
 }
 
 // DERProgram is Indicates the relative primacy of the provider of this Program.
 type DERProgram struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DERProgram"`
+	*SubscribableIdentifiedObject
+	SchemaVerAttr            SEPVersion                `xml:"schemaVer,attr,omitempty"`
 	ActiveDERControlListLink *ActiveDERControlListLink `xml:"ActiveDERControlListLink"`
 	DefaultDERControlLink    *DefaultDERControlLink    `xml:"DefaultDERControlLink"`
 	DERControlListLink       *DERControlListLink       `xml:"DERControlListLink"`
 	DERCurveListLink         *DERCurveListLink         `xml:"DERCurveListLink"`
 	Primacy                  *PrimacyType              `xml:"primacy"`
 	DERProgramr23            *Revision23Type           `xml:"DERProgram_r2_3"`
-	*SubscribableIdentifiedObject
 }
 
 // DERControlBase is Requested ramp time, in hundredths of a second, for the device to transition from the current DERControl Mode(s) to the new DERControl Mode(s). If absent, use default ramp rate (setGradW).  Resolution is 1/100 sec.
 type DERControlBase struct {
-	OpModConnect                bool                                  `xml:"opModConnect"`
+	OpModConnect                *bool                                 `xml:"opModConnect"`
 	OpModDeltaVar               *ReactivePowerDeltaControlType        `xml:"opModDeltaVar"`
 	OpModDeltaW                 *ActivePowerDeltaControlType          `xml:"opModDeltaW"`
-	OpModEnergize               bool                                  `xml:"opModEnergize"`
+	OpModEnergize               *bool                                 `xml:"opModEnergize"`
 	OpModFixedPFAbsorbW         *PowerFactorWithExcitationControlType `xml:"opModFixedPFAbsorbW"`
 	OpModFixedPFInjectW         *PowerFactorWithExcitationControlType `xml:"opModFixedPFInjectW"`
 	OpModFixedV                 *SignedPerCentControlType             `xml:"opModFixedV"`
@@ -1572,13 +1838,13 @@ type DERControlBase struct {
 	OpModFixedW                 *SignedPerCentControlType             `xml:"opModFixedW"`
 	OpModFreqDroop              *FreqDroopType                        `xml:"opModFreqDroop"`
 	OpModFreqWatt               *DERCurveLink                         `xml:"opModFreqWatt"`
-	OpModGridConnectPermit      bool                                  `xml:"opModGridConnectPermit"`
+	OpModGridConnectPermit      *bool                                 `xml:"opModGridConnectPermit"`
 	OpModHFRTMayTrip            *DERCurveLink                         `xml:"opModHFRTMayTrip"`
 	OpModHFRTMustTrip           *DERCurveLink                         `xml:"opModHFRTMustTrip"`
 	OpModHVRTMayTrip            *DERCurveLink                         `xml:"opModHVRTMayTrip"`
 	OpModHVRTMomentaryCessation *DERCurveLink                         `xml:"opModHVRTMomentaryCessation"`
 	OpModHVRTMustTrip           *DERCurveLink                         `xml:"opModHVRTMustTrip"`
-	OpModIslandPermit           bool                                  `xml:"opModIslandPermit"`
+	OpModIslandPermit           *bool                                 `xml:"opModIslandPermit"`
 	OpModLFRTMayTrip            *DERCurveLink                         `xml:"opModLFRTMayTrip"`
 	OpModLFRTMustTrip           *DERCurveLink                         `xml:"opModLFRTMustTrip"`
 	OpModLVRTMayTrip            *DERCurveLink                         `xml:"opModLVRTMayTrip"`
@@ -1601,81 +1867,96 @@ type DERControlBase struct {
 	OpModVoltWatt               *DERCurveLink                         `xml:"opModVoltWatt"`
 	OpModWattPF                 *DERCurveLink                         `xml:"opModWattPF"`
 	OpModWattVar                *DERCurveLink                         `xml:"opModWattVar"`
-	RampTms                     uint16                                `xml:"rampTms"`
+	RampTms                     *uint16                               `xml:"rampTms"`
 	DERControlBaser23           *Revision23Type                       `xml:"DERControlBase_r2_3"`
+	AnyAttr                     []Attr                                `xml:",any,attr"`
+	Any                         []*AnyElement                         `xml:",any"`
 }
 
 // DefaultDERControl is Specifies the time at which the DefaultDERControl was last updated. Provides an additional mechanism to mRID and version for clients to determine when a DefaultDERControl has been updated.
 type DefaultDERControl struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DefaultDERControl"`
+	*RespondableSubscribableIdentifiedObject
+	SchemaVerAttr        SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	DERControlBase       *DERControlBase `xml:"DERControlBase"`
-	SetESDelay           uint32          `xml:"setESDelay"`
-	SetESHighFreq        uint16          `xml:"setESHighFreq"`
-	SetESHighVolt        int16           `xml:"setESHighVolt"`
-	SetESLowFreq         uint16          `xml:"setESLowFreq"`
-	SetESLowVolt         int16           `xml:"setESLowVolt"`
-	SetESRampTms         uint32          `xml:"setESRampTms"`
-	SetESRandomDelay     uint32          `xml:"setESRandomDelay"`
-	SetGradW             uint16          `xml:"setGradW"`
-	SetSoftGradW         uint16          `xml:"setSoftGradW"`
+	SetESDelay           *uint32         `xml:"setESDelay"`
+	SetESHighFreq        *uint16         `xml:"setESHighFreq"`
+	SetESHighVolt        *int16          `xml:"setESHighVolt"`
+	SetESLowFreq         *uint16         `xml:"setESLowFreq"`
+	SetESLowVolt         *int16          `xml:"setESLowVolt"`
+	SetESRampTms         *uint32         `xml:"setESRampTms"`
+	SetESRandomDelay     *uint32         `xml:"setESRandomDelay"`
+	SetGradW             *uint16         `xml:"setGradW"`
+	SetSoftGradW         *uint16         `xml:"setSoftGradW"`
 	UpdatedTime          *TimeType       `xml:"updatedTime"`
 	DefaultDERControlr23 *Revision23Type `xml:"DefaultDERControl_r2_3"`
-	*RespondableSubscribableIdentifiedObject
 }
 
 // DERControlList is A List element to hold DERControl objects.
 type DERControlList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DERControlList"`
+	*SubscribableList
+	SchemaVerAttr     SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	DERControl        []*DERControl   `xml:"DERControl"`
 	DERControlListr23 *Revision23Type `xml:"DERControlList_r2_3"`
-	*SubscribableList
 }
 
 // DERControl is Specifies the bitmap indicating  the categories of devices that SHOULD respond. Devices SHOULD ignore events that do not indicate their device category. If not present, all devices SHOULD respond.
 type DERControl struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DERControl"`
+	*RandomizableEvent
+	SchemaVerAttr  SEPVersion          `xml:"schemaVer,attr,omitempty"`
 	DERControlBase *DERControlBase     `xml:"DERControlBase"`
 	DeviceCategory *DeviceCategoryType `xml:"deviceCategory"`
 	DERControlr23  *Revision23Type     `xml:"DERControl_r2_3"`
-	*RandomizableEvent
 }
 
 // DERCurveList is A List element to hold DERCurve objects.
 type DERCurveList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DERCurveList"`
+	*List
+	SchemaVerAttr   SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	DERCurve        []*DERCurve     `xml:"DERCurve"`
 	DERCurveListr23 *Revision23Type `xml:"DERCurveList_r2_3"`
-	*List
 }
 
 // DERCurve is The Y-axis units context.
 type DERCurve struct {
-	AutonomousVRefEnable       bool                      `xml:"autonomousVRefEnable"`
-	AutonomousVRefTimeConstant uint32                    `xml:"autonomousVRefTimeConstant"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns DERCurve"`
+	*IdentifiedObject
+	SchemaVerAttr              SEPVersion                `xml:"schemaVer,attr,omitempty"`
+	AutonomousVRefEnable       *bool                     `xml:"autonomousVRefEnable"`
+	AutonomousVRefTimeConstant *uint32                   `xml:"autonomousVRefTimeConstant"`
 	CreationTime               *TimeType                 `xml:"creationTime"`
 	CurveData                  []*CurveData              `xml:"CurveData"`
 	CurveType                  *DERCurveType             `xml:"curveType"`
-	OpenLoopTms                uint16                    `xml:"openLoopTms"`
-	RampDecTms                 uint16                    `xml:"rampDecTms"`
-	RampIncTms                 uint16                    `xml:"rampIncTms"`
-	RampPT1Tms                 uint16                    `xml:"rampPT1Tms"`
+	OpenLoopTms                *uint16                   `xml:"openLoopTms"`
+	RampDecTms                 *uint16                   `xml:"rampDecTms"`
+	RampIncTms                 *uint16                   `xml:"rampIncTms"`
+	RampPT1Tms                 *uint16                   `xml:"rampPT1Tms"`
 	VRef                       *PerCent                  `xml:"vRef"`
 	XMultiplier                *PowerOfTenMultiplierType `xml:"xMultiplier"`
 	YMultiplier                *PowerOfTenMultiplierType `xml:"yMultiplier"`
 	YRefType                   *DERUnitRefType           `xml:"yRefType"`
 	DERCurver23                *Revision23Type           `xml:"DERCurve_r2_3"`
-	*IdentifiedObject
 }
 
 // DERCurveControlType ...
 type DERCurveControlType struct {
+	XMLName xml.Name
+	*DERCurve
 	DisabledAttr           bool            `xml:"disabled,attr,omitempty"`
 	DERCurveControlTyper23 *Revision23Type `xml:"DERCurveControlType_r2_3"`
-	*DERCurve
 }
 
 // CurveData is The data value of the Y-axis (dependent) variable, depending on the curve type. See definitions in DERControlBase for further information. If yvalue is Power Factor, the excitation field SHALL be present and yvalue SHALL be a positive value. If yvalue is not Power Factor, the excitation field SHALL NOT be present.
 type CurveData struct {
-	Excitation   bool            `xml:"excitation"`
+	Excitation   *bool           `xml:"excitation"`
 	Xvalue       int             `xml:"xvalue"`
 	Yvalue       int             `xml:"yvalue"`
 	CurveDatar23 *Revision23Type `xml:"CurveData_r2_3"`
+	AnyAttr      []Attr          `xml:",any,attr"`
+	Any          []*AnyElement   `xml:",any"`
 }
 
 // DERCurveType is 0 - opModFreqWatt (Frequency-Watt Curve DERControl Mode)
@@ -1703,21 +1984,23 @@ type ActivePower struct {
 	Multiplier     *PowerOfTenMultiplierType `xml:"multiplier"`
 	Value          int16                     `xml:"value"`
 	ActivePowerr23 *Revision23Type           `xml:"ActivePower_r2_3"`
+	AnyAttr        []Attr                    `xml:",any,attr"`
+	Any            []*AnyElement             `xml:",any"`
 }
 
 // ActivePowerControlType ...
 type ActivePowerControlType struct {
+	*ActivePower
 	DisabledAttr              bool            `xml:"disabled,attr,omitempty"`
 	ActivePowerControlTyper23 *Revision23Type `xml:"ActivePowerControlType_r2_3"`
-	*ActivePower
 }
 
 // ActivePowerDeltaControlType ...
 type ActivePowerDeltaControlType struct {
+	*ActivePower
 	BidirectionalAttr              *UInt8          `xml:"bidirectional,attr,omitempty"`
 	DisabledAttr                   bool            `xml:"disabled,attr,omitempty"`
 	ActivePowerDeltaControlTyper23 *Revision23Type `xml:"ActivePowerDeltaControlType_r2_3"`
-	*ActivePower
 }
 
 // UnsignedActivePower is Value in watts (uom 38)
@@ -1725,13 +2008,15 @@ type UnsignedActivePower struct {
 	Multiplier             *PowerOfTenMultiplierType `xml:"multiplier"`
 	Value                  uint16                    `xml:"value"`
 	UnsignedActivePowerr23 *Revision23Type           `xml:"UnsignedActivePower_r2_3"`
+	AnyAttr                []Attr                    `xml:",any,attr"`
+	Any                    []*AnyElement             `xml:",any"`
 }
 
 // UnsignedActivePowerControlType ...
 type UnsignedActivePowerControlType struct {
+	*UnsignedActivePower
 	DisabledAttr                      bool            `xml:"disabled,attr,omitempty"`
 	UnsignedActivePowerControlTyper23 *Revision23Type `xml:"UnsignedActivePowerControlType_r2_3"`
-	*UnsignedActivePower
 }
 
 // AmpereHour is Value in ampere-hours (uom 106)
@@ -1739,6 +2024,8 @@ type AmpereHour struct {
 	Multiplier    *PowerOfTenMultiplierType `xml:"multiplier"`
 	Value         uint16                    `xml:"value"`
 	AmpereHourr23 *Revision23Type           `xml:"AmpereHour_r2_3"`
+	AnyAttr       []Attr                    `xml:",any,attr"`
+	Any           []*AnyElement             `xml:",any"`
 }
 
 // ApparentPower is Value in volt-amperes (uom 61)
@@ -1746,6 +2033,8 @@ type ApparentPower struct {
 	Multiplier       *PowerOfTenMultiplierType `xml:"multiplier"`
 	Value            uint16                    `xml:"value"`
 	ApparentPowerr23 *Revision23Type           `xml:"ApparentPower_r2_3"`
+	AnyAttr          []Attr                    `xml:",any,attr"`
+	Any              []*AnyElement             `xml:",any"`
 }
 
 // CurrentRMS is Value in amperes RMS (uom 5)
@@ -1753,6 +2042,8 @@ type CurrentRMS struct {
 	Multiplier    *PowerOfTenMultiplierType `xml:"multiplier"`
 	Value         uint16                    `xml:"value"`
 	CurrentRMSr23 *Revision23Type           `xml:"CurrentRMS_r2_3"`
+	AnyAttr       []Attr                    `xml:",any,attr"`
+	Any           []*AnyElement             `xml:",any"`
 }
 
 // FixedPointType is Dimensionless value
@@ -1760,6 +2051,8 @@ type FixedPointType struct {
 	Multiplier        *PowerOfTenMultiplierType `xml:"multiplier"`
 	Value             int16                     `xml:"value"`
 	FixedPointTyper23 *Revision23Type           `xml:"FixedPointType_r2_3"`
+	AnyAttr           []Attr                    `xml:",any,attr"`
+	Any               []*AnyElement             `xml:",any"`
 }
 
 // UnsignedFixedPointType is Dimensionless value
@@ -1767,6 +2060,8 @@ type UnsignedFixedPointType struct {
 	Multiplier                *PowerOfTenMultiplierType `xml:"multiplier"`
 	Value                     uint16                    `xml:"value"`
 	UnsignedFixedPointTyper23 *Revision23Type           `xml:"UnsignedFixedPointType_r2_3"`
+	AnyAttr                   []Attr                    `xml:",any,attr"`
+	Any                       []*AnyElement             `xml:",any"`
 }
 
 // FixedVar is Specify a signed setpoint for reactive power in % (see 'refType' for context).
@@ -1774,13 +2069,15 @@ type FixedVar struct {
 	RefType     *DERUnitRefType `xml:"refType"`
 	Value       *SignedPerCent  `xml:"value"`
 	FixedVarr23 *Revision23Type `xml:"FixedVar_r2_3"`
+	AnyAttr     []Attr          `xml:",any,attr"`
+	Any         []*AnyElement   `xml:",any"`
 }
 
 // FixedVarControlType ...
 type FixedVarControlType struct {
+	*FixedVar
 	DisabledAttr           bool            `xml:"disabled,attr,omitempty"`
 	FixedVarControlTyper23 *Revision23Type `xml:"FixedVarControlType_r2_3"`
-	*FixedVar
 }
 
 // UnsignedFixedVar is Specify an unsigned setpoint for reactive power in % (see 'refType' for context).
@@ -1788,13 +2085,15 @@ type UnsignedFixedVar struct {
 	RefType             *DERUnitRefType `xml:"refType"`
 	Value               *PerCent        `xml:"value"`
 	UnsignedFixedVarr23 *Revision23Type `xml:"UnsignedFixedVar_r2_3"`
+	AnyAttr             []Attr          `xml:",any,attr"`
+	Any                 []*AnyElement   `xml:",any"`
 }
 
 // UnsignedFixedVarControlType ...
 type UnsignedFixedVarControlType struct {
+	*UnsignedFixedVar
 	DisabledAttr                   bool            `xml:"disabled,attr,omitempty"`
 	UnsignedFixedVarControlTyper23 *Revision23Type `xml:"UnsignedFixedVarControlType_r2_3"`
-	*UnsignedFixedVar
 }
 
 // FreqDroopType is If present, specifies the minimum active power output. Used, for example, for testing purposes to direct a device to be able to absorb active power.
@@ -1807,12 +2106,16 @@ type FreqDroopType struct {
 	OpenLoopTms      uint16          `xml:"openLoopTms"`
 	PMin             *ActivePower    `xml:"pMin"`
 	FreqDroopTyper23 *Revision23Type `xml:"FreqDroopType_r2_3"`
+	AnyAttr          []Attr          `xml:",any,attr"`
+	Any              []*AnyElement   `xml:",any"`
 }
 
 // PerCentControlType ...
 type PerCentControlType struct {
-	DisabledAttr bool `xml:"disabled,attr,omitempty"`
 	*PerCent
+	DisabledAttr bool          `xml:"disabled,attr,omitempty"`
+	AnyAttr      []Attr        `xml:",any,attr"`
+	Any          []*AnyElement `xml:",any"`
 }
 
 // PowerFactor is Specifies exponent of 'displacement'.
@@ -1820,6 +2123,8 @@ type PowerFactor struct {
 	Displacement   uint16                    `xml:"displacement"`
 	Multiplier     *PowerOfTenMultiplierType `xml:"multiplier"`
 	PowerFactorr23 *Revision23Type           `xml:"PowerFactor_r2_3"`
+	AnyAttr        []Attr                    `xml:",any,attr"`
+	Any            []*AnyElement             `xml:",any"`
 }
 
 // PowerFactorWithExcitation is Specifies exponent of 'displacement'.
@@ -1828,13 +2133,15 @@ type PowerFactorWithExcitation struct {
 	Excitation                   bool                      `xml:"excitation"`
 	Multiplier                   *PowerOfTenMultiplierType `xml:"multiplier"`
 	PowerFactorWithExcitationr23 *Revision23Type           `xml:"PowerFactorWithExcitation_r2_3"`
+	AnyAttr                      []Attr                    `xml:",any,attr"`
+	Any                          []*AnyElement             `xml:",any"`
 }
 
 // PowerFactorWithExcitationControlType ...
 type PowerFactorWithExcitationControlType struct {
+	*PowerFactorWithExcitation
 	DisabledAttr                            bool            `xml:"disabled,attr,omitempty"`
 	PowerFactorWithExcitationControlTyper23 *Revision23Type `xml:"PowerFactorWithExcitationControlType_r2_3"`
-	*PowerFactorWithExcitation
 }
 
 // ReactivePower is Value in volt-amperes reactive (var) (uom 63)
@@ -1842,21 +2149,23 @@ type ReactivePower struct {
 	Multiplier       *PowerOfTenMultiplierType `xml:"multiplier"`
 	Value            int16                     `xml:"value"`
 	ReactivePowerr23 *Revision23Type           `xml:"ReactivePower_r2_3"`
+	AnyAttr          []Attr                    `xml:",any,attr"`
+	Any              []*AnyElement             `xml:",any"`
 }
 
 // ReactivePowerControlType ...
 type ReactivePowerControlType struct {
+	*ReactivePower
 	DisabledAttr                bool            `xml:"disabled,attr,omitempty"`
 	ReactivePowerControlTyper23 *Revision23Type `xml:"ReactivePowerControlType_r2_3"`
-	*ReactivePower
 }
 
 // ReactivePowerDeltaControlType ...
 type ReactivePowerDeltaControlType struct {
+	*ReactivePower
 	BidirectionalAttr                *UInt8          `xml:"bidirectional,attr,omitempty"`
 	DisabledAttr                     bool            `xml:"disabled,attr,omitempty"`
 	ReactivePowerDeltaControlTyper23 *Revision23Type `xml:"ReactivePowerDeltaControlType_r2_3"`
-	*ReactivePower
 }
 
 // UnsignedReactivePower is Value in volt-amperes reactive (var) (uom 63)
@@ -1864,13 +2173,15 @@ type UnsignedReactivePower struct {
 	Multiplier               *PowerOfTenMultiplierType `xml:"multiplier"`
 	Value                    uint16                    `xml:"value"`
 	UnsignedReactivePowerr23 *Revision23Type           `xml:"UnsignedReactivePower_r2_3"`
+	AnyAttr                  []Attr                    `xml:",any,attr"`
+	Any                      []*AnyElement             `xml:",any"`
 }
 
 // UnsignedReactivePowerControlType ...
 type UnsignedReactivePowerControlType struct {
+	*UnsignedReactivePower
 	DisabledAttr                        bool            `xml:"disabled,attr,omitempty"`
 	UnsignedReactivePowerControlTyper23 *Revision23Type `xml:"UnsignedReactivePowerControlType_r2_3"`
-	*UnsignedReactivePower
 }
 
 // ReactiveSusceptance is Value in siemens (uom 53)
@@ -1878,12 +2189,16 @@ type ReactiveSusceptance struct {
 	Multiplier             *PowerOfTenMultiplierType `xml:"multiplier"`
 	Value                  uint16                    `xml:"value"`
 	ReactiveSusceptancer23 *Revision23Type           `xml:"ReactiveSusceptance_r2_3"`
+	AnyAttr                []Attr                    `xml:",any,attr"`
+	Any                    []*AnyElement             `xml:",any"`
 }
 
 // SignedPerCentControlType ...
 type SignedPerCentControlType struct {
-	DisabledAttr bool `xml:"disabled,attr,omitempty"`
 	*SignedPerCent
+	DisabledAttr bool          `xml:"disabled,attr,omitempty"`
+	AnyAttr      []Attr        `xml:",any,attr"`
+	Any          []*AnyElement `xml:",any"`
 }
 
 // VoltageRMS is Value in volts RMS (uom 29)
@@ -1891,13 +2206,15 @@ type VoltageRMS struct {
 	Multiplier    *PowerOfTenMultiplierType `xml:"multiplier"`
 	Value         uint16                    `xml:"value"`
 	VoltageRMSr23 *Revision23Type           `xml:"VoltageRMS_r2_3"`
+	AnyAttr       []Attr                    `xml:",any,attr"`
+	Any           []*AnyElement             `xml:",any"`
 }
 
 // VoltageRMSControlType ...
 type VoltageRMSControlType struct {
+	*VoltageRMS
 	DisabledAttr             bool            `xml:"disabled,attr,omitempty"`
 	VoltageRMSControlTyper23 *Revision23Type `xml:"VoltageRMSControlType_r2_3"`
-	*VoltageRMS
 }
 
 // WattHour is Value in watt-hours (uom 72)
@@ -1905,6 +2222,8 @@ type WattHour struct {
 	Multiplier  *PowerOfTenMultiplierType `xml:"multiplier"`
 	Value       uint16                    `xml:"value"`
 	WattHourr23 *Revision23Type           `xml:"WattHour_r2_3"`
+	AnyAttr     []Attr                    `xml:",any,attr"`
+	Any         []*AnyElement             `xml:",any"`
 }
 
 // ConnectStatusType is The value indicating the state.
@@ -1912,6 +2231,8 @@ type ConnectStatusType struct {
 	DateTime             *TimeType       `xml:"dateTime"`
 	Value                string          `xml:"value"`
 	ConnectStatusTyper23 *Revision23Type `xml:"ConnectStatusType_r2_3"`
+	AnyAttr              []Attr          `xml:",any,attr"`
+	Any                  []*AnyElement   `xml:",any"`
 }
 
 // ConnectStatusType2 is The value indicating the state.
@@ -1919,6 +2240,8 @@ type ConnectStatusType2 struct {
 	DateTime              *TimeType       `xml:"dateTime"`
 	Value                 string          `xml:"value"`
 	ConnectStatusType2r23 *Revision23Type `xml:"ConnectStatusType2_r2_3"`
+	AnyAttr               []Attr          `xml:",any,attr"`
+	Any                   []*AnyElement   `xml:",any"`
 }
 
 // DefaultDERControlType is DefaultDERControl elements. Bit positions SHALL be defined as follows:
@@ -2042,8 +2365,10 @@ type DERUnitRefType struct {
 // InverterStatusType is The value indicating the state.
 type InverterStatusType struct {
 	DateTime              *TimeType       `xml:"dateTime"`
-	Value                 uint8           `xml:"value"`
+	Value                 InverterStatus  `xml:"value"`
 	InverterStatusTyper23 *Revision23Type `xml:"InverterStatusType_r2_3"`
+	AnyAttr               []Attr          `xml:",any,attr"`
+	Any                   []*AnyElement   `xml:",any"`
 }
 
 // LocalControlModeStatusType is The value indicating the state.
@@ -2051,6 +2376,8 @@ type LocalControlModeStatusType struct {
 	DateTime                      *TimeType       `xml:"dateTime"`
 	Value                         uint8           `xml:"value"`
 	LocalControlModeStatusTyper23 *Revision23Type `xml:"LocalControlModeStatusType_r2_3"`
+	AnyAttr                       []Attr          `xml:",any,attr"`
+	Any                           []*AnyElement   `xml:",any"`
 }
 
 // ManufacturerStatusType is The value indicating the state.
@@ -2058,6 +2385,8 @@ type ManufacturerStatusType struct {
 	DateTime                  *TimeType       `xml:"dateTime"`
 	Value                     string          `xml:"value"`
 	ManufacturerStatusTyper23 *Revision23Type `xml:"ManufacturerStatusType_r2_3"`
+	AnyAttr                   []Attr          `xml:",any,attr"`
+	Any                       []*AnyElement   `xml:",any"`
 }
 
 // OperationalModeStatusType is The value indicating the state.
@@ -2065,6 +2394,8 @@ type OperationalModeStatusType struct {
 	DateTime                     *TimeType       `xml:"dateTime"`
 	Value                        uint8           `xml:"value"`
 	OperationalModeStatusTyper23 *Revision23Type `xml:"OperationalModeStatusType_r2_3"`
+	AnyAttr                      []Attr          `xml:",any,attr"`
+	Any                          []*AnyElement   `xml:",any"`
 }
 
 // StateOfChargeStatusType is The value indicating the state.
@@ -2072,6 +2403,8 @@ type StateOfChargeStatusType struct {
 	DateTime                   *TimeType       `xml:"dateTime"`
 	Value                      *PerCent        `xml:"value"`
 	StateOfChargeStatusTyper23 *Revision23Type `xml:"StateOfChargeStatusType_r2_3"`
+	AnyAttr                    []Attr          `xml:",any,attr"`
+	Any                        []*AnyElement   `xml:",any"`
 }
 
 // StorageModeStatusType is The value indicating the state.
@@ -2079,45 +2412,55 @@ type StorageModeStatusType struct {
 	DateTime                 *TimeType       `xml:"dateTime"`
 	Value                    uint8           `xml:"value"`
 	StorageModeStatusTyper23 *Revision23Type `xml:"StorageModeStatusType_r2_3"`
+	AnyAttr                  []Attr          `xml:",any,attr"`
+	Any                      []*AnyElement   `xml:",any"`
 }
 
 // CurrentDERProgramLink is DEPRECATED
 // SHALL NOT be included by servers, but clients should note that it may be included by servers compliant with previous revisions of IEEE 2030.5.
 type CurrentDERProgramLink struct {
-	CurrentDERProgramLinkr23 *Revision23Type `xml:"CurrentDERProgramLink_r2_3"`
 	*Link
+	CurrentDERProgramLinkr23 *Revision23Type `xml:"CurrentDERProgramLink_r2_3"`
 }
 
 // AggregationPriority is Contains the order in which an aggregation with a priority distribution is to be prioritized. If an aggregation has a distribution of Priority, then this resource SHALL be present. If an aggregation does not have a distribution of Priority, then this resource SHALL NOT be present. PriorityData SHALL be listed in order of priority, with the highest priority listed first. Note that if there are a large number of PriorityData, then  this resource could grow large. Devices SHOULD use Range / Content-Range for transferring large resources as well as HTTP HEAD or other HTTP mechanisms to determine the size of the resource.
 type AggregationPriority struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns AggregationPriority"`
+	*IdentifiedObject
+	SchemaVerAttr          SEPVersion      `xml:"schemaVer,attr,omitempty"`
 	PriorityData           []*PriorityData `xml:"PriorityData"`
 	AggregationPriorityr23 *Revision23Type `xml:"AggregationPriority_r2_3"`
-	*IdentifiedObject
 }
 
 // PriorityData is Contains an instance identifying data with which to prioritize an aggregation with a priority distribution.
 type PriorityData struct {
 	LFDI            string          `xml:"lFDI"`
 	PriorityDatar23 *Revision23Type `xml:"PriorityData_r2_3"`
+	AnyAttr         []Attr          `xml:",any,attr"`
+	Any             []*AnyElement   `xml:",any"`
 }
 
 // AggregatedDeviceList is A List element to hold AggregatedDevice objects.
 type AggregatedDeviceList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns AggregatedDeviceList"`
+	*SubscribableList
 	PollRateAttr            uint32              `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr           SEPVersion          `xml:"schemaVer,attr,omitempty"`
 	AggregatedDevice        []*AggregatedDevice `xml:"AggregatedDevice"`
 	AggregatedDeviceListr23 *Revision23Type     `xml:"AggregatedDeviceList_r2_3"`
-	*SubscribableList
 }
 
 // AggregatedDevice is Long form of device identifier. See the Security section for additional details.
 type AggregatedDevice struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns AggregatedDevice"`
+	*Resource
+	SchemaVerAttr       SEPVersion          `xml:"schemaVer,attr,omitempty"`
 	ChangedTime         *TimeType           `xml:"changedTime"`
 	DeviceCategory      *DeviceCategoryType `xml:"deviceCategory"`
-	Enabled             bool                `xml:"enabled"`
+	Enabled             *bool               `xml:"enabled"`
 	LFDI                string              `xml:"lFDI"`
 	SFDI                *SFDIType           `xml:"sFDI"`
 	AggregatedDevicer23 *Revision23Type     `xml:"AggregatedDevice_r2_3"`
-	*Resource
 }
 
 // AggregationDistributionType is Specifies how to distribute a control across the population of aggregated devices to achieve the specified total:
@@ -2132,685 +2475,693 @@ type AggregationDistributionType struct {
 
 // ProxiedDevice is Asset container that performs one or more end device functions. Contains information about individual devices that are proxied by another device.
 type ProxiedDevice struct {
-	ProxiedDevicer23 *Revision23Type `xml:"ProxiedDevice_r2_3"`
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns ProxiedDevice"`
 	*ExternalDevice
+	SchemaVerAttr    SEPVersion      `xml:"schemaVer,attr,omitempty"`
+	ProxiedDevicer23 *Revision23Type `xml:"ProxiedDevice_r2_3"`
 }
 
 // ProxiedDeviceList is A List element to hold ProxiedDevice objects.
 type ProxiedDeviceList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns ProxiedDeviceList"`
+	*SubscribableList
 	PollRateAttr         uint32           `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr        SEPVersion       `xml:"schemaVer,attr,omitempty"`
 	ProxiedDevice        []*ProxiedDevice `xml:"ProxiedDevice"`
 	ProxiedDeviceListr23 *Revision23Type  `xml:"ProxiedDeviceList_r2_3"`
-	*SubscribableList
 }
 
 // AccountBalanceLink is SHALL contain a Link to an instance of AccountBalance.
 type AccountBalanceLink struct {
-	AccountBalanceLinkr23 *Revision23Type `xml:"AccountBalanceLink_r2_3"`
 	*Link
+	AccountBalanceLinkr23 *Revision23Type `xml:"AccountBalanceLink_r2_3"`
 }
 
 // AggregatedDeviceListLink is SHALL contain a Link to a List of AggregatedDevice instances.
 // An AbstractDevice (and its derivatives) MAY be an aggregation of multiple assets. If so, it MAY contain an AggregatedDeviceList.
 type AggregatedDeviceListLink struct {
-	AggregatedDeviceListLinkr23 *Revision23Type `xml:"AggregatedDeviceListLink_r2_3"`
 	*ListLink
+	AggregatedDeviceListLinkr23 *Revision23Type `xml:"AggregatedDeviceListLink_r2_3"`
 }
 
 // AggregationPriorityLink is SHALL contain a Link to an instance of AggregationPriority. If present, this resource contains the order in which an aggregation with a priority distribution is to be prioritized.
 type AggregationPriorityLink struct {
-	AggregationPriorityLinkr23 *Revision23Type `xml:"AggregationPriorityLink_r2_3"`
 	*Link
+	AggregationPriorityLinkr23 *Revision23Type `xml:"AggregationPriorityLink_r2_3"`
 }
 
 // AssociatedDERProgramListLink is SHALL contain a Link to a List of DERPrograms having the DERControl(s) for this DER.
 type AssociatedDERProgramListLink struct {
-	AssociatedDERProgramListLinkr23 *Revision23Type `xml:"AssociatedDERProgramListLink_r2_3"`
 	*ListLink
+	AssociatedDERProgramListLinkr23 *Revision23Type `xml:"AssociatedDERProgramListLink_r2_3"`
 }
 
 // AssociatedUsagePointLink is SHALL contain a Link to an instance of UsagePoint.  If present, this is the submeter that monitors the DER output. This is also the point of reference, or reference point of applicability, for voltage, limits, controls, etc.
 type AssociatedUsagePointLink struct {
-	AssociatedUsagePointLinkr23 *Revision23Type `xml:"AssociatedUsagePointLink_r2_3"`
 	*Link
+	AssociatedUsagePointLinkr23 *Revision23Type `xml:"AssociatedUsagePointLink_r2_3"`
 }
 
 // BillingPeriodListLink is SHALL contain a Link to a List of BillingPeriod instances.
 type BillingPeriodListLink struct {
-	BillingPeriodListLinkr23 *Revision23Type `xml:"BillingPeriodListLink_r2_3"`
 	*ListLink
+	BillingPeriodListLinkr23 *Revision23Type `xml:"BillingPeriodListLink_r2_3"`
 }
 
 // BillingReadingListLink is SHALL contain a Link to a List of BillingReading instances.
 type BillingReadingListLink struct {
-	BillingReadingListLinkr23 *Revision23Type `xml:"BillingReadingListLink_r2_3"`
 	*ListLink
+	BillingReadingListLinkr23 *Revision23Type `xml:"BillingReadingListLink_r2_3"`
 }
 
 // BillingReadingSetListLink is SHALL contain a Link to a List of BillingReadingSet instances.
 type BillingReadingSetListLink struct {
-	BillingReadingSetListLinkr23 *Revision23Type `xml:"BillingReadingSetListLink_r2_3"`
 	*ListLink
+	BillingReadingSetListLinkr23 *Revision23Type `xml:"BillingReadingSetListLink_r2_3"`
 }
 
 // ConfigurationLink is SHALL contain a Link to an instance of Configuration.
 type ConfigurationLink struct {
-	ConfigurationLinkr23 *Revision23Type `xml:"ConfigurationLink_r2_3"`
 	*Link
+	ConfigurationLinkr23 *Revision23Type `xml:"ConfigurationLink_r2_3"`
 }
 
 // ConsumptionTariffIntervalListLink is SHALL contain a Link to a List of ConsumptionTariffInterval instances.
 type ConsumptionTariffIntervalListLink struct {
-	ConsumptionTariffIntervalListLinkr23 *Revision23Type `xml:"ConsumptionTariffIntervalListLink_r2_3"`
 	*ListLink
+	ConsumptionTariffIntervalListLinkr23 *Revision23Type `xml:"ConsumptionTariffIntervalListLink_r2_3"`
 }
 
 // CreditRegisterListLink is SHALL contain a Link to a List of CreditRegister instances.
 type CreditRegisterListLink struct {
-	CreditRegisterListLinkr23 *Revision23Type `xml:"CreditRegisterListLink_r2_3"`
 	*ListLink
+	CreditRegisterListLinkr23 *Revision23Type `xml:"CreditRegisterListLink_r2_3"`
 }
 
 // CurrentDERControlsLink is SHALL contain a Link to the CurrentDERControls for this DER.
 type CurrentDERControlsLink struct {
-	CurrentDERControlsLinkr23 *Revision23Type `xml:"CurrentDERControlsLink_r2_3"`
 	*Link
+	CurrentDERControlsLinkr23 *Revision23Type `xml:"CurrentDERControlsLink_r2_3"`
 }
 
 // CustomerAccountLink is SHALL contain a Link to an instance of CustomerAccount.
 type CustomerAccountLink struct {
-	CustomerAccountLinkr23 *Revision23Type `xml:"CustomerAccountLink_r2_3"`
 	*Link
+	CustomerAccountLinkr23 *Revision23Type `xml:"CustomerAccountLink_r2_3"`
 }
 
 // CustomerAccountListLink is SHALL contain a Link to a List of CustomerAccount instances.
 type CustomerAccountListLink struct {
-	CustomerAccountListLinkr23 *Revision23Type `xml:"CustomerAccountListLink_r2_3"`
 	*ListLink
+	CustomerAccountListLinkr23 *Revision23Type `xml:"CustomerAccountListLink_r2_3"`
 }
 
 // CustomerAgreementListLink is SHALL contain a Link to a List of CustomerAgreement instances.
 type CustomerAgreementListLink struct {
-	CustomerAgreementListLinkr23 *Revision23Type `xml:"CustomerAgreementListLink_r2_3"`
 	*ListLink
+	CustomerAgreementListLinkr23 *Revision23Type `xml:"CustomerAgreementListLink_r2_3"`
 }
 
 // DefaultDERControlLink is SHALL contain a Link to an instance of DefaultDERControl containing the default DERControl Mode(s) of the DER which MAY be overridden by DERControl events.
 type DefaultDERControlLink struct {
-	DefaultDERControlLinkr23 *Revision23Type `xml:"DefaultDERControlLink_r2_3"`
 	*Link
+	DefaultDERControlLinkr23 *Revision23Type `xml:"DefaultDERControlLink_r2_3"`
 }
 
 // DemandResponseProgramLink is SHALL contain a Link to an instance of DemandResponseProgram.
 type DemandResponseProgramLink struct {
-	DemandResponseProgramLinkr23 *Revision23Type `xml:"DemandResponseProgramLink_r2_3"`
 	*Link
+	DemandResponseProgramLinkr23 *Revision23Type `xml:"DemandResponseProgramLink_r2_3"`
 }
 
 // DemandResponseProgramListLink is SHALL contain a Link to a List of DemandResponseProgram instances.
 type DemandResponseProgramListLink struct {
-	DemandResponseProgramListLinkr23 *Revision23Type `xml:"DemandResponseProgramListLink_r2_3"`
 	*ListLink
+	DemandResponseProgramListLinkr23 *Revision23Type `xml:"DemandResponseProgramListLink_r2_3"`
 }
 
 // DERAvailabilityLink is SHALL contain a Link to an instance of DERAvailability.
 type DERAvailabilityLink struct {
-	DERAvailabilityLinkr23 *Revision23Type `xml:"DERAvailabilityLink_r2_3"`
 	*Link
+	DERAvailabilityLinkr23 *Revision23Type `xml:"DERAvailabilityLink_r2_3"`
 }
 
 // DERCapabilityLink is SHALL contain a Link to an instance of DERCapability.
 type DERCapabilityLink struct {
-	DERCapabilityLinkr23 *Revision23Type `xml:"DERCapabilityLink_r2_3"`
 	*Link
+	DERCapabilityLinkr23 *Revision23Type `xml:"DERCapabilityLink_r2_3"`
 }
 
 // DERComponentListLink is SHALL contain a Link to a List of DERComponent instances.
 type DERComponentListLink struct {
-	DERComponentListLinkr23 *Revision23Type `xml:"DERComponentListLink_r2_3"`
 	*ListLink
+	DERComponentListLinkr23 *Revision23Type `xml:"DERComponentListLink_r2_3"`
 }
 
 // DERControlListLink is SHALL contain a Link to a List of DERControl instances.
 type DERControlListLink struct {
-	DERControlListLinkr23 *Revision23Type `xml:"DERControlListLink_r2_3"`
 	*ListLink
+	DERControlListLinkr23 *Revision23Type `xml:"DERControlListLink_r2_3"`
 }
 
 // DERCurveLink is SHALL contain a Link to an instance of DERCurve.
 type DERCurveLink struct {
+	*Link
 	DisabledAttr    bool            `xml:"disabled,attr,omitempty"`
 	DERCurveLinkr23 *Revision23Type `xml:"DERCurveLink_r2_3"`
-	*Link
 }
 
 // DERCurveListLink is SHALL contain a Link to a List of DERCurve instances.
 type DERCurveListLink struct {
-	DERCurveListLinkr23 *Revision23Type `xml:"DERCurveListLink_r2_3"`
 	*ListLink
+	DERCurveListLinkr23 *Revision23Type `xml:"DERCurveListLink_r2_3"`
 }
 
 // DERLink is SHALL contain a Link to an instance of DER.
 type DERLink struct {
-	DERLinkr23 *Revision23Type `xml:"DERLink_r2_3"`
 	*Link
+	DERLinkr23 *Revision23Type `xml:"DERLink_r2_3"`
 }
 
 // DERListLink is SHALL contain a Link to a List of DER instances.
 type DERListLink struct {
-	DERListLinkr23 *Revision23Type `xml:"DERListLink_r2_3"`
 	*ListLink
+	DERListLinkr23 *Revision23Type `xml:"DERListLink_r2_3"`
 }
 
 // DERProgramLink is SHALL contain a Link to an instance of DERProgram.
 type DERProgramLink struct {
-	DERProgramLinkr23 *Revision23Type `xml:"DERProgramLink_r2_3"`
 	*Link
+	DERProgramLinkr23 *Revision23Type `xml:"DERProgramLink_r2_3"`
 }
 
 // DERProgramListLink is SHALL contain a Link to a List of DERProgram instances.
 type DERProgramListLink struct {
-	DERProgramListLinkr23 *Revision23Type `xml:"DERProgramListLink_r2_3"`
 	*ListLink
+	DERProgramListLinkr23 *Revision23Type `xml:"DERProgramListLink_r2_3"`
 }
 
 // DERSettingsLink is SHALL contain a Link to an instance of DERSettings.
 type DERSettingsLink struct {
-	DERSettingsLinkr23 *Revision23Type `xml:"DERSettingsLink_r2_3"`
 	*Link
+	DERSettingsLinkr23 *Revision23Type `xml:"DERSettingsLink_r2_3"`
 }
 
 // DERStatusLink is SHALL contain a Link to an instance of DERStatus.
 type DERStatusLink struct {
-	DERStatusLinkr23 *Revision23Type `xml:"DERStatusLink_r2_3"`
 	*Link
+	DERStatusLinkr23 *Revision23Type `xml:"DERStatusLink_r2_3"`
 }
 
 // DeviceCapabilityLink is SHALL contain a Link to an instance of DeviceCapability.
 type DeviceCapabilityLink struct {
-	DeviceCapabilityLinkr23 *Revision23Type `xml:"DeviceCapabilityLink_r2_3"`
 	*Link
+	DeviceCapabilityLinkr23 *Revision23Type `xml:"DeviceCapabilityLink_r2_3"`
 }
 
 // DeviceInformationLink is SHALL contain a Link to an instance of DeviceInformation.
 type DeviceInformationLink struct {
-	DeviceInformationLinkr23 *Revision23Type `xml:"DeviceInformationLink_r2_3"`
 	*Link
+	DeviceInformationLinkr23 *Revision23Type `xml:"DeviceInformationLink_r2_3"`
 }
 
 // DeviceStatusLink is SHALL contain a Link to an instance of DeviceStatus.
 type DeviceStatusLink struct {
-	DeviceStatusLinkr23 *Revision23Type `xml:"DeviceStatusLink_r2_3"`
 	*Link
+	DeviceStatusLinkr23 *Revision23Type `xml:"DeviceStatusLink_r2_3"`
 }
 
 // EndDeviceControlListLink is SHALL contain a Link to a List of EndDeviceControl instances.
 type EndDeviceControlListLink struct {
-	EndDeviceControlListLinkr23 *Revision23Type `xml:"EndDeviceControlListLink_r2_3"`
 	*ListLink
+	EndDeviceControlListLinkr23 *Revision23Type `xml:"EndDeviceControlListLink_r2_3"`
 }
 
 // EndDeviceLink is SHALL contain a Link to an instance of EndDevice.
 type EndDeviceLink struct {
-	EndDeviceLinkr23 *Revision23Type `xml:"EndDeviceLink_r2_3"`
 	*Link
+	EndDeviceLinkr23 *Revision23Type `xml:"EndDeviceLink_r2_3"`
 }
 
 // EndDeviceListLink is SHALL contain a Link to a List of EndDevice instances.
 type EndDeviceListLink struct {
-	EndDeviceListLinkr23 *Revision23Type `xml:"EndDeviceListLink_r2_3"`
 	*ListLink
+	EndDeviceListLinkr23 *Revision23Type `xml:"EndDeviceListLink_r2_3"`
 }
 
 // FileLink is This element SHALL be set to the URI of the most recent File being loaded/activated by the LD. In the case of file status 0, this element SHALL be omitted.
 type FileLink struct {
-	FileLinkr23 *Revision23Type `xml:"FileLink_r2_3"`
 	*Link
+	FileLinkr23 *Revision23Type `xml:"FileLink_r2_3"`
 }
 
 // FileListLink is SHALL contain a Link to a List of File instances.
 type FileListLink struct {
-	FileListLinkr23 *Revision23Type `xml:"FileListLink_r2_3"`
 	*ListLink
+	FileListLinkr23 *Revision23Type `xml:"FileListLink_r2_3"`
 }
 
 // FileStatusLink is SHALL contain a Link to an instance of FileStatus.
 type FileStatusLink struct {
-	FileStatusLinkr23 *Revision23Type `xml:"FileStatusLink_r2_3"`
 	*Link
+	FileStatusLinkr23 *Revision23Type `xml:"FileStatusLink_r2_3"`
 }
 
 // FlowReservationRequestListLink is SHALL contain a Link to a List of FlowReservationRequest instances.
 type FlowReservationRequestListLink struct {
-	FlowReservationRequestListLinkr23 *Revision23Type `xml:"FlowReservationRequestListLink_r2_3"`
 	*ListLink
+	FlowReservationRequestListLinkr23 *Revision23Type `xml:"FlowReservationRequestListLink_r2_3"`
 }
 
 // FlowReservationResponseListLink is SHALL contain a Link to a List of FlowReservationResponse instances.
 type FlowReservationResponseListLink struct {
-	FlowReservationResponseListLinkr23 *Revision23Type `xml:"FlowReservationResponseListLink_r2_3"`
 	*ListLink
+	FlowReservationResponseListLinkr23 *Revision23Type `xml:"FlowReservationResponseListLink_r2_3"`
 }
 
 // FunctionSetAssignmentsListLink is SHALL contain a Link to a List of FunctionSetAssignments instances.
 type FunctionSetAssignmentsListLink struct {
-	FunctionSetAssignmentsListLinkr23 *Revision23Type `xml:"FunctionSetAssignmentsListLink_r2_3"`
 	*ListLink
+	FunctionSetAssignmentsListLinkr23 *Revision23Type `xml:"FunctionSetAssignmentsListLink_r2_3"`
 }
 
 // HistoricalReadingListLink is SHALL contain a Link to a List of HistoricalReading instances.
 type HistoricalReadingListLink struct {
-	HistoricalReadingListLinkr23 *Revision23Type `xml:"HistoricalReadingListLink_r2_3"`
 	*ListLink
+	HistoricalReadingListLinkr23 *Revision23Type `xml:"HistoricalReadingListLink_r2_3"`
 }
 
 // IPAddrListLink is SHALL contain a Link to a List of IPAddr instances.
 type IPAddrListLink struct {
-	IPAddrListLinkr23 *Revision23Type `xml:"IPAddrListLink_r2_3"`
 	*ListLink
+	IPAddrListLinkr23 *Revision23Type `xml:"IPAddrListLink_r2_3"`
 }
 
 // IPInterfaceListLink is SHALL contain a Link to a List of IPInterface instances.
 type IPInterfaceListLink struct {
-	IPInterfaceListLinkr23 *Revision23Type `xml:"IPInterfaceListLink_r2_3"`
 	*ListLink
+	IPInterfaceListLinkr23 *Revision23Type `xml:"IPInterfaceListLink_r2_3"`
 }
 
 // LLInterfaceListLink is SHALL contain a Link to a List of LLInterface instances.
 type LLInterfaceListLink struct {
-	LLInterfaceListLinkr23 *Revision23Type `xml:"LLInterfaceListLink_r2_3"`
 	*ListLink
+	LLInterfaceListLinkr23 *Revision23Type `xml:"LLInterfaceListLink_r2_3"`
 }
 
 // LoadShedAvailabilityListLink is SHALL contain a Link to a List of LoadShedAvailability instances.
 type LoadShedAvailabilityListLink struct {
-	LoadShedAvailabilityListLinkr23 *Revision23Type `xml:"LoadShedAvailabilityListLink_r2_3"`
 	*ListLink
+	LoadShedAvailabilityListLinkr23 *Revision23Type `xml:"LoadShedAvailabilityListLink_r2_3"`
 }
 
 // LogEventListLink is SHALL contain a Link to a List of LogEvent instances.
 type LogEventListLink struct {
-	LogEventListLinkr23 *Revision23Type `xml:"LogEventListLink_r2_3"`
 	*ListLink
+	LogEventListLinkr23 *Revision23Type `xml:"LogEventListLink_r2_3"`
 }
 
 // MessagingProgramListLink is SHALL contain a Link to a List of MessagingProgram instances.
 type MessagingProgramListLink struct {
-	MessagingProgramListLinkr23 *Revision23Type `xml:"MessagingProgramListLink_r2_3"`
 	*ListLink
+	MessagingProgramListLinkr23 *Revision23Type `xml:"MessagingProgramListLink_r2_3"`
 }
 
 // MeterReadingLink is SHALL contain a Link to an instance of MeterReading.
 type MeterReadingLink struct {
-	MeterReadingLinkr23 *Revision23Type `xml:"MeterReadingLink_r2_3"`
 	*Link
+	MeterReadingLinkr23 *Revision23Type `xml:"MeterReadingLink_r2_3"`
 }
 
 // MeterReadingListLink is SHALL contain a Link to a List of MeterReading instances.
 type MeterReadingListLink struct {
-	MeterReadingListLinkr23 *Revision23Type `xml:"MeterReadingListLink_r2_3"`
 	*ListLink
+	MeterReadingListLinkr23 *Revision23Type `xml:"MeterReadingListLink_r2_3"`
 }
 
 // MirrorUsagePointListLink is SHALL contain a Link to a List of MirrorUsagePoint instances.
 type MirrorUsagePointListLink struct {
-	MirrorUsagePointListLinkr23 *Revision23Type `xml:"MirrorUsagePointListLink_r2_3"`
 	*ListLink
+	MirrorUsagePointListLinkr23 *Revision23Type `xml:"MirrorUsagePointListLink_r2_3"`
 }
 
 // NeighborListLink is SHALL contain a Link to a List of Neighbor instances.
 type NeighborListLink struct {
-	NeighborListLinkr23 *Revision23Type `xml:"NeighborListLink_r2_3"`
 	*ListLink
+	NeighborListLinkr23 *Revision23Type `xml:"NeighborListLink_r2_3"`
 }
 
 // NotificationListLink is SHALL contain a Link to a List of Notification instances.
 type NotificationListLink struct {
-	NotificationListLinkr23 *Revision23Type `xml:"NotificationListLink_r2_3"`
 	*ListLink
+	NotificationListLinkr23 *Revision23Type `xml:"NotificationListLink_r2_3"`
 }
 
 // PowerStatusLink is SHALL contain a Link to an instance of PowerStatus.
 type PowerStatusLink struct {
-	PowerStatusLinkr23 *Revision23Type `xml:"PowerStatusLink_r2_3"`
 	*Link
+	PowerStatusLinkr23 *Revision23Type `xml:"PowerStatusLink_r2_3"`
 }
 
 // PrepaymentLink is SHALL contain a Link to an instance of Prepayment.
 type PrepaymentLink struct {
-	PrepaymentLinkr23 *Revision23Type `xml:"PrepaymentLink_r2_3"`
 	*Link
+	PrepaymentLinkr23 *Revision23Type `xml:"PrepaymentLink_r2_3"`
 }
 
 // PrepaymentListLink is SHALL contain a Link to a List of Prepayment instances.
 type PrepaymentListLink struct {
-	PrepaymentListLinkr23 *Revision23Type `xml:"PrepaymentListLink_r2_3"`
 	*ListLink
+	PrepaymentListLinkr23 *Revision23Type `xml:"PrepaymentListLink_r2_3"`
 }
 
 // PrepayOperationStatusLink is SHALL contain a Link to an instance of PrepayOperationStatus.
 type PrepayOperationStatusLink struct {
-	PrepayOperationStatusLinkr23 *Revision23Type `xml:"PrepayOperationStatusLink_r2_3"`
 	*Link
+	PrepayOperationStatusLinkr23 *Revision23Type `xml:"PrepayOperationStatusLink_r2_3"`
 }
 
 // PriceResponseCfgListLink is SHALL contain a Link to a List of PriceResponseCfg instances.
 type PriceResponseCfgListLink struct {
-	PriceResponseCfgListLinkr23 *Revision23Type `xml:"PriceResponseCfgListLink_r2_3"`
 	*ListLink
+	PriceResponseCfgListLinkr23 *Revision23Type `xml:"PriceResponseCfgListLink_r2_3"`
 }
 
 // ProjectionReadingListLink is SHALL contain a Link to a List of ProjectionReading instances.
 type ProjectionReadingListLink struct {
-	ProjectionReadingListLinkr23 *Revision23Type `xml:"ProjectionReadingListLink_r2_3"`
 	*ListLink
+	ProjectionReadingListLinkr23 *Revision23Type `xml:"ProjectionReadingListLink_r2_3"`
 }
 
 // ProxiedDeviceListLink is SHALL contain a Link to a List of Proxied EndDevice instances.
 type ProxiedDeviceListLink struct {
-	ProxiedDeviceListLinkr23 *Revision23Type `xml:"ProxiedDeviceListLink_r2_3"`
 	*ListLink
+	ProxiedDeviceListLinkr23 *Revision23Type `xml:"ProxiedDeviceListLink_r2_3"`
 }
 
 // RateComponentLink is SHALL contain a Link to an instance of RateComponent.
 type RateComponentLink struct {
-	RateComponentLinkr23 *Revision23Type `xml:"RateComponentLink_r2_3"`
 	*Link
+	RateComponentLinkr23 *Revision23Type `xml:"RateComponentLink_r2_3"`
 }
 
 // RateComponentListLink is SHALL contain a Link to a List of RateComponent instances.
 type RateComponentListLink struct {
-	RateComponentListLinkr23 *Revision23Type `xml:"RateComponentListLink_r2_3"`
 	*ListLink
+	RateComponentListLinkr23 *Revision23Type `xml:"RateComponentListLink_r2_3"`
 }
 
 // ReadingLink is A Link to a Reading.
 type ReadingLink struct {
-	ReadingLinkr23 *Revision23Type `xml:"ReadingLink_r2_3"`
 	*Link
+	ReadingLinkr23 *Revision23Type `xml:"ReadingLink_r2_3"`
 }
 
 // ReadingListLink is SHALL contain a Link to a List of Reading instances.
 type ReadingListLink struct {
-	ReadingListLinkr23 *Revision23Type `xml:"ReadingListLink_r2_3"`
 	*ListLink
+	ReadingListLinkr23 *Revision23Type `xml:"ReadingListLink_r2_3"`
 }
 
 // ReadingSetListLink is SHALL contain a Link to a List of ReadingSet instances.
 type ReadingSetListLink struct {
-	ReadingSetListLinkr23 *Revision23Type `xml:"ReadingSetListLink_r2_3"`
 	*ListLink
+	ReadingSetListLinkr23 *Revision23Type `xml:"ReadingSetListLink_r2_3"`
 }
 
 // ReadingTypeLink is SHALL contain a Link to an instance of ReadingType.
 type ReadingTypeLink struct {
-	ReadingTypeLinkr23 *Revision23Type `xml:"ReadingTypeLink_r2_3"`
 	*Link
+	ReadingTypeLinkr23 *Revision23Type `xml:"ReadingTypeLink_r2_3"`
 }
 
 // RegistrationLink is SHALL contain a Link to an instance of Registration.
 type RegistrationLink struct {
-	RegistrationLinkr23 *Revision23Type `xml:"RegistrationLink_r2_3"`
 	*Link
+	RegistrationLinkr23 *Revision23Type `xml:"RegistrationLink_r2_3"`
 }
 
 // ResponseListLink is SHALL contain a Link to a List of Response instances.
 type ResponseListLink struct {
-	ResponseListLinkr23 *Revision23Type `xml:"ResponseListLink_r2_3"`
 	*ListLink
+	ResponseListLinkr23 *Revision23Type `xml:"ResponseListLink_r2_3"`
 }
 
 // ResponseSetListLink is SHALL contain a Link to a List of ResponseSet instances.
 type ResponseSetListLink struct {
-	ResponseSetListLinkr23 *Revision23Type `xml:"ResponseSetListLink_r2_3"`
 	*ListLink
+	ResponseSetListLinkr23 *Revision23Type `xml:"ResponseSetListLink_r2_3"`
 }
 
 // RPLInstanceListLink is SHALL contain a Link to a List of RPLInterface instances.
 type RPLInstanceListLink struct {
-	RPLInstanceListLinkr23 *Revision23Type `xml:"RPLInstanceListLink_r2_3"`
 	*ListLink
+	RPLInstanceListLinkr23 *Revision23Type `xml:"RPLInstanceListLink_r2_3"`
 }
 
 // RPLSourceRoutesListLink is SHALL contain a Link to a List of RPLSourceRoutes instances.
 type RPLSourceRoutesListLink struct {
-	RPLSourceRoutesListLinkr23 *Revision23Type `xml:"RPLSourceRoutesListLink_r2_3"`
 	*ListLink
+	RPLSourceRoutesListLinkr23 *Revision23Type `xml:"RPLSourceRoutesListLink_r2_3"`
 }
 
 // SelfDeviceLink is SHALL contain a Link to an instance of SelfDevice.
 type SelfDeviceLink struct {
-	SelfDeviceLinkr23 *Revision23Type `xml:"SelfDeviceLink_r2_3"`
 	*Link
+	SelfDeviceLinkr23 *Revision23Type `xml:"SelfDeviceLink_r2_3"`
 }
 
 // ServiceSupplierLink is SHALL contain a Link to an instance of ServiceSupplier.
 type ServiceSupplierLink struct {
-	ServiceSupplierLinkr23 *Revision23Type `xml:"ServiceSupplierLink_r2_3"`
 	*Link
+	ServiceSupplierLinkr23 *Revision23Type `xml:"ServiceSupplierLink_r2_3"`
 }
 
 // SubscriptionListLink is SHALL contain a Link to a List of Subscription instances.
 type SubscriptionListLink struct {
-	SubscriptionListLinkr23 *Revision23Type `xml:"SubscriptionListLink_r2_3"`
 	*ListLink
+	SubscriptionListLinkr23 *Revision23Type `xml:"SubscriptionListLink_r2_3"`
 }
 
 // SupplyInterruptionOverrideListLink is SHALL contain a Link to a List of SupplyInterruptionOverride instances.
 type SupplyInterruptionOverrideListLink struct {
-	SupplyInterruptionOverrideListLinkr23 *Revision23Type `xml:"SupplyInterruptionOverrideListLink_r2_3"`
 	*ListLink
+	SupplyInterruptionOverrideListLinkr23 *Revision23Type `xml:"SupplyInterruptionOverrideListLink_r2_3"`
 }
 
 // SupportedLocaleListLink is SHALL contain a Link to a List of SupportedLocale instances.
 type SupportedLocaleListLink struct {
-	SupportedLocaleListLinkr23 *Revision23Type `xml:"SupportedLocaleListLink_r2_3"`
 	*ListLink
+	SupportedLocaleListLinkr23 *Revision23Type `xml:"SupportedLocaleListLink_r2_3"`
 }
 
 // TargetReadingListLink is SHALL contain a Link to a List of TargetReading instances.
 type TargetReadingListLink struct {
-	TargetReadingListLinkr23 *Revision23Type `xml:"TargetReadingListLink_r2_3"`
 	*ListLink
+	TargetReadingListLinkr23 *Revision23Type `xml:"TargetReadingListLink_r2_3"`
 }
 
 // TariffProfileLink is SHALL contain a Link to an instance of TariffProfile.
 type TariffProfileLink struct {
-	TariffProfileLinkr23 *Revision23Type `xml:"TariffProfileLink_r2_3"`
 	*Link
+	TariffProfileLinkr23 *Revision23Type `xml:"TariffProfileLink_r2_3"`
 }
 
 // TariffProfileListLink is SHALL contain a Link to a List of TariffProfile instances.
 type TariffProfileListLink struct {
-	TariffProfileListLinkr23 *Revision23Type `xml:"TariffProfileListLink_r2_3"`
 	*ListLink
+	TariffProfileListLinkr23 *Revision23Type `xml:"TariffProfileListLink_r2_3"`
 }
 
 // TextMessageListLink is SHALL contain a Link to a List of TextMessage instances.
 type TextMessageListLink struct {
-	TextMessageListLinkr23 *Revision23Type `xml:"TextMessageListLink_r2_3"`
 	*ListLink
+	TextMessageListLinkr23 *Revision23Type `xml:"TextMessageListLink_r2_3"`
 }
 
 // TimeLink is SHALL contain a Link to an instance of Time.
 type TimeLink struct {
-	TimeLinkr23 *Revision23Type `xml:"TimeLink_r2_3"`
 	*Link
+	TimeLinkr23 *Revision23Type `xml:"TimeLink_r2_3"`
 }
 
 // TimeTariffIntervalListLink is SHALL contain a Link to a List of TimeTariffInterval instances.
 type TimeTariffIntervalListLink struct {
-	TimeTariffIntervalListLinkr23 *Revision23Type `xml:"TimeTariffIntervalListLink_r2_3"`
 	*ListLink
+	TimeTariffIntervalListLinkr23 *Revision23Type `xml:"TimeTariffIntervalListLink_r2_3"`
 }
 
 // UsagePointLink is SHALL contain a Link to an instance of UsagePoint.
 type UsagePointLink struct {
-	UsagePointLinkr23 *Revision23Type `xml:"UsagePointLink_r2_3"`
 	*Link
+	UsagePointLinkr23 *Revision23Type `xml:"UsagePointLink_r2_3"`
 }
 
 // UsagePointListLink is SHALL contain a Link to a List of UsagePoint instances.
 type UsagePointListLink struct {
-	UsagePointListLinkr23 *Revision23Type `xml:"UsagePointListLink_r2_3"`
 	*ListLink
+	UsagePointListLinkr23 *Revision23Type `xml:"UsagePointListLink_r2_3"`
 }
 
 // ActiveBillingPeriodListLink is DEPRECATED
 // SHALL NOT be included by servers, but clients should note that it may be included by servers compliant with previous revisions of IEEE 2030.5.
 type ActiveBillingPeriodListLink struct {
-	ActiveBillingPeriodListLinkr23 *Revision23Type `xml:"ActiveBillingPeriodListLink_r2_3"`
 	*ListLink
+	ActiveBillingPeriodListLinkr23 *Revision23Type `xml:"ActiveBillingPeriodListLink_r2_3"`
 }
 
 // ActiveCreditRegisterListLink is DEPRECATED
 // SHALL NOT be included by servers, but clients should note that it may be included by servers compliant with previous revisions of IEEE 2030.5.
 type ActiveCreditRegisterListLink struct {
-	ActiveCreditRegisterListLinkr23 *Revision23Type `xml:"ActiveCreditRegisterListLink_r2_3"`
 	*ListLink
+	ActiveCreditRegisterListLinkr23 *Revision23Type `xml:"ActiveCreditRegisterListLink_r2_3"`
 }
 
 // ActiveDERControlListLink is DEPRECATED
 // SHALL NOT be included by servers, but clients should note that it may be included by servers compliant with previous revisions of IEEE 2030.5.
 type ActiveDERControlListLink struct {
-	ActiveDERControlListLinkr23 *Revision23Type `xml:"ActiveDERControlListLink_r2_3"`
 	*ListLink
+	ActiveDERControlListLinkr23 *Revision23Type `xml:"ActiveDERControlListLink_r2_3"`
 }
 
 // ActiveEndDeviceControlListLink is DEPRECATED
 // SHALL NOT be included by servers, but clients should note that it may be included by servers compliant with previous revisions of IEEE 2030.5.
 type ActiveEndDeviceControlListLink struct {
-	ActiveEndDeviceControlListLinkr23 *Revision23Type `xml:"ActiveEndDeviceControlListLink_r2_3"`
 	*ListLink
+	ActiveEndDeviceControlListLinkr23 *Revision23Type `xml:"ActiveEndDeviceControlListLink_r2_3"`
 }
 
 // ActiveFlowReservationListLink is DEPRECATED
 // SHALL NOT be included by servers, but clients should note that it may be included by servers compliant with previous revisions of IEEE 2030.5.
 type ActiveFlowReservationListLink struct {
-	ActiveFlowReservationListLinkr23 *Revision23Type `xml:"ActiveFlowReservationListLink_r2_3"`
 	*ListLink
+	ActiveFlowReservationListLinkr23 *Revision23Type `xml:"ActiveFlowReservationListLink_r2_3"`
 }
 
 // ActiveProjectionReadingListLink is DEPRECATED
 // SHALL NOT be included by servers, but clients should note that it may be included by servers compliant with previous revisions of IEEE 2030.5.
 type ActiveProjectionReadingListLink struct {
-	ActiveProjectionReadingListLinkr23 *Revision23Type `xml:"ActiveProjectionReadingListLink_r2_3"`
 	*ListLink
+	ActiveProjectionReadingListLinkr23 *Revision23Type `xml:"ActiveProjectionReadingListLink_r2_3"`
 }
 
 // ActiveSupplyInterruptionOverrideListLink is DEPRECATED
 // SHALL NOT be included by servers, but clients should note that it may be included by servers compliant with previous revisions of IEEE 2030.5.
 type ActiveSupplyInterruptionOverrideListLink struct {
-	ActiveSupplyInterruptionOverrideListLinkr23 *Revision23Type `xml:"ActiveSupplyInterruptionOverrideListLink_r2_3"`
 	*ListLink
+	ActiveSupplyInterruptionOverrideListLinkr23 *Revision23Type `xml:"ActiveSupplyInterruptionOverrideListLink_r2_3"`
 }
 
 // ActiveTargetReadingListLink is DEPRECATED
 // SHALL NOT be included by servers, but clients should note that it may be included by servers compliant with previous revisions of IEEE 2030.5.
 type ActiveTargetReadingListLink struct {
-	ActiveTargetReadingListLinkr23 *Revision23Type `xml:"ActiveTargetReadingListLink_r2_3"`
 	*ListLink
+	ActiveTargetReadingListLinkr23 *Revision23Type `xml:"ActiveTargetReadingListLink_r2_3"`
 }
 
 // ActiveTextMessageListLink is DEPRECATED
 // SHALL NOT be included by servers, but clients should note that it may be included by servers compliant with previous revisions of IEEE 2030.5.
 type ActiveTextMessageListLink struct {
-	ActiveTextMessageListLinkr23 *Revision23Type `xml:"ActiveTextMessageListLink_r2_3"`
 	*ListLink
+	ActiveTextMessageListLinkr23 *Revision23Type `xml:"ActiveTextMessageListLink_r2_3"`
 }
 
 // ActiveTimeTariffIntervalListLink is DEPRECATED
 // SHALL NOT be included by servers, but clients should note that it may be included by servers compliant with previous revisions of IEEE 2030.5.
 type ActiveTimeTariffIntervalListLink struct {
-	ActiveTimeTariffIntervalListLinkr23 *Revision23Type `xml:"ActiveTimeTariffIntervalListLink_r2_3"`
 	*ListLink
+	ActiveTimeTariffIntervalListLinkr23 *Revision23Type `xml:"ActiveTimeTariffIntervalListLink_r2_3"`
 }
 
 // IdentifiedObject is Contains the version number of the object. See the type definition for details.
 type IdentifiedObject struct {
+	*Resource
 	MRID                *MRIDType       `xml:"mRID"`
-	Description         string          `xml:"description"`
+	Description         *string         `xml:"description"`
 	Version             *VersionType    `xml:"version"`
 	IdentifiedObjectr23 *Revision23Type `xml:"IdentifiedObject_r2_3"`
-	*Resource
 }
 
 // Link is Links provide a reference, via URI, to another resource.
 type Link struct {
 	HrefAttr string          `xml:"href,attr"`
 	Linkr23  *Revision23Type `xml:"Link_r2_3"`
+	AnyAttr  []Attr          `xml:",any,attr"`
+	Any      []*AnyElement   `xml:",any"`
 }
 
 // List is Container to hold a collection of object instances or references. See Design Pattern section for additional details.
 type List struct {
+	*Resource
 	AllAttr     uint32          `xml:"all,attr"`
 	ResultsAttr uint32          `xml:"results,attr"`
 	Listr23     *Revision23Type `xml:"List_r2_3"`
-	*Resource
 }
 
 // ListLink is ListLinks provide a reference, via URI, to a List.
 type ListLink struct {
+	*Link
 	AllAttr     uint32          `xml:"all,attr,omitempty"`
 	ListLinkr23 *Revision23Type `xml:"ListLink_r2_3"`
-	*Link
 }
 
 // Resource is A resource is an addressable unit of information, either a collection (List) or instance of an object (identifiedObject, or simply, Resource)
 type Resource struct {
 	HrefAttr    string          `xml:"href,attr,omitempty"`
 	Resourcer23 *Revision23Type `xml:"Resource_r2_3"`
+	AnyAttr     []Attr          `xml:",any,attr"`
+	Any         []*AnyElement   `xml:",any"`
 }
 
 // RespondableIdentifiedObject is Contains the version number of the object. See the type definition for details.
 type RespondableIdentifiedObject struct {
+	*RespondableResource
 	MRID                           *MRIDType       `xml:"mRID"`
-	Description                    string          `xml:"description"`
+	Description                    *string         `xml:"description"`
 	Version                        *VersionType    `xml:"version"`
 	RespondableIdentifiedObjectr23 *Revision23Type `xml:"RespondableIdentifiedObject_r2_3"`
-	*RespondableResource
 }
 
 // RespondableResource is A Resource to which a Response can be requested.
 type RespondableResource struct {
+	*Resource
 	ReplyToAttr            string          `xml:"replyTo,attr,omitempty"`
 	ResponseRequiredAttr   string          `xml:"responseRequired,attr,omitempty"`
 	RespondableResourcer23 *Revision23Type `xml:"RespondableResource_r2_3"`
-	*Resource
 }
 
 // RespondableSubscribableIdentifiedObject is Contains the version number of the object. See the type definition for details.
 type RespondableSubscribableIdentifiedObject struct {
+	*RespondableResource
 	SubscribableAttr                           *UInt8          `xml:"subscribable,attr,omitempty"`
 	MRID                                       *MRIDType       `xml:"mRID"`
-	Description                                string          `xml:"description"`
+	Description                                *string         `xml:"description"`
 	Version                                    *VersionType    `xml:"version"`
 	RespondableSubscribableIdentifiedObjectr23 *Revision23Type `xml:"RespondableSubscribableIdentifiedObject_r2_3"`
-	*RespondableResource
 }
 
 // SubscribableIdentifiedObject is Contains the version number of the object. See the type definition for details.
 type SubscribableIdentifiedObject struct {
+	*SubscribableResource
 	MRID                            *MRIDType       `xml:"mRID"`
-	Description                     string          `xml:"description"`
+	Description                     *string         `xml:"description"`
 	Version                         *VersionType    `xml:"version"`
 	SubscribableIdentifiedObjectr23 *Revision23Type `xml:"SubscribableIdentifiedObject_r2_3"`
-	*SubscribableResource
 }
 
 // SubscribableList is A List to which a Subscription can be requested.
 type SubscribableList struct {
+	*SubscribableResource
 	AllAttr             uint32          `xml:"all,attr"`
 	ResultsAttr         uint32          `xml:"results,attr"`
 	SubscribableListr23 *Revision23Type `xml:"SubscribableList_r2_3"`
-	*SubscribableResource
 }
 
 // SubscribableResource is A Resource to which a Subscription can be requested.
 type SubscribableResource struct {
+	*Resource
 	SubscribableAttr        *UInt8          `xml:"subscribable,attr,omitempty"`
 	SubscribableResourcer23 *Revision23Type `xml:"SubscribableResource_r2_3"`
-	*Resource
 }
 
 // Error is Code indicating the reason for failure.
@@ -2822,36 +3173,42 @@ type SubscribableResource struct {
 // 4 - Maximum request frequency exceeded
 // All other values reserved
 type Error struct {
-	MaxRetryDuration uint16          `xml:"maxRetryDuration"`
+	XMLName          xml.Name        `xml:"urn:ieee:std:2030.5:ns Error"`
+	SchemaVerAttr    SEPVersion      `xml:"schemaVer,attr,omitempty"`
+	MaxRetryDuration *uint16         `xml:"maxRetryDuration"`
 	ReasonCode       uint16          `xml:"reasonCode"`
 	Errorr23         *Revision23Type `xml:"Error_r2_3"`
+	AnyAttr          []Attr          `xml:",any,attr"`
+	Any              []*AnyElement   `xml:",any"`
 }
 
 // Event is The period during which the Event applies.
 type Event struct {
+	*RespondableSubscribableIdentifiedObject
 	CreationTime *TimeType         `xml:"creationTime"`
 	EventStatus  *EventStatus      `xml:"EventStatus"`
 	Interval     *DateTimeInterval `xml:"interval"`
 	Eventr23     *Revision23Type   `xml:"Event_r2_3"`
-	*RespondableSubscribableIdentifiedObject
 }
 
 // EventStatus is The Reason attribute allows a Service provider to provide a textual explanation of the status.
 type EventStatus struct {
-	CurrentStatus             uint8           `xml:"currentStatus"`
+	CurrentStatus             EventStatusCode `xml:"currentStatus"`
 	DateTime                  *TimeType       `xml:"dateTime"`
 	PotentiallySuperseded     bool            `xml:"potentiallySuperseded"`
 	PotentiallySupersededTime *TimeType       `xml:"potentiallySupersededTime"`
-	Reason                    string          `xml:"reason"`
+	Reason                    *string         `xml:"reason"`
 	EventStatusr23            *Revision23Type `xml:"EventStatus_r2_3"`
+	AnyAttr                   []Attr          `xml:",any,attr"`
+	Any                       []*AnyElement   `xml:",any"`
 }
 
 // RandomizableEvent is Number of seconds boundary inside which a random value must be selected to be applied to the associated interval start time, to avoid sudden synchronized demand changes. If related to price level changes, sign may be ignored. Valid range is -3600 to 3600. If not specified, 0 is the default.
 type RandomizableEvent struct {
+	*Event
 	RandomizeDuration    *OneHourRangeType `xml:"randomizeDuration"`
 	RandomizeStart       *OneHourRangeType `xml:"randomizeStart"`
 	RandomizableEventr23 *Revision23Type   `xml:"RandomizableEvent_r2_3"`
-	*Event
 }
 
 // AccumulationBehaviourType is 0 = Not Applicable (default, if not specified)
@@ -2967,6 +3324,8 @@ type DateTimeInterval struct {
 	Duration            uint32          `xml:"duration"`
 	Start               *TimeType       `xml:"start"`
 	DateTimeIntervalr23 *Revision23Type `xml:"DateTimeInterval_r2_3"`
+	AnyAttr             []Attr          `xml:",any,attr"`
+	Any                 []*AnyElement   `xml:",any"`
 }
 
 // DeviceCategoryType is The Device category types defined.
@@ -3072,6 +3431,8 @@ type GeographicLocationType struct {
 	Country                   *CountryType     `xml:"country"`
 	Subdivision               *SubdivisionType `xml:"subdivision"`
 	GeographicLocationTyper23 *Revision23Type  `xml:"GeographicLocationType_r2_3"`
+	AnyAttr                   []Attr           `xml:",any,attr"`
+	Any                       []*AnyElement    `xml:",any"`
 }
 
 // GPSLocationType is Specifies the longitude from Greenwich Meridian. -180 (west) to +180 (east) in decimal degrees.
@@ -3079,6 +3440,8 @@ type GPSLocationType struct {
 	Lat                string          `xml:"lat"`
 	Lon                string          `xml:"lon"`
 	GPSLocationTyper23 *Revision23Type `xml:"GPSLocationType_r2_3"`
+	AnyAttr            []Attr          `xml:",any,attr"`
+	Any                []*AnyElement   `xml:",any"`
 }
 
 // KindType is 0 = Not Applicable (default, if not specified)
@@ -3100,7 +3463,6 @@ type LocaleType struct {
 // 0xFFFFFFFFFFFFFFFFFFFFFFFF[XXXXXXXX], where [XXXXXXXX] is the PEN, is reserved for a object that is being created (e.g., a ReadingSet for the current time that is still accumulating).
 // Except for this special reserved identifier, each modification of an object (resource) representation SHALL have a different "version".
 type MRIDType struct {
-	XMLName xml.Name `xml:"mRIDType"`
 	*HexBinary128
 }
 
@@ -3173,6 +3535,8 @@ type RealEnergy struct {
 	Multiplier    *PowerOfTenMultiplierType `xml:"multiplier"`
 	Value         uint64                    `xml:"value"`
 	RealEnergyr23 *Revision23Type           `xml:"RealEnergy_r2_3"`
+	AnyAttr       []Attr                    `xml:",any,attr"`
+	Any           []*AnyElement             `xml:",any"`
 }
 
 // RoleFlagsType is Specifies the roles that apply to a usage point.
@@ -3216,6 +3580,8 @@ type SignedRealEnergy struct {
 	Multiplier          *PowerOfTenMultiplierType `xml:"multiplier"`
 	Value               int64                     `xml:"value"`
 	SignedRealEnergyr23 *Revision23Type           `xml:"SignedRealEnergy_r2_3"`
+	AnyAttr             []Attr                    `xml:",any,attr"`
+	Any                 []*AnyElement             `xml:",any"`
 }
 
 // SubdivisionType is [ISO 3166-2] subdivision code of a country
@@ -3287,6 +3653,8 @@ type UnitValueType struct {
 	Unit             *UomType                  `xml:"unit"`
 	Value            int                       `xml:"value"`
 	UnitValueTyper23 *Revision23Type           `xml:"UnitValueType_r2_3"`
+	AnyAttr          []Attr                    `xml:",any,attr"`
+	Any              []*AnyElement             `xml:",any"`
 }
 
 // UomType is The following values are recommended values sourced from the unit of measure enumeration in IEC 61968-9 [61968]. Other values from the unit of measure enumeration in IEC 61968-9 [61968] MAY be used.
@@ -3414,84 +3782,93 @@ type SEPVersion string
 
 // MirrorMeterReading is The date and time of the next planned update.
 type MirrorMeterReading struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns MirrorMeterReading"`
+	*MeterReadingBase
+	SchemaVerAttr         SEPVersion          `xml:"schemaVer,attr,omitempty"`
 	LastUpdateTime        *TimeType           `xml:"lastUpdateTime"`
 	MirrorReadingSet      []*MirrorReadingSet `xml:"MirrorReadingSet"`
 	NextUpdateTime        *TimeType           `xml:"nextUpdateTime"`
 	Reading               *Reading            `xml:"Reading"`
 	ReadingType           *ReadingType        `xml:"ReadingType"`
 	MirrorMeterReadingr23 *Revision23Type     `xml:"MirrorMeterReading_r2_3"`
-	*MeterReadingBase
 }
 
 // MirrorMeterReadingList is A List of MirrorMeterReading instances.
 type MirrorMeterReadingList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns MirrorMeterReadingList"`
+	*List
+	SchemaVerAttr             SEPVersion            `xml:"schemaVer,attr,omitempty"`
 	MirrorMeterReading        []*MirrorMeterReading `xml:"MirrorMeterReading"`
 	MirrorMeterReadingListr23 *Revision23Type       `xml:"MirrorMeterReadingList_r2_3"`
-	*List
 }
 
 // MeterReadingBase is A container for associating ReadingType, Readings and ReadingSets.
 type MeterReadingBase struct {
-	MeterReadingBaser23 *Revision23Type `xml:"MeterReadingBase_r2_3"`
 	*IdentifiedObject
+	MeterReadingBaser23 *Revision23Type `xml:"MeterReadingBase_r2_3"`
 }
 
 // MirrorReadingSet is A set of Readings of the ReadingType indicated by the parent MeterReading.
 type MirrorReadingSet struct {
+	*ReadingSetBase
 	Reading             []*Reading      `xml:"Reading"`
 	MirrorReadingSetr23 *Revision23Type `xml:"MirrorReadingSet_r2_3"`
-	*ReadingSetBase
 }
 
 // MirrorUsagePoint is POST rate, or how often mirrored data should be POSTed, in seconds. A client MAY indicate a preferred postRate when POSTing MirrorUsagePoint. A server MAY add or modify postRate to indicate its preferred posting rate. If not specified, a default of 900 seconds (15 minutes) is used.
 type MirrorUsagePoint struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns MirrorUsagePoint"`
+	*UsagePointBase
+	SchemaVerAttr       SEPVersion            `xml:"schemaVer,attr,omitempty"`
 	SubscribableAttr    uint8                 `xml:"subscribable,attr,omitempty"`
 	DeviceLFDI          string                `xml:"deviceLFDI"`
 	MirrorMeterReading  []*MirrorMeterReading `xml:"MirrorMeterReading"`
-	PostRate            uint32                `xml:"postRate"`
+	PostRate            *uint32               `xml:"postRate"`
 	UsagePointLink      *UsagePointLink       `xml:"UsagePointLink"`
 	MirrorUsagePointr23 *Revision23Type       `xml:"MirrorUsagePoint_r2_3"`
-	*UsagePointBase
 }
 
 // MirrorUsagePointList is A List of MirrorUsagePoint instances.
 type MirrorUsagePointList struct {
+	XMLName xml.Name `xml:"urn:ieee:std:2030.5:ns MirrorUsagePointList"`
+	*SubscribableList
 	PollRateAttr            uint32              `xml:"pollRate,attr,omitempty"`
+	SchemaVerAttr           SEPVersion          `xml:"schemaVer,attr,omitempty"`
 	MirrorUsagePoint        []*MirrorUsagePoint `xml:"MirrorUsagePoint"`
 	MirrorUsagePointListr23 *Revision23Type     `xml:"MirrorUsagePointList_r2_3"`
-	*SubscribableList
 }
 
 // ReadingBase is Value in units specified by ReadingType
 type ReadingBase struct {
+	*Resource
 	ConsumptionBlock *ConsumptionBlockType `xml:"consumptionBlock"`
-	QualityFlags     string                `xml:"qualityFlags"`
+	QualityFlags     *string               `xml:"qualityFlags"`
 	TimePeriod       *DateTimeInterval     `xml:"timePeriod"`
 	TouTier          *TOUType              `xml:"touTier"`
-	Value            int64                 `xml:"value"`
+	Value            *int64                `xml:"value"`
 	ReadingBaser23   *Revision23Type       `xml:"ReadingBase_r2_3"`
-	*Resource
 }
 
 // ReadingSetBase is Specifies the time range during which the contained readings were taken.
 type ReadingSetBase struct {
+	*IdentifiedObject
 	TimePeriod        *DateTimeInterval `xml:"timePeriod"`
 	ReadingSetBaser23 *Revision23Type   `xml:"ReadingSetBase_r2_3"`
-	*IdentifiedObject
 }
 
 // UsagePointBase is Specifies the current status of the service at this usage point.
 // 0 = off
 // 1 = on
 type UsagePointBase struct {
+	*IdentifiedObject
 	RoleFlags           *RoleFlagsType  `xml:"roleFlags"`
 	ServiceCategoryKind *ServiceKind    `xml:"serviceCategoryKind"`
 	Status              uint8           `xml:"status"`
 	UsagePointBaser23   *Revision23Type `xml:"UsagePointBase_r2_3"`
-	*IdentifiedObject
 }
 
 // Revision23Type ...
 type Revision23Type struct {
-	XMLName xml.Name `xml:"Revision2_3Type"`
+	AnyAttr []Attr        `xml:",any,attr"`
+	Any     []*AnyElement `xml:",any"`
 }
@@ -0,0 +1,264 @@
+package sep
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Tylores/sep/internal/xsd"
+)
+
+func TestSchemaVer(t *testing.T) {
+	tm := NewTimeIn(time.UTC, t0)
+	var b bytes.Buffer
+	if err := Encode(&b, MediaTypeXML, tm); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(b.String(), ` schemaVer="2.2"`) || tm.SchemaVerAttr != "" {
+		t.Errorf("Encode wrote %s, set schemaVer %q", b.String(), tm.SchemaVerAttr)
+	}
+	if err := Validate(b.Bytes()); err != nil {
+		t.Error(err)
+	}
+	v, err := Decode(MediaTypeXML, &b)
+	if err != nil || v.(*Time).SchemaVerAttr != SchemaVersion {
+		t.Errorf("Decode = %+v, %v", v, err)
+	}
+
+	tm.SchemaVerAttr = "2.1"
+	b.Reset()
+	if err := Encode(&b, MediaTypeXML, *tm); err != nil || !strings.Contains(b.String(), ` schemaVer="2.1"`) {
+		t.Errorf("Encode with schemaVer 2.1 wrote %s, %v", b.String(), err)
+	}
+
+	tm.SchemaVerAttr = ""
+	data, err := MarshalEXI(tm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Time
+	if err := UnmarshalEXI(data, &got); err != nil || got.SchemaVerAttr != SchemaVersion {
+		t.Errorf("EXI round trip: schemaVer %q, %v", got.SchemaVerAttr, err)
+	}
+
+	// Only the root element carries it.
+	l := NewDERCurveList()
+	l.DERCurve = []*DERCurve{NewDERCurve()}
+	b.Reset()
+	if err := Encode(&b, MediaTypeXML, l); err != nil || strings.Count(b.String(), "schemaVer") != 1 {
+		t.Errorf("Encode wrote %s, %v", b.String(), err)
+	}
+
+	tm.SchemaVerAttr = "2"
+	err = tm.Validate()
+	if errs, ok := err.(ValidationErrors); !ok || len(errs) != 1 || errs[0].Path != "/Time/@schemaVer" {
+		t.Errorf("Validate with schemaVer 2 = %v", err)
+	}
+}
+
+// sampleValue returns a valid lexical value of the simple type t.
+func sampleValue(t *xsd.Type) string {
+	n := 1
+	for u := t; u != nil; u = u.Base {
+		f := u.Facets
+		switch {
+		case len(f.Enumeration) > 0:
+			return f.Enumeration[0]
+		case f.Pattern != nil:
+			// SEPVersion is the only type with a pattern.
+			return SchemaVersion
+		case f.Length != nil:
+			n = max(n, *f.Length)
+		case f.MinLength != nil:
+			n = max(n, *f.MinLength)
+		}
+	}
+	prim := t.Primitive()
+	switch {
+	case prim == "boolean":
+		return "true"
+	case prim == "hexBinary":
+		return strings.Repeat("A5", n)
+	case xsd.IsInteger(prim):
+		v := big.NewInt(1)
+		if lo, hi := t.IntegerRange(); lo != nil && v.Cmp(lo) < 0 {
+			v = lo
+		} else if hi != nil && v.Cmp(hi) > 0 {
+			v = hi
+		}
+		return v.String()
+	}
+	return strings.Repeat("x", n)
+}
+
+// writeSample writes the element name of type t with every attribute and
+// child element of t, once each. Optional elements of a type already being
+// written are left out, so that recursive types end, and so are the
+// revision 2.3 extension elements, which hold only wildcard content.
+func writeSample(b *strings.Builder, name string, t *xsd.Type, open map[*xsd.Type]bool) {
+	b.WriteString("<" + name)
+	if open == nil {
+		b.WriteString(` xmlns="` + Namespace + `"`)
+		open = make(map[*xsd.Type]bool)
+	}
+	for _, a := range t.AttributeUses() {
+		fmt.Fprintf(b, ` %s="%s"`, a.Name, sampleValue(a.Type))
+	}
+	b.WriteString(">")
+	if st := t.SimpleContent(); st != nil {
+		b.WriteString(sampleValue(st))
+	} else {
+		open[t] = true
+		for _, p := range t.Content() {
+			e := p.Element
+			if e == nil || e.Type.Name == "Revision2_3Type" {
+				continue
+			}
+			if p.MinOccurs > 0 || !open[e.Type] {
+				writeSample(b, e.Name, e.Type, open)
+			}
+		}
+		delete(open, t)
+	}
+	b.WriteString("</" + name + ">")
+}
+
+// canonical returns the elements, attributes and text of doc, in document
+// order except for attributes, without namespace declarations.
+func canonical(doc []byte) ([]string, error) {
+	d := xml.NewDecoder(bytes.NewReader(doc))
+	var out []string
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			var attrs []string
+			for _, a := range tok.Attr {
+				if a.Name.Space != "xmlns" && a.Name.Local != "xmlns" {
+					attrs = append(attrs, a.Name.Local+"="+a.Value)
+				}
+			}
+			slices.Sort(attrs)
+			out = append(out, "<"+tok.Name.Space+" "+tok.Name.Local+" "+strings.Join(attrs, " ")+">")
+		case xml.EndElement:
+			out = append(out, "</"+tok.Name.Local+">")
+		case xml.CharData:
+			if s := strings.TrimSpace(string(tok)); s != "" {
+				out = append(out, s)
+			}
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, name := range ElementNames() {
+		var b strings.Builder
+		writeSample(&b, name, schema().Elements[name].Type, nil)
+		doc := []byte(b.String())
+		if err := Validate(doc); err != nil {
+			t.Fatalf("%s: sample document invalid: %v", name, err)
+		}
+		v, err := Decode(MediaTypeXML, bytes.NewReader(doc))
+		if err != nil {
+			t.Errorf("%s: Decode: %v", name, err)
+			continue
+		}
+		if got, _ := ElementName(v); got != name {
+			t.Errorf("%s: decoded as %T", name, v)
+		}
+		out, err := xml.Marshal(v)
+		if err != nil {
+			t.Errorf("%s: Marshal: %v", name, err)
+			continue
+		}
+		var root struct{ XMLName xml.Name }
+		if err := xml.Unmarshal(out, &root); err != nil || root.XMLName != (xml.Name{Space: Namespace, Local: name}) {
+			t.Errorf("%s: root element %v, %v", name, root.XMLName, err)
+		}
+		if err := Validate(out); err != nil {
+			t.Errorf("%s: marshalled document invalid: %v", name, err)
+		}
+		want, _ := canonical(doc)
+		got, err := canonical(out)
+		if err != nil || !slices.Equal(got, want) {
+			t.Errorf("%s: marshalled\n%s\nfrom\n%s", name, out, doc)
+			continue
+		}
+		v2, _ := NewElement(name)
+		if err := Unmarshal(out, v2); err != nil || !reflect.DeepEqual(v, v2) {
+			t.Errorf("%s: unmarshalled %+v, %v, want %+v", name, v2, err, v)
+		}
+	}
+}
+
+func TestComplexTypes(t *testing.T) {
+	for _, name := range schema().TypeNames {
+		if schema().Types[name].Simple {
+			continue
+		}
+		typ, ok := complexTypes[name]
+		if !ok {
+			t.Errorf("no Go type for %s", name)
+			continue
+		}
+		if got := complexTypeNames[typ]; got != name {
+			t.Errorf("Go type %s of %s maps back to %q", typ, name, got)
+		}
+	}
+	if len(ElementNames()) != 129 {
+		t.Errorf("%d root elements, want 129", len(ElementNames()))
+	}
+}
+
+func TestWrongNamespace(t *testing.T) {
+	for _, doc := range []string{
+		`<Time xmlns="urn:example"><currentTime>0</currentTime></Time>`,
+		`<Time><currentTime>0</currentTime></Time>`,
+		`<sep:Time xmlns:sep="urn:ieee:std:2030.5"><sep:currentTime>0</sep:currentTime></sep:Time>`,
+	} {
+		if v, err := Decode(MediaTypeXML, strings.NewReader(doc)); err == nil {
+			t.Errorf("Decode(%s) = %T", doc, v)
+		}
+		var tm Time
+		if err := Unmarshal([]byte(doc), &tm); err == nil {
+			t.Errorf("Unmarshal(%s) succeeded", doc)
+		}
+		if err := Validate([]byte(doc)); err == nil {
+			t.Errorf("Validate(%s) succeeded", doc)
+		}
+	}
+}
+
+func TestValidateResource(t *testing.T) {
+	d := NewEndDevice()
+	d.HrefAttr = "/edev/1"
+	d.SFDI = NewSFDIType(exampleSFDI)
+	d.ChangedTime = NewTimeType(0)
+	if err := ValidateResource(d); err != nil {
+		t.Errorf("valid EndDevice: %v", err)
+	}
+
+	d.LFDI = NewString(exampleLFDI + "00")
+	err := ValidateResource(d)
+	if errs, ok := err.(ValidationErrors); !ok || len(errs) != 1 || errs[0].Path != "/EndDevice/lFDI" || errs[0].Line != 1 {
+		t.Errorf("21-octet lFDI: %v", err)
+	}
+
+	if err := ValidateResource(make(chan int)); err == nil || errors.As(err, new(ValidationErrors)) {
+		t.Errorf("unmarshallable value: %v", err)
+	}
+}
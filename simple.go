@@ -0,0 +1,147 @@
+package sep
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The simple types of the schema that restrict another simple type are
+// modelled as structs embedding a pointer to the restricted type. Their
+// MarshalText and UnmarshalText methods make them encode as the character
+// data of their element or attribute rather than as a nested element. Those
+// methods and the constructors are generated into simpletypes.go; the
+// helpers they share and the methods particular to a type are here.
+
+type signed interface {
+	~int8 | ~int16 | ~int | ~int64
+}
+
+type unsigned interface {
+	~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+func formatSigned[T signed](v *T) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return strconv.AppendInt(nil, int64(*v), 10), nil
+}
+
+func parseSigned[T signed](text []byte, bitSize int, v *T) error {
+	n, err := strconv.ParseInt(strings.TrimSpace(string(text)), 10, bitSize)
+	if err != nil {
+		return err
+	}
+	*v = T(n)
+	return nil
+}
+
+func formatUnsigned[T unsigned](v *T) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return strconv.AppendUint(nil, uint64(*v), 10), nil
+}
+
+func parseUnsigned[T unsigned](text []byte, bitSize int, v *T) error {
+	n, err := strconv.ParseUint(strings.TrimSpace(string(text)), 10, bitSize)
+	if err != nil {
+		return err
+	}
+	*v = T(n)
+	return nil
+}
+
+func formatString[T ~string](v *T) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return []byte(*v), nil
+}
+
+// NewString returns a pointer to s, for the optional string elements, which
+// are nil when absent and so distinguish an empty element from a missing
+// one.
+func NewString(s string) *string { return &s }
+
+// stringValue returns the string p points to, or "" if p is nil.
+func stringValue(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
+
+// Duration returns o as a time.Duration.
+func (o OneHourRangeType) Duration() time.Duration {
+	return time.Duration(o.Value()) * time.Second
+}
+
+// Duration returns t as a time.Duration.
+func (t TimeOffsetType) Duration() time.Duration {
+	return time.Duration(t.Value()) * time.Second
+}
+
+// NewTimeTypeFromTime returns the TimeType of t, truncated to whole seconds.
+func NewTimeTypeFromTime(t time.Time) *TimeType {
+	return NewTimeType(t.Unix())
+}
+
+// Time returns t as a UTC time.Time.
+func (t TimeType) Time() time.Time {
+	return time.Unix(t.Value(), 0).UTC()
+}
+
+// perCentControlTypeContent is the character data and attributes of a PerCentControlType.
+type perCentControlTypeContent struct {
+	Value        PerCent `xml:",chardata"`
+	DisabledAttr bool    `xml:"disabled,attr,omitempty"`
+}
+
+// MarshalXML implements xml.Marshaler. It takes precedence over the
+// MarshalText method promoted from PerCent, which would drop the attributes.
+func (c PerCentControlType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	v := perCentControlTypeContent{DisabledAttr: c.DisabledAttr}
+	if c.PerCent != nil {
+		v.Value = *c.PerCent
+	}
+	return e.EncodeElement(v, start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (c *PerCentControlType) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v perCentControlTypeContent
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	c.PerCent, c.DisabledAttr = &v.Value, v.DisabledAttr
+	return nil
+}
+
+// signedPerCentControlTypeContent is the character data and attributes of a SignedPerCentControlType.
+type signedPerCentControlTypeContent struct {
+	Value        SignedPerCent `xml:",chardata"`
+	DisabledAttr bool          `xml:"disabled,attr,omitempty"`
+}
+
+// MarshalXML implements xml.Marshaler. It takes precedence over the
+// MarshalText method promoted from SignedPerCent, which would drop the attributes.
+func (c SignedPerCentControlType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	v := signedPerCentControlTypeContent{DisabledAttr: c.DisabledAttr}
+	if c.SignedPerCent != nil {
+		v.Value = *c.SignedPerCent
+	}
+	return e.EncodeElement(v, start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (c *SignedPerCentControlType) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v signedPerCentControlTypeContent
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	c.SignedPerCent, c.DisabledAttr = &v.Value, v.DisabledAttr
+	return nil
+}
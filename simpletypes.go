@@ -0,0 +1,1163 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package sep
+
+// NewPowerSourceType returns a PowerSourceType holding v.
+func NewPowerSourceType(v uint8) *PowerSourceType {
+	x := UInt8(v)
+	return &PowerSourceType{UInt8: &x}
+}
+
+// Value returns the value held by p, or zero if it is unset.
+func (p PowerSourceType) Value() uint8 {
+	if p.UInt8 == nil {
+		return 0
+	}
+	return uint8(*p.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p PowerSourceType) MarshalText() ([]byte, error) {
+	return formatUnsigned(p.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *PowerSourceType) UnmarshalText(text []byte) error {
+	p.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, p.UInt8)
+}
+
+// NewCostKindType returns a CostKindType holding v.
+func NewCostKindType(v uint8) *CostKindType {
+	x := UInt8(v)
+	return &CostKindType{UInt8: &x}
+}
+
+// Value returns the value held by c, or zero if it is unset.
+func (c CostKindType) Value() uint8 {
+	if c.UInt8 == nil {
+		return 0
+	}
+	return uint8(*c.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c CostKindType) MarshalText() ([]byte, error) {
+	return formatUnsigned(c.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *CostKindType) UnmarshalText(text []byte) error {
+	c.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, c.UInt8)
+}
+
+// NewPriorityType returns a PriorityType holding v.
+func NewPriorityType(v uint8) *PriorityType {
+	x := UInt8(v)
+	return &PriorityType{UInt8: &x}
+}
+
+// Value returns the value held by p, or zero if it is unset.
+func (p PriorityType) Value() uint8 {
+	if p.UInt8 == nil {
+		return 0
+	}
+	return uint8(*p.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p PriorityType) MarshalText() ([]byte, error) {
+	return formatUnsigned(p.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *PriorityType) UnmarshalText(text []byte) error {
+	p.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, p.UInt8)
+}
+
+// NewChargeKind returns a ChargeKind holding v.
+func NewChargeKind(v uint8) *ChargeKind {
+	x := UInt8(v)
+	return &ChargeKind{UInt8: &x}
+}
+
+// Value returns the value held by c, or zero if it is unset.
+func (c ChargeKind) Value() uint8 {
+	if c.UInt8 == nil {
+		return 0
+	}
+	return uint8(*c.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c ChargeKind) MarshalText() ([]byte, error) {
+	return formatUnsigned(c.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *ChargeKind) UnmarshalText(text []byte) error {
+	c.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, c.UInt8)
+}
+
+// NewPrepayModeType returns a PrepayModeType holding v.
+func NewPrepayModeType(v uint8) *PrepayModeType {
+	x := UInt8(v)
+	return &PrepayModeType{UInt8: &x}
+}
+
+// Value returns the value held by p, or zero if it is unset.
+func (p PrepayModeType) Value() uint8 {
+	if p.UInt8 == nil {
+		return 0
+	}
+	return uint8(*p.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p PrepayModeType) MarshalText() ([]byte, error) {
+	return formatUnsigned(p.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *PrepayModeType) UnmarshalText(text []byte) error {
+	p.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, p.UInt8)
+}
+
+// NewCreditStatusType returns a CreditStatusType holding v.
+func NewCreditStatusType(v uint8) *CreditStatusType {
+	x := UInt8(v)
+	return &CreditStatusType{UInt8: &x}
+}
+
+// Value returns the value held by c, or zero if it is unset.
+func (c CreditStatusType) Value() uint8 {
+	if c.UInt8 == nil {
+		return 0
+	}
+	return uint8(*c.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c CreditStatusType) MarshalText() ([]byte, error) {
+	return formatUnsigned(c.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *CreditStatusType) UnmarshalText(text []byte) error {
+	c.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, c.UInt8)
+}
+
+// NewCreditTypeType returns a CreditTypeType holding v.
+func NewCreditTypeType(v uint8) *CreditTypeType {
+	x := UInt8(v)
+	return &CreditTypeType{UInt8: &x}
+}
+
+// Value returns the value held by c, or zero if it is unset.
+func (c CreditTypeType) Value() uint8 {
+	if c.UInt8 == nil {
+		return 0
+	}
+	return uint8(*c.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c CreditTypeType) MarshalText() ([]byte, error) {
+	return formatUnsigned(c.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *CreditTypeType) UnmarshalText(text []byte) error {
+	c.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, c.UInt8)
+}
+
+// NewServiceStatusType returns a ServiceStatusType holding v.
+func NewServiceStatusType(v uint8) *ServiceStatusType {
+	x := UInt8(v)
+	return &ServiceStatusType{UInt8: &x}
+}
+
+// Value returns the value held by s, or zero if it is unset.
+func (s ServiceStatusType) Value() uint8 {
+	if s.UInt8 == nil {
+		return 0
+	}
+	return uint8(*s.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ServiceStatusType) MarshalText() ([]byte, error) {
+	return formatUnsigned(s.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ServiceStatusType) UnmarshalText(text []byte) error {
+	s.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, s.UInt8)
+}
+
+// NewDERCurveType returns a DERCurveType holding v.
+func NewDERCurveType(v uint8) *DERCurveType {
+	x := UInt8(v)
+	return &DERCurveType{UInt8: &x}
+}
+
+// Value returns the value held by d, or zero if it is unset.
+func (d DERCurveType) Value() uint8 {
+	if d.UInt8 == nil {
+		return 0
+	}
+	return uint8(*d.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d DERCurveType) MarshalText() ([]byte, error) {
+	return formatUnsigned(d.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *DERCurveType) UnmarshalText(text []byte) error {
+	d.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, d.UInt8)
+}
+
+// NewDefaultDERControlType returns a DefaultDERControlType holding v.
+func NewDefaultDERControlType(v string) *DefaultDERControlType {
+	x := HexBinary32(v)
+	return &DefaultDERControlType{HexBinary32: &x}
+}
+
+// Value returns the value held by d, or the empty string if it is unset.
+func (d DefaultDERControlType) Value() string {
+	if d.HexBinary32 == nil {
+		return ""
+	}
+	return string(*d.HexBinary32)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d DefaultDERControlType) MarshalText() ([]byte, error) {
+	return formatString(d.HexBinary32)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *DefaultDERControlType) UnmarshalText(text []byte) error {
+	x := HexBinary32(text)
+	d.HexBinary32 = &x
+	return nil
+}
+
+// NewDERControlType returns a DERControlType holding v.
+func NewDERControlType(v string) *DERControlType {
+	x := HexBinary32(v)
+	return &DERControlType{HexBinary32: &x}
+}
+
+// Value returns the value held by d, or the empty string if it is unset.
+func (d DERControlType) Value() string {
+	if d.HexBinary32 == nil {
+		return ""
+	}
+	return string(*d.HexBinary32)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d DERControlType) MarshalText() ([]byte, error) {
+	return formatString(d.HexBinary32)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *DERControlType) UnmarshalText(text []byte) error {
+	x := HexBinary32(text)
+	d.HexBinary32 = &x
+	return nil
+}
+
+// NewDERControlType2 returns a DERControlType2 holding v.
+func NewDERControlType2(v string) *DERControlType2 {
+	x := HexBinary32(v)
+	return &DERControlType2{HexBinary32: &x}
+}
+
+// Value returns the value held by d, or the empty string if it is unset.
+func (d DERControlType2) Value() string {
+	if d.HexBinary32 == nil {
+		return ""
+	}
+	return string(*d.HexBinary32)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d DERControlType2) MarshalText() ([]byte, error) {
+	return formatString(d.HexBinary32)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *DERControlType2) UnmarshalText(text []byte) error {
+	x := HexBinary32(text)
+	d.HexBinary32 = &x
+	return nil
+}
+
+// NewDERType returns a DERType holding v.
+func NewDERType(v uint8) *DERType {
+	x := UInt8(v)
+	return &DERType{UInt8: &x}
+}
+
+// Value returns the value held by d, or zero if it is unset.
+func (d DERType) Value() uint8 {
+	if d.UInt8 == nil {
+		return 0
+	}
+	return uint8(*d.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d DERType) MarshalText() ([]byte, error) {
+	return formatUnsigned(d.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *DERType) UnmarshalText(text []byte) error {
+	d.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, d.UInt8)
+}
+
+// NewDERUnitRefType returns a DERUnitRefType holding v.
+func NewDERUnitRefType(v uint8) *DERUnitRefType {
+	x := UInt8(v)
+	return &DERUnitRefType{UInt8: &x}
+}
+
+// Value returns the value held by d, or zero if it is unset.
+func (d DERUnitRefType) Value() uint8 {
+	if d.UInt8 == nil {
+		return 0
+	}
+	return uint8(*d.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d DERUnitRefType) MarshalText() ([]byte, error) {
+	return formatUnsigned(d.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *DERUnitRefType) UnmarshalText(text []byte) error {
+	d.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, d.UInt8)
+}
+
+// NewAggregationDistributionType returns an AggregationDistributionType holding v.
+func NewAggregationDistributionType(v uint8) *AggregationDistributionType {
+	x := UInt8(v)
+	return &AggregationDistributionType{UInt8: &x}
+}
+
+// Value returns the value held by a, or zero if it is unset.
+func (a AggregationDistributionType) Value() uint8 {
+	if a.UInt8 == nil {
+		return 0
+	}
+	return uint8(*a.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (a AggregationDistributionType) MarshalText() ([]byte, error) {
+	return formatUnsigned(a.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (a *AggregationDistributionType) UnmarshalText(text []byte) error {
+	a.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, a.UInt8)
+}
+
+// NewAccumulationBehaviourType returns an AccumulationBehaviourType holding v.
+func NewAccumulationBehaviourType(v uint8) *AccumulationBehaviourType {
+	x := UInt8(v)
+	return &AccumulationBehaviourType{UInt8: &x}
+}
+
+// Value returns the value held by a, or zero if it is unset.
+func (a AccumulationBehaviourType) Value() uint8 {
+	if a.UInt8 == nil {
+		return 0
+	}
+	return uint8(*a.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (a AccumulationBehaviourType) MarshalText() ([]byte, error) {
+	return formatUnsigned(a.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (a *AccumulationBehaviourType) UnmarshalText(text []byte) error {
+	a.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, a.UInt8)
+}
+
+// NewApplianceLoadReductionType returns an ApplianceLoadReductionType holding v.
+func NewApplianceLoadReductionType(v uint8) *ApplianceLoadReductionType {
+	x := UInt8(v)
+	return &ApplianceLoadReductionType{UInt8: &x}
+}
+
+// Value returns the value held by a, or zero if it is unset.
+func (a ApplianceLoadReductionType) Value() uint8 {
+	if a.UInt8 == nil {
+		return 0
+	}
+	return uint8(*a.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (a ApplianceLoadReductionType) MarshalText() ([]byte, error) {
+	return formatUnsigned(a.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (a *ApplianceLoadReductionType) UnmarshalText(text []byte) error {
+	a.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, a.UInt8)
+}
+
+// NewCommodityType returns a CommodityType holding v.
+func NewCommodityType(v uint8) *CommodityType {
+	x := UInt8(v)
+	return &CommodityType{UInt8: &x}
+}
+
+// Value returns the value held by c, or zero if it is unset.
+func (c CommodityType) Value() uint8 {
+	if c.UInt8 == nil {
+		return 0
+	}
+	return uint8(*c.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c CommodityType) MarshalText() ([]byte, error) {
+	return formatUnsigned(c.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *CommodityType) UnmarshalText(text []byte) error {
+	c.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, c.UInt8)
+}
+
+// NewConsumptionBlockType returns a ConsumptionBlockType holding v.
+func NewConsumptionBlockType(v uint8) *ConsumptionBlockType {
+	x := UInt8(v)
+	return &ConsumptionBlockType{UInt8: &x}
+}
+
+// Value returns the value held by c, or zero if it is unset.
+func (c ConsumptionBlockType) Value() uint8 {
+	if c.UInt8 == nil {
+		return 0
+	}
+	return uint8(*c.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c ConsumptionBlockType) MarshalText() ([]byte, error) {
+	return formatUnsigned(c.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *ConsumptionBlockType) UnmarshalText(text []byte) error {
+	c.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, c.UInt8)
+}
+
+// NewCountryType returns a CountryType holding v.
+func NewCountryType(v string) *CountryType {
+	x := String2(v)
+	return &CountryType{String2: &x}
+}
+
+// Value returns the value held by c, or the empty string if it is unset.
+func (c CountryType) Value() string {
+	if c.String2 == nil {
+		return ""
+	}
+	return string(*c.String2)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c CountryType) MarshalText() ([]byte, error) {
+	return formatString(c.String2)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *CountryType) UnmarshalText(text []byte) error {
+	x := String2(text)
+	c.String2 = &x
+	return nil
+}
+
+// NewCurrencyCode returns a CurrencyCode holding v.
+func NewCurrencyCode(v uint16) *CurrencyCode {
+	x := UInt16(v)
+	return &CurrencyCode{UInt16: &x}
+}
+
+// Value returns the value held by c, or zero if it is unset.
+func (c CurrencyCode) Value() uint16 {
+	if c.UInt16 == nil {
+		return 0
+	}
+	return uint16(*c.UInt16)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c CurrencyCode) MarshalText() ([]byte, error) {
+	return formatUnsigned(c.UInt16)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *CurrencyCode) UnmarshalText(text []byte) error {
+	c.UInt16 = new(UInt16)
+	return parseUnsigned(text, 16, c.UInt16)
+}
+
+// NewDataQualifierType returns a DataQualifierType holding v.
+func NewDataQualifierType(v uint8) *DataQualifierType {
+	x := UInt8(v)
+	return &DataQualifierType{UInt8: &x}
+}
+
+// Value returns the value held by d, or zero if it is unset.
+func (d DataQualifierType) Value() uint8 {
+	if d.UInt8 == nil {
+		return 0
+	}
+	return uint8(*d.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d DataQualifierType) MarshalText() ([]byte, error) {
+	return formatUnsigned(d.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *DataQualifierType) UnmarshalText(text []byte) error {
+	d.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, d.UInt8)
+}
+
+// NewDeviceCategoryType returns a DeviceCategoryType holding v.
+func NewDeviceCategoryType(v string) *DeviceCategoryType {
+	x := HexBinary32(v)
+	return &DeviceCategoryType{HexBinary32: &x}
+}
+
+// Value returns the value held by d, or the empty string if it is unset.
+func (d DeviceCategoryType) Value() string {
+	if d.HexBinary32 == nil {
+		return ""
+	}
+	return string(*d.HexBinary32)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d DeviceCategoryType) MarshalText() ([]byte, error) {
+	return formatString(d.HexBinary32)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *DeviceCategoryType) UnmarshalText(text []byte) error {
+	x := HexBinary32(text)
+	d.HexBinary32 = &x
+	return nil
+}
+
+// NewDstRuleType returns a DstRuleType holding v.
+func NewDstRuleType(v string) *DstRuleType {
+	x := HexBinary32(v)
+	return &DstRuleType{HexBinary32: &x}
+}
+
+// Value returns the value held by d, or the empty string if it is unset.
+func (d DstRuleType) Value() string {
+	if d.HexBinary32 == nil {
+		return ""
+	}
+	return string(*d.HexBinary32)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d DstRuleType) MarshalText() ([]byte, error) {
+	return formatString(d.HexBinary32)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *DstRuleType) UnmarshalText(text []byte) error {
+	x := HexBinary32(text)
+	d.HexBinary32 = &x
+	return nil
+}
+
+// NewFlowDirectionType returns a FlowDirectionType holding v.
+func NewFlowDirectionType(v uint8) *FlowDirectionType {
+	x := UInt8(v)
+	return &FlowDirectionType{UInt8: &x}
+}
+
+// Value returns the value held by f, or zero if it is unset.
+func (f FlowDirectionType) Value() uint8 {
+	if f.UInt8 == nil {
+		return 0
+	}
+	return uint8(*f.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (f FlowDirectionType) MarshalText() ([]byte, error) {
+	return formatUnsigned(f.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (f *FlowDirectionType) UnmarshalText(text []byte) error {
+	f.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, f.UInt8)
+}
+
+// NewKindType returns a KindType holding v.
+func NewKindType(v uint8) *KindType {
+	x := UInt8(v)
+	return &KindType{UInt8: &x}
+}
+
+// Value returns the value held by k, or zero if it is unset.
+func (k KindType) Value() uint8 {
+	if k.UInt8 == nil {
+		return 0
+	}
+	return uint8(*k.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (k KindType) MarshalText() ([]byte, error) {
+	return formatUnsigned(k.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (k *KindType) UnmarshalText(text []byte) error {
+	k.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, k.UInt8)
+}
+
+// NewLocaleType returns a LocaleType holding v.
+func NewLocaleType(v string) *LocaleType {
+	x := String42(v)
+	return &LocaleType{String42: &x}
+}
+
+// Value returns the value held by l, or the empty string if it is unset.
+func (l LocaleType) Value() string {
+	if l.String42 == nil {
+		return ""
+	}
+	return string(*l.String42)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (l LocaleType) MarshalText() ([]byte, error) {
+	return formatString(l.String42)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (l *LocaleType) UnmarshalText(text []byte) error {
+	x := String42(text)
+	l.String42 = &x
+	return nil
+}
+
+// NewMRIDType returns a MRIDType holding v.
+func NewMRIDType(v string) *MRIDType {
+	x := HexBinary128(v)
+	return &MRIDType{HexBinary128: &x}
+}
+
+// Value returns the value held by m, or the empty string if it is unset.
+func (m MRIDType) Value() string {
+	if m.HexBinary128 == nil {
+		return ""
+	}
+	return string(*m.HexBinary128)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (m MRIDType) MarshalText() ([]byte, error) {
+	return formatString(m.HexBinary128)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (m *MRIDType) UnmarshalText(text []byte) error {
+	x := HexBinary128(text)
+	m.HexBinary128 = &x
+	return nil
+}
+
+// NewOneHourRangeType returns a OneHourRangeType holding v.
+func NewOneHourRangeType(v int16) *OneHourRangeType {
+	x := Int16(v)
+	return &OneHourRangeType{Int16: &x}
+}
+
+// Value returns the value held by o, or zero if it is unset.
+func (o OneHourRangeType) Value() int16 {
+	if o.Int16 == nil {
+		return 0
+	}
+	return int16(*o.Int16)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (o OneHourRangeType) MarshalText() ([]byte, error) {
+	return formatSigned(o.Int16)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OneHourRangeType) UnmarshalText(text []byte) error {
+	o.Int16 = new(Int16)
+	return parseSigned(text, 16, o.Int16)
+}
+
+// NewPENType returns a PENType holding v.
+func NewPENType(v uint32) *PENType {
+	x := UInt32(v)
+	return &PENType{UInt32: &x}
+}
+
+// Value returns the value held by p, or zero if it is unset.
+func (p PENType) Value() uint32 {
+	if p.UInt32 == nil {
+		return 0
+	}
+	return uint32(*p.UInt32)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p PENType) MarshalText() ([]byte, error) {
+	return formatUnsigned(p.UInt32)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *PENType) UnmarshalText(text []byte) error {
+	p.UInt32 = new(UInt32)
+	return parseUnsigned(text, 32, p.UInt32)
+}
+
+// NewPerCent returns a PerCent holding v.
+func NewPerCent(v uint16) *PerCent {
+	x := UInt16(v)
+	return &PerCent{UInt16: &x}
+}
+
+// Value returns the value held by p, or zero if it is unset.
+func (p PerCent) Value() uint16 {
+	if p.UInt16 == nil {
+		return 0
+	}
+	return uint16(*p.UInt16)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p PerCent) MarshalText() ([]byte, error) {
+	return formatUnsigned(p.UInt16)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *PerCent) UnmarshalText(text []byte) error {
+	p.UInt16 = new(UInt16)
+	return parseUnsigned(text, 16, p.UInt16)
+}
+
+// NewPhaseCode returns a PhaseCode holding v.
+func NewPhaseCode(v uint8) *PhaseCode {
+	x := UInt8(v)
+	return &PhaseCode{UInt8: &x}
+}
+
+// Value returns the value held by p, or zero if it is unset.
+func (p PhaseCode) Value() uint8 {
+	if p.UInt8 == nil {
+		return 0
+	}
+	return uint8(*p.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p PhaseCode) MarshalText() ([]byte, error) {
+	return formatUnsigned(p.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *PhaseCode) UnmarshalText(text []byte) error {
+	p.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, p.UInt8)
+}
+
+// NewPINType returns a PINType holding v.
+func NewPINType(v uint32) *PINType {
+	x := UInt32(v)
+	return &PINType{UInt32: &x}
+}
+
+// Value returns the value held by p, or zero if it is unset.
+func (p PINType) Value() uint32 {
+	if p.UInt32 == nil {
+		return 0
+	}
+	return uint32(*p.UInt32)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p PINType) MarshalText() ([]byte, error) {
+	return formatUnsigned(p.UInt32)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *PINType) UnmarshalText(text []byte) error {
+	p.UInt32 = new(UInt32)
+	return parseUnsigned(text, 32, p.UInt32)
+}
+
+// NewPowerOfTenMultiplierType returns a PowerOfTenMultiplierType holding v.
+func NewPowerOfTenMultiplierType(v int8) *PowerOfTenMultiplierType {
+	x := Int8(v)
+	return &PowerOfTenMultiplierType{Int8: &x}
+}
+
+// Value returns the value held by p, or zero if it is unset.
+func (p PowerOfTenMultiplierType) Value() int8 {
+	if p.Int8 == nil {
+		return 0
+	}
+	return int8(*p.Int8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p PowerOfTenMultiplierType) MarshalText() ([]byte, error) {
+	return formatSigned(p.Int8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *PowerOfTenMultiplierType) UnmarshalText(text []byte) error {
+	p.Int8 = new(Int8)
+	return parseSigned(text, 8, p.Int8)
+}
+
+// NewPrimacyType returns a PrimacyType holding v.
+func NewPrimacyType(v uint8) *PrimacyType {
+	x := UInt8(v)
+	return &PrimacyType{UInt8: &x}
+}
+
+// Value returns the value held by p, or zero if it is unset.
+func (p PrimacyType) Value() uint8 {
+	if p.UInt8 == nil {
+		return 0
+	}
+	return uint8(*p.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p PrimacyType) MarshalText() ([]byte, error) {
+	return formatUnsigned(p.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *PrimacyType) UnmarshalText(text []byte) error {
+	p.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, p.UInt8)
+}
+
+// NewRoleFlagsType returns a RoleFlagsType holding v.
+func NewRoleFlagsType(v string) *RoleFlagsType {
+	x := HexBinary16(v)
+	return &RoleFlagsType{HexBinary16: &x}
+}
+
+// Value returns the value held by r, or the empty string if it is unset.
+func (r RoleFlagsType) Value() string {
+	if r.HexBinary16 == nil {
+		return ""
+	}
+	return string(*r.HexBinary16)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (r RoleFlagsType) MarshalText() ([]byte, error) {
+	return formatString(r.HexBinary16)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (r *RoleFlagsType) UnmarshalText(text []byte) error {
+	x := HexBinary16(text)
+	r.HexBinary16 = &x
+	return nil
+}
+
+// NewServiceKind returns a ServiceKind holding v.
+func NewServiceKind(v uint8) *ServiceKind {
+	x := UInt8(v)
+	return &ServiceKind{UInt8: &x}
+}
+
+// Value returns the value held by s, or zero if it is unset.
+func (s ServiceKind) Value() uint8 {
+	if s.UInt8 == nil {
+		return 0
+	}
+	return uint8(*s.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ServiceKind) MarshalText() ([]byte, error) {
+	return formatUnsigned(s.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ServiceKind) UnmarshalText(text []byte) error {
+	s.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, s.UInt8)
+}
+
+// NewSFDIType returns a SFDIType holding v.
+func NewSFDIType(v uint64) *SFDIType {
+	x := UInt40(v)
+	return &SFDIType{UInt40: &x}
+}
+
+// Value returns the value held by s, or zero if it is unset.
+func (s SFDIType) Value() uint64 {
+	if s.UInt40 == nil {
+		return 0
+	}
+	return uint64(*s.UInt40)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SFDIType) MarshalText() ([]byte, error) {
+	return formatUnsigned(s.UInt40)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SFDIType) UnmarshalText(text []byte) error {
+	s.UInt40 = new(UInt40)
+	return parseUnsigned(text, 40, s.UInt40)
+}
+
+// NewSignedPerCent returns a SignedPerCent holding v.
+func NewSignedPerCent(v int16) *SignedPerCent {
+	x := Int16(v)
+	return &SignedPerCent{Int16: &x}
+}
+
+// Value returns the value held by s, or zero if it is unset.
+func (s SignedPerCent) Value() int16 {
+	if s.Int16 == nil {
+		return 0
+	}
+	return int16(*s.Int16)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SignedPerCent) MarshalText() ([]byte, error) {
+	return formatSigned(s.Int16)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SignedPerCent) UnmarshalText(text []byte) error {
+	s.Int16 = new(Int16)
+	return parseSigned(text, 16, s.Int16)
+}
+
+// NewSubdivisionType returns a SubdivisionType holding v.
+func NewSubdivisionType(v string) *SubdivisionType {
+	x := String3(v)
+	return &SubdivisionType{String3: &x}
+}
+
+// Value returns the value held by s, or the empty string if it is unset.
+func (s SubdivisionType) Value() string {
+	if s.String3 == nil {
+		return ""
+	}
+	return string(*s.String3)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SubdivisionType) MarshalText() ([]byte, error) {
+	return formatString(s.String3)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SubdivisionType) UnmarshalText(text []byte) error {
+	x := String3(text)
+	s.String3 = &x
+	return nil
+}
+
+// NewTimeOffsetType returns a TimeOffsetType holding v.
+func NewTimeOffsetType(v int32) *TimeOffsetType {
+	x := Int32(v)
+	return &TimeOffsetType{Int32: &x}
+}
+
+// Value returns the value held by t, or zero if it is unset.
+func (t TimeOffsetType) Value() int32 {
+	if t.Int32 == nil {
+		return 0
+	}
+	return int32(*t.Int32)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t TimeOffsetType) MarshalText() ([]byte, error) {
+	return formatSigned(t.Int32)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *TimeOffsetType) UnmarshalText(text []byte) error {
+	t.Int32 = new(Int32)
+	return parseSigned(text, 32, t.Int32)
+}
+
+// NewTimeType returns a TimeType holding v.
+func NewTimeType(v int64) *TimeType {
+	x := Int64(v)
+	return &TimeType{Int64: &x}
+}
+
+// Value returns the value held by t, or zero if it is unset.
+func (t TimeType) Value() int64 {
+	if t.Int64 == nil {
+		return 0
+	}
+	return int64(*t.Int64)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t TimeType) MarshalText() ([]byte, error) {
+	return formatSigned(t.Int64)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *TimeType) UnmarshalText(text []byte) error {
+	t.Int64 = new(Int64)
+	return parseSigned(text, 64, t.Int64)
+}
+
+// NewTOUType returns a TOUType holding v.
+func NewTOUType(v uint8) *TOUType {
+	x := UInt8(v)
+	return &TOUType{UInt8: &x}
+}
+
+// Value returns the value held by t, or zero if it is unset.
+func (t TOUType) Value() uint8 {
+	if t.UInt8 == nil {
+		return 0
+	}
+	return uint8(*t.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t TOUType) MarshalText() ([]byte, error) {
+	return formatUnsigned(t.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *TOUType) UnmarshalText(text []byte) error {
+	t.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, t.UInt8)
+}
+
+// NewUnitType returns a UnitType holding v.
+func NewUnitType(v uint8) *UnitType {
+	x := UInt8(v)
+	return &UnitType{UInt8: &x}
+}
+
+// Value returns the value held by u, or zero if it is unset.
+func (u UnitType) Value() uint8 {
+	if u.UInt8 == nil {
+		return 0
+	}
+	return uint8(*u.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (u UnitType) MarshalText() ([]byte, error) {
+	return formatUnsigned(u.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (u *UnitType) UnmarshalText(text []byte) error {
+	u.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, u.UInt8)
+}
+
+// NewUomType returns a UomType holding v.
+func NewUomType(v uint8) *UomType {
+	x := UInt8(v)
+	return &UomType{UInt8: &x}
+}
+
+// Value returns the value held by u, or zero if it is unset.
+func (u UomType) Value() uint8 {
+	if u.UInt8 == nil {
+		return 0
+	}
+	return uint8(*u.UInt8)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (u UomType) MarshalText() ([]byte, error) {
+	return formatUnsigned(u.UInt8)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (u *UomType) UnmarshalText(text []byte) error {
+	u.UInt8 = new(UInt8)
+	return parseUnsigned(text, 8, u.UInt8)
+}
+
+// NewVersionType returns a VersionType holding v.
+func NewVersionType(v uint16) *VersionType {
+	x := UInt16(v)
+	return &VersionType{UInt16: &x}
+}
+
+// Value returns the value held by v, or zero if it is unset.
+func (v VersionType) Value() uint16 {
+	if v.UInt16 == nil {
+		return 0
+	}
+	return uint16(*v.UInt16)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v VersionType) MarshalText() ([]byte, error) {
+	return formatUnsigned(v.UInt16)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *VersionType) UnmarshalText(text []byte) error {
+	v.UInt16 = new(UInt16)
+	return parseUnsigned(text, 16, v.UInt16)
+}
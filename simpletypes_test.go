@@ -0,0 +1,171 @@
+package sep
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+)
+
+// textCase is a text form of a simple type, and whether it is valid.
+type textCase struct {
+	in, want string
+	err      bool
+}
+
+// textRoundTrip checks that the text forms of a simple type decode, and
+// encode again, as expected.
+func textRoundTrip[T any, P interface {
+	*T
+	MarshalText() ([]byte, error)
+	UnmarshalText([]byte) error
+}](t *testing.T, tests []textCase) {
+	t.Helper()
+	for _, tt := range tests {
+		var v T
+		err := P(&v).UnmarshalText([]byte(tt.in))
+		if tt.err {
+			if err == nil {
+				t.Errorf("%T.UnmarshalText(%q) succeeded", v, tt.in)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%T.UnmarshalText(%q): %v", v, tt.in, err)
+			continue
+		}
+		if out, err := P(&v).MarshalText(); err != nil || string(out) != tt.want {
+			t.Errorf("%T %q marshalled as %q, %v, want %q", v, tt.in, out, err, tt.want)
+		}
+	}
+	// An unset value has no text.
+	var v T
+	if out, err := P(&v).MarshalText(); err != nil || len(out) != 0 {
+		t.Errorf("unset %T marshalled as %q, %v", v, out, err)
+	}
+}
+
+func TestSimpleTypesText(t *testing.T) {
+	t.Run("TimeType", func(t *testing.T) {
+		textRoundTrip[TimeType](t, []textCase{
+			{in: "1700000000", want: "1700000000"},
+			{in: "0", want: "0"},
+			{in: "-1", want: "-1"},
+			{in: " 42\n", want: "42"},
+			{in: "9223372036854775807", want: "9223372036854775807"},
+			{in: "9223372036854775808", err: true},
+			{in: "1.5", err: true},
+			{in: "2024-01-01T00:00:00Z", err: true},
+			{in: "", err: true},
+		})
+	})
+	t.Run("SFDIType", func(t *testing.T) {
+		textRoundTrip[SFDIType](t, []textCase{
+			{in: "167261211391", want: "167261211391"},
+			{in: "1099511627775", want: "1099511627775"},
+			{in: "1099511627776", err: true},
+			{in: "-1", err: true},
+			{in: "0x10", err: true},
+		})
+	})
+	t.Run("PrimacyType", func(t *testing.T) {
+		textRoundTrip[PrimacyType](t, []textCase{
+			{in: "0", want: "0"},
+			{in: "255", want: "255"},
+			{in: "256", err: true},
+			{in: "-1", err: true},
+		})
+	})
+	t.Run("VersionType", func(t *testing.T) {
+		textRoundTrip[VersionType](t, []textCase{
+			{in: "1", want: "1"},
+			{in: "65535", want: "65535"},
+			{in: "65536", err: true},
+			{in: "one", err: true},
+		})
+	})
+	t.Run("DERControlType", func(t *testing.T) {
+		// The hexBinary types keep their text as is; Validate checks it.
+		textRoundTrip[DERControlType](t, []textCase{
+			{in: "00800001", want: "00800001"},
+			{in: "ff", want: "ff"},
+			{in: "", want: ""},
+		})
+	})
+}
+
+func TestSimpleTypesValue(t *testing.T) {
+	if v := NewSFDIType(1<<40 - 1).Value(); v != 1<<40-1 {
+		t.Errorf("SFDIType Value = %d", v)
+	}
+	if v := NewPrimacyType(3).Value(); v != 3 {
+		t.Errorf("PrimacyType Value = %d", v)
+	}
+	if v := NewVersionType(2).Value(); v != 2 {
+		t.Errorf("VersionType Value = %d", v)
+	}
+	if v := NewTimeOffsetType(-18000).Value(); v != -18000 {
+		t.Errorf("TimeOffsetType Value = %d", v)
+	}
+	if v := NewDERControlType("0001").Value(); v != "0001" {
+		t.Errorf("DERControlType Value = %q", v)
+	}
+	if (TimeType{}).Value() != 0 || (DERControlType{}).Value() != "" {
+		t.Error("unset values are not zero")
+	}
+}
+
+func TestTimeTypeTime(t *testing.T) {
+	zone := time.FixedZone("EST", -5*3600)
+	at := time.Date(2024, 3, 10, 1, 59, 59, 999_000_000, zone)
+	tt := NewTimeTypeFromTime(at)
+	if tt.Value() != at.Unix() {
+		t.Errorf("Value = %d, want %d", tt.Value(), at.Unix())
+	}
+	want := time.Date(2024, 3, 10, 6, 59, 59, 0, time.UTC)
+	if got := tt.Time(); !got.Equal(want) || got.Location() != time.UTC {
+		t.Errorf("Time = %v, want %v", got, want)
+	}
+	if got := (TimeType{}).Time(); !got.Equal(time.Unix(0, 0)) {
+		t.Errorf("unset Time = %v, want the epoch", got)
+	}
+	// Before the epoch, truncation is toward the earlier second.
+	if got := NewTimeTypeFromTime(time.Unix(-1, 500_000_000)).Value(); got != -1 {
+		t.Errorf("Value of -0.5 s = %d, want -1", got)
+	}
+}
+
+func TestSimpleTypesXML(t *testing.T) {
+	type doc struct {
+		XMLName  xml.Name        `xml:"doc"`
+		Time     *TimeType       `xml:"time"`
+		Primacy  *PrimacyType    `xml:"primacy,attr,omitempty"`
+		Version  *VersionType    `xml:"version"`
+		SFDI     *SFDIType       `xml:"sFDI"`
+		Modes    *DERControlType `xml:"modes"`
+		Unset    *PrimacyType    `xml:"unset"`
+		UnsetStr *DERControlType `xml:"unsetStr,attr,omitempty"`
+	}
+	in := doc{
+		Time:    NewTimeType(1700000000),
+		Primacy: NewPrimacyType(2),
+		Version: NewVersionType(1),
+		SFDI:    NewSFDIType(167261211391),
+		Modes:   NewDERControlModes(OpModVoltVar),
+	}
+	const want = `<doc primacy="2"><time>1700000000</time><version>1</version><sFDI>167261211391</sFDI><modes>00800000</modes></doc>`
+	out, err := xml.Marshal(in)
+	if err != nil || string(out) != want {
+		t.Fatalf("marshalled\n%s, %v\nwant\n%s", out, err, want)
+	}
+	var back doc
+	if err := xml.Unmarshal(out, &back); err != nil {
+		t.Fatal(err)
+	}
+	if back.Time.Value() != 1700000000 || back.Primacy.Value() != 2 || back.Version.Value() != 1 ||
+		back.SFDI.Value() != 167261211391 || !back.Modes.Has(OpModVoltVar) || back.Unset != nil || back.UnsetStr != nil {
+		t.Errorf("unmarshalled %+v", back)
+	}
+	if err := xml.Unmarshal([]byte(`<doc primacy="300"/>`), &back); err == nil {
+		t.Error("primacy 300 unmarshalled")
+	}
+}
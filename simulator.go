@@ -0,0 +1,596 @@
+package sep
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// simStep is the longest step by which a DERSimulator advances its
+// physical state.
+const simStep = time.Second
+
+// simEfficiency is the one-way efficiency of charging and of discharging a
+// simulated battery.
+const simEfficiency = 0.95
+
+// DERSimConfig describes the DER a DERSimulator simulates.
+type DERSimConfig struct {
+	// Kind selects how the DER behaves: DERKindPV produces what the sun
+	// allows, DERKindStorage charges and discharges a battery as it is
+	// told, and DERKindEV and DERKindEVSE charge an EV battery.
+	Kind DERKind
+	// MaxW is the rated active power in watts. MaxVar defaults to 44% of
+	// MaxW, the least IEEE 1547 requires of category B at rated power, and
+	// MaxVA to what gives both at once; an EV charger has no reactive
+	// power.
+	MaxW, MaxVA, MaxVar float64
+	// MaxWh is the usable energy of the battery of storage or an EV, in
+	// watt-hours, and SOC its state of charge at the start, from 0 to 1.
+	MaxWh, SOC float64
+	// VNom is the nominal voltage, 240 V if zero.
+	VNom float64
+	// Grid returns the voltage of the grid at the DER at a time. If it is
+	// nil the grid stays at VNom.
+	Grid func(time.Time) float64
+	// Sun returns the fraction of MaxW a PV array can produce at a time. If
+	// it is nil the array follows a clear day from 6:00 to 18:00 in the
+	// location of the time.
+	Sun func(time.Time) float64
+	// PEN is the Private Enterprise Number that ends the mRIDs of the
+	// readings.
+	PEN uint32
+	// PostRate is how often the readings are posted. If it is not zero,
+	// Readings sets their nextUpdateTime.
+	PostRate time.Duration
+}
+
+// DERSimulator simulates a DER, so that servers can be tested without
+// hardware. It owns the DERCapability, DERSettings, DERStatus and
+// DERAvailability of the DER and keeps its status and availability up to
+// date; the settings may be changed between calls to Advance. It is not
+// safe for concurrent use.
+type DERSimulator struct {
+	Capability   *DERCapability
+	Settings     *DERSettings
+	Status       *DERStatus
+	Availability *DERAvailability
+
+	cfg      DERSimConfig
+	controls *CurrentDERControls
+	now      time.Time
+	// v is the voltage at the DER, w and vars its output, positive when
+	// injecting, and soc the state of charge of its battery.
+	v, w, vars, soc float64
+	// whOut and whIn are the energy delivered and received.
+	whOut, whIn float64
+
+	// ramp is the duration of the ramp the last Apply asked for, until the
+	// next step turns it into rampRate, in watts per second, until rampEnd.
+	ramp     *time.Duration
+	rampRate float64
+	rampEnd  time.Time
+	// soft is set from reconnection until the output reaches its target.
+	soft      bool
+	connected bool
+	derated   bool
+
+	readings []simReading
+}
+
+// simReading is one of the MirrorMeterReadings of a DERSimulator.
+type simReading struct {
+	mrid                          *MRIDType
+	description                   string
+	kind, uom, flow, accumulation uint8
+	multiplier                    int8
+	value                         func(*DERSimulator) float64
+}
+
+// NewDERSimulator returns a simulator of the DER cfg describes, idle at
+// start.
+func NewDERSimulator(cfg DERSimConfig, start time.Time) (*DERSimulator, error) {
+	battery := cfg.Kind != DERKindPV
+	switch cfg.Kind {
+	case DERKindPV, DERKindStorage, DERKindEV, DERKindEVSE:
+	default:
+		return nil, fmt.Errorf("sep: cannot simulate a DER of type %s", cfg.Kind)
+	}
+	if cfg.VNom == 0 {
+		cfg.VNom = 240
+	}
+	if cfg.Kind == DERKindEV || cfg.Kind == DERKindEVSE {
+		cfg.MaxVar = 0
+	} else if cfg.MaxVar == 0 {
+		cfg.MaxVar = 0.44 * cfg.MaxW
+	}
+	if cfg.MaxVA == 0 {
+		cfg.MaxVA = math.Hypot(cfg.MaxW, cfg.MaxVar)
+	}
+	for _, f := range []float64{cfg.MaxW, cfg.MaxVA, cfg.VNom} {
+		if !(f > 0 && f < 1e12) {
+			return nil, fmt.Errorf("sep: DER rating %g out of range", f)
+		}
+	}
+	if battery && !(cfg.MaxWh > 0 && cfg.MaxWh < 1e12) {
+		return nil, fmt.Errorf("sep: battery energy %g Wh out of range", cfg.MaxWh)
+	}
+	if cfg.SOC < 0 || cfg.SOC > 1 {
+		return nil, fmt.Errorf("sep: state of charge %g out of range 0 to 1", cfg.SOC)
+	}
+
+	modes, modes2 := NewDERControlModes(OpModConnect, OpModEnergize), NewDERControlModes2()
+	if cfg.Kind == DERKindPV || cfg.Kind == DERKindStorage {
+		for _, m := range []DERControlMode{OpModFixedPFInjectW, OpModFixedVar, OpModMaxLimW, OpModTargetVar,
+			OpModVoltVar, OpModVoltWatt, OpModWattPF, OpModWattVar} {
+			modes.Set(m)
+		}
+		modes2.Set(OpModMaxLimWInject)
+	}
+	if battery {
+		for _, m := range []DERControlMode{ChargeMode, OpModFixedW, OpModTargetW} {
+			modes.Set(m)
+		}
+		modes2.Set(OpModMaxLimWAbsorb)
+	}
+	if cfg.Kind == DERKindStorage {
+		modes.Set(DischargeMode)
+		modes.Set(OpModFixedPFAbsorbW)
+	}
+
+	c := NewDERCapability()
+	c.Type = NewDERType(uint8(cfg.Kind))
+	c.ModesSupported, c.ModesSupported2 = modes, modes2
+	c.RtgMaxW = rounded(ActivePowerFromFloat, cfg.MaxW)
+	c.RtgMaxVA = rounded(ApparentPowerFromFloat, cfg.MaxVA)
+	c.RtgVNom = rounded(VoltageRMSFromFloat, cfg.VNom)
+	s := NewDERSettings()
+	s.ModesEnabled, s.ModesEnabled2 = NewDERControlModes(), NewDERControlModes2()
+	s.ModesEnabled.SetBits(modes.Bits())
+	s.ModesEnabled2.SetBits(modes2.Bits())
+	s.SetMaxW, s.SetMaxVA = c.RtgMaxW, c.RtgMaxVA
+	s.SetVNom, s.SetVRef = c.RtgVNom, c.RtgVNom
+	s.SetMaxVar = rounded(ReactivePowerFromFloat, cfg.MaxVar)
+	if cfg.MaxVar > 0 {
+		c.RtgMaxVar = s.SetMaxVar
+	}
+	if battery {
+		c.RtgMaxWh = rounded(WattHourFromFloat, cfg.MaxWh)
+		c.RtgMaxChargeRateW = c.RtgMaxW
+		s.SetMaxWh, s.SetMaxChargeRateW = c.RtgMaxWh, c.RtgMaxW
+		if cfg.Kind == DERKindStorage {
+			c.RtgMaxDischargeRateW, s.SetMaxDischargeRateW = c.RtgMaxW, c.RtgMaxW
+		}
+	}
+	s.UpdatedTime = NewTimeTypeFromTime(start)
+
+	d := &DERSimulator{
+		Capability:   c,
+		Settings:     s,
+		Status:       NewDERStatus(),
+		Availability: NewDERAvailability(),
+		cfg:          cfg,
+		now:          start,
+		soc:          cfg.SOC,
+		connected:    true,
+	}
+	d.v = d.voltage(start)
+	d.readings = []simReading{
+		{description: "Real Power", kind: 37, uom: 38, flow: 1, accumulation: 12,
+			value: func(d *DERSimulator) float64 { return d.w }},
+		{description: "Reactive Power", kind: 37, uom: 63, flow: 1, accumulation: 12,
+			value: func(d *DERSimulator) float64 { return d.vars }},
+		{description: "Voltage", uom: 29, accumulation: 12, multiplier: -1,
+			value: func(d *DERSimulator) float64 { return d.v }},
+		{description: "Energy Delivered", kind: 12, uom: 72, flow: 1, accumulation: 9,
+			value: func(d *DERSimulator) float64 { return d.whOut }},
+	}
+	if battery {
+		d.readings = append(d.readings, simReading{description: "Energy Received", kind: 12, uom: 72, flow: 19, accumulation: 9,
+			value: func(d *DERSimulator) float64 { return d.whIn }})
+	}
+	g := NewMRIDGenerator(cfg.PEN)
+	for i := range d.readings {
+		d.readings[i].mrid = g.Next()
+	}
+	d.report()
+	return d, nil
+}
+
+// rounded returns f, rounded to a tenth, converted by from. The values a
+// DERSimulator reports fit any of the multiplier and value types.
+func rounded[T any](from func(float64) (*T, error), f float64) *T {
+	v, _ := from(math.Round(f*10) / 10)
+	return v
+}
+
+// W returns the active power of d in watts, positive when injecting.
+func (d *DERSimulator) W() float64 { return d.w }
+
+// Var returns the reactive power of d in vars, positive when injecting.
+func (d *DERSimulator) Var() float64 { return d.vars }
+
+// Voltage returns the voltage at d in volts.
+func (d *DERSimulator) Voltage() float64 { return d.v }
+
+// Apply makes c the controls d follows from now on; nil, or a mode c does
+// not set or the settings do not enable, leaves d to its default: PV
+// produces all it can, storage holds, and an EV charges as fast as it can.
+// rampTms is the rampTms of the DERControl that brings the change, in
+// hundredths of a second, over which d moves to its new active power; if it
+// is nil, d ramps at setGradW.
+func (d *DERSimulator) Apply(c *CurrentDERControls, rampTms *uint16) {
+	d.controls = c
+	d.ramp = nil
+	if rampTms != nil {
+		r := time.Duration(*rampTms) * 10 * time.Millisecond
+		d.ramp = &r
+	}
+}
+
+// Advance moves d on to now, in steps of at most a second, and updates its
+// status and availability.
+func (d *DERSimulator) Advance(now time.Time) {
+	for d.now.Before(now) {
+		dt := min(simStep, now.Sub(d.now))
+		d.now = d.now.Add(dt)
+		d.step(d.now, dt)
+	}
+	d.report()
+}
+
+// voltage returns the voltage of the grid at t.
+func (d *DERSimulator) voltage(t time.Time) float64 {
+	if d.cfg.Grid == nil {
+		return d.cfg.VNom
+	}
+	return d.cfg.Grid(t)
+}
+
+// clearSky returns the fraction of its rating a PV array produces at t on
+// a clear day.
+func clearSky(t time.Time) float64 {
+	h := float64(t.Hour()) + float64(t.Minute())/60 + float64(t.Second())/3600
+	return max(0, math.Sin(math.Pi*(h-6)/12))
+}
+
+// limits returns the most active power d can inject and absorb at t.
+func (d *DERSimulator) limits(t time.Time) (out, in float64) {
+	s := d.Settings
+	maxW := floatOr(s.SetMaxW)
+	if math.IsNaN(maxW) {
+		maxW = d.cfg.MaxW
+	}
+	or := func(p *ActivePower) float64 {
+		if p == nil {
+			return maxW
+		}
+		return min(p.Float64(), maxW)
+	}
+	// Charging tapers over the last tenth, as a battery does at constant
+	// voltage.
+	taper := min(1, max(0.05, (1-d.soc)*10))
+	switch d.cfg.Kind {
+	case DERKindPV:
+		sun := clearSky
+		if d.cfg.Sun != nil {
+			sun = d.cfg.Sun
+		}
+		return maxW * min(1, max(0, sun(t))), 0
+	case DERKindStorage:
+		if d.soc > 0 {
+			out = or(s.SetMaxDischargeRateW)
+		}
+		fallthrough
+	default:
+		if d.soc < 1 {
+			in = or(s.SetMaxChargeRateW) * taper
+		}
+	}
+	return out, in
+}
+
+// enabled reports whether the settings of d enable mode.
+func (d *DERSimulator) enabled(mode string) bool { return d.Settings.ModeEnabled(mode) }
+
+// curveOf returns the curve of c, or nil if c is nil.
+func curveOf(c *DERCurveControlType) *DERCurve {
+	if c == nil {
+		return nil
+	}
+	return c.DERCurve
+}
+
+// step advances the physical state of d by dt to t.
+func (d *DERSimulator) step(t time.Time, dt time.Duration) {
+	d.v = d.voltage(t)
+	c := d.controls
+	if c == nil {
+		c = new(CurrentDERControls)
+	}
+	off := func(b *bool, mode string) bool { return b != nil && !*b && d.enabled(mode) }
+	if off(c.OpModConnect, "opModConnect") || off(c.OpModEnergize, "opModEnergize") {
+		// Ceasing to energize is immediate.
+		d.w, d.vars, d.connected, d.derated = 0, 0, false, false
+		return
+	}
+	if !d.connected {
+		d.connected, d.soft = true, true
+	}
+	outMax, inMax := d.limits(t)
+	e := CurveEvaluator{Settings: d.Settings, Availability: d.Availability}
+
+	var target float64
+	switch d.cfg.Kind {
+	case DERKindPV:
+		target = outMax
+	case DERKindEV, DERKindEVSE:
+		target = -inMax
+	}
+	if v := c.OpModFixedW; v != nil && v.SignedPerCent != nil && d.enabled("opModFixedW") {
+		if w, err := e.reference(UnitRefSetMaxW, float64(v.Value())/100); err == nil {
+			target = w
+		}
+	}
+	if v := c.OpModTargetW; v != nil && v.ActivePower != nil && d.enabled("opModTargetW") {
+		target = v.ActivePower.Float64()
+	}
+	hi, lo := outMax, -inMax
+	if v := c.OpModMaxLimW; v != nil && v.PerCent != nil && d.enabled("opModMaxLimW") {
+		if w, err := e.reference(UnitRefSetMaxW, float64(v.Value())/100); err == nil {
+			hi = min(hi, w)
+		}
+	}
+	if v := c.OpModMaxLimWInject; v != nil && v.UnsignedActivePower != nil && d.enabled("opModMaxLimWInject") {
+		hi = min(hi, v.UnsignedActivePower.Float64())
+	}
+	if v := c.OpModMaxLimWAbsorb; v != nil && v.UnsignedActivePower != nil && d.enabled("opModMaxLimWAbsorb") {
+		lo = max(lo, -v.UnsignedActivePower.Float64())
+	}
+	if curve := curveOf(c.OpModVoltWatt); curve != nil && d.enabled("opModVoltWatt") {
+		if w, _, err := e.Eval(curve, d.v); err == nil {
+			hi = min(hi, w)
+		}
+	}
+	target = max(min(target, hi), lo)
+	d.derated = hi < outMax && target > 0 && target >= hi
+
+	rate := math.Inf(1)
+	if d.ramp != nil {
+		if *d.ramp > 0 {
+			d.rampRate = math.Abs(target-d.w) / d.ramp.Seconds()
+		} else {
+			d.rampRate = math.Inf(1)
+		}
+		d.rampEnd, d.ramp = t.Add(*d.ramp), nil
+	}
+	maxW := e.settings().SetMaxW.Float64()
+	switch {
+	case !t.After(d.rampEnd):
+		rate = d.rampRate
+	case d.soft && d.Settings.SetSoftGradW != nil && *d.Settings.SetSoftGradW > 0:
+		rate = maxW * float64(*d.Settings.SetSoftGradW) / 10000
+	case d.Settings.SetGradW > 0:
+		rate = maxW * float64(d.Settings.SetGradW) / 10000
+	}
+	d.w = approach(d.w, target, rate*dt.Seconds())
+	if d.w == target {
+		d.soft = false
+	}
+
+	q, tau := d.reactiveTarget(c, e)
+	va := floatOr(d.Settings.SetMaxVA)
+	if math.IsNaN(va) {
+		va = d.cfg.MaxVA
+	}
+	room := math.Sqrt(max(0, va*va-d.w*d.w))
+	qmax, qmin := room, -room
+	if v := floatOr(d.Settings.SetMaxVar); !math.IsNaN(v) {
+		qmax, qmin = min(qmax, v), max(qmin, -v)
+	}
+	if v := floatOr(d.Settings.SetMaxVarNeg); !math.IsNaN(v) {
+		qmin = max(-room, -math.Abs(v))
+	}
+	q = max(min(q, qmax), qmin)
+	if tau > 0 {
+		d.vars += (q - d.vars) * (1 - math.Exp(-dt.Seconds()/tau))
+	} else {
+		d.vars = q
+	}
+
+	h := dt.Hours()
+	switch {
+	case d.w > 0:
+		d.whOut += d.w * h
+		if d.cfg.Kind != DERKindPV {
+			d.soc -= d.w * h / simEfficiency / d.cfg.MaxWh
+		}
+	case d.w < 0:
+		d.whIn -= d.w * h
+		d.soc -= d.w * h * simEfficiency / d.cfg.MaxWh
+	}
+	d.soc = min(1, max(0, d.soc))
+}
+
+// approach returns x moved towards to by at most by.
+func approach(x, to, by float64) float64 {
+	if math.Abs(to-x) <= by {
+		return to
+	}
+	return x + math.Copysign(by, to-x)
+}
+
+// reactiveTarget returns the reactive power the controls c ask of d, and
+// the time constant in seconds with which d follows it: that of the
+// open-loop response time of a curve, or 0 for a step. A DER follows one
+// reactive power mode; the first c sets of fixed power factor, fixed vars,
+// target vars, volt-var, watt-var and watt-PF wins.
+func (d *DERSimulator) reactiveTarget(c *CurrentDERControls, e CurveEvaluator) (float64, float64) {
+	pf, mode := c.OpModFixedPFInjectW, "opModFixedPFInjectW"
+	if d.w < 0 {
+		pf, mode = c.OpModFixedPFAbsorbW, "opModFixedPFAbsorbW"
+	}
+	if pf != nil && pf.PowerFactorWithExcitation != nil && d.enabled(mode) {
+		p := pf.PowerFactorWithExcitation
+		return pfVars(d.w, p.Float64(), p.Excitation), 0
+	}
+	if v := c.OpModFixedVar; v != nil && v.FixedVar != nil && v.Value != nil && d.enabled("opModFixedVar") {
+		ref := UnitRefSetMaxVar
+		if v.RefType != nil && v.RefType.UInt8 != nil {
+			ref = UnitRef(v.RefType.Value())
+		}
+		if q, err := e.reference(ref, float64(v.Value.Value())/100); err == nil {
+			return q, 0
+		}
+	}
+	if v := c.OpModTargetVar; v != nil && v.ReactivePower != nil && d.enabled("opModTargetVar") {
+		return v.ReactivePower.Float64(), 0
+	}
+	for _, m := range []struct {
+		curve *DERCurve
+		mode  string
+		x     float64
+	}{
+		{curveOf(c.OpModVoltVar), "opModVoltVar", d.v},
+		{curveOf(c.OpModWattVar), "opModWattVar", d.w},
+		{curveOf(c.OpModWattPF), "opModWattPF", d.w},
+	} {
+		if m.curve == nil || !d.enabled(m.mode) {
+			continue
+		}
+		y, absorbing, err := e.Eval(m.curve, m.x)
+		if err != nil {
+			continue
+		}
+		if m.mode == "opModWattPF" {
+			y = pfVars(d.w, y, absorbing)
+		}
+		var tau float64
+		if m.curve.OpenLoopTms != nil {
+			// The open-loop response time is the time to 90% of a step.
+			tau = float64(*m.curve.OpenLoopTms) / 100 / math.Ln10
+		}
+		return y, tau
+	}
+	return 0, 0
+}
+
+// pfVars returns the reactive power at which active power w has the
+// displacement power factor pf, absorbing vars if absorbing.
+func pfVars(w, pf float64, absorbing bool) float64 {
+	if pf <= 0 || pf > 1 {
+		return 0
+	}
+	q := math.Abs(w) * math.Sqrt(1-pf*pf) / pf
+	if absorbing {
+		return -q
+	}
+	return q
+}
+
+// report updates the status and availability of d.
+func (d *DERSimulator) report() {
+	now := NewTimeTypeFromTime(d.now)
+	out, in := d.limits(d.now)
+	st := d.Status
+
+	inverter := InverterRunning
+	switch {
+	case !d.connected:
+		inverter = InverterOff
+	case out == 0 && in == 0 && d.vars == 0:
+		inverter = InverterSleeping
+	case d.derated:
+		inverter = InverterDerating
+	}
+	if st.InverterStatus == nil || st.InverterStatus.Value != inverter {
+		st.InverterStatus = &InverterStatusType{DateTime: now, Value: inverter}
+	}
+	mode := uint8(2)
+	if !d.connected {
+		mode = 1
+	}
+	if st.OperationalModeStatus == nil || st.OperationalModeStatus.Value != mode {
+		st.OperationalModeStatus = &OperationalModeStatusType{DateTime: now, Value: mode}
+	}
+	// Bit 0 is connected, 1 available and 2 operating.
+	var bits uint64
+	if d.connected {
+		bits |= 1
+	}
+	if out > 0 || in > 0 {
+		bits |= 2
+	}
+	if d.w != 0 {
+		bits |= 4
+	}
+	connect := &st.GenConnectStatus
+	if d.cfg.Kind != DERKindPV {
+		connect = &st.StorConnectStatus
+	}
+	if *connect == nil || (*connect).Value != formatBits(bits, 8) {
+		*connect = &ConnectStatusType{DateTime: now, Value: formatBits(bits, 8)}
+	}
+
+	a := d.Availability
+	a.ReadingTime = now
+	a.StatWAvail = rounded(ActivePowerFromFloat, out)
+	a.StatVarAvail = rounded(ReactivePowerFromFloat, min(d.cfg.MaxVar, math.Sqrt(max(0, d.cfg.MaxVA*d.cfg.MaxVA-d.w*d.w))))
+	if d.cfg.Kind != DERKindPV {
+		soc := NewPerCent(uint16(math.Round(d.soc * 10000)))
+		if st.StateOfChargeStatus == nil || st.StateOfChargeStatus.Value == nil || st.StateOfChargeStatus.Value.Value() != soc.Value() {
+			st.StateOfChargeStatus = &StateOfChargeStatusType{DateTime: now, Value: soc}
+		}
+		// Storage mode 0 is charging, 1 discharging and 2 holding.
+		storage := uint8(2)
+		switch {
+		case d.w < 0:
+			storage = 0
+		case d.w > 0:
+			storage = 1
+		}
+		if st.StorageModeStatus == nil || st.StorageModeStatus.Value != storage {
+			st.StorageModeStatus = &StorageModeStatusType{DateTime: now, Value: storage}
+		}
+
+		a.StatWAbsorbAvail = rounded(UnsignedActivePowerFromFloat, in)
+		a.AvailabilityDuration, a.MaxChargeDuration = nil, nil
+		if out > 0 {
+			s := uint32(d.soc * d.cfg.MaxWh * simEfficiency / out * 3600)
+			a.AvailabilityDuration = &s
+		}
+		if in > 0 {
+			s := uint32((1 - d.soc) * d.cfg.MaxWh / simEfficiency / in * 3600)
+			a.MaxChargeDuration = &s
+		}
+	}
+	st.ReadingTime = now
+}
+
+// Readings returns the telemetry of d as of the last Advance, as
+// MirrorMeterReadings to post to a MirrorUsagePoint: instantaneous active
+// and reactive power and voltage, and the energy delivered and, for a
+// battery, received. Their mRIDs stay the same from call to call.
+func (d *DERSimulator) Readings() []*MirrorMeterReading {
+	now := NewTimeTypeFromTime(d.now)
+	var ms []*MirrorMeterReading
+	for _, r := range d.readings {
+		m := NewMirrorMeterReading()
+		m.MRID, m.Description = r.mrid, NewString(r.description)
+		m.LastUpdateTime = now
+		if d.cfg.PostRate > 0 {
+			m.NextUpdateTime = NewTimeTypeFromTime(d.now.Add(d.cfg.PostRate))
+		}
+		t := NewReadingType()
+		t.Commodity, t.Kind, t.Uom = NewCommodityType(1), NewKindType(r.kind), NewUomType(r.uom)
+		t.FlowDirection, t.AccumulationBehaviour = NewFlowDirectionType(r.flow), NewAccumulationBehaviourType(r.accumulation)
+		t.PowerOfTenMultiplier = NewPowerOfTenMultiplierType(r.multiplier)
+		m.ReadingType = t
+		v := int64(math.Round(r.value(d) * math.Pow10(-int(r.multiplier))))
+		m.Reading = NewReading()
+		m.Reading.Value = &v
+		m.Reading.TimePeriod = &DateTimeInterval{Start: now}
+		ms = append(ms, m)
+	}
+	return ms
+}
@@ -0,0 +1,243 @@
+package sep
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func mustSimulate(t *testing.T, cfg DERSimConfig, start time.Time) *DERSimulator {
+	t.Helper()
+	d, err := NewDERSimulator(cfg, start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func targetW(w float64) *CurrentDERControls {
+	p, _ := ActivePowerFromFloat(w)
+	return &CurrentDERControls{OpModTargetW: &ActivePowerControlType{ActivePower: p}}
+}
+
+func within(a, b, tol float64) bool { return math.Abs(a-b) <= tol }
+
+func TestNewDERSimulator(t *testing.T) {
+	for _, cfg := range []DERSimConfig{
+		{Kind: DERKindPVStorage, MaxW: 5000, MaxWh: 10000},
+		{Kind: DERKindPV},
+		{Kind: DERKindPV, MaxW: math.NaN()},
+		{Kind: DERKindStorage, MaxW: 5000},
+		{Kind: DERKindStorage, MaxW: 5000, MaxWh: 10000, SOC: 1.5},
+	} {
+		if _, err := NewDERSimulator(cfg, t0); err == nil {
+			t.Errorf("NewDERSimulator(%+v) succeeded", cfg)
+		}
+	}
+
+	d := mustSimulate(t, DERSimConfig{Kind: DERKindStorage, MaxW: 5000, MaxWh: 10000, SOC: 0.5}, t0)
+	c := d.Capability
+	if k, _ := c.Kind(); k != DERKindStorage || c.RtgMaxW.Float64() != 5000 || c.RtgMaxVar.Float64() != 2200 || c.RtgMaxWh.Float64() != 10000 {
+		t.Errorf("capability %s, %g W, %g var, %g Wh", k, c.RtgMaxW.Float64(), c.RtgMaxVar.Float64(), c.RtgMaxWh.Float64())
+	}
+	if !c.ModesSupported.Has(DischargeMode) || !c.ModesSupported2.Has(OpModMaxLimWAbsorb) || !d.Settings.ModesEnabled.Has(OpModVoltVar) {
+		t.Error("storage modes not supported and enabled")
+	}
+	if d.Status.StateOfChargeStatus.Value.Value() != 5000 || d.Status.StorageModeStatus.Value != 2 {
+		t.Errorf("status %+v", d.Status)
+	}
+
+	ev := mustSimulate(t, DERSimConfig{Kind: DERKindEV, MaxW: 7000, MaxWh: 60000, SOC: 0.2}, t0)
+	if ev.Capability.RtgMaxVar != nil || ev.Capability.ModesSupported.Has(OpModVoltVar) {
+		t.Error("an EV charger has reactive power")
+	}
+}
+
+func TestDERSimulatorRamp(t *testing.T) {
+	d := mustSimulate(t, DERSimConfig{Kind: DERKindStorage, MaxW: 5000, MaxWh: 100000, SOC: 0.5}, t0)
+	d.Settings.SetGradW = 100 // 1% of setMaxW a second
+	ramp := uint16(1000)
+	d.Apply(targetW(4000), &ramp)
+	for _, tt := range []struct {
+		after time.Duration
+		w     float64
+	}{
+		// Over rampTms, 10 s, rather than at setGradW.
+		{5 * time.Second, 2000},
+		{11 * time.Second, 4000},
+		{20 * time.Second, 4000},
+	} {
+		d.Advance(t0.Add(tt.after))
+		if !within(d.W(), tt.w, 1e-9) {
+			t.Errorf("after %v: W() = %g, want %g", tt.after, d.W(), tt.w)
+		}
+	}
+
+	// Without rampTms, at setGradW.
+	d.Apply(targetW(1000), nil)
+	d.Advance(t0.Add(30 * time.Second))
+	if !within(d.W(), 3500, 1e-9) {
+		t.Errorf("at setGradW: W() = %g, want 3500", d.W())
+	}
+	zero := uint16(0)
+	d.Apply(targetW(-2000), &zero)
+	d.Advance(t0.Add(31 * time.Second))
+	if d.W() != -2000 || d.Status.StorageModeStatus.Value != 0 {
+		t.Errorf("a rampTms of 0: W() = %g, storage mode %d", d.W(), d.Status.StorageModeStatus.Value)
+	}
+}
+
+func TestDERSimulatorStateOfCharge(t *testing.T) {
+	d := mustSimulate(t, DERSimConfig{Kind: DERKindStorage, MaxW: 5000, MaxWh: 1000, SOC: 0.5}, t0)
+	d.Apply(targetW(1000), nil)
+	// 250 Wh out takes 250 / 0.95 from the battery.
+	d.Advance(t0.Add(15 * time.Minute))
+	st := d.Status
+	if soc := st.StateOfChargeStatus.Value.Value(); soc != 2368 || st.StorageModeStatus.Value != 1 {
+		t.Errorf("state of charge %d, storage mode %d, want 2368 discharging", soc, st.StorageModeStatus.Value)
+	}
+	// 225 Wh is left at the most it can discharge, 5000 W.
+	if a := d.Availability.AvailabilityDuration; a == nil || !within(float64(*a), 162, 1) {
+		t.Errorf("availabilityDuration %v, want 162 s", a)
+	}
+	d.Advance(t0.Add(time.Hour))
+	if d.W() != 0 || st.StateOfChargeStatus.Value.Value() != 0 || st.StorageModeStatus.Value != 2 ||
+		d.Availability.StatWAvail.Float64() != 0 || d.Availability.AvailabilityDuration != nil {
+		t.Errorf("empty battery: W() = %g, status %+v", d.W(), st)
+	}
+
+	// Charging tapers over the last tenth.
+	d = mustSimulate(t, DERSimConfig{Kind: DERKindStorage, MaxW: 5000, MaxWh: 10000, SOC: 0.95}, t0)
+	d.Apply(targetW(-5000), nil)
+	d.Advance(t0.Add(time.Second))
+	if !within(d.W(), -2500, 1e-9) {
+		t.Errorf("charging at 95%%: W() = %g, want -2500", d.W())
+	}
+	if in := d.Availability.StatWAbsorbAvail.Float64(); !(in > 2490 && in < 2500) {
+		t.Errorf("statWAbsorbAvail %g", in)
+	}
+
+	// An EV charges as fast as it can.
+	d = mustSimulate(t, DERSimConfig{Kind: DERKindEV, MaxW: 7000, MaxWh: 60000, SOC: 0.2}, t0)
+	d.Advance(t0.Add(time.Second))
+	if d.W() != -7000 || d.Var() != 0 {
+		t.Errorf("EV: W() = %g, Var() = %g", d.W(), d.Var())
+	}
+}
+
+func TestDERSimulatorPV(t *testing.T) {
+	noon := time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		name     string
+		sun      func(time.Time) float64
+		at       time.Time
+		limit    uint16
+		w        float64
+		inverter InverterStatus
+	}{
+		{"clear noon", nil, noon, 0, 5000, InverterRunning},
+		{"clear morning", nil, noon.Add(-3 * time.Hour), 0, 5000 * math.Sqrt2 / 2, InverterRunning},
+		{"night", nil, noon.Add(-9 * time.Hour), 0, 0, InverterSleeping},
+		{"cloud", func(time.Time) float64 { return 0.5 }, noon, 0, 2500, InverterRunning},
+		{"curtailed", nil, noon, 2000, 1000, InverterDerating},
+	} {
+		d := mustSimulate(t, DERSimConfig{Kind: DERKindPV, MaxW: 5000, Sun: tt.sun}, tt.at.Add(-time.Second))
+		if tt.limit != 0 {
+			d.Apply(&CurrentDERControls{OpModMaxLimW: maxLimW(tt.limit)}, nil)
+		}
+		d.Advance(tt.at)
+		if !within(d.W(), tt.w, 0.01) || d.Status.InverterStatus.Value != tt.inverter {
+			t.Errorf("%s: W() = %g, inverter %s, want %g, %s", tt.name, d.W(), d.Status.InverterStatus.Value, tt.w, tt.inverter)
+		}
+	}
+}
+
+func TestDERSimulatorConnect(t *testing.T) {
+	d := mustSimulate(t, DERSimConfig{Kind: DERKindPV, MaxW: 5000, Sun: func(time.Time) float64 { return 1 }}, t0)
+	d.Advance(t0.Add(time.Second))
+	d.Apply(&CurrentDERControls{OpModConnect: new(bool)}, nil)
+	d.Advance(t0.Add(2 * time.Second))
+	st := d.Status
+	if d.W() != 0 || st.InverterStatus.Value != InverterOff || st.OperationalModeStatus.Value != 1 {
+		t.Errorf("disconnected: W() = %g, status %+v", d.W(), st)
+	}
+	// Reconnection ramps at setSoftGradW, 10% of setMaxW a second.
+	soft := uint16(1000)
+	d.Settings.SetSoftGradW = &soft
+	d.Apply(nil, nil)
+	d.Advance(t0.Add(4 * time.Second))
+	if !within(d.W(), 1000, 1e-9) || st.InverterStatus.Value != InverterRunning || st.OperationalModeStatus.Value != 2 {
+		t.Errorf("reconnected: W() = %g, status %+v", d.W(), st)
+	}
+}
+
+func TestDERSimulatorVoltVar(t *testing.T) {
+	d := mustSimulate(t, DERSimConfig{
+		Kind: DERKindPV, MaxW: 5000,
+		Sun:  func(time.Time) float64 { return 1 },
+		Grid: func(time.Time) float64 { return 254.4 },
+	}, t0)
+	vv := testCurve(CurveVoltVar, UnitRefSetMaxVar, 0, 0, [2]int{92, 44}, [2]int{98, 0}, [2]int{102, 0}, [2]int{108, -44})
+	vv.OpenLoopTms = new(uint16)
+	*vv.OpenLoopTms = 1000
+	d.Apply(&CurrentDERControls{OpModVoltVar: &DERCurveControlType{DERCurve: vv}}, nil)
+	// At 106% of 240 V the curve asks for -29.3% of 2200 var, and 90% of a
+	// step takes openLoopTms.
+	want := -44.0 * 4 / 6 / 100 * 2200
+	d.Advance(t0.Add(10 * time.Second))
+	if !within(d.Var(), 0.9*want, 1e-6) || d.Voltage() != 254.4 {
+		t.Errorf("after openLoopTms: Var() = %g, want %g", d.Var(), 0.9*want)
+	}
+	d.Advance(t0.Add(time.Minute))
+	if !within(d.Var(), want, 0.01) {
+		t.Errorf("settled: Var() = %g, want %g", d.Var(), want)
+	}
+
+	// Modes the settings do not enable are ignored.
+	d.Settings.ModesEnabled.Clear(OpModVoltVar)
+	d.Advance(t0.Add(2 * time.Minute))
+	if !within(d.Var(), 0, 0.01) {
+		t.Errorf("volt-var not enabled: Var() = %g", d.Var())
+	}
+}
+
+func TestDERSimulatorReadings(t *testing.T) {
+	d := mustSimulate(t, DERSimConfig{Kind: DERKindStorage, MaxW: 5000, MaxWh: 10000, SOC: 0.5, PEN: 0x1234, PostRate: 5 * time.Minute}, t0)
+	d.Apply(targetW(1000), nil)
+	d.Advance(t0.Add(time.Hour))
+	ms := d.Readings()
+	want := []struct {
+		description string
+		value       int64
+	}{
+		{"Real Power", 1000},
+		{"Reactive Power", 0},
+		{"Voltage", 2400},
+		{"Energy Delivered", 1000},
+		{"Energy Received", 0},
+	}
+	if len(ms) != len(want) {
+		t.Fatalf("%d readings, want %d", len(ms), len(want))
+	}
+	again := d.Readings()
+	for i, w := range want {
+		m := ms[i]
+		if stringValue(m.Description) != w.description || *m.Reading.Value != w.value {
+			t.Errorf("reading %d: %s = %d, want %s = %d", i, stringValue(m.Description), *m.Reading.Value, w.description, w.value)
+		}
+		if pen, _ := m.MRID.PEN(); pen != 0x1234 || !m.MRID.Equal(again[i].MRID) {
+			t.Errorf("%s: mRID %s, then %s", stringValue(m.Description), m.MRID.Value(), again[i].MRID.Value())
+		}
+		if m.LastUpdateTime.Value() != t0.Add(time.Hour).Unix() || m.NextUpdateTime.Value() != t0.Add(time.Hour+5*time.Minute).Unix() {
+			t.Errorf("%s: updated %d, next %d", stringValue(m.Description), m.LastUpdateTime.Value(), m.NextUpdateTime.Value())
+		}
+	}
+	if ms[2].ReadingType.PowerOfTenMultiplier.Value() != -1 {
+		t.Error("voltage not in tenths of a volt")
+	}
+
+	pv := mustSimulate(t, DERSimConfig{Kind: DERKindPV, MaxW: 5000}, t0)
+	if n := len(pv.Readings()); n != 4 {
+		t.Errorf("PV has %d readings, want 4", n)
+	}
+}
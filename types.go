@@ -0,0 +1,382 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package sep
+
+import "reflect"
+
+// complexTypes maps the name of every complex type of sep.xsd to its Go type.
+var complexTypes = map[string]reflect.Type{
+	"AbstractDevice":                           reflect.TypeFor[AbstractDevice](),
+	"AccountBalance":                           reflect.TypeFor[AccountBalance](),
+	"AccountBalanceLink":                       reflect.TypeFor[AccountBalanceLink](),
+	"AccountingUnit":                           reflect.TypeFor[AccountingUnit](),
+	"AccumulationBehaviourType":                reflect.TypeFor[AccumulationBehaviourType](),
+	"ActiveBillingPeriodListLink":              reflect.TypeFor[ActiveBillingPeriodListLink](),
+	"ActiveCreditRegisterListLink":             reflect.TypeFor[ActiveCreditRegisterListLink](),
+	"ActiveDERControlListLink":                 reflect.TypeFor[ActiveDERControlListLink](),
+	"ActiveEndDeviceControlListLink":           reflect.TypeFor[ActiveEndDeviceControlListLink](),
+	"ActiveFlowReservationListLink":            reflect.TypeFor[ActiveFlowReservationListLink](),
+	"ActivePower":                              reflect.TypeFor[ActivePower](),
+	"ActivePowerControlType":                   reflect.TypeFor[ActivePowerControlType](),
+	"ActivePowerDeltaControlType":              reflect.TypeFor[ActivePowerDeltaControlType](),
+	"ActiveProjectionReadingListLink":          reflect.TypeFor[ActiveProjectionReadingListLink](),
+	"ActiveSupplyInterruptionOverrideListLink": reflect.TypeFor[ActiveSupplyInterruptionOverrideListLink](),
+	"ActiveTargetReadingListLink":              reflect.TypeFor[ActiveTargetReadingListLink](),
+	"ActiveTextMessageListLink":                reflect.TypeFor[ActiveTextMessageListLink](),
+	"ActiveTimeTariffIntervalListLink":         reflect.TypeFor[ActiveTimeTariffIntervalListLink](),
+	"AggregatedDevice":                         reflect.TypeFor[AggregatedDevice](),
+	"AggregatedDeviceList":                     reflect.TypeFor[AggregatedDeviceList](),
+	"AggregatedDeviceListLink":                 reflect.TypeFor[AggregatedDeviceListLink](),
+	"AggregationDistributionType":              reflect.TypeFor[AggregationDistributionType](),
+	"AggregationPriority":                      reflect.TypeFor[AggregationPriority](),
+	"AggregationPriorityLink":                  reflect.TypeFor[AggregationPriorityLink](),
+	"AmpereHour":                               reflect.TypeFor[AmpereHour](),
+	"ApparentPower":                            reflect.TypeFor[ApparentPower](),
+	"ApplianceLoadReduction":                   reflect.TypeFor[ApplianceLoadReduction](),
+	"ApplianceLoadReductionType":               reflect.TypeFor[ApplianceLoadReductionType](),
+	"AppliedTargetReduction":                   reflect.TypeFor[AppliedTargetReduction](),
+	"AssociatedDERProgramListLink":             reflect.TypeFor[AssociatedDERProgramListLink](),
+	"AssociatedUsagePointLink":                 reflect.TypeFor[AssociatedUsagePointLink](),
+	"BillingMeterReadingBase":                  reflect.TypeFor[BillingMeterReadingBase](),
+	"BillingPeriod":                            reflect.TypeFor[BillingPeriod](),
+	"BillingPeriodList":                        reflect.TypeFor[BillingPeriodList](),
+	"BillingPeriodListLink":                    reflect.TypeFor[BillingPeriodListLink](),
+	"BillingReading":                           reflect.TypeFor[BillingReading](),
+	"BillingReadingList":                       reflect.TypeFor[BillingReadingList](),
+	"BillingReadingListLink":                   reflect.TypeFor[BillingReadingListLink](),
+	"BillingReadingSet":                        reflect.TypeFor[BillingReadingSet](),
+	"BillingReadingSetList":                    reflect.TypeFor[BillingReadingSetList](),
+	"BillingReadingSetListLink":                reflect.TypeFor[BillingReadingSetListLink](),
+	"Charge":                                   reflect.TypeFor[Charge](),
+	"ChargeKind":                               reflect.TypeFor[ChargeKind](),
+	"CommodityType":                            reflect.TypeFor[CommodityType](),
+	"Condition":                                reflect.TypeFor[Condition](),
+	"Configuration":                            reflect.TypeFor[Configuration](),
+	"ConfigurationLink":                        reflect.TypeFor[ConfigurationLink](),
+	"ConnectStatusType":                        reflect.TypeFor[ConnectStatusType](),
+	"ConnectStatusType2":                       reflect.TypeFor[ConnectStatusType2](),
+	"ConsumptionBlockType":                     reflect.TypeFor[ConsumptionBlockType](),
+	"ConsumptionTariffInterval":                reflect.TypeFor[ConsumptionTariffInterval](),
+	"ConsumptionTariffIntervalList":            reflect.TypeFor[ConsumptionTariffIntervalList](),
+	"ConsumptionTariffIntervalListLink":        reflect.TypeFor[ConsumptionTariffIntervalListLink](),
+	"CostKindType":                             reflect.TypeFor[CostKindType](),
+	"CountryType":                              reflect.TypeFor[CountryType](),
+	"CreditRegister":                           reflect.TypeFor[CreditRegister](),
+	"CreditRegisterList":                       reflect.TypeFor[CreditRegisterList](),
+	"CreditRegisterListLink":                   reflect.TypeFor[CreditRegisterListLink](),
+	"CreditStatusType":                         reflect.TypeFor[CreditStatusType](),
+	"CreditTypeChange":                         reflect.TypeFor[CreditTypeChange](),
+	"CreditTypeType":                           reflect.TypeFor[CreditTypeType](),
+	"CurrencyCode":                             reflect.TypeFor[CurrencyCode](),
+	"CurrentDERControls":                       reflect.TypeFor[CurrentDERControls](),
+	"CurrentDERControlsLink":                   reflect.TypeFor[CurrentDERControlsLink](),
+	"CurrentDERProgramLink":                    reflect.TypeFor[CurrentDERProgramLink](),
+	"CurrentRMS":                               reflect.TypeFor[CurrentRMS](),
+	"CurveData":                                reflect.TypeFor[CurveData](),
+	"CustomerAccount":                          reflect.TypeFor[CustomerAccount](),
+	"CustomerAccountLink":                      reflect.TypeFor[CustomerAccountLink](),
+	"CustomerAccountList":                      reflect.TypeFor[CustomerAccountList](),
+	"CustomerAccountListLink":                  reflect.TypeFor[CustomerAccountListLink](),
+	"CustomerAgreement":                        reflect.TypeFor[CustomerAgreement](),
+	"CustomerAgreementList":                    reflect.TypeFor[CustomerAgreementList](),
+	"CustomerAgreementListLink":                reflect.TypeFor[CustomerAgreementListLink](),
+	"DER":                                      reflect.TypeFor[DER](),
+	"DERAvailability":                          reflect.TypeFor[DERAvailability](),
+	"DERAvailabilityLink":                      reflect.TypeFor[DERAvailabilityLink](),
+	"DERCapability":                            reflect.TypeFor[DERCapability](),
+	"DERCapabilityLink":                        reflect.TypeFor[DERCapabilityLink](),
+	"DERComponent":                             reflect.TypeFor[DERComponent](),
+	"DERComponentBase":                         reflect.TypeFor[DERComponentBase](),
+	"DERComponentList":                         reflect.TypeFor[DERComponentList](),
+	"DERComponentListLink":                     reflect.TypeFor[DERComponentListLink](),
+	"DERControl":                               reflect.TypeFor[DERControl](),
+	"DERControlBase":                           reflect.TypeFor[DERControlBase](),
+	"DERControlList":                           reflect.TypeFor[DERControlList](),
+	"DERControlListLink":                       reflect.TypeFor[DERControlListLink](),
+	"DERControlResponse":                       reflect.TypeFor[DERControlResponse](),
+	"DERControlType":                           reflect.TypeFor[DERControlType](),
+	"DERControlType2":                          reflect.TypeFor[DERControlType2](),
+	"DERCurve":                                 reflect.TypeFor[DERCurve](),
+	"DERCurveControlType":                      reflect.TypeFor[DERCurveControlType](),
+	"DERCurveLink":                             reflect.TypeFor[DERCurveLink](),
+	"DERCurveList":                             reflect.TypeFor[DERCurveList](),
+	"DERCurveListLink":                         reflect.TypeFor[DERCurveListLink](),
+	"DERCurveType":                             reflect.TypeFor[DERCurveType](),
+	"DERLink":                                  reflect.TypeFor[DERLink](),
+	"DERList":                                  reflect.TypeFor[DERList](),
+	"DERListLink":                              reflect.TypeFor[DERListLink](),
+	"DERProgram":                               reflect.TypeFor[DERProgram](),
+	"DERProgramLink":                           reflect.TypeFor[DERProgramLink](),
+	"DERProgramList":                           reflect.TypeFor[DERProgramList](),
+	"DERProgramListLink":                       reflect.TypeFor[DERProgramListLink](),
+	"DERSettings":                              reflect.TypeFor[DERSettings](),
+	"DERSettingsLink":                          reflect.TypeFor[DERSettingsLink](),
+	"DERStatus":                                reflect.TypeFor[DERStatus](),
+	"DERStatusLink":                            reflect.TypeFor[DERStatusLink](),
+	"DERType":                                  reflect.TypeFor[DERType](),
+	"DERUnitRefType":                           reflect.TypeFor[DERUnitRefType](),
+	"DRLCCapabilities":                         reflect.TypeFor[DRLCCapabilities](),
+	"DataQualifierType":                        reflect.TypeFor[DataQualifierType](),
+	"DateTimeInterval":                         reflect.TypeFor[DateTimeInterval](),
+	"DefaultDERControl":                        reflect.TypeFor[DefaultDERControl](),
+	"DefaultDERControlLink":                    reflect.TypeFor[DefaultDERControlLink](),
+	"DefaultDERControlResponse":                reflect.TypeFor[DefaultDERControlResponse](),
+	"DefaultDERControlType":                    reflect.TypeFor[DefaultDERControlType](),
+	"DemandResponseProgram":                    reflect.TypeFor[DemandResponseProgram](),
+	"DemandResponseProgramLink":                reflect.TypeFor[DemandResponseProgramLink](),
+	"DemandResponseProgramList":                reflect.TypeFor[DemandResponseProgramList](),
+	"DemandResponseProgramListLink":            reflect.TypeFor[DemandResponseProgramListLink](),
+	"DeviceCapability":                         reflect.TypeFor[DeviceCapability](),
+	"DeviceCapabilityLink":                     reflect.TypeFor[DeviceCapabilityLink](),
+	"DeviceCategoryType":                       reflect.TypeFor[DeviceCategoryType](),
+	"DeviceInformation":                        reflect.TypeFor[DeviceInformation](),
+	"DeviceInformationLink":                    reflect.TypeFor[DeviceInformationLink](),
+	"DeviceStatus":                             reflect.TypeFor[DeviceStatus](),
+	"DeviceStatusLink":                         reflect.TypeFor[DeviceStatusLink](),
+	"DrResponse":                               reflect.TypeFor[DrResponse](),
+	"DstRuleType":                              reflect.TypeFor[DstRuleType](),
+	"DutyCycle":                                reflect.TypeFor[DutyCycle](),
+	"EndDevice":                                reflect.TypeFor[EndDevice](),
+	"EndDeviceControl":                         reflect.TypeFor[EndDeviceControl](),
+	"EndDeviceControlList":                     reflect.TypeFor[EndDeviceControlList](),
+	"EndDeviceControlListLink":                 reflect.TypeFor[EndDeviceControlListLink](),
+	"EndDeviceLink":                            reflect.TypeFor[EndDeviceLink](),
+	"EndDeviceList":                            reflect.TypeFor[EndDeviceList](),
+	"EndDeviceListLink":                        reflect.TypeFor[EndDeviceListLink](),
+	"EnvironmentalCost":                        reflect.TypeFor[EnvironmentalCost](),
+	"Error":                                    reflect.TypeFor[Error](),
+	"Event":                                    reflect.TypeFor[Event](),
+	"EventStatus":                              reflect.TypeFor[EventStatus](),
+	"ExternalDevice":                           reflect.TypeFor[ExternalDevice](),
+	"File":                                     reflect.TypeFor[File](),
+	"FileLink":                                 reflect.TypeFor[FileLink](),
+	"FileList":                                 reflect.TypeFor[FileList](),
+	"FileListLink":                             reflect.TypeFor[FileListLink](),
+	"FileStatus":                               reflect.TypeFor[FileStatus](),
+	"FileStatusLink":                           reflect.TypeFor[FileStatusLink](),
+	"FixedPointType":                           reflect.TypeFor[FixedPointType](),
+	"FixedVar":                                 reflect.TypeFor[FixedVar](),
+	"FixedVarControlType":                      reflect.TypeFor[FixedVarControlType](),
+	"FlowDirectionType":                        reflect.TypeFor[FlowDirectionType](),
+	"FlowReservationRequest":                   reflect.TypeFor[FlowReservationRequest](),
+	"FlowReservationRequestList":               reflect.TypeFor[FlowReservationRequestList](),
+	"FlowReservationRequestListLink":           reflect.TypeFor[FlowReservationRequestListLink](),
+	"FlowReservationResponse":                  reflect.TypeFor[FlowReservationResponse](),
+	"FlowReservationResponseList":              reflect.TypeFor[FlowReservationResponseList](),
+	"FlowReservationResponseListLink":          reflect.TypeFor[FlowReservationResponseListLink](),
+	"FlowReservationResponseResponse":          reflect.TypeFor[FlowReservationResponseResponse](),
+	"FreqDroopType":                            reflect.TypeFor[FreqDroopType](),
+	"FunctionSetAssignments":                   reflect.TypeFor[FunctionSetAssignments](),
+	"FunctionSetAssignmentsBase":               reflect.TypeFor[FunctionSetAssignmentsBase](),
+	"FunctionSetAssignmentsList":               reflect.TypeFor[FunctionSetAssignmentsList](),
+	"FunctionSetAssignmentsListLink":           reflect.TypeFor[FunctionSetAssignmentsListLink](),
+	"GPSLocationType":                          reflect.TypeFor[GPSLocationType](),
+	"GeographicLocationType":                   reflect.TypeFor[GeographicLocationType](),
+	"HistoricalReading":                        reflect.TypeFor[HistoricalReading](),
+	"HistoricalReadingList":                    reflect.TypeFor[HistoricalReadingList](),
+	"HistoricalReadingListLink":                reflect.TypeFor[HistoricalReadingListLink](),
+	"IEEE_802_15_4":                            reflect.TypeFor[IEEE802154](),
+	"IPAddr":                                   reflect.TypeFor[IPAddr](),
+	"IPAddrList":                               reflect.TypeFor[IPAddrList](),
+	"IPAddrListLink":                           reflect.TypeFor[IPAddrListLink](),
+	"IPInterface":                              reflect.TypeFor[IPInterface](),
+	"IPInterfaceList":                          reflect.TypeFor[IPInterfaceList](),
+	"IPInterfaceListLink":                      reflect.TypeFor[IPInterfaceListLink](),
+	"IdentifiedObject":                         reflect.TypeFor[IdentifiedObject](),
+	"InverterStatusType":                       reflect.TypeFor[InverterStatusType](),
+	"KindType":                                 reflect.TypeFor[KindType](),
+	"LLInterface":                              reflect.TypeFor[LLInterface](),
+	"LLInterfaceList":                          reflect.TypeFor[LLInterfaceList](),
+	"LLInterfaceListLink":                      reflect.TypeFor[LLInterfaceListLink](),
+	"Link":                                     reflect.TypeFor[Link](),
+	"List":                                     reflect.TypeFor[List](),
+	"ListLink":                                 reflect.TypeFor[ListLink](),
+	"LoadShedAvailability":                     reflect.TypeFor[LoadShedAvailability](),
+	"LoadShedAvailabilityList":                 reflect.TypeFor[LoadShedAvailabilityList](),
+	"LoadShedAvailabilityListLink":             reflect.TypeFor[LoadShedAvailabilityListLink](),
+	"LocalControlModeStatusType":               reflect.TypeFor[LocalControlModeStatusType](),
+	"LocaleType":                               reflect.TypeFor[LocaleType](),
+	"LogEvent":                                 reflect.TypeFor[LogEvent](),
+	"LogEventList":                             reflect.TypeFor[LogEventList](),
+	"LogEventListLink":                         reflect.TypeFor[LogEventListLink](),
+	"ManufacturerStatusType":                   reflect.TypeFor[ManufacturerStatusType](),
+	"MessagingProgram":                         reflect.TypeFor[MessagingProgram](),
+	"MessagingProgramList":                     reflect.TypeFor[MessagingProgramList](),
+	"MessagingProgramListLink":                 reflect.TypeFor[MessagingProgramListLink](),
+	"MeterReading":                             reflect.TypeFor[MeterReading](),
+	"MeterReadingBase":                         reflect.TypeFor[MeterReadingBase](),
+	"MeterReadingLink":                         reflect.TypeFor[MeterReadingLink](),
+	"MeterReadingList":                         reflect.TypeFor[MeterReadingList](),
+	"MeterReadingListLink":                     reflect.TypeFor[MeterReadingListLink](),
+	"MirrorMeterReading":                       reflect.TypeFor[MirrorMeterReading](),
+	"MirrorMeterReadingList":                   reflect.TypeFor[MirrorMeterReadingList](),
+	"MirrorReadingSet":                         reflect.TypeFor[MirrorReadingSet](),
+	"MirrorUsagePoint":                         reflect.TypeFor[MirrorUsagePoint](),
+	"MirrorUsagePointList":                     reflect.TypeFor[MirrorUsagePointList](),
+	"MirrorUsagePointListLink":                 reflect.TypeFor[MirrorUsagePointListLink](),
+	"Neighbor":                                 reflect.TypeFor[Neighbor](),
+	"NeighborList":                             reflect.TypeFor[NeighborList](),
+	"NeighborListLink":                         reflect.TypeFor[NeighborListLink](),
+	"Notification":                             reflect.TypeFor[Notification](),
+	"NotificationList":                         reflect.TypeFor[NotificationList](),
+	"NotificationListLink":                     reflect.TypeFor[NotificationListLink](),
+	"Offset":                                   reflect.TypeFor[Offset](),
+	"OneHourRangeType":                         reflect.TypeFor[OneHourRangeType](),
+	"OperationalModeStatusType":                reflect.TypeFor[OperationalModeStatusType](),
+	"PENType":                                  reflect.TypeFor[PENType](),
+	"PEVInfo":                                  reflect.TypeFor[PEVInfo](),
+	"PINType":                                  reflect.TypeFor[PINType](),
+	"PerCent":                                  reflect.TypeFor[PerCent](),
+	"PerCentControlType":                       reflect.TypeFor[PerCentControlType](),
+	"PhaseCode":                                reflect.TypeFor[PhaseCode](),
+	"PowerConfiguration":                       reflect.TypeFor[PowerConfiguration](),
+	"PowerFactor":                              reflect.TypeFor[PowerFactor](),
+	"PowerFactorWithExcitation":                reflect.TypeFor[PowerFactorWithExcitation](),
+	"PowerFactorWithExcitationControlType":     reflect.TypeFor[PowerFactorWithExcitationControlType](),
+	"PowerOfTenMultiplierType":                 reflect.TypeFor[PowerOfTenMultiplierType](),
+	"PowerSourceType":                          reflect.TypeFor[PowerSourceType](),
+	"PowerStatus":                              reflect.TypeFor[PowerStatus](),
+	"PowerStatusLink":                          reflect.TypeFor[PowerStatusLink](),
+	"PrepayModeType":                           reflect.TypeFor[PrepayModeType](),
+	"PrepayOperationStatus":                    reflect.TypeFor[PrepayOperationStatus](),
+	"PrepayOperationStatusLink":                reflect.TypeFor[PrepayOperationStatusLink](),
+	"Prepayment":                               reflect.TypeFor[Prepayment](),
+	"PrepaymentLink":                           reflect.TypeFor[PrepaymentLink](),
+	"PrepaymentList":                           reflect.TypeFor[PrepaymentList](),
+	"PrepaymentListLink":                       reflect.TypeFor[PrepaymentListLink](),
+	"PriceResponse":                            reflect.TypeFor[PriceResponse](),
+	"PriceResponseCfg":                         reflect.TypeFor[PriceResponseCfg](),
+	"PriceResponseCfgList":                     reflect.TypeFor[PriceResponseCfgList](),
+	"PriceResponseCfgListLink":                 reflect.TypeFor[PriceResponseCfgListLink](),
+	"PrimacyType":                              reflect.TypeFor[PrimacyType](),
+	"PriorityData":                             reflect.TypeFor[PriorityData](),
+	"PriorityType":                             reflect.TypeFor[PriorityType](),
+	"ProjectionReading":                        reflect.TypeFor[ProjectionReading](),
+	"ProjectionReadingList":                    reflect.TypeFor[ProjectionReadingList](),
+	"ProjectionReadingListLink":                reflect.TypeFor[ProjectionReadingListLink](),
+	"ProxiedDevice":                            reflect.TypeFor[ProxiedDevice](),
+	"ProxiedDeviceList":                        reflect.TypeFor[ProxiedDeviceList](),
+	"ProxiedDeviceListLink":                    reflect.TypeFor[ProxiedDeviceListLink](),
+	"RPLInstance":                              reflect.TypeFor[RPLInstance](),
+	"RPLInstanceList":                          reflect.TypeFor[RPLInstanceList](),
+	"RPLInstanceListLink":                      reflect.TypeFor[RPLInstanceListLink](),
+	"RPLSourceRoutes":                          reflect.TypeFor[RPLSourceRoutes](),
+	"RPLSourceRoutesList":                      reflect.TypeFor[RPLSourceRoutesList](),
+	"RPLSourceRoutesListLink":                  reflect.TypeFor[RPLSourceRoutesListLink](),
+	"RandomizableEvent":                        reflect.TypeFor[RandomizableEvent](),
+	"RateComponent":                            reflect.TypeFor[RateComponent](),
+	"RateComponentLink":                        reflect.TypeFor[RateComponentLink](),
+	"RateComponentList":                        reflect.TypeFor[RateComponentList](),
+	"RateComponentListLink":                    reflect.TypeFor[RateComponentListLink](),
+	"ReactivePower":                            reflect.TypeFor[ReactivePower](),
+	"ReactivePowerControlType":                 reflect.TypeFor[ReactivePowerControlType](),
+	"ReactivePowerDeltaControlType":            reflect.TypeFor[ReactivePowerDeltaControlType](),
+	"ReactiveSusceptance":                      reflect.TypeFor[ReactiveSusceptance](),
+	"Reading":                                  reflect.TypeFor[Reading](),
+	"ReadingBase":                              reflect.TypeFor[ReadingBase](),
+	"ReadingLink":                              reflect.TypeFor[ReadingLink](),
+	"ReadingList":                              reflect.TypeFor[ReadingList](),
+	"ReadingListLink":                          reflect.TypeFor[ReadingListLink](),
+	"ReadingSet":                               reflect.TypeFor[ReadingSet](),
+	"ReadingSetBase":                           reflect.TypeFor[ReadingSetBase](),
+	"ReadingSetList":                           reflect.TypeFor[ReadingSetList](),
+	"ReadingSetListLink":                       reflect.TypeFor[ReadingSetListLink](),
+	"ReadingType":                              reflect.TypeFor[ReadingType](),
+	"ReadingTypeLink":                          reflect.TypeFor[ReadingTypeLink](),
+	"RealEnergy":                               reflect.TypeFor[RealEnergy](),
+	"Registration":                             reflect.TypeFor[Registration](),
+	"RegistrationLink":                         reflect.TypeFor[RegistrationLink](),
+	"RequestStatus":                            reflect.TypeFor[RequestStatus](),
+	"Resource":                                 reflect.TypeFor[Resource](),
+	"RespondableIdentifiedObject":              reflect.TypeFor[RespondableIdentifiedObject](),
+	"RespondableResource":                      reflect.TypeFor[RespondableResource](),
+	"RespondableSubscribableIdentifiedObject":  reflect.TypeFor[RespondableSubscribableIdentifiedObject](),
+	"Response":                                 reflect.TypeFor[Response](),
+	"ResponseList":                             reflect.TypeFor[ResponseList](),
+	"ResponseListLink":                         reflect.TypeFor[ResponseListLink](),
+	"ResponseSet":                              reflect.TypeFor[ResponseSet](),
+	"ResponseSetList":                          reflect.TypeFor[ResponseSetList](),
+	"ResponseSetListLink":                      reflect.TypeFor[ResponseSetListLink](),
+	"Revision2_3Type":                          reflect.TypeFor[Revision23Type](),
+	"RoleFlagsType":                            reflect.TypeFor[RoleFlagsType](),
+	"SFDIType":                                 reflect.TypeFor[SFDIType](),
+	"SelfDevice":                               reflect.TypeFor[SelfDevice](),
+	"SelfDeviceLink":                           reflect.TypeFor[SelfDeviceLink](),
+	"ServiceChange":                            reflect.TypeFor[ServiceChange](),
+	"ServiceKind":                              reflect.TypeFor[ServiceKind](),
+	"ServiceStatusType":                        reflect.TypeFor[ServiceStatusType](),
+	"ServiceSupplier":                          reflect.TypeFor[ServiceSupplier](),
+	"ServiceSupplierLink":                      reflect.TypeFor[ServiceSupplierLink](),
+	"SetPoint":                                 reflect.TypeFor[SetPoint](),
+	"SignedPerCent":                            reflect.TypeFor[SignedPerCent](),
+	"SignedPerCentControlType":                 reflect.TypeFor[SignedPerCentControlType](),
+	"SignedRealEnergy":                         reflect.TypeFor[SignedRealEnergy](),
+	"StateOfChargeStatusType":                  reflect.TypeFor[StateOfChargeStatusType](),
+	"StorageModeStatusType":                    reflect.TypeFor[StorageModeStatusType](),
+	"SubdivisionType":                          reflect.TypeFor[SubdivisionType](),
+	"SubscribableIdentifiedObject":             reflect.TypeFor[SubscribableIdentifiedObject](),
+	"SubscribableList":                         reflect.TypeFor[SubscribableList](),
+	"SubscribableResource":                     reflect.TypeFor[SubscribableResource](),
+	"Subscription":                             reflect.TypeFor[Subscription](),
+	"SubscriptionBase":                         reflect.TypeFor[SubscriptionBase](),
+	"SubscriptionList":                         reflect.TypeFor[SubscriptionList](),
+	"SubscriptionListLink":                     reflect.TypeFor[SubscriptionListLink](),
+	"SupplyInterruptionOverride":               reflect.TypeFor[SupplyInterruptionOverride](),
+	"SupplyInterruptionOverrideList":           reflect.TypeFor[SupplyInterruptionOverrideList](),
+	"SupplyInterruptionOverrideListLink":       reflect.TypeFor[SupplyInterruptionOverrideListLink](),
+	"SupportedLocale":                          reflect.TypeFor[SupportedLocale](),
+	"SupportedLocaleList":                      reflect.TypeFor[SupportedLocaleList](),
+	"SupportedLocaleListLink":                  reflect.TypeFor[SupportedLocaleListLink](),
+	"TOUType":                                  reflect.TypeFor[TOUType](),
+	"TargetReading":                            reflect.TypeFor[TargetReading](),
+	"TargetReadingList":                        reflect.TypeFor[TargetReadingList](),
+	"TargetReadingListLink":                    reflect.TypeFor[TargetReadingListLink](),
+	"TargetReduction":                          reflect.TypeFor[TargetReduction](),
+	"TariffProfile":                            reflect.TypeFor[TariffProfile](),
+	"TariffProfileLink":                        reflect.TypeFor[TariffProfileLink](),
+	"TariffProfileList":                        reflect.TypeFor[TariffProfileList](),
+	"TariffProfileListLink":                    reflect.TypeFor[TariffProfileListLink](),
+	"Temperature":                              reflect.TypeFor[Temperature](),
+	"TextMessage":                              reflect.TypeFor[TextMessage](),
+	"TextMessageList":                          reflect.TypeFor[TextMessageList](),
+	"TextMessageListLink":                      reflect.TypeFor[TextMessageListLink](),
+	"TextResponse":                             reflect.TypeFor[TextResponse](),
+	"Time":                                     reflect.TypeFor[Time](),
+	"TimeConfiguration":                        reflect.TypeFor[TimeConfiguration](),
+	"TimeLink":                                 reflect.TypeFor[TimeLink](),
+	"TimeOffsetType":                           reflect.TypeFor[TimeOffsetType](),
+	"TimeTariffInterval":                       reflect.TypeFor[TimeTariffInterval](),
+	"TimeTariffIntervalList":                   reflect.TypeFor[TimeTariffIntervalList](),
+	"TimeTariffIntervalListLink":               reflect.TypeFor[TimeTariffIntervalListLink](),
+	"TimeType":                                 reflect.TypeFor[TimeType](),
+	"UnitType":                                 reflect.TypeFor[UnitType](),
+	"UnitValueType":                            reflect.TypeFor[UnitValueType](),
+	"UnsignedActivePower":                      reflect.TypeFor[UnsignedActivePower](),
+	"UnsignedActivePowerControlType":           reflect.TypeFor[UnsignedActivePowerControlType](),
+	"UnsignedFixedPointType":                   reflect.TypeFor[UnsignedFixedPointType](),
+	"UnsignedFixedVar":                         reflect.TypeFor[UnsignedFixedVar](),
+	"UnsignedFixedVarControlType":              reflect.TypeFor[UnsignedFixedVarControlType](),
+	"UnsignedReactivePower":                    reflect.TypeFor[UnsignedReactivePower](),
+	"UnsignedReactivePowerControlType":         reflect.TypeFor[UnsignedReactivePowerControlType](),
+	"UomType":                                  reflect.TypeFor[UomType](),
+	"UsagePoint":                               reflect.TypeFor[UsagePoint](),
+	"UsagePointBase":                           reflect.TypeFor[UsagePointBase](),
+	"UsagePointLink":                           reflect.TypeFor[UsagePointLink](),
+	"UsagePointList":                           reflect.TypeFor[UsagePointList](),
+	"UsagePointListLink":                       reflect.TypeFor[UsagePointListLink](),
+	"VersionType":                              reflect.TypeFor[VersionType](),
+	"VoltageRMS":                               reflect.TypeFor[VoltageRMS](),
+	"VoltageRMSControlType":                    reflect.TypeFor[VoltageRMSControlType](),
+	"WattHour":                                 reflect.TypeFor[WattHour](),
+	"loWPAN":                                   reflect.TypeFor[LoWPAN](),
+	"mRIDType":                                 reflect.TypeFor[MRIDType](),
+}
+
+// complexTypeNames maps each Go type of complexTypes back to its schema name.
+var complexTypeNames = func() map[reflect.Type]string {
+	m := make(map[reflect.Type]string, len(complexTypes))
+	for name, t := range complexTypes {
+		m[t] = name
+	}
+	return m
+}()
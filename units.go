@@ -0,0 +1,1175 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package sep
+
+import (
+	"errors"
+	"math/big"
+)
+
+// ActivePowerFromRat returns r watts as an ActivePower.
+func ActivePowerFromRat(r *big.Rat) (*ActivePower, error) {
+	v, m, err := normalize(r, rangeInt16)
+	if err != nil {
+		return nil, err
+	}
+	return &ActivePower{Multiplier: m, Value: int16(v)}, nil
+}
+
+// ActivePowerFromFloat returns f watts as an ActivePower.
+func ActivePowerFromFloat(f float64) (*ActivePower, error) {
+	r, err := ratOfFloat(f)
+	if err != nil {
+		return nil, err
+	}
+	return ActivePowerFromRat(r)
+}
+
+// ParseActivePower parses a decimal number of watts, such as "1.25", as an ActivePower.
+func ParseActivePower(s string) (*ActivePower, error) {
+	r, err := parseDecimal(s)
+	if err != nil {
+		return nil, err
+	}
+	return ActivePowerFromRat(r)
+}
+
+// Rat returns the exact value of a in watts, or 0 if a is nil.
+func (a *ActivePower) Rat() *big.Rat {
+	if a == nil {
+		return new(big.Rat)
+	}
+	return decimal(int64(a.Value), a.Multiplier)
+}
+
+// Float64 returns the value of a in watts, or 0 if a is nil.
+func (a *ActivePower) Float64() float64 { return floatOf(a.Rat()) }
+
+// String returns the value of a in decimal notation, such as "1.25".
+func (a *ActivePower) String() string {
+	if a == nil {
+		return "0"
+	}
+	return formatDecimal(int64(a.Value), a.Multiplier)
+}
+
+// Cmp compares the values of a and b, returning -1, 0 or +1.
+func (a *ActivePower) Cmp(b *ActivePower) int { return a.Rat().Cmp(b.Rat()) }
+
+// Add returns a + b.
+func (a *ActivePower) Add(b *ActivePower) (*ActivePower, error) {
+	return ActivePowerFromRat(new(big.Rat).Add(a.Rat(), b.Rat()))
+}
+
+// Sub returns a - b.
+func (a *ActivePower) Sub(b *ActivePower) (*ActivePower, error) {
+	return ActivePowerFromRat(new(big.Rat).Sub(a.Rat(), b.Rat()))
+}
+
+// Scale returns a × k.
+func (a *ActivePower) Scale(k float64) (*ActivePower, error) {
+	q, err := ratOfFloat(k)
+	if err != nil {
+		return nil, err
+	}
+	return ActivePowerFromRat(new(big.Rat).Mul(a.Rat(), q))
+}
+
+// Normalize returns a held with its best multiplier.
+func (a *ActivePower) Normalize() (*ActivePower, error) {
+	return ActivePowerFromRat(a.Rat())
+}
+
+// ReactivePowerFromRat returns r vars as a ReactivePower.
+func ReactivePowerFromRat(r *big.Rat) (*ReactivePower, error) {
+	v, m, err := normalize(r, rangeInt16)
+	if err != nil {
+		return nil, err
+	}
+	return &ReactivePower{Multiplier: m, Value: int16(v)}, nil
+}
+
+// ReactivePowerFromFloat returns f vars as a ReactivePower.
+func ReactivePowerFromFloat(f float64) (*ReactivePower, error) {
+	r, err := ratOfFloat(f)
+	if err != nil {
+		return nil, err
+	}
+	return ReactivePowerFromRat(r)
+}
+
+// ParseReactivePower parses a decimal number of vars, such as "1.25", as a ReactivePower.
+func ParseReactivePower(s string) (*ReactivePower, error) {
+	r, err := parseDecimal(s)
+	if err != nil {
+		return nil, err
+	}
+	return ReactivePowerFromRat(r)
+}
+
+// Rat returns the exact value of r in vars, or 0 if r is nil.
+func (r *ReactivePower) Rat() *big.Rat {
+	if r == nil {
+		return new(big.Rat)
+	}
+	return decimal(int64(r.Value), r.Multiplier)
+}
+
+// Float64 returns the value of r in vars, or 0 if r is nil.
+func (r *ReactivePower) Float64() float64 { return floatOf(r.Rat()) }
+
+// String returns the value of r in decimal notation, such as "1.25".
+func (r *ReactivePower) String() string {
+	if r == nil {
+		return "0"
+	}
+	return formatDecimal(int64(r.Value), r.Multiplier)
+}
+
+// Cmp compares the values of r and b, returning -1, 0 or +1.
+func (r *ReactivePower) Cmp(b *ReactivePower) int { return r.Rat().Cmp(b.Rat()) }
+
+// Add returns r + b.
+func (r *ReactivePower) Add(b *ReactivePower) (*ReactivePower, error) {
+	return ReactivePowerFromRat(new(big.Rat).Add(r.Rat(), b.Rat()))
+}
+
+// Sub returns r - b.
+func (r *ReactivePower) Sub(b *ReactivePower) (*ReactivePower, error) {
+	return ReactivePowerFromRat(new(big.Rat).Sub(r.Rat(), b.Rat()))
+}
+
+// Scale returns r × k.
+func (r *ReactivePower) Scale(k float64) (*ReactivePower, error) {
+	q, err := ratOfFloat(k)
+	if err != nil {
+		return nil, err
+	}
+	return ReactivePowerFromRat(new(big.Rat).Mul(r.Rat(), q))
+}
+
+// Normalize returns r held with its best multiplier.
+func (r *ReactivePower) Normalize() (*ReactivePower, error) {
+	return ReactivePowerFromRat(r.Rat())
+}
+
+// UnsignedActivePowerFromRat returns r watts as an UnsignedActivePower.
+func UnsignedActivePowerFromRat(r *big.Rat) (*UnsignedActivePower, error) {
+	v, m, err := normalize(r, rangeUInt16)
+	if err != nil {
+		return nil, err
+	}
+	return &UnsignedActivePower{Multiplier: m, Value: uint16(v)}, nil
+}
+
+// UnsignedActivePowerFromFloat returns f watts as an UnsignedActivePower.
+func UnsignedActivePowerFromFloat(f float64) (*UnsignedActivePower, error) {
+	r, err := ratOfFloat(f)
+	if err != nil {
+		return nil, err
+	}
+	return UnsignedActivePowerFromRat(r)
+}
+
+// ParseUnsignedActivePower parses a decimal number of watts, such as "1.25", as an UnsignedActivePower.
+func ParseUnsignedActivePower(s string) (*UnsignedActivePower, error) {
+	r, err := parseDecimal(s)
+	if err != nil {
+		return nil, err
+	}
+	return UnsignedActivePowerFromRat(r)
+}
+
+// Rat returns the exact value of u in watts, or 0 if u is nil.
+func (u *UnsignedActivePower) Rat() *big.Rat {
+	if u == nil {
+		return new(big.Rat)
+	}
+	return decimal(int64(u.Value), u.Multiplier)
+}
+
+// Float64 returns the value of u in watts, or 0 if u is nil.
+func (u *UnsignedActivePower) Float64() float64 { return floatOf(u.Rat()) }
+
+// String returns the value of u in decimal notation, such as "1.25".
+func (u *UnsignedActivePower) String() string {
+	if u == nil {
+		return "0"
+	}
+	return formatDecimal(int64(u.Value), u.Multiplier)
+}
+
+// Cmp compares the values of u and b, returning -1, 0 or +1.
+func (u *UnsignedActivePower) Cmp(b *UnsignedActivePower) int { return u.Rat().Cmp(b.Rat()) }
+
+// Add returns u + b.
+func (u *UnsignedActivePower) Add(b *UnsignedActivePower) (*UnsignedActivePower, error) {
+	return UnsignedActivePowerFromRat(new(big.Rat).Add(u.Rat(), b.Rat()))
+}
+
+// Sub returns u - b.
+func (u *UnsignedActivePower) Sub(b *UnsignedActivePower) (*UnsignedActivePower, error) {
+	return UnsignedActivePowerFromRat(new(big.Rat).Sub(u.Rat(), b.Rat()))
+}
+
+// Scale returns u × k.
+func (u *UnsignedActivePower) Scale(k float64) (*UnsignedActivePower, error) {
+	q, err := ratOfFloat(k)
+	if err != nil {
+		return nil, err
+	}
+	return UnsignedActivePowerFromRat(new(big.Rat).Mul(u.Rat(), q))
+}
+
+// Normalize returns u held with its best multiplier.
+func (u *UnsignedActivePower) Normalize() (*UnsignedActivePower, error) {
+	return UnsignedActivePowerFromRat(u.Rat())
+}
+
+// UnsignedReactivePowerFromRat returns r vars as an UnsignedReactivePower.
+func UnsignedReactivePowerFromRat(r *big.Rat) (*UnsignedReactivePower, error) {
+	v, m, err := normalize(r, rangeUInt16)
+	if err != nil {
+		return nil, err
+	}
+	return &UnsignedReactivePower{Multiplier: m, Value: uint16(v)}, nil
+}
+
+// UnsignedReactivePowerFromFloat returns f vars as an UnsignedReactivePower.
+func UnsignedReactivePowerFromFloat(f float64) (*UnsignedReactivePower, error) {
+	r, err := ratOfFloat(f)
+	if err != nil {
+		return nil, err
+	}
+	return UnsignedReactivePowerFromRat(r)
+}
+
+// ParseUnsignedReactivePower parses a decimal number of vars, such as "1.25", as an UnsignedReactivePower.
+func ParseUnsignedReactivePower(s string) (*UnsignedReactivePower, error) {
+	r, err := parseDecimal(s)
+	if err != nil {
+		return nil, err
+	}
+	return UnsignedReactivePowerFromRat(r)
+}
+
+// Rat returns the exact value of u in vars, or 0 if u is nil.
+func (u *UnsignedReactivePower) Rat() *big.Rat {
+	if u == nil {
+		return new(big.Rat)
+	}
+	return decimal(int64(u.Value), u.Multiplier)
+}
+
+// Float64 returns the value of u in vars, or 0 if u is nil.
+func (u *UnsignedReactivePower) Float64() float64 { return floatOf(u.Rat()) }
+
+// String returns the value of u in decimal notation, such as "1.25".
+func (u *UnsignedReactivePower) String() string {
+	if u == nil {
+		return "0"
+	}
+	return formatDecimal(int64(u.Value), u.Multiplier)
+}
+
+// Cmp compares the values of u and b, returning -1, 0 or +1.
+func (u *UnsignedReactivePower) Cmp(b *UnsignedReactivePower) int { return u.Rat().Cmp(b.Rat()) }
+
+// Add returns u + b.
+func (u *UnsignedReactivePower) Add(b *UnsignedReactivePower) (*UnsignedReactivePower, error) {
+	return UnsignedReactivePowerFromRat(new(big.Rat).Add(u.Rat(), b.Rat()))
+}
+
+// Sub returns u - b.
+func (u *UnsignedReactivePower) Sub(b *UnsignedReactivePower) (*UnsignedReactivePower, error) {
+	return UnsignedReactivePowerFromRat(new(big.Rat).Sub(u.Rat(), b.Rat()))
+}
+
+// Scale returns u × k.
+func (u *UnsignedReactivePower) Scale(k float64) (*UnsignedReactivePower, error) {
+	q, err := ratOfFloat(k)
+	if err != nil {
+		return nil, err
+	}
+	return UnsignedReactivePowerFromRat(new(big.Rat).Mul(u.Rat(), q))
+}
+
+// Normalize returns u held with its best multiplier.
+func (u *UnsignedReactivePower) Normalize() (*UnsignedReactivePower, error) {
+	return UnsignedReactivePowerFromRat(u.Rat())
+}
+
+// ApparentPowerFromRat returns r volt-amperes as an ApparentPower.
+func ApparentPowerFromRat(r *big.Rat) (*ApparentPower, error) {
+	v, m, err := normalize(r, rangeUInt16)
+	if err != nil {
+		return nil, err
+	}
+	return &ApparentPower{Multiplier: m, Value: uint16(v)}, nil
+}
+
+// ApparentPowerFromFloat returns f volt-amperes as an ApparentPower.
+func ApparentPowerFromFloat(f float64) (*ApparentPower, error) {
+	r, err := ratOfFloat(f)
+	if err != nil {
+		return nil, err
+	}
+	return ApparentPowerFromRat(r)
+}
+
+// ParseApparentPower parses a decimal number of volt-amperes, such as "1.25", as an ApparentPower.
+func ParseApparentPower(s string) (*ApparentPower, error) {
+	r, err := parseDecimal(s)
+	if err != nil {
+		return nil, err
+	}
+	return ApparentPowerFromRat(r)
+}
+
+// Rat returns the exact value of a in volt-amperes, or 0 if a is nil.
+func (a *ApparentPower) Rat() *big.Rat {
+	if a == nil {
+		return new(big.Rat)
+	}
+	return decimal(int64(a.Value), a.Multiplier)
+}
+
+// Float64 returns the value of a in volt-amperes, or 0 if a is nil.
+func (a *ApparentPower) Float64() float64 { return floatOf(a.Rat()) }
+
+// String returns the value of a in decimal notation, such as "1.25".
+func (a *ApparentPower) String() string {
+	if a == nil {
+		return "0"
+	}
+	return formatDecimal(int64(a.Value), a.Multiplier)
+}
+
+// Cmp compares the values of a and b, returning -1, 0 or +1.
+func (a *ApparentPower) Cmp(b *ApparentPower) int { return a.Rat().Cmp(b.Rat()) }
+
+// Add returns a + b.
+func (a *ApparentPower) Add(b *ApparentPower) (*ApparentPower, error) {
+	return ApparentPowerFromRat(new(big.Rat).Add(a.Rat(), b.Rat()))
+}
+
+// Sub returns a - b.
+func (a *ApparentPower) Sub(b *ApparentPower) (*ApparentPower, error) {
+	return ApparentPowerFromRat(new(big.Rat).Sub(a.Rat(), b.Rat()))
+}
+
+// Scale returns a × k.
+func (a *ApparentPower) Scale(k float64) (*ApparentPower, error) {
+	q, err := ratOfFloat(k)
+	if err != nil {
+		return nil, err
+	}
+	return ApparentPowerFromRat(new(big.Rat).Mul(a.Rat(), q))
+}
+
+// Normalize returns a held with its best multiplier.
+func (a *ApparentPower) Normalize() (*ApparentPower, error) {
+	return ApparentPowerFromRat(a.Rat())
+}
+
+// VoltageRMSFromRat returns r volts as a VoltageRMS.
+func VoltageRMSFromRat(r *big.Rat) (*VoltageRMS, error) {
+	v, m, err := normalize(r, rangeUInt16)
+	if err != nil {
+		return nil, err
+	}
+	return &VoltageRMS{Multiplier: m, Value: uint16(v)}, nil
+}
+
+// VoltageRMSFromFloat returns f volts as a VoltageRMS.
+func VoltageRMSFromFloat(f float64) (*VoltageRMS, error) {
+	r, err := ratOfFloat(f)
+	if err != nil {
+		return nil, err
+	}
+	return VoltageRMSFromRat(r)
+}
+
+// ParseVoltageRMS parses a decimal number of volts, such as "1.25", as a VoltageRMS.
+func ParseVoltageRMS(s string) (*VoltageRMS, error) {
+	r, err := parseDecimal(s)
+	if err != nil {
+		return nil, err
+	}
+	return VoltageRMSFromRat(r)
+}
+
+// Rat returns the exact value of v in volts, or 0 if v is nil.
+func (v *VoltageRMS) Rat() *big.Rat {
+	if v == nil {
+		return new(big.Rat)
+	}
+	return decimal(int64(v.Value), v.Multiplier)
+}
+
+// Float64 returns the value of v in volts, or 0 if v is nil.
+func (v *VoltageRMS) Float64() float64 { return floatOf(v.Rat()) }
+
+// String returns the value of v in decimal notation, such as "1.25".
+func (v *VoltageRMS) String() string {
+	if v == nil {
+		return "0"
+	}
+	return formatDecimal(int64(v.Value), v.Multiplier)
+}
+
+// Cmp compares the values of v and b, returning -1, 0 or +1.
+func (v *VoltageRMS) Cmp(b *VoltageRMS) int { return v.Rat().Cmp(b.Rat()) }
+
+// Add returns v + b.
+func (v *VoltageRMS) Add(b *VoltageRMS) (*VoltageRMS, error) {
+	return VoltageRMSFromRat(new(big.Rat).Add(v.Rat(), b.Rat()))
+}
+
+// Sub returns v - b.
+func (v *VoltageRMS) Sub(b *VoltageRMS) (*VoltageRMS, error) {
+	return VoltageRMSFromRat(new(big.Rat).Sub(v.Rat(), b.Rat()))
+}
+
+// Scale returns v × k.
+func (v *VoltageRMS) Scale(k float64) (*VoltageRMS, error) {
+	q, err := ratOfFloat(k)
+	if err != nil {
+		return nil, err
+	}
+	return VoltageRMSFromRat(new(big.Rat).Mul(v.Rat(), q))
+}
+
+// Normalize returns v held with its best multiplier.
+func (v *VoltageRMS) Normalize() (*VoltageRMS, error) {
+	return VoltageRMSFromRat(v.Rat())
+}
+
+// CurrentRMSFromRat returns r amperes as a CurrentRMS.
+func CurrentRMSFromRat(r *big.Rat) (*CurrentRMS, error) {
+	v, m, err := normalize(r, rangeUInt16)
+	if err != nil {
+		return nil, err
+	}
+	return &CurrentRMS{Multiplier: m, Value: uint16(v)}, nil
+}
+
+// CurrentRMSFromFloat returns f amperes as a CurrentRMS.
+func CurrentRMSFromFloat(f float64) (*CurrentRMS, error) {
+	r, err := ratOfFloat(f)
+	if err != nil {
+		return nil, err
+	}
+	return CurrentRMSFromRat(r)
+}
+
+// ParseCurrentRMS parses a decimal number of amperes, such as "1.25", as a CurrentRMS.
+func ParseCurrentRMS(s string) (*CurrentRMS, error) {
+	r, err := parseDecimal(s)
+	if err != nil {
+		return nil, err
+	}
+	return CurrentRMSFromRat(r)
+}
+
+// Rat returns the exact value of c in amperes, or 0 if c is nil.
+func (c *CurrentRMS) Rat() *big.Rat {
+	if c == nil {
+		return new(big.Rat)
+	}
+	return decimal(int64(c.Value), c.Multiplier)
+}
+
+// Float64 returns the value of c in amperes, or 0 if c is nil.
+func (c *CurrentRMS) Float64() float64 { return floatOf(c.Rat()) }
+
+// String returns the value of c in decimal notation, such as "1.25".
+func (c *CurrentRMS) String() string {
+	if c == nil {
+		return "0"
+	}
+	return formatDecimal(int64(c.Value), c.Multiplier)
+}
+
+// Cmp compares the values of c and b, returning -1, 0 or +1.
+func (c *CurrentRMS) Cmp(b *CurrentRMS) int { return c.Rat().Cmp(b.Rat()) }
+
+// Add returns c + b.
+func (c *CurrentRMS) Add(b *CurrentRMS) (*CurrentRMS, error) {
+	return CurrentRMSFromRat(new(big.Rat).Add(c.Rat(), b.Rat()))
+}
+
+// Sub returns c - b.
+func (c *CurrentRMS) Sub(b *CurrentRMS) (*CurrentRMS, error) {
+	return CurrentRMSFromRat(new(big.Rat).Sub(c.Rat(), b.Rat()))
+}
+
+// Scale returns c × k.
+func (c *CurrentRMS) Scale(k float64) (*CurrentRMS, error) {
+	q, err := ratOfFloat(k)
+	if err != nil {
+		return nil, err
+	}
+	return CurrentRMSFromRat(new(big.Rat).Mul(c.Rat(), q))
+}
+
+// Normalize returns c held with its best multiplier.
+func (c *CurrentRMS) Normalize() (*CurrentRMS, error) {
+	return CurrentRMSFromRat(c.Rat())
+}
+
+// WattHourFromRat returns r watt-hours as a WattHour.
+func WattHourFromRat(r *big.Rat) (*WattHour, error) {
+	v, m, err := normalize(r, rangeUInt16)
+	if err != nil {
+		return nil, err
+	}
+	return &WattHour{Multiplier: m, Value: uint16(v)}, nil
+}
+
+// WattHourFromFloat returns f watt-hours as a WattHour.
+func WattHourFromFloat(f float64) (*WattHour, error) {
+	r, err := ratOfFloat(f)
+	if err != nil {
+		return nil, err
+	}
+	return WattHourFromRat(r)
+}
+
+// ParseWattHour parses a decimal number of watt-hours, such as "1.25", as a WattHour.
+func ParseWattHour(s string) (*WattHour, error) {
+	r, err := parseDecimal(s)
+	if err != nil {
+		return nil, err
+	}
+	return WattHourFromRat(r)
+}
+
+// Rat returns the exact value of w in watt-hours, or 0 if w is nil.
+func (w *WattHour) Rat() *big.Rat {
+	if w == nil {
+		return new(big.Rat)
+	}
+	return decimal(int64(w.Value), w.Multiplier)
+}
+
+// Float64 returns the value of w in watt-hours, or 0 if w is nil.
+func (w *WattHour) Float64() float64 { return floatOf(w.Rat()) }
+
+// String returns the value of w in decimal notation, such as "1.25".
+func (w *WattHour) String() string {
+	if w == nil {
+		return "0"
+	}
+	return formatDecimal(int64(w.Value), w.Multiplier)
+}
+
+// Cmp compares the values of w and b, returning -1, 0 or +1.
+func (w *WattHour) Cmp(b *WattHour) int { return w.Rat().Cmp(b.Rat()) }
+
+// Add returns w + b.
+func (w *WattHour) Add(b *WattHour) (*WattHour, error) {
+	return WattHourFromRat(new(big.Rat).Add(w.Rat(), b.Rat()))
+}
+
+// Sub returns w - b.
+func (w *WattHour) Sub(b *WattHour) (*WattHour, error) {
+	return WattHourFromRat(new(big.Rat).Sub(w.Rat(), b.Rat()))
+}
+
+// Scale returns w × k.
+func (w *WattHour) Scale(k float64) (*WattHour, error) {
+	q, err := ratOfFloat(k)
+	if err != nil {
+		return nil, err
+	}
+	return WattHourFromRat(new(big.Rat).Mul(w.Rat(), q))
+}
+
+// Normalize returns w held with its best multiplier.
+func (w *WattHour) Normalize() (*WattHour, error) {
+	return WattHourFromRat(w.Rat())
+}
+
+// AmpereHourFromRat returns r ampere-hours as an AmpereHour.
+func AmpereHourFromRat(r *big.Rat) (*AmpereHour, error) {
+	v, m, err := normalize(r, rangeUInt16)
+	if err != nil {
+		return nil, err
+	}
+	return &AmpereHour{Multiplier: m, Value: uint16(v)}, nil
+}
+
+// AmpereHourFromFloat returns f ampere-hours as an AmpereHour.
+func AmpereHourFromFloat(f float64) (*AmpereHour, error) {
+	r, err := ratOfFloat(f)
+	if err != nil {
+		return nil, err
+	}
+	return AmpereHourFromRat(r)
+}
+
+// ParseAmpereHour parses a decimal number of ampere-hours, such as "1.25", as an AmpereHour.
+func ParseAmpereHour(s string) (*AmpereHour, error) {
+	r, err := parseDecimal(s)
+	if err != nil {
+		return nil, err
+	}
+	return AmpereHourFromRat(r)
+}
+
+// Rat returns the exact value of a in ampere-hours, or 0 if a is nil.
+func (a *AmpereHour) Rat() *big.Rat {
+	if a == nil {
+		return new(big.Rat)
+	}
+	return decimal(int64(a.Value), a.Multiplier)
+}
+
+// Float64 returns the value of a in ampere-hours, or 0 if a is nil.
+func (a *AmpereHour) Float64() float64 { return floatOf(a.Rat()) }
+
+// String returns the value of a in decimal notation, such as "1.25".
+func (a *AmpereHour) String() string {
+	if a == nil {
+		return "0"
+	}
+	return formatDecimal(int64(a.Value), a.Multiplier)
+}
+
+// Cmp compares the values of a and b, returning -1, 0 or +1.
+func (a *AmpereHour) Cmp(b *AmpereHour) int { return a.Rat().Cmp(b.Rat()) }
+
+// Add returns a + b.
+func (a *AmpereHour) Add(b *AmpereHour) (*AmpereHour, error) {
+	return AmpereHourFromRat(new(big.Rat).Add(a.Rat(), b.Rat()))
+}
+
+// Sub returns a - b.
+func (a *AmpereHour) Sub(b *AmpereHour) (*AmpereHour, error) {
+	return AmpereHourFromRat(new(big.Rat).Sub(a.Rat(), b.Rat()))
+}
+
+// Scale returns a × k.
+func (a *AmpereHour) Scale(k float64) (*AmpereHour, error) {
+	q, err := ratOfFloat(k)
+	if err != nil {
+		return nil, err
+	}
+	return AmpereHourFromRat(new(big.Rat).Mul(a.Rat(), q))
+}
+
+// Normalize returns a held with its best multiplier.
+func (a *AmpereHour) Normalize() (*AmpereHour, error) {
+	return AmpereHourFromRat(a.Rat())
+}
+
+// RealEnergyFromRat returns r watt-hours as a RealEnergy.
+func RealEnergyFromRat(r *big.Rat) (*RealEnergy, error) {
+	v, m, err := normalize(r, rangeUInt48)
+	if err != nil {
+		return nil, err
+	}
+	return &RealEnergy{Multiplier: m, Value: uint64(v)}, nil
+}
+
+// RealEnergyFromFloat returns f watt-hours as a RealEnergy.
+func RealEnergyFromFloat(f float64) (*RealEnergy, error) {
+	r, err := ratOfFloat(f)
+	if err != nil {
+		return nil, err
+	}
+	return RealEnergyFromRat(r)
+}
+
+// ParseRealEnergy parses a decimal number of watt-hours, such as "1.25", as a RealEnergy.
+func ParseRealEnergy(s string) (*RealEnergy, error) {
+	r, err := parseDecimal(s)
+	if err != nil {
+		return nil, err
+	}
+	return RealEnergyFromRat(r)
+}
+
+// Rat returns the exact value of r in watt-hours, or 0 if r is nil.
+func (r *RealEnergy) Rat() *big.Rat {
+	if r == nil {
+		return new(big.Rat)
+	}
+	return decimal(int64(r.Value), r.Multiplier)
+}
+
+// Float64 returns the value of r in watt-hours, or 0 if r is nil.
+func (r *RealEnergy) Float64() float64 { return floatOf(r.Rat()) }
+
+// String returns the value of r in decimal notation, such as "1.25".
+func (r *RealEnergy) String() string {
+	if r == nil {
+		return "0"
+	}
+	return formatDecimal(int64(r.Value), r.Multiplier)
+}
+
+// Cmp compares the values of r and b, returning -1, 0 or +1.
+func (r *RealEnergy) Cmp(b *RealEnergy) int { return r.Rat().Cmp(b.Rat()) }
+
+// Add returns r + b.
+func (r *RealEnergy) Add(b *RealEnergy) (*RealEnergy, error) {
+	return RealEnergyFromRat(new(big.Rat).Add(r.Rat(), b.Rat()))
+}
+
+// Sub returns r - b.
+func (r *RealEnergy) Sub(b *RealEnergy) (*RealEnergy, error) {
+	return RealEnergyFromRat(new(big.Rat).Sub(r.Rat(), b.Rat()))
+}
+
+// Scale returns r × k.
+func (r *RealEnergy) Scale(k float64) (*RealEnergy, error) {
+	q, err := ratOfFloat(k)
+	if err != nil {
+		return nil, err
+	}
+	return RealEnergyFromRat(new(big.Rat).Mul(r.Rat(), q))
+}
+
+// Normalize returns r held with its best multiplier.
+func (r *RealEnergy) Normalize() (*RealEnergy, error) {
+	return RealEnergyFromRat(r.Rat())
+}
+
+// UnitValueTypeFromRat returns r as a UnitValueType without a unit.
+func UnitValueTypeFromRat(r *big.Rat) (*UnitValueType, error) {
+	v, m, err := normalize(r, rangeInt32)
+	if err != nil {
+		return nil, err
+	}
+	return &UnitValueType{Multiplier: m, Value: int(v)}, nil
+}
+
+// UnitValueTypeFromFloat returns f as a UnitValueType without a unit.
+func UnitValueTypeFromFloat(f float64) (*UnitValueType, error) {
+	r, err := ratOfFloat(f)
+	if err != nil {
+		return nil, err
+	}
+	return UnitValueTypeFromRat(r)
+}
+
+// ParseUnitValueType parses a decimal number, such as "1.25", as a UnitValueType without a unit.
+func ParseUnitValueType(s string) (*UnitValueType, error) {
+	r, err := parseDecimal(s)
+	if err != nil {
+		return nil, err
+	}
+	return UnitValueTypeFromRat(r)
+}
+
+// Rat returns the exact value of u, or 0 if u is nil.
+func (u *UnitValueType) Rat() *big.Rat {
+	if u == nil {
+		return new(big.Rat)
+	}
+	return decimal(int64(u.Value), u.Multiplier)
+}
+
+// Float64 returns the value of u, or 0 if u is nil.
+func (u *UnitValueType) Float64() float64 { return floatOf(u.Rat()) }
+
+// String returns the value of u in decimal notation, such as "1.25".
+func (u *UnitValueType) String() string {
+	if u == nil {
+		return "0"
+	}
+	return formatDecimal(int64(u.Value), u.Multiplier)
+}
+
+// Cmp compares the values of u and b, returning -1, 0 or +1.
+func (u *UnitValueType) Cmp(b *UnitValueType) int { return u.Rat().Cmp(b.Rat()) }
+
+// Add returns u + b. It keeps the unit of u.
+func (u *UnitValueType) Add(b *UnitValueType) (*UnitValueType, error) {
+	if !sameUnit(u, b) {
+		return nil, errors.New("sep: adding or subtracting UnitValueType values in different units")
+	}
+	x, err := UnitValueTypeFromRat(new(big.Rat).Add(u.Rat(), b.Rat()))
+	if err != nil {
+		return nil, err
+	}
+	if u != nil {
+		x.Unit = u.Unit
+	}
+	return x, nil
+}
+
+// Sub returns u - b. It keeps the unit of u.
+func (u *UnitValueType) Sub(b *UnitValueType) (*UnitValueType, error) {
+	if !sameUnit(u, b) {
+		return nil, errors.New("sep: adding or subtracting UnitValueType values in different units")
+	}
+	x, err := UnitValueTypeFromRat(new(big.Rat).Sub(u.Rat(), b.Rat()))
+	if err != nil {
+		return nil, err
+	}
+	if u != nil {
+		x.Unit = u.Unit
+	}
+	return x, nil
+}
+
+// Scale returns u × k. It keeps the unit of u.
+func (u *UnitValueType) Scale(k float64) (*UnitValueType, error) {
+	q, err := ratOfFloat(k)
+	if err != nil {
+		return nil, err
+	}
+	x, err := UnitValueTypeFromRat(new(big.Rat).Mul(u.Rat(), q))
+	if err != nil {
+		return nil, err
+	}
+	if u != nil {
+		x.Unit = u.Unit
+	}
+	return x, nil
+}
+
+// Normalize returns u held with its best multiplier. It keeps the unit of u.
+func (u *UnitValueType) Normalize() (*UnitValueType, error) {
+	x, err := UnitValueTypeFromRat(u.Rat())
+	if err != nil {
+		return nil, err
+	}
+	if u != nil {
+		x.Unit = u.Unit
+	}
+	return x, nil
+}
+
+// AccountingUnitFromRat returns r as an AccountingUnit without a unit.
+func AccountingUnitFromRat(r *big.Rat) (*AccountingUnit, error) {
+	v, m, err := normalize(r, rangeInt32)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountingUnit{Multiplier: m, Value: int(v)}, nil
+}
+
+// AccountingUnitFromFloat returns f as an AccountingUnit without a unit.
+func AccountingUnitFromFloat(f float64) (*AccountingUnit, error) {
+	r, err := ratOfFloat(f)
+	if err != nil {
+		return nil, err
+	}
+	return AccountingUnitFromRat(r)
+}
+
+// ParseAccountingUnit parses a decimal number, such as "1.25", as an AccountingUnit without a unit.
+func ParseAccountingUnit(s string) (*AccountingUnit, error) {
+	r, err := parseDecimal(s)
+	if err != nil {
+		return nil, err
+	}
+	return AccountingUnitFromRat(r)
+}
+
+// Rat returns the exact value of a, or 0 if a is nil.
+func (a *AccountingUnit) Rat() *big.Rat {
+	if a == nil {
+		return new(big.Rat)
+	}
+	return decimal(int64(a.Value), a.Multiplier)
+}
+
+// Float64 returns the value of a, or 0 if a is nil.
+func (a *AccountingUnit) Float64() float64 { return floatOf(a.Rat()) }
+
+// String returns the value of a in decimal notation, such as "1.25".
+func (a *AccountingUnit) String() string {
+	if a == nil {
+		return "0"
+	}
+	return formatDecimal(int64(a.Value), a.Multiplier)
+}
+
+// Cmp compares the values of a and b, returning -1, 0 or +1.
+func (a *AccountingUnit) Cmp(b *AccountingUnit) int { return a.Rat().Cmp(b.Rat()) }
+
+// Add returns a + b. It keeps the units of a.
+func (a *AccountingUnit) Add(b *AccountingUnit) (*AccountingUnit, error) {
+	if !sameCurrency(a, b) {
+		return nil, errors.New("sep: adding or subtracting AccountingUnit values in different currencies")
+	}
+	x, err := AccountingUnitFromRat(new(big.Rat).Add(a.Rat(), b.Rat()))
+	if err != nil {
+		return nil, err
+	}
+	if a != nil {
+		x.EnergyUnit, x.MonetaryUnit = a.EnergyUnit, a.MonetaryUnit
+	}
+	return x, nil
+}
+
+// Sub returns a - b. It keeps the units of a.
+func (a *AccountingUnit) Sub(b *AccountingUnit) (*AccountingUnit, error) {
+	if !sameCurrency(a, b) {
+		return nil, errors.New("sep: adding or subtracting AccountingUnit values in different currencies")
+	}
+	x, err := AccountingUnitFromRat(new(big.Rat).Sub(a.Rat(), b.Rat()))
+	if err != nil {
+		return nil, err
+	}
+	if a != nil {
+		x.EnergyUnit, x.MonetaryUnit = a.EnergyUnit, a.MonetaryUnit
+	}
+	return x, nil
+}
+
+// Scale returns a × k. It keeps the units of a.
+func (a *AccountingUnit) Scale(k float64) (*AccountingUnit, error) {
+	q, err := ratOfFloat(k)
+	if err != nil {
+		return nil, err
+	}
+	x, err := AccountingUnitFromRat(new(big.Rat).Mul(a.Rat(), q))
+	if err != nil {
+		return nil, err
+	}
+	if a != nil {
+		x.EnergyUnit, x.MonetaryUnit = a.EnergyUnit, a.MonetaryUnit
+	}
+	return x, nil
+}
+
+// Normalize returns a held with its best multiplier. It keeps the units of a.
+func (a *AccountingUnit) Normalize() (*AccountingUnit, error) {
+	x, err := AccountingUnitFromRat(a.Rat())
+	if err != nil {
+		return nil, err
+	}
+	if a != nil {
+		x.EnergyUnit, x.MonetaryUnit = a.EnergyUnit, a.MonetaryUnit
+	}
+	return x, nil
+}
+
+// FixedPointTypeFromRat returns r as a FixedPointType.
+func FixedPointTypeFromRat(r *big.Rat) (*FixedPointType, error) {
+	v, m, err := normalize(r, rangeInt16)
+	if err != nil {
+		return nil, err
+	}
+	return &FixedPointType{Multiplier: m, Value: int16(v)}, nil
+}
+
+// FixedPointTypeFromFloat returns f as a FixedPointType.
+func FixedPointTypeFromFloat(f float64) (*FixedPointType, error) {
+	r, err := ratOfFloat(f)
+	if err != nil {
+		return nil, err
+	}
+	return FixedPointTypeFromRat(r)
+}
+
+// ParseFixedPointType parses a decimal number, such as "1.25", as a FixedPointType.
+func ParseFixedPointType(s string) (*FixedPointType, error) {
+	r, err := parseDecimal(s)
+	if err != nil {
+		return nil, err
+	}
+	return FixedPointTypeFromRat(r)
+}
+
+// Rat returns the exact value of f, or 0 if f is nil.
+func (f *FixedPointType) Rat() *big.Rat {
+	if f == nil {
+		return new(big.Rat)
+	}
+	return decimal(int64(f.Value), f.Multiplier)
+}
+
+// Float64 returns the value of f, or 0 if f is nil.
+func (f *FixedPointType) Float64() float64 { return floatOf(f.Rat()) }
+
+// String returns the value of f in decimal notation, such as "1.25".
+func (f *FixedPointType) String() string {
+	if f == nil {
+		return "0"
+	}
+	return formatDecimal(int64(f.Value), f.Multiplier)
+}
+
+// Cmp compares the values of f and b, returning -1, 0 or +1.
+func (f *FixedPointType) Cmp(b *FixedPointType) int { return f.Rat().Cmp(b.Rat()) }
+
+// Add returns f + b.
+func (f *FixedPointType) Add(b *FixedPointType) (*FixedPointType, error) {
+	return FixedPointTypeFromRat(new(big.Rat).Add(f.Rat(), b.Rat()))
+}
+
+// Sub returns f - b.
+func (f *FixedPointType) Sub(b *FixedPointType) (*FixedPointType, error) {
+	return FixedPointTypeFromRat(new(big.Rat).Sub(f.Rat(), b.Rat()))
+}
+
+// Scale returns f × k.
+func (f *FixedPointType) Scale(k float64) (*FixedPointType, error) {
+	q, err := ratOfFloat(k)
+	if err != nil {
+		return nil, err
+	}
+	return FixedPointTypeFromRat(new(big.Rat).Mul(f.Rat(), q))
+}
+
+// Normalize returns f held with its best multiplier.
+func (f *FixedPointType) Normalize() (*FixedPointType, error) {
+	return FixedPointTypeFromRat(f.Rat())
+}
+
+// UnsignedFixedPointTypeFromRat returns r as an UnsignedFixedPointType.
+func UnsignedFixedPointTypeFromRat(r *big.Rat) (*UnsignedFixedPointType, error) {
+	v, m, err := normalize(r, rangeUInt16)
+	if err != nil {
+		return nil, err
+	}
+	return &UnsignedFixedPointType{Multiplier: m, Value: uint16(v)}, nil
+}
+
+// UnsignedFixedPointTypeFromFloat returns f as an UnsignedFixedPointType.
+func UnsignedFixedPointTypeFromFloat(f float64) (*UnsignedFixedPointType, error) {
+	r, err := ratOfFloat(f)
+	if err != nil {
+		return nil, err
+	}
+	return UnsignedFixedPointTypeFromRat(r)
+}
+
+// ParseUnsignedFixedPointType parses a decimal number, such as "1.25", as an UnsignedFixedPointType.
+func ParseUnsignedFixedPointType(s string) (*UnsignedFixedPointType, error) {
+	r, err := parseDecimal(s)
+	if err != nil {
+		return nil, err
+	}
+	return UnsignedFixedPointTypeFromRat(r)
+}
+
+// Rat returns the exact value of u, or 0 if u is nil.
+func (u *UnsignedFixedPointType) Rat() *big.Rat {
+	if u == nil {
+		return new(big.Rat)
+	}
+	return decimal(int64(u.Value), u.Multiplier)
+}
+
+// Float64 returns the value of u, or 0 if u is nil.
+func (u *UnsignedFixedPointType) Float64() float64 { return floatOf(u.Rat()) }
+
+// String returns the value of u in decimal notation, such as "1.25".
+func (u *UnsignedFixedPointType) String() string {
+	if u == nil {
+		return "0"
+	}
+	return formatDecimal(int64(u.Value), u.Multiplier)
+}
+
+// Cmp compares the values of u and b, returning -1, 0 or +1.
+func (u *UnsignedFixedPointType) Cmp(b *UnsignedFixedPointType) int { return u.Rat().Cmp(b.Rat()) }
+
+// Add returns u + b.
+func (u *UnsignedFixedPointType) Add(b *UnsignedFixedPointType) (*UnsignedFixedPointType, error) {
+	return UnsignedFixedPointTypeFromRat(new(big.Rat).Add(u.Rat(), b.Rat()))
+}
+
+// Sub returns u - b.
+func (u *UnsignedFixedPointType) Sub(b *UnsignedFixedPointType) (*UnsignedFixedPointType, error) {
+	return UnsignedFixedPointTypeFromRat(new(big.Rat).Sub(u.Rat(), b.Rat()))
+}
+
+// Scale returns u × k.
+func (u *UnsignedFixedPointType) Scale(k float64) (*UnsignedFixedPointType, error) {
+	q, err := ratOfFloat(k)
+	if err != nil {
+		return nil, err
+	}
+	return UnsignedFixedPointTypeFromRat(new(big.Rat).Mul(u.Rat(), q))
+}
+
+// Normalize returns u held with its best multiplier.
+func (u *UnsignedFixedPointType) Normalize() (*UnsignedFixedPointType, error) {
+	return UnsignedFixedPointTypeFromRat(u.Rat())
+}
+
+// PowerFactorFromRat returns r as a PowerFactor.
+func PowerFactorFromRat(r *big.Rat) (*PowerFactor, error) {
+	v, m, err := normalize(r, rangeUInt16)
+	if err != nil {
+		return nil, err
+	}
+	return &PowerFactor{Multiplier: m, Displacement: uint16(v)}, nil
+}
+
+// PowerFactorFromFloat returns f as a PowerFactor.
+func PowerFactorFromFloat(f float64) (*PowerFactor, error) {
+	r, err := ratOfFloat(f)
+	if err != nil {
+		return nil, err
+	}
+	return PowerFactorFromRat(r)
+}
+
+// Rat returns the exact displacement of p, or 0 if p is nil.
+func (p *PowerFactor) Rat() *big.Rat {
+	if p == nil {
+		return new(big.Rat)
+	}
+	return decimal(int64(p.Displacement), p.Multiplier)
+}
+
+// Float64 returns the displacement of p, or 0 if p is nil.
+func (p *PowerFactor) Float64() float64 { return floatOf(p.Rat()) }
+
+// String returns the displacement of p in decimal notation, such as "0.95".
+func (p *PowerFactor) String() string {
+	if p == nil {
+		return "0"
+	}
+	return formatDecimal(int64(p.Displacement), p.Multiplier)
+}
+
+// Cmp compares the displacements of p and b, returning -1, 0 or +1.
+func (p *PowerFactor) Cmp(b *PowerFactor) int { return p.Rat().Cmp(b.Rat()) }
+
+// PowerFactorWithExcitationFromRat returns r as a PowerFactorWithExcitation of the excitation given.
+func PowerFactorWithExcitationFromRat(r *big.Rat, excitation bool) (*PowerFactorWithExcitation, error) {
+	v, m, err := normalize(r, rangeUInt16)
+	if err != nil {
+		return nil, err
+	}
+	return &PowerFactorWithExcitation{Multiplier: m, Displacement: uint16(v), Excitation: excitation}, nil
+}
+
+// PowerFactorWithExcitationFromFloat returns f as a PowerFactorWithExcitation of the excitation given.
+func PowerFactorWithExcitationFromFloat(f float64, excitation bool) (*PowerFactorWithExcitation, error) {
+	r, err := ratOfFloat(f)
+	if err != nil {
+		return nil, err
+	}
+	return PowerFactorWithExcitationFromRat(r, excitation)
+}
+
+// Rat returns the exact displacement of p, or 0 if p is nil.
+func (p *PowerFactorWithExcitation) Rat() *big.Rat {
+	if p == nil {
+		return new(big.Rat)
+	}
+	return decimal(int64(p.Displacement), p.Multiplier)
+}
+
+// Float64 returns the displacement of p, or 0 if p is nil.
+func (p *PowerFactorWithExcitation) Float64() float64 { return floatOf(p.Rat()) }
+
+// String returns the displacement of p in decimal notation, such as "0.95".
+func (p *PowerFactorWithExcitation) String() string {
+	if p == nil {
+		return "0"
+	}
+	return formatDecimal(int64(p.Displacement), p.Multiplier)
+}
@@ -0,0 +1,432 @@
+package sep
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+// valueOf returns the value and multiplier of p.
+func valueOf(p *ActivePower, err error) (int16, int8, error) {
+	if err != nil {
+		return 0, 0, err
+	}
+	return p.Value, int8(multiplierOf(p.Multiplier)), nil
+}
+
+func TestActivePowerFromRat(t *testing.T) {
+	tests := []struct {
+		in    string
+		value int16
+		mult  int8
+		err   bool
+	}{
+		// Exact, with the multiplier closest to 0.
+		{in: "0", value: 0, mult: 0},
+		{in: "5000", value: 5000, mult: 0},
+		{in: "1.25", value: 125, mult: -2},
+		{in: "-0.005", value: -5, mult: -3},
+		{in: "32767", value: 32767, mult: 0},
+		{in: "-32768", value: -32768, mult: 0},
+		{in: "40000", value: 4000, mult: 1},
+		{in: "12000000000", value: 12000, mult: 6},
+		// Overflowing int16 with no exact representation.
+		{in: "32768", value: 3277, mult: 1},
+		{in: "-32769", value: -3277, mult: 1},
+		// Rounding half to even.
+		{in: "32.7645", value: 32764, mult: -3},
+		{in: "32.7655", value: 32766, mult: -3},
+		{in: "-32.7645", value: -32764, mult: -3},
+		{in: "-32.7655", value: -32766, mult: -3},
+		{in: "1/3", value: 3333, mult: -4},
+		// Too small to represent rounds to 0.
+		{in: "1e-15", value: 0, mult: 0},
+		// Out of range.
+		{in: "1e15", err: true},
+		{in: "-4e13", err: true},
+	}
+	for _, tt := range tests {
+		r, _ := new(big.Rat).SetString(tt.in)
+		v, m, err := valueOf(ActivePowerFromRat(r))
+		if tt.err {
+			if !errors.Is(err, ErrValueRange) {
+				t.Errorf("ActivePowerFromRat(%s) error = %v, want ErrValueRange", tt.in, err)
+			}
+			continue
+		}
+		if err != nil || v != tt.value || m != tt.mult {
+			t.Errorf("ActivePowerFromRat(%s) = %d×10^%d, %v, want %d×10^%d", tt.in, v, m, err, tt.value, tt.mult)
+		}
+	}
+}
+
+func TestUnsignedRange(t *testing.T) {
+	if _, err := ApparentPowerFromFloat(-1); !errors.Is(err, ErrValueRange) {
+		t.Errorf("ApparentPowerFromFloat(-1) error = %v, want ErrValueRange", err)
+	}
+	if _, err := UnsignedActivePowerFromFloat(-0.4); !errors.Is(err, ErrValueRange) {
+		t.Errorf("UnsignedActivePowerFromFloat(-0.4) error = %v, want ErrValueRange", err)
+	}
+	a, err := ApparentPowerFromFloat(65535)
+	if err != nil || a.Value != 65535 || multiplierOf(a.Multiplier) != 0 {
+		t.Errorf("ApparentPowerFromFloat(65535) = %v, %v", a, err)
+	}
+	a, err = ApparentPowerFromFloat(65536)
+	if err != nil || a.Value != 6554 || multiplierOf(a.Multiplier) != 1 {
+		t.Errorf("ApparentPowerFromFloat(65536) = %d×10^%d, %v, want 6554×10^1", a.Value, multiplierOf(a.Multiplier), err)
+	}
+	e, err := RealEnergyFromFloat(1 << 40)
+	if err != nil || e.Value != 1<<40 || multiplierOf(e.Multiplier) != 0 {
+		t.Errorf("RealEnergyFromFloat(2^40) = %v, %v", e, err)
+	}
+}
+
+func TestActivePowerFromFloat(t *testing.T) {
+	for _, tt := range []struct {
+		in    float64
+		value int16
+		mult  int8
+	}{
+		{0.1, 1, -1},
+		{0.3, 3, -1},
+		{-1500, -1500, 0},
+		{2.675, 2675, -3},
+	} {
+		if v, m, err := valueOf(ActivePowerFromFloat(tt.in)); err != nil || v != tt.value || m != tt.mult {
+			t.Errorf("ActivePowerFromFloat(%v) = %d×10^%d, %v, want %d×10^%d", tt.in, v, m, err, tt.value, tt.mult)
+		}
+	}
+	for _, in := range []float64{math.Inf(1), math.Inf(-1), math.NaN()} {
+		if _, err := ActivePowerFromFloat(in); !errors.Is(err, ErrValueRange) {
+			t.Errorf("ActivePowerFromFloat(%v) error = %v, want ErrValueRange", in, err)
+		}
+	}
+}
+
+func TestParseActivePower(t *testing.T) {
+	for _, tt := range []struct {
+		in    string
+		value int16
+		mult  int8
+		err   bool
+	}{
+		{in: "1.25", value: 125, mult: -2},
+		{in: " -7 ", value: -7, mult: 0},
+		{in: "3e3", value: 3000, mult: 0},
+		{in: "2.5E4", value: 25000, mult: 0},
+		{in: "0.000", value: 0, mult: 0},
+		{in: "1/2", err: true},
+		{in: "", err: true},
+		{in: "1.2.3", err: true},
+		{in: "watts", err: true},
+	} {
+		v, m, err := valueOf(ParseActivePower(tt.in))
+		if tt.err {
+			if err == nil {
+				t.Errorf("ParseActivePower(%q) = %d×10^%d, want error", tt.in, v, m)
+			}
+			continue
+		}
+		if err != nil || v != tt.value || m != tt.mult {
+			t.Errorf("ParseActivePower(%q) = %d×10^%d, %v, want %d×10^%d", tt.in, v, m, err, tt.value, tt.mult)
+		}
+	}
+}
+
+func TestUnitsString(t *testing.T) {
+	for _, tt := range []struct {
+		value int16
+		mult  int8
+		want  string
+	}{
+		{125, -2, "1.25"},
+		{-5, -3, "-0.005"},
+		{12, 3, "12000"},
+		{1200, -2, "12"},
+		{0, 5, "0"},
+	} {
+		p := &ActivePower{Value: tt.value, Multiplier: NewPowerOfTenMultiplierType(tt.mult)}
+		if got := p.String(); got != tt.want {
+			t.Errorf("%d×10^%d String() = %q, want %q", tt.value, tt.mult, got, tt.want)
+		}
+	}
+	if got := (*ActivePower)(nil).String(); got != "0" {
+		t.Errorf("nil String() = %q, want 0", got)
+	}
+}
+
+func TestUnitsArithmetic(t *testing.T) {
+	p := func(s string) *ActivePower {
+		v, err := ParseActivePower(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	check := func(name string, got *ActivePower, err error, want string) {
+		t.Helper()
+		if err != nil || got.String() != want {
+			t.Errorf("%s = %v, %v, want %s", name, got, err, want)
+		}
+	}
+	sum, err := p("1.25").Add(p("0.75"))
+	check("1.25 + 0.75", sum, err, "2")
+	if sum != nil && multiplierOf(sum.Multiplier) != 0 {
+		t.Errorf("1.25 + 0.75 has multiplier %d, want 0", multiplierOf(sum.Multiplier))
+	}
+	diff, err := p("1000").Sub(p("0.001"))
+	check("1000 - 0.001", diff, err, "1000")
+	diff, err = p("10").Sub(p("12.5"))
+	check("10 - 12.5", diff, err, "-2.5")
+	sc, err := p("1.5").Scale(0.1)
+	check("1.5 × 0.1", sc, err, "0.15")
+	sc, err = (*ActivePower)(nil).Scale(3)
+	check("nil × 3", sc, err, "0")
+	if _, err := p("30000").Add(p("30000")); err != nil {
+		t.Errorf("30000 + 30000: %v", err)
+	}
+	if _, err := p("3e13").Add(p("3e13")); !errors.Is(err, ErrValueRange) {
+		t.Errorf("3e13 + 3e13 error = %v, want ErrValueRange", err)
+	}
+	n, err := (&ActivePower{Value: 25000, Multiplier: NewPowerOfTenMultiplierType(-3)}).Normalize()
+	if err != nil || n.Value != 25 || multiplierOf(n.Multiplier) != 0 {
+		t.Errorf("Normalize(25000×10^-3) = %v, %v, want 25×10^0", n, err)
+	}
+
+	if c := p("1.5").Cmp(&ActivePower{Value: 15, Multiplier: NewPowerOfTenMultiplierType(-1)}); c != 0 {
+		t.Errorf("Cmp of equal values = %d", c)
+	}
+	if c := p("-1").Cmp(nil); c != -1 {
+		t.Errorf("Cmp(-1, nil) = %d, want -1", c)
+	}
+	if f := p("2.5").Float64(); f != 2.5 {
+		t.Errorf("Float64 = %v, want 2.5", f)
+	}
+
+	watts := NewUomType(38)
+	u := &UnitValueType{Value: 5, Unit: watts}
+	if _, err := u.Add(&UnitValueType{Value: 1, Unit: NewUomType(29)}); err == nil {
+		t.Error("adding UnitValueTypes in different units succeeded")
+	}
+	if s, err := u.Add(&UnitValueType{Value: 1}); err != nil || s.Value != 6 || s.Unit != watts {
+		t.Errorf("UnitValueType Add = %v, %v, want 6 W", s, err)
+	}
+	a := &AccountingUnit{Value: 100, Multiplier: NewPowerOfTenMultiplierType(-2), MonetaryUnit: NewCurrencyCode(840)}
+	if _, err := a.Sub(&AccountingUnit{Value: 1, MonetaryUnit: NewCurrencyCode(978)}); err == nil {
+		t.Error("subtracting AccountingUnits in different currencies succeeded")
+	}
+	if s, err := a.Scale(2.5); err != nil || s.String() != "2.5" || s.MonetaryUnit.Value() != 840 {
+		t.Errorf("AccountingUnit Scale = %v, %v, want 2.5 USD", s, err)
+	}
+}
+
+func TestPowerFactor(t *testing.T) {
+	pf := &PowerFactorWithExcitation{Displacement: 95, Multiplier: NewPowerOfTenMultiplierType(-2), Excitation: true}
+	if f := pf.Float64(); f != 0.95 {
+		t.Errorf("Float64 = %v, want 0.95", f)
+	}
+	if f := (*PowerFactorWithExcitation)(nil).Float64(); f != 0 {
+		t.Errorf("nil Float64 = %v, want 0", f)
+	}
+	got, err := PowerFactorWithExcitationFromFloat(0.9, false)
+	if err != nil || got.Displacement != 9 || multiplierOf(got.Multiplier) != -1 || got.Excitation {
+		t.Errorf("PowerFactorWithExcitationFromFloat(0.9) = %v, %v", got, err)
+	}
+	p, err := PowerFactorFromFloat(0.985)
+	if err != nil || p.String() != "0.985" || p.Cmp(&PowerFactor{Displacement: 1}) != -1 {
+		t.Errorf("PowerFactorFromFloat(0.985) = %v, %v", p, err)
+	}
+}
+
+// quantity is implemented by the pointer types with arithmetic in units.go.
+type quantity[T any] interface {
+	*T
+	Rat() *big.Rat
+	Float64() float64
+	String() string
+	Cmp(*T) int
+	Add(*T) (*T, error)
+	Sub(*T) (*T, error)
+	Scale(float64) (*T, error)
+	Normalize() (*T, error)
+}
+
+// checkQuantity checks the conversions and arithmetic of a type whose value
+// ranges up to max, and down to -max if signed.
+func checkQuantity[T any, P quantity[T]](t *testing.T, fromRat func(*big.Rat) (*T, error), fromFloat func(float64) (*T, error), parse func(string) (*T, error), max string, signed bool) {
+	t.Helper()
+	p := func(s string) P {
+		t.Helper()
+		v, err := parse(s)
+		if err != nil {
+			t.Fatalf("parse %q: %v", s, err)
+		}
+		return v
+	}
+	check := func(name string, got P, err error, want string) {
+		t.Helper()
+		if err != nil || got.String() != want {
+			t.Errorf("%s = %v, %v, want %s", name, got, err, want)
+		}
+	}
+	v := p("12.5")
+	if v.Float64() != 12.5 || v.Rat().Cmp(big.NewRat(25, 2)) != 0 || v.String() != "12.5" {
+		t.Errorf("12.5 = %v, %v", v.Float64(), v.Rat())
+	}
+	if f, err := fromFloat(12.5); err != nil || v.Cmp(f) != 0 {
+		t.Errorf("FromFloat(12.5) = %v, %v", f, err)
+	}
+	if r, err := fromRat(big.NewRat(1, 8)); err != nil || P(r).String() != "0.125" {
+		t.Errorf("FromRat(1/8) = %v, %v", r, err)
+	}
+	sum, err := v.Add(p("0.5"))
+	check("12.5 + 0.5", sum, err, "13")
+	diff, err := v.Sub(p("0.5"))
+	check("12.5 - 0.5", diff, err, "12")
+	sc, err := v.Scale(2)
+	check("12.5 × 2", sc, err, "25")
+	n, err := p("1000").Normalize()
+	check("Normalize(1000)", n, err, "1000")
+	if c := v.Cmp(p("13")); c != -1 {
+		t.Errorf("Cmp(12.5, 13) = %d", c)
+	}
+
+	var zero P
+	if zero.String() != "0" || zero.Float64() != 0 || zero.Rat().Sign() != 0 || zero.Cmp(nil) != 0 {
+		t.Error("nil is not 0")
+	}
+	sum, err = zero.Add(v)
+	check("nil + 12.5", sum, err, "12.5")
+
+	// The largest value fits exactly, and larger ones with a multiplier,
+	// rounded if need be, up to 10^9 times the largest.
+	for _, s := range []string{max, max + "0", max + "000000000"} {
+		check(s, p(s), nil, s)
+	}
+	if r := p(max + "1"); r.Cmp(p(max+"0")) != 0 {
+		t.Errorf("%s1 = %v, want %s0", max, r, max)
+	}
+	if _, err := parse(max + "0000000000"); !errors.Is(err, ErrValueRange) {
+		t.Errorf("%s0000000000 error = %v, want ErrValueRange", max, err)
+	}
+	if _, err := p(max + "000000000").Scale(10); !errors.Is(err, ErrValueRange) {
+		t.Errorf("overflowing Scale error = %v, want ErrValueRange", err)
+	}
+	if _, err := p(max + "000000000").Add(p("1e9")); !errors.Is(err, ErrValueRange) {
+		t.Errorf("overflowing Add error = %v, want ErrValueRange", err)
+	}
+	if signed {
+		check("-"+max, p("-"+max), nil, "-"+max)
+		diff, err := p("1").Sub(p("3.5"))
+		check("1 - 3.5", diff, err, "-2.5")
+	} else {
+		for _, s := range []string{"-1", "-0.4"} {
+			if _, err := parse(s); !errors.Is(err, ErrValueRange) {
+				t.Errorf("%s error = %v, want ErrValueRange", s, err)
+			}
+		}
+		if _, err := p("1").Sub(p("3.5")); !errors.Is(err, ErrValueRange) {
+			t.Errorf("1 - 3.5 error = %v, want ErrValueRange", err)
+		}
+	}
+}
+
+func TestQuantities(t *testing.T) {
+	const int16Max, uint16Max, int32Max, uint48Max = "32767", "65535", "2147483647", "281474976710655"
+	t.Run("ActivePower", func(t *testing.T) {
+		checkQuantity(t, ActivePowerFromRat, ActivePowerFromFloat, ParseActivePower, int16Max, true)
+	})
+	t.Run("ReactivePower", func(t *testing.T) {
+		checkQuantity(t, ReactivePowerFromRat, ReactivePowerFromFloat, ParseReactivePower, int16Max, true)
+	})
+	t.Run("UnsignedActivePower", func(t *testing.T) {
+		checkQuantity(t, UnsignedActivePowerFromRat, UnsignedActivePowerFromFloat, ParseUnsignedActivePower, uint16Max, false)
+	})
+	t.Run("UnsignedReactivePower", func(t *testing.T) {
+		checkQuantity(t, UnsignedReactivePowerFromRat, UnsignedReactivePowerFromFloat, ParseUnsignedReactivePower, uint16Max, false)
+	})
+	t.Run("ApparentPower", func(t *testing.T) {
+		checkQuantity(t, ApparentPowerFromRat, ApparentPowerFromFloat, ParseApparentPower, uint16Max, false)
+	})
+	t.Run("VoltageRMS", func(t *testing.T) {
+		checkQuantity(t, VoltageRMSFromRat, VoltageRMSFromFloat, ParseVoltageRMS, uint16Max, false)
+	})
+	t.Run("CurrentRMS", func(t *testing.T) {
+		checkQuantity(t, CurrentRMSFromRat, CurrentRMSFromFloat, ParseCurrentRMS, uint16Max, false)
+	})
+	t.Run("WattHour", func(t *testing.T) {
+		checkQuantity(t, WattHourFromRat, WattHourFromFloat, ParseWattHour, uint16Max, false)
+	})
+	t.Run("AmpereHour", func(t *testing.T) {
+		checkQuantity(t, AmpereHourFromRat, AmpereHourFromFloat, ParseAmpereHour, uint16Max, false)
+	})
+	t.Run("RealEnergy", func(t *testing.T) {
+		checkQuantity(t, RealEnergyFromRat, RealEnergyFromFloat, ParseRealEnergy, uint48Max, false)
+	})
+	t.Run("UnitValueType", func(t *testing.T) {
+		checkQuantity(t, UnitValueTypeFromRat, UnitValueTypeFromFloat, ParseUnitValueType, int32Max, true)
+	})
+	t.Run("AccountingUnit", func(t *testing.T) {
+		checkQuantity(t, AccountingUnitFromRat, AccountingUnitFromFloat, ParseAccountingUnit, int32Max, true)
+	})
+	t.Run("FixedPointType", func(t *testing.T) {
+		checkQuantity(t, FixedPointTypeFromRat, FixedPointTypeFromFloat, ParseFixedPointType, int16Max, true)
+	})
+	t.Run("UnsignedFixedPointType", func(t *testing.T) {
+		checkQuantity(t, UnsignedFixedPointTypeFromRat, UnsignedFixedPointTypeFromFloat, ParseUnsignedFixedPointType, uint16Max, false)
+	})
+}
+
+func TestUnitsKept(t *testing.T) {
+	watts := NewUomType(38)
+	u := &UnitValueType{Value: 5, Unit: watts}
+	for name, f := range map[string]func() (*UnitValueType, error){
+		"Sub":       func() (*UnitValueType, error) { return u.Sub(&UnitValueType{Value: 1, Unit: watts}) },
+		"Scale":     func() (*UnitValueType, error) { return u.Scale(0.5) },
+		"Normalize": u.Normalize,
+	} {
+		if got, err := f(); err != nil || got.Unit != watts {
+			t.Errorf("UnitValueType %s = %v, %v, want the unit kept", name, got, err)
+		}
+	}
+	if _, err := u.Sub(&UnitValueType{Value: 1, Unit: NewUomType(29)}); err == nil {
+		t.Error("subtracting UnitValueTypes in different units succeeded")
+	}
+	if got, err := UnitValueTypeFromFloat(5); err != nil || got.Unit != nil {
+		t.Errorf("UnitValueTypeFromFloat = %v, %v, want no unit", got, err)
+	}
+
+	kWh := &RealEnergy{Value: 1, Multiplier: NewPowerOfTenMultiplierType(3)}
+	a := &AccountingUnit{Value: 3, EnergyUnit: kWh, MonetaryUnit: NewCurrencyCode(840)}
+	for name, f := range map[string]func() (*AccountingUnit, error){
+		"Add": func() (*AccountingUnit, error) { return a.Add(&AccountingUnit{Value: 1}) },
+		"Sub": func() (*AccountingUnit, error) {
+			return a.Sub(&AccountingUnit{Value: 1, MonetaryUnit: NewCurrencyCode(840)})
+		},
+		"Normalize": a.Normalize,
+	} {
+		if got, err := f(); err != nil || got.EnergyUnit != kWh || got.MonetaryUnit.Value() != 840 {
+			t.Errorf("AccountingUnit %s = %v, %v, want the units kept", name, got, err)
+		}
+	}
+	if _, err := a.Add(&AccountingUnit{Value: 1, MonetaryUnit: NewCurrencyCode(978)}); err == nil {
+		t.Error("adding AccountingUnits in different currencies succeeded")
+	}
+}
+
+func TestPowerFactorRange(t *testing.T) {
+	if _, err := PowerFactorFromFloat(-0.5); !errors.Is(err, ErrValueRange) {
+		t.Errorf("PowerFactorFromFloat(-0.5) error = %v, want ErrValueRange", err)
+	}
+	if _, err := PowerFactorWithExcitationFromRat(big.NewRat(-1, 2), true); !errors.Is(err, ErrValueRange) {
+		t.Errorf("PowerFactorWithExcitationFromRat(-0.5) error = %v, want ErrValueRange", err)
+	}
+	p, err := PowerFactorWithExcitationFromRat(big.NewRat(19, 20), true)
+	if err != nil || p.String() != "0.95" || !p.Excitation || p.Rat().Cmp(big.NewRat(19, 20)) != 0 {
+		t.Errorf("PowerFactorWithExcitationFromRat(0.95, true) = %v, %v", p, err)
+	}
+	if (*PowerFactor)(nil).Cmp(&PowerFactor{}) != 0 || (*PowerFactor)(nil).String() != "0" {
+		t.Error("nil PowerFactor is not 0")
+	}
+}
@@ -0,0 +1,46 @@
+package sep
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/xml"
+	"sync"
+
+	"github.com/Tylores/sep/internal/xsd"
+)
+
+//go:embed sep.xsd
+var schemaDocument []byte
+
+// schema returns the parsed IEEE 2030.5 schema bundled with the package.
+var schema = sync.OnceValue(func() *xsd.Schema {
+	s, err := xsd.Parse(bytes.NewReader(schemaDocument))
+	if err != nil {
+		panic("sep: parsing embedded sep.xsd: " + err.Error())
+	}
+	return s
+})
+
+// ValidationError is a single schema violation, located by the path of the
+// offending element or attribute within the document.
+type ValidationError = xsd.Error
+
+// ValidationErrors lists every schema violation found in a document.
+type ValidationErrors = xsd.Errors
+
+// Validate checks doc against the bundled sep.xsd: the root element, the
+// order and cardinality of child elements, attributes, and the facets of
+// every simple value. It returns ValidationErrors when doc is well-formed
+// but invalid.
+func Validate(doc []byte) error {
+	return schema().Validate(bytes.NewReader(doc))
+}
+
+// ValidateResource marshals v and validates the resulting document.
+func ValidateResource(v any) error {
+	doc, err := xml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return Validate(doc)
+}
@@ -0,0 +1,1968 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package sep
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DeviceCapability) Validate() error {
+	return validateFacets(d, "DeviceCapability")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *AbstractDevice) Validate() error {
+	return validateFacets(a, "AbstractDevice")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DeviceStatus) Validate() error {
+	return validateFacets(d, "DeviceStatus")
+}
+
+// Validate checks every value in e against the facets of sep.xsd.
+func (e *EndDeviceList) Validate() error {
+	return validateFacets(e, "EndDeviceList")
+}
+
+// Validate checks every value in e against the facets of sep.xsd.
+func (e *EndDevice) Validate() error {
+	return validateFacets(e, "EndDevice")
+}
+
+// Validate checks every value in e against the facets of sep.xsd.
+func (e *ExternalDevice) Validate() error {
+	return validateFacets(e, "ExternalDevice")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *Registration) Validate() error {
+	return validateFacets(r, "Registration")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *SelfDevice) Validate() error {
+	return validateFacets(s, "SelfDevice")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *Temperature) Validate() error {
+	return validateFacets(t, "Temperature")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FunctionSetAssignmentsBase) Validate() error {
+	return validateFacets(f, "FunctionSetAssignmentsBase")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FunctionSetAssignments) Validate() error {
+	return validateFacets(f, "FunctionSetAssignments")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FunctionSetAssignmentsList) Validate() error {
+	return validateFacets(f, "FunctionSetAssignmentsList")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *Condition) Validate() error {
+	return validateFacets(c, "Condition")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *SubscriptionBase) Validate() error {
+	return validateFacets(s, "SubscriptionBase")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *Subscription) Validate() error {
+	return validateFacets(s, "Subscription")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *SubscriptionList) Validate() error {
+	return validateFacets(s, "SubscriptionList")
+}
+
+// Validate checks every value in n against the facets of sep.xsd.
+func (n *Notification) Validate() error {
+	return validateFacets(n, "Notification")
+}
+
+// Validate checks every value in n against the facets of sep.xsd.
+func (n *NotificationList) Validate() error {
+	return validateFacets(n, "NotificationList")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ResponseSetList) Validate() error {
+	return validateFacets(r, "ResponseSetList")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ResponseSet) Validate() error {
+	return validateFacets(r, "ResponseSet")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ResponseList) Validate() error {
+	return validateFacets(r, "ResponseList")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *Response) Validate() error {
+	return validateFacets(r, "Response")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DefaultDERControlResponse) Validate() error {
+	return validateFacets(d, "DefaultDERControlResponse")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERControlResponse) Validate() error {
+	return validateFacets(d, "DERControlResponse")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DrResponse) Validate() error {
+	return validateFacets(d, "DrResponse")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *AppliedTargetReduction) Validate() error {
+	return validateFacets(a, "AppliedTargetReduction")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FlowReservationResponseResponse) Validate() error {
+	return validateFacets(f, "FlowReservationResponseResponse")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *PriceResponse) Validate() error {
+	return validateFacets(p, "PriceResponse")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *TextResponse) Validate() error {
+	return validateFacets(t, "TextResponse")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *Time) Validate() error {
+	return validateFacets(t, "Time")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DeviceInformation) Validate() error {
+	return validateFacets(d, "DeviceInformation")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DRLCCapabilities) Validate() error {
+	return validateFacets(d, "DRLCCapabilities")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *SupportedLocale) Validate() error {
+	return validateFacets(s, "SupportedLocale")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *SupportedLocaleList) Validate() error {
+	return validateFacets(s, "SupportedLocaleList")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *PowerStatus) Validate() error {
+	return validateFacets(p, "PowerStatus")
+}
+
+// Validate checks p against the facets of PowerSourceType in sep.xsd.
+func (p PowerSourceType) Validate() error {
+	return validateFacets(p, "PowerSourceType")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *PEVInfo) Validate() error {
+	return validateFacets(p, "PEVInfo")
+}
+
+// Validate checks every value in i against the facets of sep.xsd.
+func (i *IEEE802154) Validate() error {
+	return validateFacets(i, "IEEE802154")
+}
+
+// Validate checks every value in i against the facets of sep.xsd.
+func (i *IPAddr) Validate() error {
+	return validateFacets(i, "IPAddr")
+}
+
+// Validate checks every value in i against the facets of sep.xsd.
+func (i *IPAddrList) Validate() error {
+	return validateFacets(i, "IPAddrList")
+}
+
+// Validate checks every value in i against the facets of sep.xsd.
+func (i *IPInterface) Validate() error {
+	return validateFacets(i, "IPInterface")
+}
+
+// Validate checks every value in i against the facets of sep.xsd.
+func (i *IPInterfaceList) Validate() error {
+	return validateFacets(i, "IPInterfaceList")
+}
+
+// Validate checks every value in l against the facets of sep.xsd.
+func (l *LLInterface) Validate() error {
+	return validateFacets(l, "LLInterface")
+}
+
+// Validate checks every value in l against the facets of sep.xsd.
+func (l *LLInterfaceList) Validate() error {
+	return validateFacets(l, "LLInterfaceList")
+}
+
+// Validate checks every value in l against the facets of sep.xsd.
+func (l *LoWPAN) Validate() error {
+	return validateFacets(l, "LoWPAN")
+}
+
+// Validate checks every value in n against the facets of sep.xsd.
+func (n *Neighbor) Validate() error {
+	return validateFacets(n, "Neighbor")
+}
+
+// Validate checks every value in n against the facets of sep.xsd.
+func (n *NeighborList) Validate() error {
+	return validateFacets(n, "NeighborList")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *RPLInstance) Validate() error {
+	return validateFacets(r, "RPLInstance")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *RPLInstanceList) Validate() error {
+	return validateFacets(r, "RPLInstanceList")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *RPLSourceRoutes) Validate() error {
+	return validateFacets(r, "RPLSourceRoutes")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *RPLSourceRoutesList) Validate() error {
+	return validateFacets(r, "RPLSourceRoutesList")
+}
+
+// Validate checks every value in l against the facets of sep.xsd.
+func (l *LogEvent) Validate() error {
+	return validateFacets(l, "LogEvent")
+}
+
+// Validate checks every value in l against the facets of sep.xsd.
+func (l *LogEventList) Validate() error {
+	return validateFacets(l, "LogEventList")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *Configuration) Validate() error {
+	return validateFacets(c, "Configuration")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *PowerConfiguration) Validate() error {
+	return validateFacets(p, "PowerConfiguration")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *PriceResponseCfg) Validate() error {
+	return validateFacets(p, "PriceResponseCfg")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *PriceResponseCfgList) Validate() error {
+	return validateFacets(p, "PriceResponseCfgList")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *TimeConfiguration) Validate() error {
+	return validateFacets(t, "TimeConfiguration")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *File) Validate() error {
+	return validateFacets(f, "File")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FileList) Validate() error {
+	return validateFacets(f, "FileList")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FileStatus) Validate() error {
+	return validateFacets(f, "FileStatus")
+}
+
+// Validate checks every value in l against the facets of sep.xsd.
+func (l *LoadShedAvailabilityList) Validate() error {
+	return validateFacets(l, "LoadShedAvailabilityList")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *ApplianceLoadReduction) Validate() error {
+	return validateFacets(a, "ApplianceLoadReduction")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DemandResponseProgram) Validate() error {
+	return validateFacets(d, "DemandResponseProgram")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DemandResponseProgramList) Validate() error {
+	return validateFacets(d, "DemandResponseProgramList")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DutyCycle) Validate() error {
+	return validateFacets(d, "DutyCycle")
+}
+
+// Validate checks every value in e against the facets of sep.xsd.
+func (e *EndDeviceControl) Validate() error {
+	return validateFacets(e, "EndDeviceControl")
+}
+
+// Validate checks every value in e against the facets of sep.xsd.
+func (e *EndDeviceControlList) Validate() error {
+	return validateFacets(e, "EndDeviceControlList")
+}
+
+// Validate checks every value in l against the facets of sep.xsd.
+func (l *LoadShedAvailability) Validate() error {
+	return validateFacets(l, "LoadShedAvailability")
+}
+
+// Validate checks every value in o against the facets of sep.xsd.
+func (o *Offset) Validate() error {
+	return validateFacets(o, "Offset")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *SetPoint) Validate() error {
+	return validateFacets(s, "SetPoint")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *TargetReduction) Validate() error {
+	return validateFacets(t, "TargetReduction")
+}
+
+// Validate checks every value in m against the facets of sep.xsd.
+func (m *MeterReading) Validate() error {
+	return validateFacets(m, "MeterReading")
+}
+
+// Validate checks every value in m against the facets of sep.xsd.
+func (m *MeterReadingList) Validate() error {
+	return validateFacets(m, "MeterReadingList")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *Reading) Validate() error {
+	return validateFacets(r, "Reading")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ReadingList) Validate() error {
+	return validateFacets(r, "ReadingList")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ReadingSet) Validate() error {
+	return validateFacets(r, "ReadingSet")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ReadingSetList) Validate() error {
+	return validateFacets(r, "ReadingSetList")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ReadingType) Validate() error {
+	return validateFacets(r, "ReadingType")
+}
+
+// Validate checks every value in u against the facets of sep.xsd.
+func (u *UsagePoint) Validate() error {
+	return validateFacets(u, "UsagePoint")
+}
+
+// Validate checks every value in u against the facets of sep.xsd.
+func (u *UsagePointList) Validate() error {
+	return validateFacets(u, "UsagePointList")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *ConsumptionTariffInterval) Validate() error {
+	return validateFacets(c, "ConsumptionTariffInterval")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *ConsumptionTariffIntervalList) Validate() error {
+	return validateFacets(c, "ConsumptionTariffIntervalList")
+}
+
+// Validate checks c against the facets of CostKindType in sep.xsd.
+func (c CostKindType) Validate() error {
+	return validateFacets(c, "CostKindType")
+}
+
+// Validate checks every value in e against the facets of sep.xsd.
+func (e *EnvironmentalCost) Validate() error {
+	return validateFacets(e, "EnvironmentalCost")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *RateComponent) Validate() error {
+	return validateFacets(r, "RateComponent")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *RateComponentList) Validate() error {
+	return validateFacets(r, "RateComponentList")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *TariffProfile) Validate() error {
+	return validateFacets(t, "TariffProfile")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *TariffProfileList) Validate() error {
+	return validateFacets(t, "TariffProfileList")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *TimeTariffInterval) Validate() error {
+	return validateFacets(t, "TimeTariffInterval")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *TimeTariffIntervalList) Validate() error {
+	return validateFacets(t, "TimeTariffIntervalList")
+}
+
+// Validate checks every value in m against the facets of sep.xsd.
+func (m *MessagingProgram) Validate() error {
+	return validateFacets(m, "MessagingProgram")
+}
+
+// Validate checks every value in m against the facets of sep.xsd.
+func (m *MessagingProgramList) Validate() error {
+	return validateFacets(m, "MessagingProgramList")
+}
+
+// Validate checks p against the facets of PriorityType in sep.xsd.
+func (p PriorityType) Validate() error {
+	return validateFacets(p, "PriorityType")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *TextMessage) Validate() error {
+	return validateFacets(t, "TextMessage")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *TextMessageList) Validate() error {
+	return validateFacets(t, "TextMessageList")
+}
+
+// Validate checks every value in b against the facets of sep.xsd.
+func (b *BillingPeriod) Validate() error {
+	return validateFacets(b, "BillingPeriod")
+}
+
+// Validate checks every value in b against the facets of sep.xsd.
+func (b *BillingPeriodList) Validate() error {
+	return validateFacets(b, "BillingPeriodList")
+}
+
+// Validate checks every value in b against the facets of sep.xsd.
+func (b *BillingMeterReadingBase) Validate() error {
+	return validateFacets(b, "BillingMeterReadingBase")
+}
+
+// Validate checks every value in b against the facets of sep.xsd.
+func (b *BillingReading) Validate() error {
+	return validateFacets(b, "BillingReading")
+}
+
+// Validate checks every value in b against the facets of sep.xsd.
+func (b *BillingReadingList) Validate() error {
+	return validateFacets(b, "BillingReadingList")
+}
+
+// Validate checks every value in b against the facets of sep.xsd.
+func (b *BillingReadingSet) Validate() error {
+	return validateFacets(b, "BillingReadingSet")
+}
+
+// Validate checks every value in b against the facets of sep.xsd.
+func (b *BillingReadingSetList) Validate() error {
+	return validateFacets(b, "BillingReadingSetList")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *Charge) Validate() error {
+	return validateFacets(c, "Charge")
+}
+
+// Validate checks c against the facets of ChargeKind in sep.xsd.
+func (c ChargeKind) Validate() error {
+	return validateFacets(c, "ChargeKind")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *CustomerAccount) Validate() error {
+	return validateFacets(c, "CustomerAccount")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *CustomerAccountList) Validate() error {
+	return validateFacets(c, "CustomerAccountList")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *CustomerAgreement) Validate() error {
+	return validateFacets(c, "CustomerAgreement")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *CustomerAgreementList) Validate() error {
+	return validateFacets(c, "CustomerAgreementList")
+}
+
+// Validate checks every value in h against the facets of sep.xsd.
+func (h *HistoricalReading) Validate() error {
+	return validateFacets(h, "HistoricalReading")
+}
+
+// Validate checks every value in h against the facets of sep.xsd.
+func (h *HistoricalReadingList) Validate() error {
+	return validateFacets(h, "HistoricalReadingList")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *ProjectionReading) Validate() error {
+	return validateFacets(p, "ProjectionReading")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *ProjectionReadingList) Validate() error {
+	return validateFacets(p, "ProjectionReadingList")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *TargetReading) Validate() error {
+	return validateFacets(t, "TargetReading")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *TargetReadingList) Validate() error {
+	return validateFacets(t, "TargetReadingList")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *ServiceSupplier) Validate() error {
+	return validateFacets(s, "ServiceSupplier")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *AccountBalance) Validate() error {
+	return validateFacets(a, "AccountBalance")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *AccountingUnit) Validate() error {
+	return validateFacets(a, "AccountingUnit")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *CreditRegister) Validate() error {
+	return validateFacets(c, "CreditRegister")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *CreditRegisterList) Validate() error {
+	return validateFacets(c, "CreditRegisterList")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *Prepayment) Validate() error {
+	return validateFacets(p, "Prepayment")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *PrepaymentList) Validate() error {
+	return validateFacets(p, "PrepaymentList")
+}
+
+// Validate checks p against the facets of PrepayModeType in sep.xsd.
+func (p PrepayModeType) Validate() error {
+	return validateFacets(p, "PrepayModeType")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *PrepayOperationStatus) Validate() error {
+	return validateFacets(p, "PrepayOperationStatus")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *ServiceChange) Validate() error {
+	return validateFacets(s, "ServiceChange")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *SupplyInterruptionOverride) Validate() error {
+	return validateFacets(s, "SupplyInterruptionOverride")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *SupplyInterruptionOverrideList) Validate() error {
+	return validateFacets(s, "SupplyInterruptionOverrideList")
+}
+
+// Validate checks c against the facets of CreditStatusType in sep.xsd.
+func (c CreditStatusType) Validate() error {
+	return validateFacets(c, "CreditStatusType")
+}
+
+// Validate checks c against the facets of CreditTypeType in sep.xsd.
+func (c CreditTypeType) Validate() error {
+	return validateFacets(c, "CreditTypeType")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *CreditTypeChange) Validate() error {
+	return validateFacets(c, "CreditTypeChange")
+}
+
+// Validate checks s against the facets of ServiceStatusType in sep.xsd.
+func (s ServiceStatusType) Validate() error {
+	return validateFacets(s, "ServiceStatusType")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *RequestStatus) Validate() error {
+	return validateFacets(r, "RequestStatus")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FlowReservationRequest) Validate() error {
+	return validateFacets(f, "FlowReservationRequest")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FlowReservationRequestList) Validate() error {
+	return validateFacets(f, "FlowReservationRequestList")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FlowReservationResponse) Validate() error {
+	return validateFacets(f, "FlowReservationResponse")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FlowReservationResponseList) Validate() error {
+	return validateFacets(f, "FlowReservationResponseList")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERList) Validate() error {
+	return validateFacets(d, "DERList")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DER) Validate() error {
+	return validateFacets(d, "DER")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *CurrentDERControls) Validate() error {
+	return validateFacets(c, "CurrentDERControls")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERComponentList) Validate() error {
+	return validateFacets(d, "DERComponentList")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERComponentBase) Validate() error {
+	return validateFacets(d, "DERComponentBase")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERComponent) Validate() error {
+	return validateFacets(d, "DERComponent")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERAvailability) Validate() error {
+	return validateFacets(d, "DERAvailability")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERCapability) Validate() error {
+	return validateFacets(d, "DERCapability")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERSettings) Validate() error {
+	return validateFacets(d, "DERSettings")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERStatus) Validate() error {
+	return validateFacets(d, "DERStatus")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERProgramList) Validate() error {
+	return validateFacets(d, "DERProgramList")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERProgram) Validate() error {
+	return validateFacets(d, "DERProgram")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERControlBase) Validate() error {
+	return validateFacets(d, "DERControlBase")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DefaultDERControl) Validate() error {
+	return validateFacets(d, "DefaultDERControl")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERControlList) Validate() error {
+	return validateFacets(d, "DERControlList")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERControl) Validate() error {
+	return validateFacets(d, "DERControl")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERCurveList) Validate() error {
+	return validateFacets(d, "DERCurveList")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERCurve) Validate() error {
+	return validateFacets(d, "DERCurve")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERCurveControlType) Validate() error {
+	return validateFacets(d, "DERCurveControlType")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *CurveData) Validate() error {
+	return validateFacets(c, "CurveData")
+}
+
+// Validate checks d against the facets of DERCurveType in sep.xsd.
+func (d DERCurveType) Validate() error {
+	return validateFacets(d, "DERCurveType")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *ActivePower) Validate() error {
+	return validateFacets(a, "ActivePower")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *ActivePowerControlType) Validate() error {
+	return validateFacets(a, "ActivePowerControlType")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *ActivePowerDeltaControlType) Validate() error {
+	return validateFacets(a, "ActivePowerDeltaControlType")
+}
+
+// Validate checks every value in u against the facets of sep.xsd.
+func (u *UnsignedActivePower) Validate() error {
+	return validateFacets(u, "UnsignedActivePower")
+}
+
+// Validate checks every value in u against the facets of sep.xsd.
+func (u *UnsignedActivePowerControlType) Validate() error {
+	return validateFacets(u, "UnsignedActivePowerControlType")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *AmpereHour) Validate() error {
+	return validateFacets(a, "AmpereHour")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *ApparentPower) Validate() error {
+	return validateFacets(a, "ApparentPower")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *CurrentRMS) Validate() error {
+	return validateFacets(c, "CurrentRMS")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FixedPointType) Validate() error {
+	return validateFacets(f, "FixedPointType")
+}
+
+// Validate checks every value in u against the facets of sep.xsd.
+func (u *UnsignedFixedPointType) Validate() error {
+	return validateFacets(u, "UnsignedFixedPointType")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FixedVar) Validate() error {
+	return validateFacets(f, "FixedVar")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FixedVarControlType) Validate() error {
+	return validateFacets(f, "FixedVarControlType")
+}
+
+// Validate checks every value in u against the facets of sep.xsd.
+func (u *UnsignedFixedVar) Validate() error {
+	return validateFacets(u, "UnsignedFixedVar")
+}
+
+// Validate checks every value in u against the facets of sep.xsd.
+func (u *UnsignedFixedVarControlType) Validate() error {
+	return validateFacets(u, "UnsignedFixedVarControlType")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FreqDroopType) Validate() error {
+	return validateFacets(f, "FreqDroopType")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *PerCentControlType) Validate() error {
+	return validateFacets(p, "PerCentControlType")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *PowerFactor) Validate() error {
+	return validateFacets(p, "PowerFactor")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *PowerFactorWithExcitation) Validate() error {
+	return validateFacets(p, "PowerFactorWithExcitation")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *PowerFactorWithExcitationControlType) Validate() error {
+	return validateFacets(p, "PowerFactorWithExcitationControlType")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ReactivePower) Validate() error {
+	return validateFacets(r, "ReactivePower")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ReactivePowerControlType) Validate() error {
+	return validateFacets(r, "ReactivePowerControlType")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ReactivePowerDeltaControlType) Validate() error {
+	return validateFacets(r, "ReactivePowerDeltaControlType")
+}
+
+// Validate checks every value in u against the facets of sep.xsd.
+func (u *UnsignedReactivePower) Validate() error {
+	return validateFacets(u, "UnsignedReactivePower")
+}
+
+// Validate checks every value in u against the facets of sep.xsd.
+func (u *UnsignedReactivePowerControlType) Validate() error {
+	return validateFacets(u, "UnsignedReactivePowerControlType")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ReactiveSusceptance) Validate() error {
+	return validateFacets(r, "ReactiveSusceptance")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *SignedPerCentControlType) Validate() error {
+	return validateFacets(s, "SignedPerCentControlType")
+}
+
+// Validate checks every value in v against the facets of sep.xsd.
+func (v *VoltageRMS) Validate() error {
+	return validateFacets(v, "VoltageRMS")
+}
+
+// Validate checks every value in v against the facets of sep.xsd.
+func (v *VoltageRMSControlType) Validate() error {
+	return validateFacets(v, "VoltageRMSControlType")
+}
+
+// Validate checks every value in w against the facets of sep.xsd.
+func (w *WattHour) Validate() error {
+	return validateFacets(w, "WattHour")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *ConnectStatusType) Validate() error {
+	return validateFacets(c, "ConnectStatusType")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *ConnectStatusType2) Validate() error {
+	return validateFacets(c, "ConnectStatusType2")
+}
+
+// Validate checks d against the facets of DefaultDERControlType in sep.xsd.
+func (d DefaultDERControlType) Validate() error {
+	return validateFacets(d, "DefaultDERControlType")
+}
+
+// Validate checks d against the facets of DeltaBidirectionalType in sep.xsd.
+func (d DeltaBidirectionalType) Validate() error {
+	return validateFacets(d, "DeltaBidirectionalType")
+}
+
+// Validate checks d against the facets of DERControlType in sep.xsd.
+func (d DERControlType) Validate() error {
+	return validateFacets(d, "DERControlType")
+}
+
+// Validate checks d against the facets of DERControlType2 in sep.xsd.
+func (d DERControlType2) Validate() error {
+	return validateFacets(d, "DERControlType2")
+}
+
+// Validate checks d against the facets of DERType in sep.xsd.
+func (d DERType) Validate() error {
+	return validateFacets(d, "DERType")
+}
+
+// Validate checks d against the facets of DERUnitRefType in sep.xsd.
+func (d DERUnitRefType) Validate() error {
+	return validateFacets(d, "DERUnitRefType")
+}
+
+// Validate checks every value in i against the facets of sep.xsd.
+func (i *InverterStatusType) Validate() error {
+	return validateFacets(i, "InverterStatusType")
+}
+
+// Validate checks every value in l against the facets of sep.xsd.
+func (l *LocalControlModeStatusType) Validate() error {
+	return validateFacets(l, "LocalControlModeStatusType")
+}
+
+// Validate checks every value in m against the facets of sep.xsd.
+func (m *ManufacturerStatusType) Validate() error {
+	return validateFacets(m, "ManufacturerStatusType")
+}
+
+// Validate checks every value in o against the facets of sep.xsd.
+func (o *OperationalModeStatusType) Validate() error {
+	return validateFacets(o, "OperationalModeStatusType")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *StateOfChargeStatusType) Validate() error {
+	return validateFacets(s, "StateOfChargeStatusType")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *StorageModeStatusType) Validate() error {
+	return validateFacets(s, "StorageModeStatusType")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *CurrentDERProgramLink) Validate() error {
+	return validateFacets(c, "CurrentDERProgramLink")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *AggregationPriority) Validate() error {
+	return validateFacets(a, "AggregationPriority")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *PriorityData) Validate() error {
+	return validateFacets(p, "PriorityData")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *AggregatedDeviceList) Validate() error {
+	return validateFacets(a, "AggregatedDeviceList")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *AggregatedDevice) Validate() error {
+	return validateFacets(a, "AggregatedDevice")
+}
+
+// Validate checks a against the facets of AggregationDistributionType in sep.xsd.
+func (a AggregationDistributionType) Validate() error {
+	return validateFacets(a, "AggregationDistributionType")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *ProxiedDevice) Validate() error {
+	return validateFacets(p, "ProxiedDevice")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *ProxiedDeviceList) Validate() error {
+	return validateFacets(p, "ProxiedDeviceList")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *AccountBalanceLink) Validate() error {
+	return validateFacets(a, "AccountBalanceLink")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *AggregatedDeviceListLink) Validate() error {
+	return validateFacets(a, "AggregatedDeviceListLink")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *AggregationPriorityLink) Validate() error {
+	return validateFacets(a, "AggregationPriorityLink")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *AssociatedDERProgramListLink) Validate() error {
+	return validateFacets(a, "AssociatedDERProgramListLink")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *AssociatedUsagePointLink) Validate() error {
+	return validateFacets(a, "AssociatedUsagePointLink")
+}
+
+// Validate checks every value in b against the facets of sep.xsd.
+func (b *BillingPeriodListLink) Validate() error {
+	return validateFacets(b, "BillingPeriodListLink")
+}
+
+// Validate checks every value in b against the facets of sep.xsd.
+func (b *BillingReadingListLink) Validate() error {
+	return validateFacets(b, "BillingReadingListLink")
+}
+
+// Validate checks every value in b against the facets of sep.xsd.
+func (b *BillingReadingSetListLink) Validate() error {
+	return validateFacets(b, "BillingReadingSetListLink")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *ConfigurationLink) Validate() error {
+	return validateFacets(c, "ConfigurationLink")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *ConsumptionTariffIntervalListLink) Validate() error {
+	return validateFacets(c, "ConsumptionTariffIntervalListLink")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *CreditRegisterListLink) Validate() error {
+	return validateFacets(c, "CreditRegisterListLink")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *CurrentDERControlsLink) Validate() error {
+	return validateFacets(c, "CurrentDERControlsLink")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *CustomerAccountLink) Validate() error {
+	return validateFacets(c, "CustomerAccountLink")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *CustomerAccountListLink) Validate() error {
+	return validateFacets(c, "CustomerAccountListLink")
+}
+
+// Validate checks every value in c against the facets of sep.xsd.
+func (c *CustomerAgreementListLink) Validate() error {
+	return validateFacets(c, "CustomerAgreementListLink")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DefaultDERControlLink) Validate() error {
+	return validateFacets(d, "DefaultDERControlLink")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DemandResponseProgramLink) Validate() error {
+	return validateFacets(d, "DemandResponseProgramLink")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DemandResponseProgramListLink) Validate() error {
+	return validateFacets(d, "DemandResponseProgramListLink")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERAvailabilityLink) Validate() error {
+	return validateFacets(d, "DERAvailabilityLink")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERCapabilityLink) Validate() error {
+	return validateFacets(d, "DERCapabilityLink")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERComponentListLink) Validate() error {
+	return validateFacets(d, "DERComponentListLink")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERControlListLink) Validate() error {
+	return validateFacets(d, "DERControlListLink")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERCurveLink) Validate() error {
+	return validateFacets(d, "DERCurveLink")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERCurveListLink) Validate() error {
+	return validateFacets(d, "DERCurveListLink")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERLink) Validate() error {
+	return validateFacets(d, "DERLink")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERListLink) Validate() error {
+	return validateFacets(d, "DERListLink")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERProgramLink) Validate() error {
+	return validateFacets(d, "DERProgramLink")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERProgramListLink) Validate() error {
+	return validateFacets(d, "DERProgramListLink")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERSettingsLink) Validate() error {
+	return validateFacets(d, "DERSettingsLink")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DERStatusLink) Validate() error {
+	return validateFacets(d, "DERStatusLink")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DeviceCapabilityLink) Validate() error {
+	return validateFacets(d, "DeviceCapabilityLink")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DeviceInformationLink) Validate() error {
+	return validateFacets(d, "DeviceInformationLink")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DeviceStatusLink) Validate() error {
+	return validateFacets(d, "DeviceStatusLink")
+}
+
+// Validate checks every value in e against the facets of sep.xsd.
+func (e *EndDeviceControlListLink) Validate() error {
+	return validateFacets(e, "EndDeviceControlListLink")
+}
+
+// Validate checks every value in e against the facets of sep.xsd.
+func (e *EndDeviceLink) Validate() error {
+	return validateFacets(e, "EndDeviceLink")
+}
+
+// Validate checks every value in e against the facets of sep.xsd.
+func (e *EndDeviceListLink) Validate() error {
+	return validateFacets(e, "EndDeviceListLink")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FileLink) Validate() error {
+	return validateFacets(f, "FileLink")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FileListLink) Validate() error {
+	return validateFacets(f, "FileListLink")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FileStatusLink) Validate() error {
+	return validateFacets(f, "FileStatusLink")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FlowReservationRequestListLink) Validate() error {
+	return validateFacets(f, "FlowReservationRequestListLink")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FlowReservationResponseListLink) Validate() error {
+	return validateFacets(f, "FlowReservationResponseListLink")
+}
+
+// Validate checks every value in f against the facets of sep.xsd.
+func (f *FunctionSetAssignmentsListLink) Validate() error {
+	return validateFacets(f, "FunctionSetAssignmentsListLink")
+}
+
+// Validate checks every value in h against the facets of sep.xsd.
+func (h *HistoricalReadingListLink) Validate() error {
+	return validateFacets(h, "HistoricalReadingListLink")
+}
+
+// Validate checks every value in i against the facets of sep.xsd.
+func (i *IPAddrListLink) Validate() error {
+	return validateFacets(i, "IPAddrListLink")
+}
+
+// Validate checks every value in i against the facets of sep.xsd.
+func (i *IPInterfaceListLink) Validate() error {
+	return validateFacets(i, "IPInterfaceListLink")
+}
+
+// Validate checks every value in l against the facets of sep.xsd.
+func (l *LLInterfaceListLink) Validate() error {
+	return validateFacets(l, "LLInterfaceListLink")
+}
+
+// Validate checks every value in l against the facets of sep.xsd.
+func (l *LoadShedAvailabilityListLink) Validate() error {
+	return validateFacets(l, "LoadShedAvailabilityListLink")
+}
+
+// Validate checks every value in l against the facets of sep.xsd.
+func (l *LogEventListLink) Validate() error {
+	return validateFacets(l, "LogEventListLink")
+}
+
+// Validate checks every value in m against the facets of sep.xsd.
+func (m *MessagingProgramListLink) Validate() error {
+	return validateFacets(m, "MessagingProgramListLink")
+}
+
+// Validate checks every value in m against the facets of sep.xsd.
+func (m *MeterReadingLink) Validate() error {
+	return validateFacets(m, "MeterReadingLink")
+}
+
+// Validate checks every value in m against the facets of sep.xsd.
+func (m *MeterReadingListLink) Validate() error {
+	return validateFacets(m, "MeterReadingListLink")
+}
+
+// Validate checks every value in m against the facets of sep.xsd.
+func (m *MirrorUsagePointListLink) Validate() error {
+	return validateFacets(m, "MirrorUsagePointListLink")
+}
+
+// Validate checks every value in n against the facets of sep.xsd.
+func (n *NeighborListLink) Validate() error {
+	return validateFacets(n, "NeighborListLink")
+}
+
+// Validate checks every value in n against the facets of sep.xsd.
+func (n *NotificationListLink) Validate() error {
+	return validateFacets(n, "NotificationListLink")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *PowerStatusLink) Validate() error {
+	return validateFacets(p, "PowerStatusLink")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *PrepaymentLink) Validate() error {
+	return validateFacets(p, "PrepaymentLink")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *PrepaymentListLink) Validate() error {
+	return validateFacets(p, "PrepaymentListLink")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *PrepayOperationStatusLink) Validate() error {
+	return validateFacets(p, "PrepayOperationStatusLink")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *PriceResponseCfgListLink) Validate() error {
+	return validateFacets(p, "PriceResponseCfgListLink")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *ProjectionReadingListLink) Validate() error {
+	return validateFacets(p, "ProjectionReadingListLink")
+}
+
+// Validate checks every value in p against the facets of sep.xsd.
+func (p *ProxiedDeviceListLink) Validate() error {
+	return validateFacets(p, "ProxiedDeviceListLink")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *RateComponentLink) Validate() error {
+	return validateFacets(r, "RateComponentLink")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *RateComponentListLink) Validate() error {
+	return validateFacets(r, "RateComponentListLink")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ReadingLink) Validate() error {
+	return validateFacets(r, "ReadingLink")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ReadingListLink) Validate() error {
+	return validateFacets(r, "ReadingListLink")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ReadingSetListLink) Validate() error {
+	return validateFacets(r, "ReadingSetListLink")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ReadingTypeLink) Validate() error {
+	return validateFacets(r, "ReadingTypeLink")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *RegistrationLink) Validate() error {
+	return validateFacets(r, "RegistrationLink")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ResponseListLink) Validate() error {
+	return validateFacets(r, "ResponseListLink")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ResponseSetListLink) Validate() error {
+	return validateFacets(r, "ResponseSetListLink")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *RPLInstanceListLink) Validate() error {
+	return validateFacets(r, "RPLInstanceListLink")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *RPLSourceRoutesListLink) Validate() error {
+	return validateFacets(r, "RPLSourceRoutesListLink")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *SelfDeviceLink) Validate() error {
+	return validateFacets(s, "SelfDeviceLink")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *ServiceSupplierLink) Validate() error {
+	return validateFacets(s, "ServiceSupplierLink")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *SubscriptionListLink) Validate() error {
+	return validateFacets(s, "SubscriptionListLink")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *SupplyInterruptionOverrideListLink) Validate() error {
+	return validateFacets(s, "SupplyInterruptionOverrideListLink")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *SupportedLocaleListLink) Validate() error {
+	return validateFacets(s, "SupportedLocaleListLink")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *TargetReadingListLink) Validate() error {
+	return validateFacets(t, "TargetReadingListLink")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *TariffProfileLink) Validate() error {
+	return validateFacets(t, "TariffProfileLink")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *TariffProfileListLink) Validate() error {
+	return validateFacets(t, "TariffProfileListLink")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *TextMessageListLink) Validate() error {
+	return validateFacets(t, "TextMessageListLink")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *TimeLink) Validate() error {
+	return validateFacets(t, "TimeLink")
+}
+
+// Validate checks every value in t against the facets of sep.xsd.
+func (t *TimeTariffIntervalListLink) Validate() error {
+	return validateFacets(t, "TimeTariffIntervalListLink")
+}
+
+// Validate checks every value in u against the facets of sep.xsd.
+func (u *UsagePointLink) Validate() error {
+	return validateFacets(u, "UsagePointLink")
+}
+
+// Validate checks every value in u against the facets of sep.xsd.
+func (u *UsagePointListLink) Validate() error {
+	return validateFacets(u, "UsagePointListLink")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *ActiveBillingPeriodListLink) Validate() error {
+	return validateFacets(a, "ActiveBillingPeriodListLink")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *ActiveCreditRegisterListLink) Validate() error {
+	return validateFacets(a, "ActiveCreditRegisterListLink")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *ActiveDERControlListLink) Validate() error {
+	return validateFacets(a, "ActiveDERControlListLink")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *ActiveEndDeviceControlListLink) Validate() error {
+	return validateFacets(a, "ActiveEndDeviceControlListLink")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *ActiveFlowReservationListLink) Validate() error {
+	return validateFacets(a, "ActiveFlowReservationListLink")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *ActiveProjectionReadingListLink) Validate() error {
+	return validateFacets(a, "ActiveProjectionReadingListLink")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *ActiveSupplyInterruptionOverrideListLink) Validate() error {
+	return validateFacets(a, "ActiveSupplyInterruptionOverrideListLink")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *ActiveTargetReadingListLink) Validate() error {
+	return validateFacets(a, "ActiveTargetReadingListLink")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *ActiveTextMessageListLink) Validate() error {
+	return validateFacets(a, "ActiveTextMessageListLink")
+}
+
+// Validate checks every value in a against the facets of sep.xsd.
+func (a *ActiveTimeTariffIntervalListLink) Validate() error {
+	return validateFacets(a, "ActiveTimeTariffIntervalListLink")
+}
+
+// Validate checks every value in i against the facets of sep.xsd.
+func (i *IdentifiedObject) Validate() error {
+	return validateFacets(i, "IdentifiedObject")
+}
+
+// Validate checks every value in l against the facets of sep.xsd.
+func (l *Link) Validate() error {
+	return validateFacets(l, "Link")
+}
+
+// Validate checks every value in l against the facets of sep.xsd.
+func (l *List) Validate() error {
+	return validateFacets(l, "List")
+}
+
+// Validate checks every value in l against the facets of sep.xsd.
+func (l *ListLink) Validate() error {
+	return validateFacets(l, "ListLink")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *Resource) Validate() error {
+	return validateFacets(r, "Resource")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *RespondableIdentifiedObject) Validate() error {
+	return validateFacets(r, "RespondableIdentifiedObject")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *RespondableResource) Validate() error {
+	return validateFacets(r, "RespondableResource")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *RespondableSubscribableIdentifiedObject) Validate() error {
+	return validateFacets(r, "RespondableSubscribableIdentifiedObject")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *SubscribableIdentifiedObject) Validate() error {
+	return validateFacets(s, "SubscribableIdentifiedObject")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *SubscribableList) Validate() error {
+	return validateFacets(s, "SubscribableList")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *SubscribableResource) Validate() error {
+	return validateFacets(s, "SubscribableResource")
+}
+
+// Validate checks every value in e against the facets of sep.xsd.
+func (e *Error) Validate() error {
+	return validateFacets(e, "Error")
+}
+
+// Validate checks every value in e against the facets of sep.xsd.
+func (e *Event) Validate() error {
+	return validateFacets(e, "Event")
+}
+
+// Validate checks every value in e against the facets of sep.xsd.
+func (e *EventStatus) Validate() error {
+	return validateFacets(e, "EventStatus")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *RandomizableEvent) Validate() error {
+	return validateFacets(r, "RandomizableEvent")
+}
+
+// Validate checks a against the facets of AccumulationBehaviourType in sep.xsd.
+func (a AccumulationBehaviourType) Validate() error {
+	return validateFacets(a, "AccumulationBehaviourType")
+}
+
+// Validate checks a against the facets of ApplianceLoadReductionType in sep.xsd.
+func (a ApplianceLoadReductionType) Validate() error {
+	return validateFacets(a, "ApplianceLoadReductionType")
+}
+
+// Validate checks c against the facets of CommodityType in sep.xsd.
+func (c CommodityType) Validate() error {
+	return validateFacets(c, "CommodityType")
+}
+
+// Validate checks c against the facets of ConsumptionBlockType in sep.xsd.
+func (c ConsumptionBlockType) Validate() error {
+	return validateFacets(c, "ConsumptionBlockType")
+}
+
+// Validate checks c against the facets of CountryType in sep.xsd.
+func (c CountryType) Validate() error {
+	return validateFacets(c, "CountryType")
+}
+
+// Validate checks c against the facets of CurrencyCode in sep.xsd.
+func (c CurrencyCode) Validate() error {
+	return validateFacets(c, "CurrencyCode")
+}
+
+// Validate checks d against the facets of DataQualifierType in sep.xsd.
+func (d DataQualifierType) Validate() error {
+	return validateFacets(d, "DataQualifierType")
+}
+
+// Validate checks every value in d against the facets of sep.xsd.
+func (d *DateTimeInterval) Validate() error {
+	return validateFacets(d, "DateTimeInterval")
+}
+
+// Validate checks d against the facets of DeviceCategoryType in sep.xsd.
+func (d DeviceCategoryType) Validate() error {
+	return validateFacets(d, "DeviceCategoryType")
+}
+
+// Validate checks d against the facets of DstRuleType in sep.xsd.
+func (d DstRuleType) Validate() error {
+	return validateFacets(d, "DstRuleType")
+}
+
+// Validate checks f against the facets of FlowDirectionType in sep.xsd.
+func (f FlowDirectionType) Validate() error {
+	return validateFacets(f, "FlowDirectionType")
+}
+
+// Validate checks every value in g against the facets of sep.xsd.
+func (g *GeographicLocationType) Validate() error {
+	return validateFacets(g, "GeographicLocationType")
+}
+
+// Validate checks every value in g against the facets of sep.xsd.
+func (g *GPSLocationType) Validate() error {
+	return validateFacets(g, "GPSLocationType")
+}
+
+// Validate checks k against the facets of KindType in sep.xsd.
+func (k KindType) Validate() error {
+	return validateFacets(k, "KindType")
+}
+
+// Validate checks l against the facets of LocaleType in sep.xsd.
+func (l LocaleType) Validate() error {
+	return validateFacets(l, "LocaleType")
+}
+
+// Validate checks m against the facets of MRIDType in sep.xsd.
+func (m MRIDType) Validate() error {
+	return validateFacets(m, "MRIDType")
+}
+
+// Validate checks o against the facets of OneHourRangeType in sep.xsd.
+func (o OneHourRangeType) Validate() error {
+	return validateFacets(o, "OneHourRangeType")
+}
+
+// Validate checks p against the facets of PENType in sep.xsd.
+func (p PENType) Validate() error {
+	return validateFacets(p, "PENType")
+}
+
+// Validate checks p against the facets of PerCent in sep.xsd.
+func (p PerCent) Validate() error {
+	return validateFacets(p, "PerCent")
+}
+
+// Validate checks p against the facets of PhaseCode in sep.xsd.
+func (p PhaseCode) Validate() error {
+	return validateFacets(p, "PhaseCode")
+}
+
+// Validate checks p against the facets of PINType in sep.xsd.
+func (p PINType) Validate() error {
+	return validateFacets(p, "PINType")
+}
+
+// Validate checks p against the facets of PowerOfTenMultiplierType in sep.xsd.
+func (p PowerOfTenMultiplierType) Validate() error {
+	return validateFacets(p, "PowerOfTenMultiplierType")
+}
+
+// Validate checks p against the facets of PrimacyType in sep.xsd.
+func (p PrimacyType) Validate() error {
+	return validateFacets(p, "PrimacyType")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *RealEnergy) Validate() error {
+	return validateFacets(r, "RealEnergy")
+}
+
+// Validate checks r against the facets of RoleFlagsType in sep.xsd.
+func (r RoleFlagsType) Validate() error {
+	return validateFacets(r, "RoleFlagsType")
+}
+
+// Validate checks s against the facets of ServiceKind in sep.xsd.
+func (s ServiceKind) Validate() error {
+	return validateFacets(s, "ServiceKind")
+}
+
+// Validate checks s against the facets of SFDIType in sep.xsd.
+func (s SFDIType) Validate() error {
+	return validateFacets(s, "SFDIType")
+}
+
+// Validate checks s against the facets of SignedPerCent in sep.xsd.
+func (s SignedPerCent) Validate() error {
+	return validateFacets(s, "SignedPerCent")
+}
+
+// Validate checks every value in s against the facets of sep.xsd.
+func (s *SignedRealEnergy) Validate() error {
+	return validateFacets(s, "SignedRealEnergy")
+}
+
+// Validate checks s against the facets of SubdivisionType in sep.xsd.
+func (s SubdivisionType) Validate() error {
+	return validateFacets(s, "SubdivisionType")
+}
+
+// Validate checks s against the facets of SubscribableType in sep.xsd.
+func (s SubscribableType) Validate() error {
+	return validateFacets(s, "SubscribableType")
+}
+
+// Validate checks t against the facets of TimeOffsetType in sep.xsd.
+func (t TimeOffsetType) Validate() error {
+	return validateFacets(t, "TimeOffsetType")
+}
+
+// Validate checks t against the facets of TimeType in sep.xsd.
+func (t TimeType) Validate() error {
+	return validateFacets(t, "TimeType")
+}
+
+// Validate checks t against the facets of TOUType in sep.xsd.
+func (t TOUType) Validate() error {
+	return validateFacets(t, "TOUType")
+}
+
+// Validate checks u against the facets of UnitType in sep.xsd.
+func (u UnitType) Validate() error {
+	return validateFacets(u, "UnitType")
+}
+
+// Validate checks every value in u against the facets of sep.xsd.
+func (u *UnitValueType) Validate() error {
+	return validateFacets(u, "UnitValueType")
+}
+
+// Validate checks u against the facets of UomType in sep.xsd.
+func (u UomType) Validate() error {
+	return validateFacets(u, "UomType")
+}
+
+// Validate checks v against the facets of VersionType in sep.xsd.
+func (v VersionType) Validate() error {
+	return validateFacets(v, "VersionType")
+}
+
+// Validate checks h against the facets of HexBinary8 in sep.xsd.
+func (h HexBinary8) Validate() error {
+	return validateFacets(h, "HexBinary8")
+}
+
+// Validate checks h against the facets of HexBinary16 in sep.xsd.
+func (h HexBinary16) Validate() error {
+	return validateFacets(h, "HexBinary16")
+}
+
+// Validate checks h against the facets of HexBinary32 in sep.xsd.
+func (h HexBinary32) Validate() error {
+	return validateFacets(h, "HexBinary32")
+}
+
+// Validate checks h against the facets of HexBinary48 in sep.xsd.
+func (h HexBinary48) Validate() error {
+	return validateFacets(h, "HexBinary48")
+}
+
+// Validate checks h against the facets of HexBinary64 in sep.xsd.
+func (h HexBinary64) Validate() error {
+	return validateFacets(h, "HexBinary64")
+}
+
+// Validate checks h against the facets of HexBinary128 in sep.xsd.
+func (h HexBinary128) Validate() error {
+	return validateFacets(h, "HexBinary128")
+}
+
+// Validate checks h against the facets of HexBinary160 in sep.xsd.
+func (h HexBinary160) Validate() error {
+	return validateFacets(h, "HexBinary160")
+}
+
+// Validate checks s against the facets of String2 in sep.xsd.
+func (s String2) Validate() error {
+	return validateFacets(s, "String2")
+}
+
+// Validate checks s against the facets of String3 in sep.xsd.
+func (s String3) Validate() error {
+	return validateFacets(s, "String3")
+}
+
+// Validate checks s against the facets of String6 in sep.xsd.
+func (s String6) Validate() error {
+	return validateFacets(s, "String6")
+}
+
+// Validate checks s against the facets of String16 in sep.xsd.
+func (s String16) Validate() error {
+	return validateFacets(s, "String16")
+}
+
+// Validate checks s against the facets of String20 in sep.xsd.
+func (s String20) Validate() error {
+	return validateFacets(s, "String20")
+}
+
+// Validate checks s against the facets of String32 in sep.xsd.
+func (s String32) Validate() error {
+	return validateFacets(s, "String32")
+}
+
+// Validate checks s against the facets of String42 in sep.xsd.
+func (s String42) Validate() error {
+	return validateFacets(s, "String42")
+}
+
+// Validate checks s against the facets of String192 in sep.xsd.
+func (s String192) Validate() error {
+	return validateFacets(s, "String192")
+}
+
+// Validate checks u against the facets of UInt8 in sep.xsd.
+func (u UInt8) Validate() error {
+	return validateFacets(u, "UInt8")
+}
+
+// Validate checks u against the facets of UInt16 in sep.xsd.
+func (u UInt16) Validate() error {
+	return validateFacets(u, "UInt16")
+}
+
+// Validate checks u against the facets of UInt32 in sep.xsd.
+func (u UInt32) Validate() error {
+	return validateFacets(u, "UInt32")
+}
+
+// Validate checks u against the facets of UInt40 in sep.xsd.
+func (u UInt40) Validate() error {
+	return validateFacets(u, "UInt40")
+}
+
+// Validate checks u against the facets of UInt48 in sep.xsd.
+func (u UInt48) Validate() error {
+	return validateFacets(u, "UInt48")
+}
+
+// Validate checks u against the facets of UInt64 in sep.xsd.
+func (u UInt64) Validate() error {
+	return validateFacets(u, "UInt64")
+}
+
+// Validate checks i against the facets of Int8 in sep.xsd.
+func (i Int8) Validate() error {
+	return validateFacets(i, "Int8")
+}
+
+// Validate checks i against the facets of Int16 in sep.xsd.
+func (i Int16) Validate() error {
+	return validateFacets(i, "Int16")
+}
+
+// Validate checks i against the facets of Int32 in sep.xsd.
+func (i Int32) Validate() error {
+	return validateFacets(i, "Int32")
+}
+
+// Validate checks i against the facets of Int48 in sep.xsd.
+func (i Int48) Validate() error {
+	return validateFacets(i, "Int48")
+}
+
+// Validate checks i against the facets of Int64 in sep.xsd.
+func (i Int64) Validate() error {
+	return validateFacets(i, "Int64")
+}
+
+// Validate checks s against the facets of SEPVersion in sep.xsd.
+func (s SEPVersion) Validate() error {
+	return validateFacets(s, "SEPVersion")
+}
+
+// Validate checks every value in m against the facets of sep.xsd.
+func (m *MirrorMeterReading) Validate() error {
+	return validateFacets(m, "MirrorMeterReading")
+}
+
+// Validate checks every value in m against the facets of sep.xsd.
+func (m *MirrorMeterReadingList) Validate() error {
+	return validateFacets(m, "MirrorMeterReadingList")
+}
+
+// Validate checks every value in m against the facets of sep.xsd.
+func (m *MeterReadingBase) Validate() error {
+	return validateFacets(m, "MeterReadingBase")
+}
+
+// Validate checks every value in m against the facets of sep.xsd.
+func (m *MirrorReadingSet) Validate() error {
+	return validateFacets(m, "MirrorReadingSet")
+}
+
+// Validate checks every value in m against the facets of sep.xsd.
+func (m *MirrorUsagePoint) Validate() error {
+	return validateFacets(m, "MirrorUsagePoint")
+}
+
+// Validate checks every value in m against the facets of sep.xsd.
+func (m *MirrorUsagePointList) Validate() error {
+	return validateFacets(m, "MirrorUsagePointList")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ReadingBase) Validate() error {
+	return validateFacets(r, "ReadingBase")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *ReadingSetBase) Validate() error {
+	return validateFacets(r, "ReadingSetBase")
+}
+
+// Validate checks every value in u against the facets of sep.xsd.
+func (u *UsagePointBase) Validate() error {
+	return validateFacets(u, "UsagePointBase")
+}
+
+// Validate checks every value in r against the facets of sep.xsd.
+func (r *Revision23Type) Validate() error {
+	return validateFacets(r, "Revision23Type")
+}